@@ -26,6 +26,9 @@ type LandscaperConfiguration struct {
 	Registry RegistryConfiguration
 	// BlueprintStore contains the configuration for the blueprint cache.
 	BlueprintStore BlueprintStore
+	// JSONSchema contains the configuration for the validation of jsonschemas.
+	// +optional
+	JSONSchema *JSONSchemaConfiguration
 	// Metrics allows to configure how metrics are exposed
 	//+optional
 	Metrics *MetricsConfiguration
@@ -46,6 +49,14 @@ type LandscaperConfiguration struct {
 	SignatureVerificationEnforcementPolicy SignatureVerificationEnforcementPolicy `json:"signatureVerificationEnforcementPolicy,omitempty"`
 }
 
+// JSONSchemaConfiguration contains the configuration for the validation of jsonschemas.
+type JSONSchemaConfiguration struct {
+	// AssertAllFormats enables the validation of the formats duration, period and semver.
+	// These formats are ignored by default, as previous versions of the landscaper did not validate them.
+	// +optional
+	AssertAllFormats bool
+}
+
 // LsDeployments contains the names of the landscaper deployments.
 type LsDeployments struct {
 	// LsController is the name of the Landscaper controller deployment.
@@ -109,6 +120,8 @@ type Controllers struct {
 	DeployItems DeployItemsController
 	// Contexts contains the controller config that reconciles context objects.
 	Contexts ContextsController
+	// Targets contains the controller config that probes the health of targets.
+	Targets TargetsController
 }
 
 // InstallationsController contains the controller config that reconciles installations.
@@ -150,6 +163,27 @@ type ContextControllerDefaultConfig struct {
 	RepositoryContext *cdv2.UnstructuredTypedObject
 }
 
+// TargetsController contains all configuration for the target health controller.
+type TargetsController struct {
+	CommonControllerConfig
+	Config TargetControllerConfig
+}
+
+// TargetControllerConfig contains the target health specific configuration.
+type TargetControllerConfig struct {
+	// Disable disables the target health controller.
+	// If disabled the status of targets is not maintained anymore.
+	Disable bool
+	// ProbeInterval defines the interval in which the api server of a target is probed.
+	// Defaults to 5 minutes.
+	// +optional
+	ProbeInterval *metav1.Duration
+	// ProbeTimeout defines the timeout of a single probe.
+	// Defaults to 10 seconds.
+	// +optional
+	ProbeTimeout *metav1.Duration
+}
+
 // DeployItemTimeouts contains multiple timeout configurations for deploy items
 type DeployItemTimeouts struct {
 	// PickupTimeout defines how long a deployer can take to react on changes to a deploy item before the landscaper will mark it as failed.
@@ -33,6 +33,8 @@ func SetDefaults_LandscaperConfiguration(obj *LandscaperConfiguration) {
 	SetDefaults_CommonControllerConfig(&obj.Controllers.Executions.CommonControllerConfig)
 	SetDefaults_CommonControllerConfig(&obj.Controllers.DeployItems.CommonControllerConfig)
 	SetDefaults_CommonControllerConfig(&obj.Controllers.Contexts.CommonControllerConfig)
+	SetDefaults_CommonControllerConfig(&obj.Controllers.Targets.CommonControllerConfig)
+	SetDefaults_TargetControllerConfig(&obj.Controllers.Targets.Config)
 
 	if obj.DeployItemTimeouts == nil {
 		obj.DeployItemTimeouts = &DeployItemTimeouts{}
@@ -80,6 +82,16 @@ func SetDefaults_CommonControllerConfig(obj *CommonControllerConfig) {
 	}
 }
 
+// SetDefaults_TargetControllerConfig sets the defaults for the target health controller configuration.
+func SetDefaults_TargetControllerConfig(obj *TargetControllerConfig) {
+	if obj.ProbeInterval == nil {
+		obj.ProbeInterval = &metav1.Duration{Duration: 5 * time.Minute}
+	}
+	if obj.ProbeTimeout == nil {
+		obj.ProbeTimeout = &metav1.Duration{Duration: 10 * time.Second}
+	}
+}
+
 // SetDefaults_BlueprintStore sets the defaults for the landscaper blueprint store configuration.
 func SetDefaults_BlueprintStore(obj *BlueprintStore) {
 	// GCHighThreshold defines the default percent of disk usage which triggers files garbage collection.
@@ -26,6 +26,9 @@ type LandscaperConfiguration struct {
 	Registry RegistryConfiguration `json:"registry"`
 	// BlueprintStore contains the configuration for the blueprint cache.
 	BlueprintStore BlueprintStore `json:"blueprintStore"`
+	// JSONSchema contains the configuration for the validation of jsonschemas.
+	// +optional
+	JSONSchema *JSONSchemaConfiguration `json:"jsonSchema,omitempty"`
 	// Metrics allows to configure how metrics are exposed
 	//+optional
 	Metrics *MetricsConfiguration `json:"metrics,omitempty"`
@@ -46,6 +49,14 @@ type LandscaperConfiguration struct {
 	SignatureVerificationEnforcementPolicy SignatureVerificationEnforcementPolicy `json:"signatureVerificationEnforcementPolicy,omitempty"`
 }
 
+// JSONSchemaConfiguration contains the configuration for the validation of jsonschemas.
+type JSONSchemaConfiguration struct {
+	// AssertAllFormats enables the validation of the formats duration, period and semver.
+	// These formats are ignored by default, as previous versions of the landscaper did not validate them.
+	// +optional
+	AssertAllFormats bool `json:"assertAllFormats,omitempty"`
+}
+
 // LsDeployments contains the names of the landscaper deployments.
 type LsDeployments struct {
 	// LsController is the name of the Landscaper controller deployment.
@@ -82,6 +93,32 @@ type CommonControllerConfig struct {
 	CacheSyncTimeout *metav1.Duration `json:"cacheSyncTimeout"`
 }
 
+// TargetResolversConfiguration enables built-in target resolvers of a deployer that are disabled by default,
+// because they execute commands or read files in the deployer.
+type TargetResolversConfiguration struct {
+	// Exec enables the exec target resolver.
+	// +optional
+	Exec *ExecTargetResolverConfiguration `json:"exec,omitempty"`
+	// KubeconfigDirectory enables the kubeconfig-directory target resolver.
+	// +optional
+	KubeconfigDirectory *KubeconfigDirectoryTargetResolverConfiguration `json:"kubeconfigDirectory,omitempty"`
+}
+
+// ExecTargetResolverConfiguration configures the exec target resolver.
+type ExecTargetResolverConfiguration struct {
+	// AllowedCommands are the commands that Targets may use as exec credential plugin.
+	// Targets with other commands are not resolved.
+	AllowedCommands []string `json:"allowedCommands"`
+}
+
+// KubeconfigDirectoryTargetResolverConfiguration configures the kubeconfig-directory target resolver.
+type KubeconfigDirectoryTargetResolverConfiguration struct {
+	// Directory is the directory from which the kubeconfigs are read.
+	// Defaults to /etc/landscaper/kubeconfigs.
+	// +optional
+	Directory string `json:"directory,omitempty"`
+}
+
 // Controllers contains all configuration for the specific controllers
 type Controllers struct {
 	// SyncPeriod determines the minimum frequency at which watched resources are
@@ -109,6 +146,8 @@ type Controllers struct {
 	DeployItems DeployItemsController `json:"deployItems"`
 	// Contexts contains the controller config that reconciles context objects.
 	Contexts ContextsController `json:"contexts"`
+	// Targets contains the controller config that probes the health of targets.
+	Targets TargetsController `json:"targets"`
 }
 
 // InstallationsController contains the controller config that reconciles installations.
@@ -150,6 +189,27 @@ type ContextControllerDefaultConfig struct {
 	RepositoryContext *cdv2.UnstructuredTypedObject `json:"repositoryContext,omitempty"`
 }
 
+// TargetsController contains all configuration for the target health controller.
+type TargetsController struct {
+	CommonControllerConfig
+	Config TargetControllerConfig `json:"config"`
+}
+
+// TargetControllerConfig contains the target health specific configuration.
+type TargetControllerConfig struct {
+	// Disable disables the target health controller.
+	// If disabled the status of targets is not maintained anymore.
+	Disable bool `json:"disable"`
+	// ProbeInterval defines the interval in which the api server of a target is probed.
+	// Defaults to 5 minutes.
+	// +optional
+	ProbeInterval *metav1.Duration `json:"probeInterval,omitempty"`
+	// ProbeTimeout defines the timeout of a single probe.
+	// Defaults to 10 seconds.
+	// +optional
+	ProbeTimeout *metav1.Duration `json:"probeTimeout,omitempty"`
+}
+
 // DeployItemTimeouts contains multiple timeout configurations for deploy items
 type DeployItemTimeouts struct {
 	// PickupTimeout defines how long a deployer can take to react on changes to a deploy item before the landscaper will mark it as failed.
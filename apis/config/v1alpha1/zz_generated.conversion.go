@@ -168,6 +168,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*JSONSchemaConfiguration)(nil), (*config.JSONSchemaConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_JSONSchemaConfiguration_To_config_JSONSchemaConfiguration(a.(*JSONSchemaConfiguration), b.(*config.JSONSchemaConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.JSONSchemaConfiguration)(nil), (*JSONSchemaConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_JSONSchemaConfiguration_To_v1alpha1_JSONSchemaConfiguration(a.(*config.JSONSchemaConfiguration), b.(*JSONSchemaConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*LandscaperConfiguration)(nil), (*config.LandscaperConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_LandscaperConfiguration_To_config_LandscaperConfiguration(a.(*LandscaperConfiguration), b.(*config.LandscaperConfiguration), scope)
 	}); err != nil {
@@ -238,6 +248,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*TargetControllerConfig)(nil), (*config.TargetControllerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TargetControllerConfig_To_config_TargetControllerConfig(a.(*TargetControllerConfig), b.(*config.TargetControllerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.TargetControllerConfig)(nil), (*TargetControllerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_TargetControllerConfig_To_v1alpha1_TargetControllerConfig(a.(*config.TargetControllerConfig), b.(*TargetControllerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TargetsController)(nil), (*config.TargetsController)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TargetsController_To_config_TargetsController(a.(*TargetsController), b.(*config.TargetsController), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.TargetsController)(nil), (*TargetsController)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_TargetsController_To_v1alpha1_TargetsController(a.(*config.TargetsController), b.(*TargetsController), scope)
+	}); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -405,6 +435,9 @@ func autoConvert_v1alpha1_Controllers_To_config_Controllers(in *Controllers, out
 	if err := Convert_v1alpha1_ContextsController_To_config_ContextsController(&in.Contexts, &out.Contexts, s); err != nil {
 		return err
 	}
+	if err := Convert_v1alpha1_TargetsController_To_config_TargetsController(&in.Targets, &out.Targets, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -427,6 +460,9 @@ func autoConvert_config_Controllers_To_v1alpha1_Controllers(in *config.Controlle
 	if err := Convert_config_ContextsController_To_v1alpha1_ContextsController(&in.Contexts, &out.Contexts, s); err != nil {
 		return err
 	}
+	if err := Convert_config_TargetsController_To_v1alpha1_TargetsController(&in.Targets, &out.Targets, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -603,6 +639,26 @@ func Convert_config_InstallationsController_To_v1alpha1_InstallationsController(
 	return autoConvert_config_InstallationsController_To_v1alpha1_InstallationsController(in, out, s)
 }
 
+func autoConvert_v1alpha1_JSONSchemaConfiguration_To_config_JSONSchemaConfiguration(in *JSONSchemaConfiguration, out *config.JSONSchemaConfiguration, s conversion.Scope) error {
+	out.AssertAllFormats = in.AssertAllFormats
+	return nil
+}
+
+// Convert_v1alpha1_JSONSchemaConfiguration_To_config_JSONSchemaConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_JSONSchemaConfiguration_To_config_JSONSchemaConfiguration(in *JSONSchemaConfiguration, out *config.JSONSchemaConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_JSONSchemaConfiguration_To_config_JSONSchemaConfiguration(in, out, s)
+}
+
+func autoConvert_config_JSONSchemaConfiguration_To_v1alpha1_JSONSchemaConfiguration(in *config.JSONSchemaConfiguration, out *JSONSchemaConfiguration, s conversion.Scope) error {
+	out.AssertAllFormats = in.AssertAllFormats
+	return nil
+}
+
+// Convert_config_JSONSchemaConfiguration_To_v1alpha1_JSONSchemaConfiguration is an autogenerated conversion function.
+func Convert_config_JSONSchemaConfiguration_To_v1alpha1_JSONSchemaConfiguration(in *config.JSONSchemaConfiguration, out *JSONSchemaConfiguration, s conversion.Scope) error {
+	return autoConvert_config_JSONSchemaConfiguration_To_v1alpha1_JSONSchemaConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_LandscaperConfiguration_To_config_LandscaperConfiguration(in *LandscaperConfiguration, out *config.LandscaperConfiguration, s conversion.Scope) error {
 	if err := Convert_v1alpha1_Controllers_To_config_Controllers(&in.Controllers, &out.Controllers, s); err != nil {
 		return err
@@ -614,6 +670,7 @@ func autoConvert_v1alpha1_LandscaperConfiguration_To_config_LandscaperConfigurat
 	if err := Convert_v1alpha1_BlueprintStore_To_config_BlueprintStore(&in.BlueprintStore, &out.BlueprintStore, s); err != nil {
 		return err
 	}
+	out.JSONSchema = (*config.JSONSchemaConfiguration)(unsafe.Pointer(in.JSONSchema))
 	out.Metrics = (*config.MetricsConfiguration)(unsafe.Pointer(in.Metrics))
 	if err := Convert_v1alpha1_CrdManagementConfiguration_To_config_CrdManagementConfiguration(&in.CrdManagement, &out.CrdManagement, s); err != nil {
 		return err
@@ -641,6 +698,7 @@ func autoConvert_config_LandscaperConfiguration_To_v1alpha1_LandscaperConfigurat
 	if err := Convert_config_BlueprintStore_To_v1alpha1_BlueprintStore(&in.BlueprintStore, &out.BlueprintStore, s); err != nil {
 		return err
 	}
+	out.JSONSchema = (*JSONSchemaConfiguration)(unsafe.Pointer(in.JSONSchema))
 	out.Metrics = (*MetricsConfiguration)(unsafe.Pointer(in.Metrics))
 	if err := Convert_config_CrdManagementConfiguration_To_v1alpha1_CrdManagementConfiguration(&in.CrdManagement, &out.CrdManagement, s); err != nil {
 		return err
@@ -796,3 +854,57 @@ func autoConvert_config_RegistryConfiguration_To_v1alpha1_RegistryConfiguration(
 func Convert_config_RegistryConfiguration_To_v1alpha1_RegistryConfiguration(in *config.RegistryConfiguration, out *RegistryConfiguration, s conversion.Scope) error {
 	return autoConvert_config_RegistryConfiguration_To_v1alpha1_RegistryConfiguration(in, out, s)
 }
+
+func autoConvert_v1alpha1_TargetControllerConfig_To_config_TargetControllerConfig(in *TargetControllerConfig, out *config.TargetControllerConfig, s conversion.Scope) error {
+	out.Disable = in.Disable
+	out.ProbeInterval = (*v1.Duration)(unsafe.Pointer(in.ProbeInterval))
+	out.ProbeTimeout = (*v1.Duration)(unsafe.Pointer(in.ProbeTimeout))
+	return nil
+}
+
+// Convert_v1alpha1_TargetControllerConfig_To_config_TargetControllerConfig is an autogenerated conversion function.
+func Convert_v1alpha1_TargetControllerConfig_To_config_TargetControllerConfig(in *TargetControllerConfig, out *config.TargetControllerConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_TargetControllerConfig_To_config_TargetControllerConfig(in, out, s)
+}
+
+func autoConvert_config_TargetControllerConfig_To_v1alpha1_TargetControllerConfig(in *config.TargetControllerConfig, out *TargetControllerConfig, s conversion.Scope) error {
+	out.Disable = in.Disable
+	out.ProbeInterval = (*v1.Duration)(unsafe.Pointer(in.ProbeInterval))
+	out.ProbeTimeout = (*v1.Duration)(unsafe.Pointer(in.ProbeTimeout))
+	return nil
+}
+
+// Convert_config_TargetControllerConfig_To_v1alpha1_TargetControllerConfig is an autogenerated conversion function.
+func Convert_config_TargetControllerConfig_To_v1alpha1_TargetControllerConfig(in *config.TargetControllerConfig, out *TargetControllerConfig, s conversion.Scope) error {
+	return autoConvert_config_TargetControllerConfig_To_v1alpha1_TargetControllerConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_TargetsController_To_config_TargetsController(in *TargetsController, out *config.TargetsController, s conversion.Scope) error {
+	if err := Convert_v1alpha1_CommonControllerConfig_To_config_CommonControllerConfig(&in.CommonControllerConfig, &out.CommonControllerConfig, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_TargetControllerConfig_To_config_TargetControllerConfig(&in.Config, &out.Config, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_TargetsController_To_config_TargetsController is an autogenerated conversion function.
+func Convert_v1alpha1_TargetsController_To_config_TargetsController(in *TargetsController, out *config.TargetsController, s conversion.Scope) error {
+	return autoConvert_v1alpha1_TargetsController_To_config_TargetsController(in, out, s)
+}
+
+func autoConvert_config_TargetsController_To_v1alpha1_TargetsController(in *config.TargetsController, out *TargetsController, s conversion.Scope) error {
+	if err := Convert_config_CommonControllerConfig_To_v1alpha1_CommonControllerConfig(&in.CommonControllerConfig, &out.CommonControllerConfig, s); err != nil {
+		return err
+	}
+	if err := Convert_config_TargetControllerConfig_To_v1alpha1_TargetControllerConfig(&in.Config, &out.Config, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_config_TargetsController_To_v1alpha1_TargetsController is an autogenerated conversion function.
+func Convert_config_TargetsController_To_v1alpha1_TargetsController(in *config.TargetsController, out *TargetsController, s conversion.Scope) error {
+	return autoConvert_config_TargetsController_To_v1alpha1_TargetsController(in, out, s)
+}
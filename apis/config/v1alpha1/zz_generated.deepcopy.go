@@ -146,6 +146,7 @@ func (in *Controllers) DeepCopyInto(out *Controllers) {
 	in.Executions.DeepCopyInto(&out.Executions)
 	in.DeployItems.DeepCopyInto(&out.DeployItems)
 	in.Contexts.DeepCopyInto(&out.Contexts)
+	in.Targets.DeepCopyInto(&out.Targets)
 	return
 }
 
@@ -228,6 +229,27 @@ func (in *DeployItemsController) DeepCopy() *DeployItemsController {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExecTargetResolverConfiguration) DeepCopyInto(out *ExecTargetResolverConfiguration) {
+	*out = *in
+	if in.AllowedCommands != nil {
+		in, out := &in.AllowedCommands, &out.AllowedCommands
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExecTargetResolverConfiguration.
+func (in *ExecTargetResolverConfiguration) DeepCopy() *ExecTargetResolverConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ExecTargetResolverConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExecutionsController) DeepCopyInto(out *ExecutionsController) {
 	*out = *in
@@ -299,6 +321,38 @@ func (in *InstallationsController) DeepCopy() *InstallationsController {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JSONSchemaConfiguration) DeepCopyInto(out *JSONSchemaConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JSONSchemaConfiguration.
+func (in *JSONSchemaConfiguration) DeepCopy() *JSONSchemaConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(JSONSchemaConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeconfigDirectoryTargetResolverConfiguration) DeepCopyInto(out *KubeconfigDirectoryTargetResolverConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeconfigDirectoryTargetResolverConfiguration.
+func (in *KubeconfigDirectoryTargetResolverConfiguration) DeepCopy() *KubeconfigDirectoryTargetResolverConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeconfigDirectoryTargetResolverConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LandscaperConfiguration) DeepCopyInto(out *LandscaperConfiguration) {
 	*out = *in
@@ -310,6 +364,11 @@ func (in *LandscaperConfiguration) DeepCopyInto(out *LandscaperConfiguration) {
 	}
 	in.Registry.DeepCopyInto(&out.Registry)
 	in.BlueprintStore.DeepCopyInto(&out.BlueprintStore)
+	if in.JSONSchema != nil {
+		in, out := &in.JSONSchema, &out.JSONSchema
+		*out = new(JSONSchemaConfiguration)
+		**out = **in
+	}
 	if in.Metrics != nil {
 		in, out := &in.Metrics, &out.Metrics
 		*out = new(MetricsConfiguration)
@@ -472,3 +531,73 @@ func (in *RegistryConfiguration) DeepCopy() *RegistryConfiguration {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetControllerConfig) DeepCopyInto(out *TargetControllerConfig) {
+	*out = *in
+	if in.ProbeInterval != nil {
+		in, out := &in.ProbeInterval, &out.ProbeInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.ProbeTimeout != nil {
+		in, out := &in.ProbeTimeout, &out.ProbeTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetControllerConfig.
+func (in *TargetControllerConfig) DeepCopy() *TargetControllerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetControllerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetResolversConfiguration) DeepCopyInto(out *TargetResolversConfiguration) {
+	*out = *in
+	if in.Exec != nil {
+		in, out := &in.Exec, &out.Exec
+		*out = new(ExecTargetResolverConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KubeconfigDirectory != nil {
+		in, out := &in.KubeconfigDirectory, &out.KubeconfigDirectory
+		*out = new(KubeconfigDirectoryTargetResolverConfiguration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetResolversConfiguration.
+func (in *TargetResolversConfiguration) DeepCopy() *TargetResolversConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetResolversConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetsController) DeepCopyInto(out *TargetsController) {
+	*out = *in
+	in.CommonControllerConfig.DeepCopyInto(&out.CommonControllerConfig)
+	in.Config.DeepCopyInto(&out.Config)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetsController.
+func (in *TargetsController) DeepCopy() *TargetsController {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetsController)
+	in.DeepCopyInto(out)
+	return out
+}
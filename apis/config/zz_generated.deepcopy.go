@@ -146,6 +146,7 @@ func (in *Controllers) DeepCopyInto(out *Controllers) {
 	in.Executions.DeepCopyInto(&out.Executions)
 	in.DeployItems.DeepCopyInto(&out.DeployItems)
 	in.Contexts.DeepCopyInto(&out.Contexts)
+	in.Targets.DeepCopyInto(&out.Targets)
 	return
 }
 
@@ -295,6 +296,22 @@ func (in *InstallationsController) DeepCopy() *InstallationsController {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JSONSchemaConfiguration) DeepCopyInto(out *JSONSchemaConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JSONSchemaConfiguration.
+func (in *JSONSchemaConfiguration) DeepCopy() *JSONSchemaConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(JSONSchemaConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LandscaperConfiguration) DeepCopyInto(out *LandscaperConfiguration) {
 	*out = *in
@@ -306,6 +323,11 @@ func (in *LandscaperConfiguration) DeepCopyInto(out *LandscaperConfiguration) {
 	}
 	in.Registry.DeepCopyInto(&out.Registry)
 	out.BlueprintStore = in.BlueprintStore
+	if in.JSONSchema != nil {
+		in, out := &in.JSONSchema, &out.JSONSchema
+		*out = new(JSONSchemaConfiguration)
+		**out = **in
+	}
 	if in.Metrics != nil {
 		in, out := &in.Metrics, &out.Metrics
 		*out = new(MetricsConfiguration)
@@ -468,3 +490,47 @@ func (in *RegistryConfiguration) DeepCopy() *RegistryConfiguration {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetControllerConfig) DeepCopyInto(out *TargetControllerConfig) {
+	*out = *in
+	if in.ProbeInterval != nil {
+		in, out := &in.ProbeInterval, &out.ProbeInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.ProbeTimeout != nil {
+		in, out := &in.ProbeTimeout, &out.ProbeTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetControllerConfig.
+func (in *TargetControllerConfig) DeepCopy() *TargetControllerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetControllerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetsController) DeepCopyInto(out *TargetsController) {
+	*out = *in
+	in.CommonControllerConfig.DeepCopyInto(&out.CommonControllerConfig)
+	in.Config.DeepCopyInto(&out.Config)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetsController.
+func (in *TargetsController) DeepCopy() *TargetsController {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetsController)
+	in.DeepCopyInto(out)
+	return out
+}
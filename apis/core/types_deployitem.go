@@ -122,6 +122,69 @@ type DeployItemStatus struct {
 	// TransitionTimes contains timestamps of status transitions
 	// +optional
 	TransitionTimes *TransitionTimes `json:"transitionTimes,omitempty"`
+
+	// ApprovalRequest describes the pending or granted approval of the current job of a deploy item which requires an approval.
+	// +optional
+	ApprovalRequest *ApprovalRequest `json:"approvalRequest,omitempty"`
+
+	// History contains the results of the most recent finished jobs of the deploy item, the latest job last.
+	// The number of entries is bounded; the oldest entries are removed.
+	// +optional
+	History []DeployItemHistoryEntry `json:"history,omitempty"`
+}
+
+// DeployItemHistoryEntry is the result of a finished job of a deploy item.
+type DeployItemHistoryEntry struct {
+	// JobID is the ID of the job.
+	JobID string `json:"jobID"`
+
+	// Generation is the generation of the deploy item that was processed by the job.
+	Generation int64 `json:"generation"`
+
+	// Phase is the final phase of the job.
+	Phase DeployItemPhase `json:"phase"`
+
+	// StartTime is the time when the job was started by the deployer.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// EndTime is the time when the job was finished.
+	// +optional
+	EndTime *metav1.Time `json:"endTime,omitempty"`
+
+	// Error is the error of the job, if any. Long error messages are truncated.
+	// +optional
+	Error *Error `json:"error,omitempty"`
+
+	// ConfigurationHash is the sha256 hash of the provider configuration that was applied by the job.
+	// +optional
+	ConfigurationHash string `json:"configurationHash,omitempty"`
+
+	// ManagedResources is the number of resources that are managed by the deploy item after the job,
+	// if the deployer reports managed resources in its provider status.
+	// +optional
+	ManagedResources *int `json:"managedResources,omitempty"`
+}
+
+// ApprovalRequest describes the request to approve a job of a deploy item.
+type ApprovalRequest struct {
+	// JobID is the job of the deploy item that must be approved.
+	JobID string `json:"jobID"`
+
+	// Summary is a human-readable summary of the changes that are applied by the job.
+	// +optional
+	Summary string `json:"summary,omitempty"`
+
+	// RequestTime is the time when the approval has been requested.
+	RequestTime metav1.Time `json:"requestTime"`
+
+	// ApprovedBy is the name of the user who approved the job.
+	// +optional
+	ApprovedBy string `json:"approvedBy,omitempty"`
+
+	// ApprovalTime is the time when the approval has been recognized by the landscaper.
+	// +optional
+	ApprovalTime *metav1.Time `json:"approvalTime,omitempty"`
 }
 
 // DeployerInformation holds additional information about the deployer that
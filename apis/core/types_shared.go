@@ -162,6 +162,8 @@ const (
 	ErrorForInfoOnly ErrorCode = "ERR_FOR_INFO_ONLY"
 	// ErrorNoRetry indicates that no retry is required.
 	ErrorNoRetry ErrorCode = "ERR_NO_RETRY"
+	// ErrorTargetUnreachable indicates that the target of a deploy item is not reachable according to its status.
+	ErrorTargetUnreachable ErrorCode = "ERR_TARGET_UNREACHABLE"
 )
 
 // Condition holds the information about the state of a resource.
@@ -30,6 +30,10 @@ type Target struct {
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 
 	Spec TargetSpec `json:"spec"`
+
+	// Status contains the observed health of the target.
+	// +optional
+	Status *TargetStatus `json:"status,omitempty"`
 }
 
 // TargetSpec contains the definition of a target.
@@ -39,14 +43,65 @@ type TargetSpec struct {
 	Type TargetType `json:"type"`
 
 	// Configuration contains the target type specific configuration.
-	// Exactly one of the fields Configuration and SecretRef must be set
+	// At most one of the fields Configuration, SecretRef and ResolverRef must be set
 	// +optional
 	Configuration *AnyJSON `json:"config,omitempty"`
 
 	// Reference to a secret containing the target type specific configuration.
-	// Exactly one of the fields Configuration and SecretRef must be set
+	// At most one of the fields Configuration, SecretRef and ResolverRef must be set
 	// +optional
 	SecretRef *LocalSecretReference `json:"secretRef,omitempty"`
+
+	// ResolverRef references a target resolver that computes the target type specific configuration.
+	// At most one of the fields Configuration, SecretRef and ResolverRef must be set.
+	// +optional
+	ResolverRef *TargetResolverReference `json:"resolverRef,omitempty"`
+}
+
+// TargetResolverReference references a target resolver and contains its configuration.
+type TargetResolverReference struct {
+	// Name is the name of the target resolver.
+	// Built-in resolvers are "serviceaccount-token", "exec" and "kubeconfig-directory".
+	// Deployers may register additional resolvers.
+	Name string `json:"name"`
+
+	// Config contains the resolver specific configuration.
+	// +optional
+	Config *AnyJSON `json:"config,omitempty"`
+}
+
+// TargetStatus contains the observed health of a target.
+// The status is only maintained for targets of type kubernetes-cluster.
+type TargetStatus struct {
+	// ObservedGeneration is the most recent generation of the target that has been probed.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Reachable indicates whether the api server of the target cluster could be accessed
+	// with the credentials of the target during the last probe.
+	// +optional
+	Reachable *bool `json:"reachable,omitempty"`
+
+	// LastProbeTime is the time when the target has been probed the last time.
+	// +optional
+	LastProbeTime *metav1.Time `json:"lastProbeTime,omitempty"`
+
+	// LastTransitionTime is the time when the reachability of the target has changed the last time.
+	// +optional
+	LastTransitionTime *metav1.Time `json:"lastTransitionTime,omitempty"`
+
+	// ServerVersion is the version of the api server of the target cluster.
+	// +optional
+	ServerVersion string `json:"serverVersion,omitempty"`
+
+	// CredentialExpirationTime is the time when the credentials of the target expire.
+	// It is derived from the client certificate or the token of the target.
+	// +optional
+	CredentialExpirationTime *metav1.Time `json:"credentialExpirationTime,omitempty"`
+
+	// Message describes why the target is not reachable.
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 // TargetTemplate exposes specific parts of a target that are used in the exports
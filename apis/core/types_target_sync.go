@@ -53,23 +53,64 @@ type TargetSyncSpec struct {
 	// SecretNameExpression defines the names of the secrets which should be synced via a regular expression according
 	// to https://github.com/google/re2/wiki/Syntax with the extension that * is also a valid expression and matches
 	// all names.
-	// if not set no secrets are synced
+	// if neither SecretNameExpression, SecretSelector nor ClusterAPISecrets is set no secrets are synced
 	// +optional
 	SecretNameExpression string `json:"secretNameExpression"`
 
+	// SecretSelector selects the secrets which should be synced by their labels.
+	// If it is set together with SecretNameExpression, a secret must match both to be synced.
+	// +optional
+	SecretSelector *metav1.LabelSelector `json:"secretSelector,omitempty"`
+
+	// ClusterAPISecrets specifies that the secrets to be synced are kubeconfig secrets of ClusterAPI clusters.
+	// Such secrets are named "<cluster>-kubeconfig" and contain the kubeconfig in the data key "value".
+	// Other secrets are not synced. The targets are named after the clusters and reference the key "value".
+	// +optional
+	ClusterAPISecrets bool `json:"clusterAPISecrets,omitempty"`
+
 	// ShootNameExpression defines the names of shoot clusters for which targets with short living access data
 	// to the shoots are created via a regular expression according to https://github.com/google/re2/wiki/Syntax with
 	// the extension that * is also a valid expression and matches all names.
-	// if not set no targets for the shoots are created
+	// if neither ShootNameExpression nor ShootSelector is set no targets for the shoots are created
 	// +optional
 	ShootNameExpression string `json:"shootNameExpression"`
 
+	// ShootSelector selects the shoot clusters for which targets are created by their labels.
+	// If it is set together with ShootNameExpression, a shoot must match both.
+	// +optional
+	ShootSelector *metav1.LabelSelector `json:"shootSelector,omitempty"`
+
+	// TargetTemplate defines the name, labels and annotations of the synced targets.
+	// +optional
+	TargetTemplate *TargetSyncTemplate `json:"targetTemplate,omitempty"`
+
 	// TokenRotation defines the data to perform an automatic rotation of the token to access the source cluster with the
 	// secrets to sync. The token expires after 90 days and will be rotated every 60 days.
 	// +optional
 	TokenRotation *TokenRotation `json:"tokenRotation,omitempty"`
 }
 
+// TargetSyncTemplate defines the metadata of the synced targets.
+// All fields are go templates, which are executed with the metadata of the synced secret or shoot:
+// .name, .namespace, .labels and .annotations, as well as .clusterName, which is the name of the cluster
+// for ClusterAPI secrets and the name of the secret or shoot otherwise.
+// The hermetic sprig functions are available in the templates.
+type TargetSyncTemplate struct {
+	// Name is the template for the name of the targets.
+	// Defaults to the name of the synced secret or shoot, or the cluster name for ClusterAPI secrets.
+	// The secrets referenced by the targets have the same name as the targets.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Labels are templates for additional labels of the targets.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Annotations are templates for additional annotations of the targets.
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
 type TokenRotation struct {
 	// Enabled defines if automatic token is executed
 	Enabled bool `json:"enabled,omitempty"`
@@ -13,6 +13,13 @@ const (
 	// DataObjectSecretDataKey is the key of the secret where the landscape and installations stores their merged configuration.
 	DataObjectSecretDataKey = "config"
 
+	// ExecutionDeployItemsSecretDataKey is the key of the secret that contains the deploy items of an execution.
+	ExecutionDeployItemsSecretDataKey = "deployItems"
+
+	// SensitiveValuesSecretDataKey is the key of the deploy items secret of an execution that contains
+	// the sensitive values which have to be masked in the statuses and logs of the execution and its deploy items.
+	SensitiveValuesSecretDataKey = "sensitiveValues"
+
 	// LandscaperFinalizer is the finalizer of the landscaper
 	LandscaperFinalizer = "finalizer." + LandscaperDomain
 
@@ -34,6 +41,10 @@ const (
 	// CacheHelmChartsAnnotation specifies if helm charts of an installation should be cached
 	CacheHelmChartsAnnotation = LandscaperDomain + "/cache-helm-charts"
 
+	// TemplateDebugAnnotation specifies that the debug information of a failed templating of an installation
+	// is written to a secret. Will only have an effect if set to 'true'.
+	TemplateDebugAnnotation = LandscaperDomain + "/template-debug"
+
 	// DeleteIgnoreSuccessors is the annotation that specifies that an installation is deleted even if there
 	// are dependent installations.
 	DeleteIgnoreSuccessors = LandscaperDomain + "/delete-ignore-successors"
@@ -51,6 +62,20 @@ const (
 	// Will only have an effect if set to 'true'.
 	IgnoreAnnotation = LandscaperDomain + "/ignore"
 
+	// SuspendedAnnotation is set by the landscaper on the subinstallations, executions and deploy items
+	// of a suspended installation. Objects with this annotation are not processed.
+	// Will only have an effect if set to 'true'.
+	SuspendedAnnotation = LandscaperDomain + "/suspended"
+
+	// ApprovedJobAnnotation is set by an approver on a deploy item which requires an approval.
+	// Its value is the job ID of the deploy item that is approved.
+	// The approval is validated by the webhook against the configured approver groups.
+	ApprovedJobAnnotation = LandscaperDomain + "/approved-job"
+
+	// ApprovedByAnnotation must be set together with the ApprovedJobAnnotation.
+	// Its value is the name of the approving user, which is validated by the webhook against the identity of the requester.
+	ApprovedByAnnotation = LandscaperDomain + "/approved-by"
+
 	// TouchAnnotation can be used to trigger a reconciliation event for a landscaper resource.
 	TouchAnnotation = LandscaperDomain + "/touch"
 
@@ -64,6 +89,11 @@ const (
 	DeployerTargetNameAnnotation = LandscaperDomain + "/deployer-target-name"
 	NoTargetNameValue            = ".noTargetName"
 
+	// SensitiveValuesSecretAnnotation is set by the landscaper on deploy items that contain sensitive values.
+	// Its value is the name of the secret in the namespace of the deploy item that contains the sensitive values
+	// which have to be masked in the status and the logs of the deploy item.
+	SensitiveValuesSecretAnnotation = LandscaperDomain + "/sensitive-values-secret"
+
 	// Labels
 
 	// LandscaperComponentLabelName is the name of the labels the holds the information about landscaper components.
@@ -114,13 +144,14 @@ const (
 // define common constants for phase names here, so all phases which use any of them
 // will use the same ones
 const (
-	PhaseStringInit            string = "Init"
-	PhaseStringCleanupOrphaned string = "CleanupOrphaned"
-	PhaseStringObjectsCreated  string = "ObjectsCreated"
-	PhaseStringProgressing     string = "Progressing"
-	PhaseStringCompleting      string = "Completing"
-	PhaseStringSucceeded       string = "Succeeded"
-	PhaseStringFailed          string = "Failed"
+	PhaseStringInit               string = "Init"
+	PhaseStringWaitingForApproval string = "WaitingForApproval"
+	PhaseStringCleanupOrphaned    string = "CleanupOrphaned"
+	PhaseStringObjectsCreated     string = "ObjectsCreated"
+	PhaseStringProgressing        string = "Progressing"
+	PhaseStringCompleting         string = "Completing"
+	PhaseStringSucceeded          string = "Succeeded"
+	PhaseStringFailed             string = "Failed"
 
 	PhaseStringInitDelete    string = "InitDelete"
 	PhaseStringTriggerDelete string = "TriggerDelete"
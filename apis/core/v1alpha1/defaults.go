@@ -19,7 +19,7 @@ func addDefaultingFuncs(scheme *runtime.Scheme) error {
 // SetDefaults_Blueprint sets default values for blueprint objects
 func SetDefaults_Blueprint(obj *Blueprint) {
 	if len(obj.JSONSchemaVersion) == 0 {
-		obj.JSONSchemaVersion = "https://json-schema.org/draft/2019-09/schema"
+		obj.JSONSchemaVersion = DefaultJSONSchemaVersion
 	}
 
 	SetDefaults_DefinitionImport(&obj.Imports)
@@ -234,6 +234,21 @@ func HasIgnoreAnnotation(obj metav1.ObjectMeta) bool {
 	return ok && v == "true"
 }
 
+// HasSuspendedAnnotation returns true only if the given object
+// has the 'landscaper.gardener.cloud/suspended' annotation
+// and its value is 'true'.
+func HasSuspendedAnnotation(obj metav1.ObjectMeta) bool {
+	v, ok := obj.GetAnnotations()[v1alpha1.SuspendedAnnotation]
+	return ok && v == "true"
+}
+
+// IsWaitingForApproval returns true if the current job of the given deploy item requires an approval
+// which has not yet been given by the approved-job annotation.
+func IsWaitingForApproval(di *v1alpha1.DeployItem) bool {
+	return di.Status.Phase == v1alpha1.DeployItemPhases.WaitingForApproval &&
+		di.GetAnnotations()[v1alpha1.ApprovedJobAnnotation] != di.Status.GetJobID()
+}
+
 // HasDeleteWithoutUninstallAnnotation returns true only if the given object
 // has the 'landscaper.gardener.cloud/delete-without-uninstall' annotation
 // and its value is 'true'.
@@ -247,6 +262,14 @@ func HasCacheHelmChartsAnnotation(obj *metav1.ObjectMeta) bool {
 	return ok && v == "true"
 }
 
+// HasTemplateDebugAnnotation returns true only if the given object
+// has the 'landscaper.gardener.cloud/template-debug' annotation
+// and its value is 'true'.
+func HasTemplateDebugAnnotation(obj metav1.ObjectMeta) bool {
+	v, ok := obj.GetAnnotations()[v1alpha1.TemplateDebugAnnotation]
+	return ok && v == "true"
+}
+
 func SetCacheHelmChartsAnnotation(obj *metav1.ObjectMeta) {
 	metav1.SetMetaDataAnnotation(obj, v1alpha1.CacheHelmChartsAnnotation, "true")
 }
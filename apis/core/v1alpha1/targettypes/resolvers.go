@@ -0,0 +1,56 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package targettypes
+
+import (
+	v1 "k8s.io/api/core/v1"
+)
+
+const (
+	// ServiceAccountTokenResolverName is the name of the built-in target resolver that requests a token
+	// for a service account in the landscaper resource cluster.
+	ServiceAccountTokenResolverName = "serviceaccount-token"
+	// ExecResolverName is the name of the built-in target resolver that creates a kubeconfig with an exec credential plugin.
+	ExecResolverName = "exec"
+	// KubeconfigDirectoryResolverName is the name of the built-in target resolver that reads a kubeconfig
+	// from a directory that is mounted into the deployer.
+	KubeconfigDirectoryResolverName = "kubeconfig-directory"
+)
+
+// ServiceAccountTokenResolverConfig is the configuration of the serviceaccount-token target resolver.
+// The resolver requests a token for the service account in the namespace of the target and
+// resolves the target to a kubeconfig for the landscaper resource cluster that contains the token.
+type ServiceAccountTokenResolverConfig struct {
+	ServiceAccount    v1.LocalObjectReference `json:"serviceAccount"`
+	Audience          []string                `json:"audience,omitempty"`
+	ExpirationSeconds *int64                  `json:"expirationSeconds,omitempty"`
+}
+
+// ExecResolverConfig is the configuration of the exec target resolver.
+// The resolver resolves the target to a kubeconfig that uses the exec credential plugin to obtain credentials.
+// The command must be available in the image of the deployer that uses the target.
+type ExecResolverConfig struct {
+	Server string `json:"server"`
+	CAData []byte `json:"caData,omitempty"`
+	// APIVersion is the api version of the ExecCredential that is expected from the plugin.
+	// Defaults to client.authentication.k8s.io/v1.
+	APIVersion string       `json:"apiVersion,omitempty"`
+	Command    string       `json:"command"`
+	Args       []string     `json:"args,omitempty"`
+	Env        []ExecEnvVar `json:"env,omitempty"`
+}
+
+// ExecEnvVar is an environment variable that is passed to an exec credential plugin.
+type ExecEnvVar struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// KubeconfigDirectoryResolverConfig is the configuration of the kubeconfig-directory target resolver.
+// The resolver resolves the target to the kubeconfig with the given file name
+// in the kubeconfig directory of the deployer.
+type KubeconfigDirectoryResolverConfig struct {
+	Name string `json:"name"`
+}
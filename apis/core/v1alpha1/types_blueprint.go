@@ -11,6 +11,9 @@ import (
 // BlueprintResourceType is the name of the blueprint resource defined in component descriptors.
 const BlueprintResourceType = "blueprint"
 
+// DefaultJSONSchemaVersion is the jsonschema version of blueprints that do not define a jsonSchemaVersion.
+const DefaultJSONSchemaVersion = "https://json-schema.org/draft/2019-09/schema"
+
 // ImportType is a string alias
 type ImportType string
 
@@ -66,6 +69,12 @@ type Blueprint struct {
 	// +optional
 	ImportExecutions []TemplateExecutor `json:"importExecutions,omitempty"`
 
+	// GeneratedSecrets defines certificates, keys and passwords that are generated by the landscaper.
+	// The generated values are stored in secrets owned by the installation and are available in the templates
+	// as imports with the names of the definitions.
+	// +optional
+	GeneratedSecrets []GeneratedSecretDefinition `json:"generatedSecrets,omitempty"`
+
 	// Exports define the exported values of the definition and its sub-definitions
 	// +optional
 	Exports ExportDefinitionList `json:"exports,omitempty"`
@@ -110,12 +119,143 @@ type ImportDefinition struct {
 	// Default sets a default value for the current import that is used if the key is not set.
 	Default Default `json:"default,omitempty"`
 
+	// Sensitive marks the imported value as sensitive.
+	// Sensitive values are stored in secrets instead of data objects, are masked in error messages and logs
+	// and the deploy items of the installation are not stored in clear text in the execution.
+	// +optional
+	Sensitive bool `json:"sensitive,omitempty"`
+
 	// ConditionalImports are Imports that are only valid if this imports is satisfied.
 	// Does only make sense for optional imports.
 	// +optional
 	ConditionalImports ImportDefinitionList `json:"imports,omitempty"`
 }
 
+// GeneratedSecretType describes the type of a generated secret.
+type GeneratedSecretType string
+
+const (
+	// GeneratedSecretTypeCA is a self-signed certificate authority.
+	GeneratedSecretTypeCA GeneratedSecretType = "ca"
+	// GeneratedSecretTypeCertificate is a certificate that is signed by a generated certificate authority
+	// or self-signed.
+	GeneratedSecretTypeCertificate GeneratedSecretType = "certificate"
+	// GeneratedSecretTypeSSHKeyPair is a ssh key pair.
+	GeneratedSecretTypeSSHKeyPair GeneratedSecretType = "sshKeyPair"
+	// GeneratedSecretTypePassword is a random password.
+	GeneratedSecretTypePassword GeneratedSecretType = "password"
+)
+
+// SSHKeyAlgorithm describes the algorithm of a generated ssh key pair.
+type SSHKeyAlgorithm string
+
+const (
+	// SSHKeyAlgorithmEd25519 is the ed25519 algorithm.
+	SSHKeyAlgorithmEd25519 SSHKeyAlgorithm = "ed25519"
+	// SSHKeyAlgorithmRSA is the rsa algorithm.
+	SSHKeyAlgorithmRSA SSHKeyAlgorithm = "rsa"
+)
+
+// GeneratedSecretDefinition defines a secret that is generated once and stored in a secret owned by the installation.
+type GeneratedSecretDefinition struct {
+	// Name is the name of the generated secret and of the import under which its value is available.
+	Name string `json:"name"`
+
+	// Type is the type of the generated secret.
+	Type GeneratedSecretType `json:"type"`
+
+	// Certificate configures a generated certificate authority or certificate.
+	// +optional
+	Certificate *GeneratedCertificate `json:"certificate,omitempty"`
+
+	// SSHKeyPair configures a generated ssh key pair.
+	// +optional
+	SSHKeyPair *GeneratedSSHKeyPair `json:"sshKeyPair,omitempty"`
+
+	// Password configures a generated password.
+	// +optional
+	Password *GeneratedPassword `json:"password,omitempty"`
+
+	// Rotation defines when the generated secret is replaced by a new one.
+	// +optional
+	Rotation *GeneratedSecretRotation `json:"rotation,omitempty"`
+}
+
+// GeneratedCertificate configures a generated certificate authority or certificate.
+type GeneratedCertificate struct {
+	// CommonName is the common name of the certificate.
+	CommonName string `json:"commonName"`
+
+	// Organization is the list of organizations of the certificate.
+	// +optional
+	Organization []string `json:"organization,omitempty"`
+
+	// DNSNames is the list of dns names of the certificate.
+	// +optional
+	DNSNames []string `json:"dnsNames,omitempty"`
+
+	// IPAddresses is the list of ip addresses of the certificate.
+	// +optional
+	IPAddresses []string `json:"ipAddresses,omitempty"`
+
+	// SANsFromImports adds the values of imports as subject alternative names.
+	// The referenced values must be strings or lists of strings. Ip addresses are added as ip addresses,
+	// all other values as dns names.
+	// +optional
+	SANsFromImports []GeneratedSecretImportReference `json:"sansFromImports,omitempty"`
+
+	// CA is the name of the generated certificate authority that signs the certificate.
+	// The certificate is self-signed if no certificate authority is set.
+	// +optional
+	CA string `json:"ca,omitempty"`
+
+	// Validity is the duration for which the certificate is valid.
+	// Defaults to 10 years for certificate authorities and to 1 year for certificates.
+	// +optional
+	Validity *Duration `json:"validity,omitempty"`
+}
+
+// GeneratedSecretImportReference references the value of an import.
+type GeneratedSecretImportReference struct {
+	// Import is the name of the import.
+	Import string `json:"import"`
+
+	// Path is an optional jsonpath to a value within the import, e.g. ".ingress.hosts".
+	// +optional
+	Path string `json:"path,omitempty"`
+}
+
+// GeneratedSSHKeyPair configures a generated ssh key pair.
+type GeneratedSSHKeyPair struct {
+	// Algorithm is the algorithm of the key pair. Defaults to ed25519.
+	// +optional
+	Algorithm SSHKeyAlgorithm `json:"algorithm,omitempty"`
+
+	// Bits is the size of rsa keys. Defaults to 4096.
+	// +optional
+	Bits int `json:"bits,omitempty"`
+}
+
+// GeneratedPassword configures a generated password.
+type GeneratedPassword struct {
+	// Length is the length of the password. Defaults to 32.
+	// +optional
+	Length int `json:"length,omitempty"`
+}
+
+// GeneratedSecretRotation defines when a generated secret is replaced by a new one.
+type GeneratedSecretRotation struct {
+	// RenewBefore is the remaining validity of certificate authorities and certificates at which they are renewed.
+	// Defaults to a third of their validity.
+	// +optional
+	RenewBefore *Duration `json:"renewBefore,omitempty"`
+
+	// Interval is the duration after which a secret is regenerated.
+	// Passwords and ssh key pairs are not rotated if no interval is set.
+	// +optional
+	Interval *Duration `json:"interval,omitempty"`
+}
+
 // ExportDefinitionList defines a list of export definitions.
 type ExportDefinitionList []ExportDefinition
 
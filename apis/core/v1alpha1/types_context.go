@@ -69,6 +69,22 @@ type ContextConfiguration struct {
 	// VerificationSignatures maps a signature name to the trusted verification information
 	// +optional
 	VerificationSignatures map[string]VerificationSignature `json:"verificationSignatures,omitempty"`
+
+	// MaintenanceWindows restrict the automatic reconciles of installations and the continuous reconciles of deploy items
+	// that use this context to the given time windows. Work that becomes due outside of the windows is queued until
+	// the begin of the next window. If no window is defined, there is no restriction.
+	// +optional
+	MaintenanceWindows []MaintenanceWindow `json:"maintenanceWindows,omitempty"`
+}
+
+// MaintenanceWindow defines a recurring time window.
+type MaintenanceWindow struct {
+	// CronSpec defines the begin of the window as cron expression, e.g. "0 22 * * 1-5".
+	// A time zone can be specified with the prefix "CRON_TZ=", e.g. "CRON_TZ=Europe/Berlin 0 22 * * 1-5".
+	// The default time zone is UTC.
+	CronSpec string `json:"cronSpec"`
+	// Duration is the length of the window.
+	Duration Duration `json:"duration"`
 }
 
 // VerificationSignatures contains the trusted verification information
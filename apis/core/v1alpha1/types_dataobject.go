@@ -45,6 +45,9 @@ const DataObjectJobIDLabel = "data.landscaper.gardener.cloud/jobid"
 // DataObjectHashAnnotation defines the name of the annotation that specifies the hash of the data.
 const DataObjectHashAnnotation = "data.landscaper.gardener.cloud/hash"
 
+// DataObjectSensitiveLabel marks a secret that is used instead of a dataobject because the data is sensitive.
+const DataObjectSensitiveLabel = "data.landscaper.gardener.cloud/sensitive"
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // DataObjectList contains a list of DataObject
@@ -10,6 +10,7 @@ import (
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	"github.com/gardener/landscaper/apis/utils"
 )
@@ -129,6 +130,16 @@ type ExecutionSpec struct {
 
 	// DeployItemsCompressed as zipped byte array
 	DeployItemsCompressed []byte `json:"deployItemsCompressed,omitempty"`
+
+	// DeployItemsSecretRef references a secret that contains the deploy items of the execution.
+	// It is used instead of the inline deploy items if the deploy items contain sensitive values.
+	// +optional
+	DeployItemsSecretRef *ObjectReference `json:"deployItemsSecretRef,omitempty"`
+
+	// Rollouts define the progressive rollout of groups of deploy items, e.g. of the deploy items
+	// for the targets of a target map.
+	// +optional
+	Rollouts []Rollout `json:"rollouts,omitempty"`
 }
 
 // ExecutionStatus contains the current status of a execution.
@@ -171,6 +182,78 @@ type ExecutionStatus struct {
 	// TransitionTimes contains timestamps of status transitions
 	// +optional
 	TransitionTimes *TransitionTimes `json:"transitionTimes,omitempty"`
+
+	// Rollouts contains the progress of the rollouts of the current job.
+	// +optional
+	Rollouts []RolloutStatus `json:"rollouts,omitempty"`
+}
+
+// Rollout defines the progressive rollout of a group of deploy items or subinstallations.
+// The deploy items or subinstallations are rolled out target by target in batches. A batch is started when the previous
+// batch has finished, the pause between the batches has passed, and not more targets have failed than allowed.
+type Rollout struct {
+	// Name is the unique name of the rollout.
+	Name string `json:"name"`
+
+	// Targets are the targets of the rollout with their deploy items or subinstallations
+	// in the order in which they are rolled out.
+	Targets []RolloutTarget `json:"targets"`
+
+	// Canary is the key of a target that is rolled out first and must succeed before the other targets are rolled out.
+	// +optional
+	Canary string `json:"canary,omitempty"`
+
+	// BatchSize is the number or the percentage of targets that are rolled out in parallel. The canary is not counted.
+	// Defaults to all targets.
+	// +optional
+	BatchSize *intstr.IntOrString `json:"batchSize,omitempty"`
+
+	// PauseBetweenBatches is the time between the end of a batch and the start of the next batch.
+	// +optional
+	PauseBetweenBatches *Duration `json:"pauseBetweenBatches,omitempty"`
+
+	// MaxFailedTargets is the number of failed targets that is tolerated. If more targets fail, no further batch is started.
+	// Defaults to 0.
+	// +optional
+	MaxFailedTargets *int `json:"maxFailedTargets,omitempty"`
+}
+
+// RolloutTarget defines the deploy items or the subinstallations of a target of a rollout.
+type RolloutTarget struct {
+	// Key is the key of the target in the target map.
+	Key string `json:"key"`
+
+	// DeployItems are the names of the deploy items that deploy to the target.
+	// +optional
+	DeployItems []string `json:"deployItems,omitempty"`
+
+	// Installations are the names of the installation templates of the subinstallations that import the target.
+	// +optional
+	Installations []string `json:"installations,omitempty"`
+}
+
+// RolloutStatus contains the progress of a rollout.
+type RolloutStatus struct {
+	// Name is the name of the rollout.
+	Name string `json:"name"`
+
+	// Batches is the number of batches of the rollout including the canary.
+	Batches int `json:"batches"`
+
+	// CompletedBatches is the number of finished batches.
+	CompletedBatches int `json:"completedBatches"`
+
+	// FailedTargets contains the keys of the failed targets.
+	// +optional
+	FailedTargets []string `json:"failedTargets,omitempty"`
+
+	// Stopped is true if the rollout has been stopped because the canary or too many targets failed.
+	// +optional
+	Stopped bool `json:"stopped,omitempty"`
+
+	// NextBatchTime is the time when the next batch is started after a pause.
+	// +optional
+	NextBatchTime *metav1.Time `json:"nextBatchTime,omitempty"`
 }
 
 // DeployItemTemplateList is a list of deploy item templates
@@ -213,6 +296,10 @@ type DeployItemTemplate struct {
 
 	// OnDelete specifies particular setting when deleting a deploy item
 	OnDelete *OnDeleteConfig `json:"onDelete,omitempty"`
+
+	// RequireApproval specifies that every job of the deploy item must be approved before it is picked up by a deployer.
+	// +optional
+	RequireApproval bool `json:"requireApproval,omitempty"`
 }
 
 // OnDeleteConfig specifies particular setting when deleting a deploy item
@@ -22,6 +22,14 @@ const EncompassedByLabel = "landscaper.gardener.cloud/encompassed-by"
 // todo: add conversion
 const SubinstallationNameAnnotation = "landscaper.gardener.cloud/subinstallation-name"
 
+// GeneratedSecretInstallationLabel is the label of generated secrets that contains the name of the installation
+// the secrets are generated for.
+const GeneratedSecretInstallationLabel = "landscaper.gardener.cloud/generated-secret-of"
+
+// GeneratedSecretNameAnnotation is the annotation of generated secrets that contains the name of the
+// generated secret definition in the blueprint.
+const GeneratedSecretNameAnnotation = "landscaper.gardener.cloud/generated-secret-name"
+
 // todo: keep only subinstallations?
 const KeepChildrenAnnotation = "landscaper.gardener.cloud/keep-children"
 
@@ -124,6 +132,7 @@ type InstallationList struct {
 // +kubebuilder:resource:shortName=inst
 // +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
 // +kubebuilder:printcolumn:name="Execution",type=string,JSONPath=`.status.executionRef.name`
+// +kubebuilder:printcolumn:name="Suspended Since",type="date",JSONPath=".status.suspendedSince"
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:subresource:status
 
@@ -192,6 +201,16 @@ type InstallationSpec struct {
 	// Optimization contains settings to improve execution performance.
 	// +optional
 	Optimization *Optimization `json:"optimization,omitempty"`
+
+	// Suspend stops the processing of the installation and of all its subinstallations, executions and deploy items.
+	// Changes and operations are kept and processed after the installation has been resumed.
+	// +optional
+	Suspend bool `json:"suspend,omitempty"`
+
+	// RequireApproval specifies that the deploy items of the installation and of all its subinstallations
+	// must be approved before they are deployed.
+	// +optional
+	RequireApproval bool `json:"requireApproval,omitempty"`
 }
 
 // Verification defines the necessary data to verify the signature of the refered component
@@ -287,6 +306,104 @@ type InstallationStatus struct {
 	// TransitionTimes contains timestamps of status transitions
 	// +optional
 	TransitionTimes *TransitionTimes `json:"transitionTimes,omitempty"`
+
+	// SuspendedSince is the time since when the installation is suspended,
+	// either by its own spec or by a suspended parent installation.
+	// +optional
+	SuspendedSince *metav1.Time `json:"suspendedSince,omitempty"`
+
+	// GeneratedSecrets contains the status of the secrets that are generated for the blueprint.
+	// +optional
+	GeneratedSecrets []GeneratedSecretStatus `json:"generatedSecrets,omitempty"`
+
+	// ImportValidationErrors lists the values of data imports that violate the schema of their import definition.
+	// It is reset as soon as all imports are valid.
+	// +optional
+	ImportValidationErrors []ImportValidationError `json:"importValidationErrors,omitempty"`
+
+	// SubInstallationRollouts define the progressive rollout of the subinstallations of the current job,
+	// e.g. of the subinstallations for the targets of a target map.
+	// +optional
+	SubInstallationRollouts []Rollout `json:"subInstallationRollouts,omitempty"`
+
+	// Rollouts contains the progress of the rollouts of the subinstallations of the current job.
+	// +optional
+	Rollouts []RolloutStatus `json:"rollouts,omitempty"`
+}
+
+// GeneratedSecretStatus describes the status of a generated secret.
+type GeneratedSecretStatus struct {
+	// Name is the name of the generated secret definition in the blueprint.
+	Name string `json:"name"`
+
+	// SecretName is the name of the secret in the namespace of the installation that contains the generated values.
+	SecretName string `json:"secretName"`
+
+	// GenerationTime is the time when the values were generated.
+	GenerationTime metav1.Time `json:"generationTime"`
+
+	// ExpirationTime is the time when a generated certificate expires.
+	// +optional
+	ExpirationTime *metav1.Time `json:"expirationTime,omitempty"`
+
+	// RotationTime is the time from which on the values are regenerated.
+	// +optional
+	RotationTime *metav1.Time `json:"rotationTime,omitempty"`
+}
+
+// ImportSourceType defines where the value of an import comes from.
+type ImportSourceType string
+
+const (
+	// ImportSourceTypeDataObject is the type of imports whose value is read from a data object.
+	ImportSourceTypeDataObject ImportSourceType = "DataObject"
+	// ImportSourceTypeSecret is the type of imports whose value is read from a secret.
+	ImportSourceTypeSecret ImportSourceType = "Secret"
+	// ImportSourceTypeConfigMap is the type of imports whose value is read from a configmap.
+	ImportSourceTypeConfigMap ImportSourceType = "ConfigMap"
+	// ImportSourceTypeImportDataMapping is the type of imports whose value is templated by an import data mapping
+	// of the installation.
+	ImportSourceTypeImportDataMapping ImportSourceType = "ImportDataMapping"
+	// ImportSourceTypeDefault is the type of optional imports that are not satisfied
+	// and whose value is the default value of the import definition.
+	ImportSourceTypeDefault ImportSourceType = "Default"
+)
+
+// ImportSource describes where the value of an import comes from.
+type ImportSource struct {
+	// Type is the type of the source.
+	Type ImportSourceType `json:"type"`
+
+	// Name is the name of the data object, secret or configmap that contains the value.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Key is the key of the secret or configmap that contains the value.
+	// +optional
+	Key string `json:"key,omitempty"`
+
+	// Installation is the name of the installation that has created the data object.
+	// This is either a sibling installation that exports the data object or the parent installation
+	// that passes on one of its own imports or the result of one of its import data mappings.
+	// +optional
+	Installation string `json:"installation,omitempty"`
+}
+
+// ImportValidationError describes a value of a data import that violates the schema of its import definition.
+type ImportValidationError struct {
+	// Import is the name of the import.
+	Import string `json:"import"`
+
+	// Source describes where the value of the import comes from.
+	Source ImportSource `json:"source"`
+
+	// Pointer is the json pointer of the invalid value within the value of the import.
+	// The pointer is empty if the value of the import itself is invalid.
+	// +optional
+	Pointer string `json:"pointer,omitempty"`
+
+	// Message describes the violation.
+	Message string `json:"message"`
 }
 
 type DependentToTrigger struct {
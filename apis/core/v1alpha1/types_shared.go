@@ -171,6 +171,8 @@ const (
 	ErrorForInfoOnly ErrorCode = "ERR_FOR_INFO_ONLY"
 	// ErrorNoRetry indicates that no retry is required.
 	ErrorNoRetry ErrorCode = "ERR_NO_RETRY"
+	// ErrorTargetUnreachable indicates that the target of a deploy item is not reachable according to its status.
+	ErrorTargetUnreachable ErrorCode = "ERR_TARGET_UNREACHABLE"
 )
 
 // UnrecoverableErrorCodes defines unrecoverable error codes
@@ -180,6 +182,7 @@ var UnrecoverableErrorCodes = []ErrorCode{
 	ErrorTimeout,
 	ErrorUnauthorized,
 	ErrorCyclicDependencies,
+	ErrorTargetUnreachable,
 }
 
 // Condition holds the information about the state of a resource.
@@ -14,11 +14,12 @@ import (
 	unsafe "unsafe"
 
 	v2 "github.com/gardener/component-spec/bindings-go/apis/v2"
-	v1 "k8s.io/api/core/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	selection "k8s.io/apimachinery/pkg/selection"
+	intstr "k8s.io/apimachinery/pkg/util/intstr"
 
 	core "github.com/gardener/landscaper/apis/core"
 )
@@ -40,6 +41,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ApprovalRequest)(nil), (*core.ApprovalRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ApprovalRequest_To_core_ApprovalRequest(a.(*ApprovalRequest), b.(*core.ApprovalRequest), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ApprovalRequest)(nil), (*ApprovalRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ApprovalRequest_To_v1alpha1_ApprovalRequest(a.(*core.ApprovalRequest), b.(*ApprovalRequest), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*AutomaticReconcile)(nil), (*core.AutomaticReconcile)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_AutomaticReconcile_To_core_AutomaticReconcile(a.(*AutomaticReconcile), b.(*core.AutomaticReconcile), scope)
 	}); err != nil {
@@ -340,6 +351,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*DeployItemHistoryEntry)(nil), (*core.DeployItemHistoryEntry)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DeployItemHistoryEntry_To_core_DeployItemHistoryEntry(a.(*DeployItemHistoryEntry), b.(*core.DeployItemHistoryEntry), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.DeployItemHistoryEntry)(nil), (*DeployItemHistoryEntry)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_DeployItemHistoryEntry_To_v1alpha1_DeployItemHistoryEntry(a.(*core.DeployItemHistoryEntry), b.(*DeployItemHistoryEntry), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*DeployItemList)(nil), (*core.DeployItemList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_DeployItemList_To_core_DeployItemList(a.(*DeployItemList), b.(*core.DeployItemList), scope)
 	}); err != nil {
@@ -480,6 +501,76 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*GeneratedCertificate)(nil), (*core.GeneratedCertificate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_GeneratedCertificate_To_core_GeneratedCertificate(a.(*GeneratedCertificate), b.(*core.GeneratedCertificate), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.GeneratedCertificate)(nil), (*GeneratedCertificate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_GeneratedCertificate_To_v1alpha1_GeneratedCertificate(a.(*core.GeneratedCertificate), b.(*GeneratedCertificate), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*GeneratedPassword)(nil), (*core.GeneratedPassword)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_GeneratedPassword_To_core_GeneratedPassword(a.(*GeneratedPassword), b.(*core.GeneratedPassword), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.GeneratedPassword)(nil), (*GeneratedPassword)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_GeneratedPassword_To_v1alpha1_GeneratedPassword(a.(*core.GeneratedPassword), b.(*GeneratedPassword), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*GeneratedSSHKeyPair)(nil), (*core.GeneratedSSHKeyPair)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_GeneratedSSHKeyPair_To_core_GeneratedSSHKeyPair(a.(*GeneratedSSHKeyPair), b.(*core.GeneratedSSHKeyPair), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.GeneratedSSHKeyPair)(nil), (*GeneratedSSHKeyPair)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_GeneratedSSHKeyPair_To_v1alpha1_GeneratedSSHKeyPair(a.(*core.GeneratedSSHKeyPair), b.(*GeneratedSSHKeyPair), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*GeneratedSecretDefinition)(nil), (*core.GeneratedSecretDefinition)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_GeneratedSecretDefinition_To_core_GeneratedSecretDefinition(a.(*GeneratedSecretDefinition), b.(*core.GeneratedSecretDefinition), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.GeneratedSecretDefinition)(nil), (*GeneratedSecretDefinition)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_GeneratedSecretDefinition_To_v1alpha1_GeneratedSecretDefinition(a.(*core.GeneratedSecretDefinition), b.(*GeneratedSecretDefinition), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*GeneratedSecretImportReference)(nil), (*core.GeneratedSecretImportReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_GeneratedSecretImportReference_To_core_GeneratedSecretImportReference(a.(*GeneratedSecretImportReference), b.(*core.GeneratedSecretImportReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.GeneratedSecretImportReference)(nil), (*GeneratedSecretImportReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_GeneratedSecretImportReference_To_v1alpha1_GeneratedSecretImportReference(a.(*core.GeneratedSecretImportReference), b.(*GeneratedSecretImportReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*GeneratedSecretRotation)(nil), (*core.GeneratedSecretRotation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_GeneratedSecretRotation_To_core_GeneratedSecretRotation(a.(*GeneratedSecretRotation), b.(*core.GeneratedSecretRotation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.GeneratedSecretRotation)(nil), (*GeneratedSecretRotation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_GeneratedSecretRotation_To_v1alpha1_GeneratedSecretRotation(a.(*core.GeneratedSecretRotation), b.(*GeneratedSecretRotation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*GeneratedSecretStatus)(nil), (*core.GeneratedSecretStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_GeneratedSecretStatus_To_core_GeneratedSecretStatus(a.(*GeneratedSecretStatus), b.(*core.GeneratedSecretStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.GeneratedSecretStatus)(nil), (*GeneratedSecretStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_GeneratedSecretStatus_To_v1alpha1_GeneratedSecretStatus(a.(*core.GeneratedSecretStatus), b.(*GeneratedSecretStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ImportDefinition)(nil), (*core.ImportDefinition)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ImportDefinition_To_core_ImportDefinition(a.(*ImportDefinition), b.(*core.ImportDefinition), scope)
 	}); err != nil {
@@ -490,6 +581,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ImportSource)(nil), (*core.ImportSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ImportSource_To_core_ImportSource(a.(*ImportSource), b.(*core.ImportSource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ImportSource)(nil), (*ImportSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ImportSource_To_v1alpha1_ImportSource(a.(*core.ImportSource), b.(*ImportSource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ImportValidationError)(nil), (*core.ImportValidationError)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ImportValidationError_To_core_ImportValidationError(a.(*ImportValidationError), b.(*core.ImportValidationError), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ImportValidationError)(nil), (*ImportValidationError)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ImportValidationError_To_v1alpha1_ImportValidationError(a.(*core.ImportValidationError), b.(*ImportValidationError), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*InlineBlueprint)(nil), (*core.InlineBlueprint)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_InlineBlueprint_To_core_InlineBlueprint(a.(*InlineBlueprint), b.(*core.InlineBlueprint), scope)
 	}); err != nil {
@@ -630,6 +741,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*MaintenanceWindow)(nil), (*core.MaintenanceWindow)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MaintenanceWindow_To_core_MaintenanceWindow(a.(*MaintenanceWindow), b.(*core.MaintenanceWindow), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.MaintenanceWindow)(nil), (*MaintenanceWindow)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_MaintenanceWindow_To_v1alpha1_MaintenanceWindow(a.(*core.MaintenanceWindow), b.(*MaintenanceWindow), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*NamedObjectReference)(nil), (*core.NamedObjectReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_NamedObjectReference_To_core_NamedObjectReference(a.(*NamedObjectReference), b.(*core.NamedObjectReference), scope)
 	}); err != nil {
@@ -710,6 +831,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*Rollout)(nil), (*core.Rollout)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Rollout_To_core_Rollout(a.(*Rollout), b.(*core.Rollout), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.Rollout)(nil), (*Rollout)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_Rollout_To_v1alpha1_Rollout(a.(*core.Rollout), b.(*Rollout), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RolloutStatus)(nil), (*core.RolloutStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RolloutStatus_To_core_RolloutStatus(a.(*RolloutStatus), b.(*core.RolloutStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.RolloutStatus)(nil), (*RolloutStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_RolloutStatus_To_v1alpha1_RolloutStatus(a.(*core.RolloutStatus), b.(*RolloutStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RolloutTarget)(nil), (*core.RolloutTarget)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RolloutTarget_To_core_RolloutTarget(a.(*RolloutTarget), b.(*core.RolloutTarget), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.RolloutTarget)(nil), (*RolloutTarget)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_RolloutTarget_To_v1alpha1_RolloutTarget(a.(*core.RolloutTarget), b.(*RolloutTarget), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SecretLabelSelectorRef)(nil), (*core.SecretLabelSelectorRef)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_SecretLabelSelectorRef_To_core_SecretLabelSelectorRef(a.(*SecretLabelSelectorRef), b.(*core.SecretLabelSelectorRef), scope)
 	}); err != nil {
@@ -870,6 +1021,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*TargetResolverReference)(nil), (*core.TargetResolverReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TargetResolverReference_To_core_TargetResolverReference(a.(*TargetResolverReference), b.(*core.TargetResolverReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.TargetResolverReference)(nil), (*TargetResolverReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_TargetResolverReference_To_v1alpha1_TargetResolverReference(a.(*core.TargetResolverReference), b.(*TargetResolverReference), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*TargetSelector)(nil), (*core.TargetSelector)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_TargetSelector_To_core_TargetSelector(a.(*TargetSelector), b.(*core.TargetSelector), scope)
 	}); err != nil {
@@ -890,6 +1051,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*TargetStatus)(nil), (*core.TargetStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TargetStatus_To_core_TargetStatus(a.(*TargetStatus), b.(*core.TargetStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.TargetStatus)(nil), (*TargetStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_TargetStatus_To_v1alpha1_TargetStatus(a.(*core.TargetStatus), b.(*TargetStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*TargetSync)(nil), (*core.TargetSync)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_TargetSync_To_core_TargetSync(a.(*TargetSync), b.(*core.TargetSync), scope)
 	}); err != nil {
@@ -930,6 +1101,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*TargetSyncTemplate)(nil), (*core.TargetSyncTemplate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TargetSyncTemplate_To_core_TargetSyncTemplate(a.(*TargetSyncTemplate), b.(*core.TargetSyncTemplate), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.TargetSyncTemplate)(nil), (*TargetSyncTemplate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_TargetSyncTemplate_To_v1alpha1_TargetSyncTemplate(a.(*core.TargetSyncTemplate), b.(*TargetSyncTemplate), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*TargetTemplate)(nil), (*core.TargetTemplate)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_TargetTemplate_To_core_TargetTemplate(a.(*TargetTemplate), b.(*core.TargetTemplate), scope)
 	}); err != nil {
@@ -1073,6 +1254,34 @@ func Convert_core_AnyJSON_To_v1alpha1_AnyJSON(in *core.AnyJSON, out *AnyJSON, s
 	return autoConvert_core_AnyJSON_To_v1alpha1_AnyJSON(in, out, s)
 }
 
+func autoConvert_v1alpha1_ApprovalRequest_To_core_ApprovalRequest(in *ApprovalRequest, out *core.ApprovalRequest, s conversion.Scope) error {
+	out.JobID = in.JobID
+	out.Summary = in.Summary
+	out.RequestTime = in.RequestTime
+	out.ApprovedBy = in.ApprovedBy
+	out.ApprovalTime = (*v1.Time)(unsafe.Pointer(in.ApprovalTime))
+	return nil
+}
+
+// Convert_v1alpha1_ApprovalRequest_To_core_ApprovalRequest is an autogenerated conversion function.
+func Convert_v1alpha1_ApprovalRequest_To_core_ApprovalRequest(in *ApprovalRequest, out *core.ApprovalRequest, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ApprovalRequest_To_core_ApprovalRequest(in, out, s)
+}
+
+func autoConvert_core_ApprovalRequest_To_v1alpha1_ApprovalRequest(in *core.ApprovalRequest, out *ApprovalRequest, s conversion.Scope) error {
+	out.JobID = in.JobID
+	out.Summary = in.Summary
+	out.RequestTime = in.RequestTime
+	out.ApprovedBy = in.ApprovedBy
+	out.ApprovalTime = (*v1.Time)(unsafe.Pointer(in.ApprovalTime))
+	return nil
+}
+
+// Convert_core_ApprovalRequest_To_v1alpha1_ApprovalRequest is an autogenerated conversion function.
+func Convert_core_ApprovalRequest_To_v1alpha1_ApprovalRequest(in *core.ApprovalRequest, out *ApprovalRequest, s conversion.Scope) error {
+	return autoConvert_core_ApprovalRequest_To_v1alpha1_ApprovalRequest(in, out, s)
+}
+
 func autoConvert_v1alpha1_AutomaticReconcile_To_core_AutomaticReconcile(in *AutomaticReconcile, out *core.AutomaticReconcile, s conversion.Scope) error {
 	out.SucceededReconcile = (*core.SucceededReconcile)(unsafe.Pointer(in.SucceededReconcile))
 	out.FailedReconcile = (*core.FailedReconcile)(unsafe.Pointer(in.FailedReconcile))
@@ -1127,6 +1336,7 @@ func autoConvert_v1alpha1_Blueprint_To_core_Blueprint(in *Blueprint, out *core.B
 	out.LocalTypes = *(*map[string]core.JSONSchemaDefinition)(unsafe.Pointer(&in.LocalTypes))
 	out.Imports = *(*core.ImportDefinitionList)(unsafe.Pointer(&in.Imports))
 	out.ImportExecutions = *(*[]core.TemplateExecutor)(unsafe.Pointer(&in.ImportExecutions))
+	out.GeneratedSecrets = *(*[]core.GeneratedSecretDefinition)(unsafe.Pointer(&in.GeneratedSecrets))
 	out.Exports = *(*core.ExportDefinitionList)(unsafe.Pointer(&in.Exports))
 	out.Subinstallations = *(*core.SubinstallationTemplateList)(unsafe.Pointer(&in.Subinstallations))
 	out.SubinstallationExecutions = *(*[]core.TemplateExecutor)(unsafe.Pointer(&in.SubinstallationExecutions))
@@ -1147,6 +1357,7 @@ func autoConvert_core_Blueprint_To_v1alpha1_Blueprint(in *core.Blueprint, out *B
 	out.Imports = *(*ImportDefinitionList)(unsafe.Pointer(&in.Imports))
 	out.Exports = *(*ExportDefinitionList)(unsafe.Pointer(&in.Exports))
 	out.ImportExecutions = *(*[]TemplateExecutor)(unsafe.Pointer(&in.ImportExecutions))
+	out.GeneratedSecrets = *(*[]GeneratedSecretDefinition)(unsafe.Pointer(&in.GeneratedSecrets))
 	out.Subinstallations = *(*SubinstallationTemplateList)(unsafe.Pointer(&in.Subinstallations))
 	out.SubinstallationExecutions = *(*[]TemplateExecutor)(unsafe.Pointer(&in.SubinstallationExecutions))
 	out.DeployExecutions = *(*[]TemplateExecutor)(unsafe.Pointer(&in.DeployExecutions))
@@ -1457,11 +1668,12 @@ func Convert_core_Context_To_v1alpha1_Context(in *core.Context, out *Context, s
 
 func autoConvert_v1alpha1_ContextConfiguration_To_core_ContextConfiguration(in *ContextConfiguration, out *core.ContextConfiguration, s conversion.Scope) error {
 	out.RepositoryContext = (*v2.UnstructuredTypedObject)(unsafe.Pointer(in.RepositoryContext))
-	out.OCMConfig = (*v1.LocalObjectReference)(unsafe.Pointer(in.OCMConfig))
-	out.RegistryPullSecrets = *(*[]v1.LocalObjectReference)(unsafe.Pointer(&in.RegistryPullSecrets))
+	out.OCMConfig = (*corev1.LocalObjectReference)(unsafe.Pointer(in.OCMConfig))
+	out.RegistryPullSecrets = *(*[]corev1.LocalObjectReference)(unsafe.Pointer(&in.RegistryPullSecrets))
 	out.Configurations = *(*map[string]core.AnyJSON)(unsafe.Pointer(&in.Configurations))
 	out.ComponentVersionOverwritesReference = in.ComponentVersionOverwritesReference
 	out.VerificationSignatures = *(*map[string]core.VerificationSignature)(unsafe.Pointer(&in.VerificationSignatures))
+	out.MaintenanceWindows = *(*[]core.MaintenanceWindow)(unsafe.Pointer(&in.MaintenanceWindows))
 	return nil
 }
 
@@ -1472,11 +1684,12 @@ func Convert_v1alpha1_ContextConfiguration_To_core_ContextConfiguration(in *Cont
 
 func autoConvert_core_ContextConfiguration_To_v1alpha1_ContextConfiguration(in *core.ContextConfiguration, out *ContextConfiguration, s conversion.Scope) error {
 	out.RepositoryContext = (*v2.UnstructuredTypedObject)(unsafe.Pointer(in.RepositoryContext))
-	out.OCMConfig = (*v1.LocalObjectReference)(unsafe.Pointer(in.OCMConfig))
-	out.RegistryPullSecrets = *(*[]v1.LocalObjectReference)(unsafe.Pointer(&in.RegistryPullSecrets))
+	out.OCMConfig = (*corev1.LocalObjectReference)(unsafe.Pointer(in.OCMConfig))
+	out.RegistryPullSecrets = *(*[]corev1.LocalObjectReference)(unsafe.Pointer(&in.RegistryPullSecrets))
 	out.Configurations = *(*map[string]AnyJSON)(unsafe.Pointer(&in.Configurations))
 	out.ComponentVersionOverwritesReference = in.ComponentVersionOverwritesReference
 	out.VerificationSignatures = *(*map[string]VerificationSignature)(unsafe.Pointer(&in.VerificationSignatures))
+	out.MaintenanceWindows = *(*[]MaintenanceWindow)(unsafe.Pointer(&in.MaintenanceWindows))
 	return nil
 }
 
@@ -1819,6 +2032,40 @@ func Convert_core_DeployItemCache_To_v1alpha1_DeployItemCache(in *core.DeployIte
 	return autoConvert_core_DeployItemCache_To_v1alpha1_DeployItemCache(in, out, s)
 }
 
+func autoConvert_v1alpha1_DeployItemHistoryEntry_To_core_DeployItemHistoryEntry(in *DeployItemHistoryEntry, out *core.DeployItemHistoryEntry, s conversion.Scope) error {
+	out.JobID = in.JobID
+	out.Generation = in.Generation
+	out.Phase = core.DeployItemPhase(in.Phase)
+	out.StartTime = (*v1.Time)(unsafe.Pointer(in.StartTime))
+	out.EndTime = (*v1.Time)(unsafe.Pointer(in.EndTime))
+	out.Error = (*core.Error)(unsafe.Pointer(in.Error))
+	out.ConfigurationHash = in.ConfigurationHash
+	out.ManagedResources = (*int)(unsafe.Pointer(in.ManagedResources))
+	return nil
+}
+
+// Convert_v1alpha1_DeployItemHistoryEntry_To_core_DeployItemHistoryEntry is an autogenerated conversion function.
+func Convert_v1alpha1_DeployItemHistoryEntry_To_core_DeployItemHistoryEntry(in *DeployItemHistoryEntry, out *core.DeployItemHistoryEntry, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DeployItemHistoryEntry_To_core_DeployItemHistoryEntry(in, out, s)
+}
+
+func autoConvert_core_DeployItemHistoryEntry_To_v1alpha1_DeployItemHistoryEntry(in *core.DeployItemHistoryEntry, out *DeployItemHistoryEntry, s conversion.Scope) error {
+	out.JobID = in.JobID
+	out.Generation = in.Generation
+	out.Phase = DeployItemPhase(in.Phase)
+	out.StartTime = (*v1.Time)(unsafe.Pointer(in.StartTime))
+	out.EndTime = (*v1.Time)(unsafe.Pointer(in.EndTime))
+	out.Error = (*Error)(unsafe.Pointer(in.Error))
+	out.ConfigurationHash = in.ConfigurationHash
+	out.ManagedResources = (*int)(unsafe.Pointer(in.ManagedResources))
+	return nil
+}
+
+// Convert_core_DeployItemHistoryEntry_To_v1alpha1_DeployItemHistoryEntry is an autogenerated conversion function.
+func Convert_core_DeployItemHistoryEntry_To_v1alpha1_DeployItemHistoryEntry(in *core.DeployItemHistoryEntry, out *DeployItemHistoryEntry, s conversion.Scope) error {
+	return autoConvert_core_DeployItemHistoryEntry_To_v1alpha1_DeployItemHistoryEntry(in, out, s)
+}
+
 func autoConvert_v1alpha1_DeployItemList_To_core_DeployItemList(in *DeployItemList, out *core.DeployItemList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	out.Items = *(*[]core.DeployItem)(unsafe.Pointer(&in.Items))
@@ -1880,7 +2127,7 @@ func autoConvert_v1alpha1_DeployItemStatus_To_core_DeployItemStatus(in *DeployIt
 	out.LastError = (*core.Error)(unsafe.Pointer(in.LastError))
 	out.LastErrors = *(*[]*core.Error)(unsafe.Pointer(&in.LastErrors))
 	out.FirstError = (*core.Error)(unsafe.Pointer(in.FirstError))
-	out.LastReconcileTime = (*metav1.Time)(unsafe.Pointer(in.LastReconcileTime))
+	out.LastReconcileTime = (*v1.Time)(unsafe.Pointer(in.LastReconcileTime))
 	if err := Convert_v1alpha1_DeployerInformation_To_core_DeployerInformation(&in.Deployer, &out.Deployer, s); err != nil {
 		return err
 	}
@@ -1888,9 +2135,11 @@ func autoConvert_v1alpha1_DeployItemStatus_To_core_DeployItemStatus(in *DeployIt
 	out.ExportReference = (*core.ObjectReference)(unsafe.Pointer(in.ExportReference))
 	out.JobID = in.JobID
 	out.JobIDFinished = in.JobIDFinished
-	out.JobIDGenerationTime = (*metav1.Time)(unsafe.Pointer(in.JobIDGenerationTime))
+	out.JobIDGenerationTime = (*v1.Time)(unsafe.Pointer(in.JobIDGenerationTime))
 	out.DeployerPhase = (*string)(unsafe.Pointer(in.DeployerPhase))
 	out.TransitionTimes = (*core.TransitionTimes)(unsafe.Pointer(in.TransitionTimes))
+	out.ApprovalRequest = (*core.ApprovalRequest)(unsafe.Pointer(in.ApprovalRequest))
+	out.History = *(*[]core.DeployItemHistoryEntry)(unsafe.Pointer(&in.History))
 	return nil
 }
 
@@ -1906,7 +2155,7 @@ func autoConvert_core_DeployItemStatus_To_v1alpha1_DeployItemStatus(in *core.Dep
 	out.LastError = (*Error)(unsafe.Pointer(in.LastError))
 	out.LastErrors = *(*[]*Error)(unsafe.Pointer(&in.LastErrors))
 	out.FirstError = (*Error)(unsafe.Pointer(in.FirstError))
-	out.LastReconcileTime = (*metav1.Time)(unsafe.Pointer(in.LastReconcileTime))
+	out.LastReconcileTime = (*v1.Time)(unsafe.Pointer(in.LastReconcileTime))
 	if err := Convert_core_DeployerInformation_To_v1alpha1_DeployerInformation(&in.Deployer, &out.Deployer, s); err != nil {
 		return err
 	}
@@ -1914,9 +2163,11 @@ func autoConvert_core_DeployItemStatus_To_v1alpha1_DeployItemStatus(in *core.Dep
 	out.ExportReference = (*ObjectReference)(unsafe.Pointer(in.ExportReference))
 	out.JobID = in.JobID
 	out.JobIDFinished = in.JobIDFinished
-	out.JobIDGenerationTime = (*metav1.Time)(unsafe.Pointer(in.JobIDGenerationTime))
+	out.JobIDGenerationTime = (*v1.Time)(unsafe.Pointer(in.JobIDGenerationTime))
 	out.DeployerPhase = (*string)(unsafe.Pointer(in.DeployerPhase))
 	out.TransitionTimes = (*TransitionTimes)(unsafe.Pointer(in.TransitionTimes))
+	out.ApprovalRequest = (*ApprovalRequest)(unsafe.Pointer(in.ApprovalRequest))
+	out.History = *(*[]DeployItemHistoryEntry)(unsafe.Pointer(&in.History))
 	return nil
 }
 
@@ -1935,6 +2186,7 @@ func autoConvert_v1alpha1_DeployItemTemplate_To_core_DeployItemTemplate(in *Depl
 	out.Timeout = (*core.Duration)(unsafe.Pointer(in.Timeout))
 	out.UpdateOnChangeOnly = in.UpdateOnChangeOnly
 	out.OnDelete = (*core.OnDeleteConfig)(unsafe.Pointer(in.OnDelete))
+	out.RequireApproval = in.RequireApproval
 	return nil
 }
 
@@ -1953,6 +2205,7 @@ func autoConvert_core_DeployItemTemplate_To_v1alpha1_DeployItemTemplate(in *core
 	out.Timeout = (*Duration)(unsafe.Pointer(in.Timeout))
 	out.UpdateOnChangeOnly = in.UpdateOnChangeOnly
 	out.OnDelete = (*OnDeleteConfig)(unsafe.Pointer(in.OnDelete))
+	out.RequireApproval = in.RequireApproval
 	return nil
 }
 
@@ -2135,6 +2388,8 @@ func autoConvert_v1alpha1_ExecutionSpec_To_core_ExecutionSpec(in *ExecutionSpec,
 	out.Context = in.Context
 	out.DeployItems = *(*core.DeployItemTemplateList)(unsafe.Pointer(&in.DeployItems))
 	out.DeployItemsCompressed = *(*[]byte)(unsafe.Pointer(&in.DeployItemsCompressed))
+	out.DeployItemsSecretRef = (*core.ObjectReference)(unsafe.Pointer(in.DeployItemsSecretRef))
+	out.Rollouts = *(*[]core.Rollout)(unsafe.Pointer(&in.Rollouts))
 	return nil
 }
 
@@ -2142,6 +2397,8 @@ func autoConvert_core_ExecutionSpec_To_v1alpha1_ExecutionSpec(in *core.Execution
 	out.Context = in.Context
 	out.DeployItems = *(*DeployItemTemplateList)(unsafe.Pointer(&in.DeployItems))
 	out.DeployItemsCompressed = *(*[]byte)(unsafe.Pointer(&in.DeployItemsCompressed))
+	out.DeployItemsSecretRef = (*ObjectReference)(unsafe.Pointer(in.DeployItemsSecretRef))
+	out.Rollouts = *(*[]Rollout)(unsafe.Pointer(&in.Rollouts))
 	return nil
 }
 
@@ -2154,8 +2411,9 @@ func autoConvert_v1alpha1_ExecutionStatus_To_core_ExecutionStatus(in *ExecutionS
 	out.JobID = in.JobID
 	out.JobIDFinished = in.JobIDFinished
 	out.ExecutionPhase = core.ExecutionPhase(in.ExecutionPhase)
-	out.PhaseTransitionTime = (*metav1.Time)(unsafe.Pointer(in.PhaseTransitionTime))
+	out.PhaseTransitionTime = (*v1.Time)(unsafe.Pointer(in.PhaseTransitionTime))
 	out.TransitionTimes = (*core.TransitionTimes)(unsafe.Pointer(in.TransitionTimes))
+	out.Rollouts = *(*[]core.RolloutStatus)(unsafe.Pointer(&in.Rollouts))
 	return nil
 }
 
@@ -2173,8 +2431,9 @@ func autoConvert_core_ExecutionStatus_To_v1alpha1_ExecutionStatus(in *core.Execu
 	out.JobID = in.JobID
 	out.JobIDFinished = in.JobIDFinished
 	out.ExecutionPhase = ExecutionPhase(in.ExecutionPhase)
-	out.PhaseTransitionTime = (*metav1.Time)(unsafe.Pointer(in.PhaseTransitionTime))
+	out.PhaseTransitionTime = (*v1.Time)(unsafe.Pointer(in.PhaseTransitionTime))
 	out.TransitionTimes = (*TransitionTimes)(unsafe.Pointer(in.TransitionTimes))
+	out.Rollouts = *(*[]RolloutStatus)(unsafe.Pointer(&in.Rollouts))
 	return nil
 }
 
@@ -2257,6 +2516,182 @@ func Convert_core_FieldValueDefinition_To_v1alpha1_FieldValueDefinition(in *core
 	return autoConvert_core_FieldValueDefinition_To_v1alpha1_FieldValueDefinition(in, out, s)
 }
 
+func autoConvert_v1alpha1_GeneratedCertificate_To_core_GeneratedCertificate(in *GeneratedCertificate, out *core.GeneratedCertificate, s conversion.Scope) error {
+	out.CommonName = in.CommonName
+	out.Organization = *(*[]string)(unsafe.Pointer(&in.Organization))
+	out.DNSNames = *(*[]string)(unsafe.Pointer(&in.DNSNames))
+	out.IPAddresses = *(*[]string)(unsafe.Pointer(&in.IPAddresses))
+	out.SANsFromImports = *(*[]core.GeneratedSecretImportReference)(unsafe.Pointer(&in.SANsFromImports))
+	out.CA = in.CA
+	out.Validity = (*core.Duration)(unsafe.Pointer(in.Validity))
+	return nil
+}
+
+// Convert_v1alpha1_GeneratedCertificate_To_core_GeneratedCertificate is an autogenerated conversion function.
+func Convert_v1alpha1_GeneratedCertificate_To_core_GeneratedCertificate(in *GeneratedCertificate, out *core.GeneratedCertificate, s conversion.Scope) error {
+	return autoConvert_v1alpha1_GeneratedCertificate_To_core_GeneratedCertificate(in, out, s)
+}
+
+func autoConvert_core_GeneratedCertificate_To_v1alpha1_GeneratedCertificate(in *core.GeneratedCertificate, out *GeneratedCertificate, s conversion.Scope) error {
+	out.CommonName = in.CommonName
+	out.Organization = *(*[]string)(unsafe.Pointer(&in.Organization))
+	out.DNSNames = *(*[]string)(unsafe.Pointer(&in.DNSNames))
+	out.IPAddresses = *(*[]string)(unsafe.Pointer(&in.IPAddresses))
+	out.SANsFromImports = *(*[]GeneratedSecretImportReference)(unsafe.Pointer(&in.SANsFromImports))
+	out.CA = in.CA
+	out.Validity = (*Duration)(unsafe.Pointer(in.Validity))
+	return nil
+}
+
+// Convert_core_GeneratedCertificate_To_v1alpha1_GeneratedCertificate is an autogenerated conversion function.
+func Convert_core_GeneratedCertificate_To_v1alpha1_GeneratedCertificate(in *core.GeneratedCertificate, out *GeneratedCertificate, s conversion.Scope) error {
+	return autoConvert_core_GeneratedCertificate_To_v1alpha1_GeneratedCertificate(in, out, s)
+}
+
+func autoConvert_v1alpha1_GeneratedPassword_To_core_GeneratedPassword(in *GeneratedPassword, out *core.GeneratedPassword, s conversion.Scope) error {
+	out.Length = in.Length
+	return nil
+}
+
+// Convert_v1alpha1_GeneratedPassword_To_core_GeneratedPassword is an autogenerated conversion function.
+func Convert_v1alpha1_GeneratedPassword_To_core_GeneratedPassword(in *GeneratedPassword, out *core.GeneratedPassword, s conversion.Scope) error {
+	return autoConvert_v1alpha1_GeneratedPassword_To_core_GeneratedPassword(in, out, s)
+}
+
+func autoConvert_core_GeneratedPassword_To_v1alpha1_GeneratedPassword(in *core.GeneratedPassword, out *GeneratedPassword, s conversion.Scope) error {
+	out.Length = in.Length
+	return nil
+}
+
+// Convert_core_GeneratedPassword_To_v1alpha1_GeneratedPassword is an autogenerated conversion function.
+func Convert_core_GeneratedPassword_To_v1alpha1_GeneratedPassword(in *core.GeneratedPassword, out *GeneratedPassword, s conversion.Scope) error {
+	return autoConvert_core_GeneratedPassword_To_v1alpha1_GeneratedPassword(in, out, s)
+}
+
+func autoConvert_v1alpha1_GeneratedSSHKeyPair_To_core_GeneratedSSHKeyPair(in *GeneratedSSHKeyPair, out *core.GeneratedSSHKeyPair, s conversion.Scope) error {
+	out.Algorithm = core.SSHKeyAlgorithm(in.Algorithm)
+	out.Bits = in.Bits
+	return nil
+}
+
+// Convert_v1alpha1_GeneratedSSHKeyPair_To_core_GeneratedSSHKeyPair is an autogenerated conversion function.
+func Convert_v1alpha1_GeneratedSSHKeyPair_To_core_GeneratedSSHKeyPair(in *GeneratedSSHKeyPair, out *core.GeneratedSSHKeyPair, s conversion.Scope) error {
+	return autoConvert_v1alpha1_GeneratedSSHKeyPair_To_core_GeneratedSSHKeyPair(in, out, s)
+}
+
+func autoConvert_core_GeneratedSSHKeyPair_To_v1alpha1_GeneratedSSHKeyPair(in *core.GeneratedSSHKeyPair, out *GeneratedSSHKeyPair, s conversion.Scope) error {
+	out.Algorithm = SSHKeyAlgorithm(in.Algorithm)
+	out.Bits = in.Bits
+	return nil
+}
+
+// Convert_core_GeneratedSSHKeyPair_To_v1alpha1_GeneratedSSHKeyPair is an autogenerated conversion function.
+func Convert_core_GeneratedSSHKeyPair_To_v1alpha1_GeneratedSSHKeyPair(in *core.GeneratedSSHKeyPair, out *GeneratedSSHKeyPair, s conversion.Scope) error {
+	return autoConvert_core_GeneratedSSHKeyPair_To_v1alpha1_GeneratedSSHKeyPair(in, out, s)
+}
+
+func autoConvert_v1alpha1_GeneratedSecretDefinition_To_core_GeneratedSecretDefinition(in *GeneratedSecretDefinition, out *core.GeneratedSecretDefinition, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Type = core.GeneratedSecretType(in.Type)
+	out.Certificate = (*core.GeneratedCertificate)(unsafe.Pointer(in.Certificate))
+	out.SSHKeyPair = (*core.GeneratedSSHKeyPair)(unsafe.Pointer(in.SSHKeyPair))
+	out.Password = (*core.GeneratedPassword)(unsafe.Pointer(in.Password))
+	out.Rotation = (*core.GeneratedSecretRotation)(unsafe.Pointer(in.Rotation))
+	return nil
+}
+
+// Convert_v1alpha1_GeneratedSecretDefinition_To_core_GeneratedSecretDefinition is an autogenerated conversion function.
+func Convert_v1alpha1_GeneratedSecretDefinition_To_core_GeneratedSecretDefinition(in *GeneratedSecretDefinition, out *core.GeneratedSecretDefinition, s conversion.Scope) error {
+	return autoConvert_v1alpha1_GeneratedSecretDefinition_To_core_GeneratedSecretDefinition(in, out, s)
+}
+
+func autoConvert_core_GeneratedSecretDefinition_To_v1alpha1_GeneratedSecretDefinition(in *core.GeneratedSecretDefinition, out *GeneratedSecretDefinition, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Type = GeneratedSecretType(in.Type)
+	out.Certificate = (*GeneratedCertificate)(unsafe.Pointer(in.Certificate))
+	out.SSHKeyPair = (*GeneratedSSHKeyPair)(unsafe.Pointer(in.SSHKeyPair))
+	out.Password = (*GeneratedPassword)(unsafe.Pointer(in.Password))
+	out.Rotation = (*GeneratedSecretRotation)(unsafe.Pointer(in.Rotation))
+	return nil
+}
+
+// Convert_core_GeneratedSecretDefinition_To_v1alpha1_GeneratedSecretDefinition is an autogenerated conversion function.
+func Convert_core_GeneratedSecretDefinition_To_v1alpha1_GeneratedSecretDefinition(in *core.GeneratedSecretDefinition, out *GeneratedSecretDefinition, s conversion.Scope) error {
+	return autoConvert_core_GeneratedSecretDefinition_To_v1alpha1_GeneratedSecretDefinition(in, out, s)
+}
+
+func autoConvert_v1alpha1_GeneratedSecretImportReference_To_core_GeneratedSecretImportReference(in *GeneratedSecretImportReference, out *core.GeneratedSecretImportReference, s conversion.Scope) error {
+	out.Import = in.Import
+	out.Path = in.Path
+	return nil
+}
+
+// Convert_v1alpha1_GeneratedSecretImportReference_To_core_GeneratedSecretImportReference is an autogenerated conversion function.
+func Convert_v1alpha1_GeneratedSecretImportReference_To_core_GeneratedSecretImportReference(in *GeneratedSecretImportReference, out *core.GeneratedSecretImportReference, s conversion.Scope) error {
+	return autoConvert_v1alpha1_GeneratedSecretImportReference_To_core_GeneratedSecretImportReference(in, out, s)
+}
+
+func autoConvert_core_GeneratedSecretImportReference_To_v1alpha1_GeneratedSecretImportReference(in *core.GeneratedSecretImportReference, out *GeneratedSecretImportReference, s conversion.Scope) error {
+	out.Import = in.Import
+	out.Path = in.Path
+	return nil
+}
+
+// Convert_core_GeneratedSecretImportReference_To_v1alpha1_GeneratedSecretImportReference is an autogenerated conversion function.
+func Convert_core_GeneratedSecretImportReference_To_v1alpha1_GeneratedSecretImportReference(in *core.GeneratedSecretImportReference, out *GeneratedSecretImportReference, s conversion.Scope) error {
+	return autoConvert_core_GeneratedSecretImportReference_To_v1alpha1_GeneratedSecretImportReference(in, out, s)
+}
+
+func autoConvert_v1alpha1_GeneratedSecretRotation_To_core_GeneratedSecretRotation(in *GeneratedSecretRotation, out *core.GeneratedSecretRotation, s conversion.Scope) error {
+	out.RenewBefore = (*core.Duration)(unsafe.Pointer(in.RenewBefore))
+	out.Interval = (*core.Duration)(unsafe.Pointer(in.Interval))
+	return nil
+}
+
+// Convert_v1alpha1_GeneratedSecretRotation_To_core_GeneratedSecretRotation is an autogenerated conversion function.
+func Convert_v1alpha1_GeneratedSecretRotation_To_core_GeneratedSecretRotation(in *GeneratedSecretRotation, out *core.GeneratedSecretRotation, s conversion.Scope) error {
+	return autoConvert_v1alpha1_GeneratedSecretRotation_To_core_GeneratedSecretRotation(in, out, s)
+}
+
+func autoConvert_core_GeneratedSecretRotation_To_v1alpha1_GeneratedSecretRotation(in *core.GeneratedSecretRotation, out *GeneratedSecretRotation, s conversion.Scope) error {
+	out.RenewBefore = (*Duration)(unsafe.Pointer(in.RenewBefore))
+	out.Interval = (*Duration)(unsafe.Pointer(in.Interval))
+	return nil
+}
+
+// Convert_core_GeneratedSecretRotation_To_v1alpha1_GeneratedSecretRotation is an autogenerated conversion function.
+func Convert_core_GeneratedSecretRotation_To_v1alpha1_GeneratedSecretRotation(in *core.GeneratedSecretRotation, out *GeneratedSecretRotation, s conversion.Scope) error {
+	return autoConvert_core_GeneratedSecretRotation_To_v1alpha1_GeneratedSecretRotation(in, out, s)
+}
+
+func autoConvert_v1alpha1_GeneratedSecretStatus_To_core_GeneratedSecretStatus(in *GeneratedSecretStatus, out *core.GeneratedSecretStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.SecretName = in.SecretName
+	out.GenerationTime = in.GenerationTime
+	out.ExpirationTime = (*v1.Time)(unsafe.Pointer(in.ExpirationTime))
+	out.RotationTime = (*v1.Time)(unsafe.Pointer(in.RotationTime))
+	return nil
+}
+
+// Convert_v1alpha1_GeneratedSecretStatus_To_core_GeneratedSecretStatus is an autogenerated conversion function.
+func Convert_v1alpha1_GeneratedSecretStatus_To_core_GeneratedSecretStatus(in *GeneratedSecretStatus, out *core.GeneratedSecretStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_GeneratedSecretStatus_To_core_GeneratedSecretStatus(in, out, s)
+}
+
+func autoConvert_core_GeneratedSecretStatus_To_v1alpha1_GeneratedSecretStatus(in *core.GeneratedSecretStatus, out *GeneratedSecretStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.SecretName = in.SecretName
+	out.GenerationTime = in.GenerationTime
+	out.ExpirationTime = (*v1.Time)(unsafe.Pointer(in.ExpirationTime))
+	out.RotationTime = (*v1.Time)(unsafe.Pointer(in.RotationTime))
+	return nil
+}
+
+// Convert_core_GeneratedSecretStatus_To_v1alpha1_GeneratedSecretStatus is an autogenerated conversion function.
+func Convert_core_GeneratedSecretStatus_To_v1alpha1_GeneratedSecretStatus(in *core.GeneratedSecretStatus, out *GeneratedSecretStatus, s conversion.Scope) error {
+	return autoConvert_core_GeneratedSecretStatus_To_v1alpha1_GeneratedSecretStatus(in, out, s)
+}
+
 func autoConvert_v1alpha1_ImportDefinition_To_core_ImportDefinition(in *ImportDefinition, out *core.ImportDefinition, s conversion.Scope) error {
 	if err := Convert_v1alpha1_FieldValueDefinition_To_core_FieldValueDefinition(&in.FieldValueDefinition, &out.FieldValueDefinition, s); err != nil {
 		return err
@@ -2266,6 +2701,7 @@ func autoConvert_v1alpha1_ImportDefinition_To_core_ImportDefinition(in *ImportDe
 	if err := Convert_v1alpha1_Default_To_core_Default(&in.Default, &out.Default, s); err != nil {
 		return err
 	}
+	out.Sensitive = in.Sensitive
 	out.ConditionalImports = *(*[]core.ImportDefinition)(unsafe.Pointer(&in.ConditionalImports))
 	return nil
 }
@@ -2284,6 +2720,7 @@ func autoConvert_core_ImportDefinition_To_v1alpha1_ImportDefinition(in *core.Imp
 	if err := Convert_core_Default_To_v1alpha1_Default(&in.Default, &out.Default, s); err != nil {
 		return err
 	}
+	out.Sensitive = in.Sensitive
 	out.ConditionalImports = *(*ImportDefinitionList)(unsafe.Pointer(&in.ConditionalImports))
 	return nil
 }
@@ -2293,6 +2730,62 @@ func Convert_core_ImportDefinition_To_v1alpha1_ImportDefinition(in *core.ImportD
 	return autoConvert_core_ImportDefinition_To_v1alpha1_ImportDefinition(in, out, s)
 }
 
+func autoConvert_v1alpha1_ImportSource_To_core_ImportSource(in *ImportSource, out *core.ImportSource, s conversion.Scope) error {
+	out.Type = core.ImportSourceType(in.Type)
+	out.Name = in.Name
+	out.Key = in.Key
+	out.Installation = in.Installation
+	return nil
+}
+
+// Convert_v1alpha1_ImportSource_To_core_ImportSource is an autogenerated conversion function.
+func Convert_v1alpha1_ImportSource_To_core_ImportSource(in *ImportSource, out *core.ImportSource, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ImportSource_To_core_ImportSource(in, out, s)
+}
+
+func autoConvert_core_ImportSource_To_v1alpha1_ImportSource(in *core.ImportSource, out *ImportSource, s conversion.Scope) error {
+	out.Type = ImportSourceType(in.Type)
+	out.Name = in.Name
+	out.Key = in.Key
+	out.Installation = in.Installation
+	return nil
+}
+
+// Convert_core_ImportSource_To_v1alpha1_ImportSource is an autogenerated conversion function.
+func Convert_core_ImportSource_To_v1alpha1_ImportSource(in *core.ImportSource, out *ImportSource, s conversion.Scope) error {
+	return autoConvert_core_ImportSource_To_v1alpha1_ImportSource(in, out, s)
+}
+
+func autoConvert_v1alpha1_ImportValidationError_To_core_ImportValidationError(in *ImportValidationError, out *core.ImportValidationError, s conversion.Scope) error {
+	out.Import = in.Import
+	if err := Convert_v1alpha1_ImportSource_To_core_ImportSource(&in.Source, &out.Source, s); err != nil {
+		return err
+	}
+	out.Pointer = in.Pointer
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1alpha1_ImportValidationError_To_core_ImportValidationError is an autogenerated conversion function.
+func Convert_v1alpha1_ImportValidationError_To_core_ImportValidationError(in *ImportValidationError, out *core.ImportValidationError, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ImportValidationError_To_core_ImportValidationError(in, out, s)
+}
+
+func autoConvert_core_ImportValidationError_To_v1alpha1_ImportValidationError(in *core.ImportValidationError, out *ImportValidationError, s conversion.Scope) error {
+	out.Import = in.Import
+	if err := Convert_core_ImportSource_To_v1alpha1_ImportSource(&in.Source, &out.Source, s); err != nil {
+		return err
+	}
+	out.Pointer = in.Pointer
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_core_ImportValidationError_To_v1alpha1_ImportValidationError is an autogenerated conversion function.
+func Convert_core_ImportValidationError_To_v1alpha1_ImportValidationError(in *core.ImportValidationError, out *ImportValidationError, s conversion.Scope) error {
+	return autoConvert_core_ImportValidationError_To_v1alpha1_ImportValidationError(in, out, s)
+}
+
 func autoConvert_v1alpha1_InlineBlueprint_To_core_InlineBlueprint(in *InlineBlueprint, out *core.InlineBlueprint, s conversion.Scope) error {
 	if err := Convert_v1alpha1_AnyJSON_To_core_AnyJSON(&in.Filesystem, &out.Filesystem, s); err != nil {
 		return err
@@ -2432,6 +2925,8 @@ func autoConvert_v1alpha1_InstallationSpec_To_core_InstallationSpec(in *Installa
 	out.ExportDataMappings = *(*map[string]core.AnyJSON)(unsafe.Pointer(&in.ExportDataMappings))
 	out.AutomaticReconcile = (*core.AutomaticReconcile)(unsafe.Pointer(in.AutomaticReconcile))
 	out.Optimization = (*core.Optimization)(unsafe.Pointer(in.Optimization))
+	out.Suspend = in.Suspend
+	out.RequireApproval = in.RequireApproval
 	return nil
 }
 
@@ -2457,6 +2952,8 @@ func autoConvert_core_InstallationSpec_To_v1alpha1_InstallationSpec(in *core.Ins
 	out.ExportDataMappings = *(*map[string]AnyJSON)(unsafe.Pointer(&in.ExportDataMappings))
 	out.AutomaticReconcile = (*AutomaticReconcile)(unsafe.Pointer(in.AutomaticReconcile))
 	out.Optimization = (*Optimization)(unsafe.Pointer(in.Optimization))
+	out.Suspend = in.Suspend
+	out.RequireApproval = in.RequireApproval
 	return nil
 }
 
@@ -2474,11 +2971,16 @@ func autoConvert_v1alpha1_InstallationStatus_To_core_InstallationStatus(in *Inst
 	out.JobID = in.JobID
 	out.JobIDFinished = in.JobIDFinished
 	out.InstallationPhase = core.InstallationPhase(in.InstallationPhase)
-	out.PhaseTransitionTime = (*metav1.Time)(unsafe.Pointer(in.PhaseTransitionTime))
+	out.PhaseTransitionTime = (*v1.Time)(unsafe.Pointer(in.PhaseTransitionTime))
 	out.ImportsHash = in.ImportsHash
 	out.AutomaticReconcileStatus = (*core.AutomaticReconcileStatus)(unsafe.Pointer(in.AutomaticReconcileStatus))
 	out.DependentsToTrigger = *(*[]core.DependentToTrigger)(unsafe.Pointer(&in.DependentsToTrigger))
 	out.TransitionTimes = (*core.TransitionTimes)(unsafe.Pointer(in.TransitionTimes))
+	out.SuspendedSince = (*v1.Time)(unsafe.Pointer(in.SuspendedSince))
+	out.GeneratedSecrets = *(*[]core.GeneratedSecretStatus)(unsafe.Pointer(&in.GeneratedSecrets))
+	out.ImportValidationErrors = *(*[]core.ImportValidationError)(unsafe.Pointer(&in.ImportValidationErrors))
+	out.SubInstallationRollouts = *(*[]core.Rollout)(unsafe.Pointer(&in.SubInstallationRollouts))
+	out.Rollouts = *(*[]core.RolloutStatus)(unsafe.Pointer(&in.Rollouts))
 	return nil
 }
 
@@ -2496,11 +2998,16 @@ func autoConvert_core_InstallationStatus_To_v1alpha1_InstallationStatus(in *core
 	out.JobID = in.JobID
 	out.JobIDFinished = in.JobIDFinished
 	out.InstallationPhase = InstallationPhase(in.InstallationPhase)
-	out.PhaseTransitionTime = (*metav1.Time)(unsafe.Pointer(in.PhaseTransitionTime))
+	out.PhaseTransitionTime = (*v1.Time)(unsafe.Pointer(in.PhaseTransitionTime))
 	out.ImportsHash = in.ImportsHash
 	out.AutomaticReconcileStatus = (*AutomaticReconcileStatus)(unsafe.Pointer(in.AutomaticReconcileStatus))
 	out.DependentsToTrigger = *(*[]DependentToTrigger)(unsafe.Pointer(&in.DependentsToTrigger))
 	out.TransitionTimes = (*TransitionTimes)(unsafe.Pointer(in.TransitionTimes))
+	out.SuspendedSince = (*v1.Time)(unsafe.Pointer(in.SuspendedSince))
+	out.GeneratedSecrets = *(*[]GeneratedSecretStatus)(unsafe.Pointer(&in.GeneratedSecrets))
+	out.ImportValidationErrors = *(*[]ImportValidationError)(unsafe.Pointer(&in.ImportValidationErrors))
+	out.SubInstallationRollouts = *(*[]Rollout)(unsafe.Pointer(&in.SubInstallationRollouts))
+	out.Rollouts = *(*[]RolloutStatus)(unsafe.Pointer(&in.Rollouts))
 	return nil
 }
 
@@ -2691,6 +3198,32 @@ func Convert_core_LsHealthCheckList_To_v1alpha1_LsHealthCheckList(in *core.LsHea
 	return autoConvert_core_LsHealthCheckList_To_v1alpha1_LsHealthCheckList(in, out, s)
 }
 
+func autoConvert_v1alpha1_MaintenanceWindow_To_core_MaintenanceWindow(in *MaintenanceWindow, out *core.MaintenanceWindow, s conversion.Scope) error {
+	out.CronSpec = in.CronSpec
+	if err := Convert_v1alpha1_Duration_To_core_Duration(&in.Duration, &out.Duration, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_MaintenanceWindow_To_core_MaintenanceWindow is an autogenerated conversion function.
+func Convert_v1alpha1_MaintenanceWindow_To_core_MaintenanceWindow(in *MaintenanceWindow, out *core.MaintenanceWindow, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MaintenanceWindow_To_core_MaintenanceWindow(in, out, s)
+}
+
+func autoConvert_core_MaintenanceWindow_To_v1alpha1_MaintenanceWindow(in *core.MaintenanceWindow, out *MaintenanceWindow, s conversion.Scope) error {
+	out.CronSpec = in.CronSpec
+	if err := Convert_core_Duration_To_v1alpha1_Duration(&in.Duration, &out.Duration, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_core_MaintenanceWindow_To_v1alpha1_MaintenanceWindow is an autogenerated conversion function.
+func Convert_core_MaintenanceWindow_To_v1alpha1_MaintenanceWindow(in *core.MaintenanceWindow, out *MaintenanceWindow, s conversion.Scope) error {
+	return autoConvert_core_MaintenanceWindow_To_v1alpha1_MaintenanceWindow(in, out, s)
+}
+
 func autoConvert_v1alpha1_NamedObjectReference_To_core_NamedObjectReference(in *NamedObjectReference, out *core.NamedObjectReference, s conversion.Scope) error {
 	out.Name = in.Name
 	if err := Convert_v1alpha1_ObjectReference_To_core_ObjectReference(&in.Reference, &out.Reference, s); err != nil {
@@ -2869,6 +3402,90 @@ func Convert_core_ResourceReference_To_v1alpha1_ResourceReference(in *core.Resou
 	return autoConvert_core_ResourceReference_To_v1alpha1_ResourceReference(in, out, s)
 }
 
+func autoConvert_v1alpha1_Rollout_To_core_Rollout(in *Rollout, out *core.Rollout, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Targets = *(*[]core.RolloutTarget)(unsafe.Pointer(&in.Targets))
+	out.Canary = in.Canary
+	out.BatchSize = (*intstr.IntOrString)(unsafe.Pointer(in.BatchSize))
+	out.PauseBetweenBatches = (*core.Duration)(unsafe.Pointer(in.PauseBetweenBatches))
+	out.MaxFailedTargets = (*int)(unsafe.Pointer(in.MaxFailedTargets))
+	return nil
+}
+
+// Convert_v1alpha1_Rollout_To_core_Rollout is an autogenerated conversion function.
+func Convert_v1alpha1_Rollout_To_core_Rollout(in *Rollout, out *core.Rollout, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Rollout_To_core_Rollout(in, out, s)
+}
+
+func autoConvert_core_Rollout_To_v1alpha1_Rollout(in *core.Rollout, out *Rollout, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Targets = *(*[]RolloutTarget)(unsafe.Pointer(&in.Targets))
+	out.Canary = in.Canary
+	out.BatchSize = (*intstr.IntOrString)(unsafe.Pointer(in.BatchSize))
+	out.PauseBetweenBatches = (*Duration)(unsafe.Pointer(in.PauseBetweenBatches))
+	out.MaxFailedTargets = (*int)(unsafe.Pointer(in.MaxFailedTargets))
+	return nil
+}
+
+// Convert_core_Rollout_To_v1alpha1_Rollout is an autogenerated conversion function.
+func Convert_core_Rollout_To_v1alpha1_Rollout(in *core.Rollout, out *Rollout, s conversion.Scope) error {
+	return autoConvert_core_Rollout_To_v1alpha1_Rollout(in, out, s)
+}
+
+func autoConvert_v1alpha1_RolloutStatus_To_core_RolloutStatus(in *RolloutStatus, out *core.RolloutStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Batches = in.Batches
+	out.CompletedBatches = in.CompletedBatches
+	out.FailedTargets = *(*[]string)(unsafe.Pointer(&in.FailedTargets))
+	out.Stopped = in.Stopped
+	out.NextBatchTime = (*v1.Time)(unsafe.Pointer(in.NextBatchTime))
+	return nil
+}
+
+// Convert_v1alpha1_RolloutStatus_To_core_RolloutStatus is an autogenerated conversion function.
+func Convert_v1alpha1_RolloutStatus_To_core_RolloutStatus(in *RolloutStatus, out *core.RolloutStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RolloutStatus_To_core_RolloutStatus(in, out, s)
+}
+
+func autoConvert_core_RolloutStatus_To_v1alpha1_RolloutStatus(in *core.RolloutStatus, out *RolloutStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Batches = in.Batches
+	out.CompletedBatches = in.CompletedBatches
+	out.FailedTargets = *(*[]string)(unsafe.Pointer(&in.FailedTargets))
+	out.Stopped = in.Stopped
+	out.NextBatchTime = (*v1.Time)(unsafe.Pointer(in.NextBatchTime))
+	return nil
+}
+
+// Convert_core_RolloutStatus_To_v1alpha1_RolloutStatus is an autogenerated conversion function.
+func Convert_core_RolloutStatus_To_v1alpha1_RolloutStatus(in *core.RolloutStatus, out *RolloutStatus, s conversion.Scope) error {
+	return autoConvert_core_RolloutStatus_To_v1alpha1_RolloutStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_RolloutTarget_To_core_RolloutTarget(in *RolloutTarget, out *core.RolloutTarget, s conversion.Scope) error {
+	out.Key = in.Key
+	out.DeployItems = *(*[]string)(unsafe.Pointer(&in.DeployItems))
+	out.Installations = *(*[]string)(unsafe.Pointer(&in.Installations))
+	return nil
+}
+
+// Convert_v1alpha1_RolloutTarget_To_core_RolloutTarget is an autogenerated conversion function.
+func Convert_v1alpha1_RolloutTarget_To_core_RolloutTarget(in *RolloutTarget, out *core.RolloutTarget, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RolloutTarget_To_core_RolloutTarget(in, out, s)
+}
+
+func autoConvert_core_RolloutTarget_To_v1alpha1_RolloutTarget(in *core.RolloutTarget, out *RolloutTarget, s conversion.Scope) error {
+	out.Key = in.Key
+	out.DeployItems = *(*[]string)(unsafe.Pointer(&in.DeployItems))
+	out.Installations = *(*[]string)(unsafe.Pointer(&in.Installations))
+	return nil
+}
+
+// Convert_core_RolloutTarget_To_v1alpha1_RolloutTarget is an autogenerated conversion function.
+func Convert_core_RolloutTarget_To_v1alpha1_RolloutTarget(in *core.RolloutTarget, out *RolloutTarget, s conversion.Scope) error {
+	return autoConvert_core_RolloutTarget_To_v1alpha1_RolloutTarget(in, out, s)
+}
+
 func autoConvert_v1alpha1_SecretLabelSelectorRef_To_core_SecretLabelSelectorRef(in *SecretLabelSelectorRef, out *core.SecretLabelSelectorRef, s conversion.Scope) error {
 	out.Selector = *(*map[string]string)(unsafe.Pointer(&in.Selector))
 	out.Key = in.Key
@@ -2944,7 +3561,7 @@ func Convert_core_StaticDataSource_To_v1alpha1_StaticDataSource(in *core.StaticD
 }
 
 func autoConvert_v1alpha1_StaticDataValueFrom_To_core_StaticDataValueFrom(in *StaticDataValueFrom, out *core.StaticDataValueFrom, s conversion.Scope) error {
-	out.SecretKeyRef = (*v1.SecretKeySelector)(unsafe.Pointer(in.SecretKeyRef))
+	out.SecretKeyRef = (*corev1.SecretKeySelector)(unsafe.Pointer(in.SecretKeyRef))
 	out.SecretLabelSelector = (*core.SecretLabelSelectorRef)(unsafe.Pointer(in.SecretLabelSelector))
 	return nil
 }
@@ -2955,7 +3572,7 @@ func Convert_v1alpha1_StaticDataValueFrom_To_core_StaticDataValueFrom(in *Static
 }
 
 func autoConvert_core_StaticDataValueFrom_To_v1alpha1_StaticDataValueFrom(in *core.StaticDataValueFrom, out *StaticDataValueFrom, s conversion.Scope) error {
-	out.SecretKeyRef = (*v1.SecretKeySelector)(unsafe.Pointer(in.SecretKeyRef))
+	out.SecretKeyRef = (*corev1.SecretKeySelector)(unsafe.Pointer(in.SecretKeyRef))
 	out.SecretLabelSelector = (*SecretLabelSelectorRef)(unsafe.Pointer(in.SecretLabelSelector))
 	return nil
 }
@@ -3158,6 +3775,7 @@ func autoConvert_v1alpha1_Target_To_core_Target(in *Target, out *core.Target, s
 	if err := Convert_v1alpha1_TargetSpec_To_core_TargetSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
+	out.Status = (*core.TargetStatus)(unsafe.Pointer(in.Status))
 	return nil
 }
 
@@ -3171,6 +3789,7 @@ func autoConvert_core_Target_To_v1alpha1_Target(in *core.Target, out *Target, s
 	if err := Convert_core_TargetSpec_To_v1alpha1_TargetSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
+	out.Status = (*TargetStatus)(unsafe.Pointer(in.Status))
 	return nil
 }
 
@@ -3253,6 +3872,28 @@ func Convert_core_TargetList_To_v1alpha1_TargetList(in *core.TargetList, out *Ta
 	return autoConvert_core_TargetList_To_v1alpha1_TargetList(in, out, s)
 }
 
+func autoConvert_v1alpha1_TargetResolverReference_To_core_TargetResolverReference(in *TargetResolverReference, out *core.TargetResolverReference, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Config = (*core.AnyJSON)(unsafe.Pointer(in.Config))
+	return nil
+}
+
+// Convert_v1alpha1_TargetResolverReference_To_core_TargetResolverReference is an autogenerated conversion function.
+func Convert_v1alpha1_TargetResolverReference_To_core_TargetResolverReference(in *TargetResolverReference, out *core.TargetResolverReference, s conversion.Scope) error {
+	return autoConvert_v1alpha1_TargetResolverReference_To_core_TargetResolverReference(in, out, s)
+}
+
+func autoConvert_core_TargetResolverReference_To_v1alpha1_TargetResolverReference(in *core.TargetResolverReference, out *TargetResolverReference, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Config = (*AnyJSON)(unsafe.Pointer(in.Config))
+	return nil
+}
+
+// Convert_core_TargetResolverReference_To_v1alpha1_TargetResolverReference is an autogenerated conversion function.
+func Convert_core_TargetResolverReference_To_v1alpha1_TargetResolverReference(in *core.TargetResolverReference, out *TargetResolverReference, s conversion.Scope) error {
+	return autoConvert_core_TargetResolverReference_To_v1alpha1_TargetResolverReference(in, out, s)
+}
+
 func autoConvert_v1alpha1_TargetSelector_To_core_TargetSelector(in *TargetSelector, out *core.TargetSelector, s conversion.Scope) error {
 	out.Targets = *(*[]core.ObjectReference)(unsafe.Pointer(&in.Targets))
 	out.Annotations = *(*[]core.Requirement)(unsafe.Pointer(&in.Annotations))
@@ -3281,6 +3922,7 @@ func autoConvert_v1alpha1_TargetSpec_To_core_TargetSpec(in *TargetSpec, out *cor
 	out.Type = core.TargetType(in.Type)
 	out.Configuration = (*core.AnyJSON)(unsafe.Pointer(in.Configuration))
 	out.SecretRef = (*core.LocalSecretReference)(unsafe.Pointer(in.SecretRef))
+	out.ResolverRef = (*core.TargetResolverReference)(unsafe.Pointer(in.ResolverRef))
 	return nil
 }
 
@@ -3293,6 +3935,7 @@ func autoConvert_core_TargetSpec_To_v1alpha1_TargetSpec(in *core.TargetSpec, out
 	out.Type = TargetType(in.Type)
 	out.Configuration = (*AnyJSON)(unsafe.Pointer(in.Configuration))
 	out.SecretRef = (*LocalSecretReference)(unsafe.Pointer(in.SecretRef))
+	out.ResolverRef = (*TargetResolverReference)(unsafe.Pointer(in.ResolverRef))
 	return nil
 }
 
@@ -3301,6 +3944,38 @@ func Convert_core_TargetSpec_To_v1alpha1_TargetSpec(in *core.TargetSpec, out *Ta
 	return autoConvert_core_TargetSpec_To_v1alpha1_TargetSpec(in, out, s)
 }
 
+func autoConvert_v1alpha1_TargetStatus_To_core_TargetStatus(in *TargetStatus, out *core.TargetStatus, s conversion.Scope) error {
+	out.ObservedGeneration = in.ObservedGeneration
+	out.Reachable = (*bool)(unsafe.Pointer(in.Reachable))
+	out.LastProbeTime = (*v1.Time)(unsafe.Pointer(in.LastProbeTime))
+	out.LastTransitionTime = (*v1.Time)(unsafe.Pointer(in.LastTransitionTime))
+	out.ServerVersion = in.ServerVersion
+	out.CredentialExpirationTime = (*v1.Time)(unsafe.Pointer(in.CredentialExpirationTime))
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1alpha1_TargetStatus_To_core_TargetStatus is an autogenerated conversion function.
+func Convert_v1alpha1_TargetStatus_To_core_TargetStatus(in *TargetStatus, out *core.TargetStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_TargetStatus_To_core_TargetStatus(in, out, s)
+}
+
+func autoConvert_core_TargetStatus_To_v1alpha1_TargetStatus(in *core.TargetStatus, out *TargetStatus, s conversion.Scope) error {
+	out.ObservedGeneration = in.ObservedGeneration
+	out.Reachable = (*bool)(unsafe.Pointer(in.Reachable))
+	out.LastProbeTime = (*v1.Time)(unsafe.Pointer(in.LastProbeTime))
+	out.LastTransitionTime = (*v1.Time)(unsafe.Pointer(in.LastTransitionTime))
+	out.ServerVersion = in.ServerVersion
+	out.CredentialExpirationTime = (*v1.Time)(unsafe.Pointer(in.CredentialExpirationTime))
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_core_TargetStatus_To_v1alpha1_TargetStatus is an autogenerated conversion function.
+func Convert_core_TargetStatus_To_v1alpha1_TargetStatus(in *core.TargetStatus, out *TargetStatus, s conversion.Scope) error {
+	return autoConvert_core_TargetStatus_To_v1alpha1_TargetStatus(in, out, s)
+}
+
 func autoConvert_v1alpha1_TargetSync_To_core_TargetSync(in *TargetSync, out *core.TargetSync, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1alpha1_TargetSyncSpec_To_core_TargetSyncSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -3363,7 +4038,11 @@ func autoConvert_v1alpha1_TargetSyncSpec_To_core_TargetSyncSpec(in *TargetSyncSp
 	out.CreateTargetToSource = in.CreateTargetToSource
 	out.TargetToSourceName = in.TargetToSourceName
 	out.SecretNameExpression = in.SecretNameExpression
+	out.SecretSelector = (*v1.LabelSelector)(unsafe.Pointer(in.SecretSelector))
+	out.ClusterAPISecrets = in.ClusterAPISecrets
 	out.ShootNameExpression = in.ShootNameExpression
+	out.ShootSelector = (*v1.LabelSelector)(unsafe.Pointer(in.ShootSelector))
+	out.TargetTemplate = (*core.TargetSyncTemplate)(unsafe.Pointer(in.TargetTemplate))
 	out.TokenRotation = (*core.TokenRotation)(unsafe.Pointer(in.TokenRotation))
 	return nil
 }
@@ -3381,7 +4060,11 @@ func autoConvert_core_TargetSyncSpec_To_v1alpha1_TargetSyncSpec(in *core.TargetS
 	out.CreateTargetToSource = in.CreateTargetToSource
 	out.TargetToSourceName = in.TargetToSourceName
 	out.SecretNameExpression = in.SecretNameExpression
+	out.SecretSelector = (*v1.LabelSelector)(unsafe.Pointer(in.SecretSelector))
+	out.ClusterAPISecrets = in.ClusterAPISecrets
 	out.ShootNameExpression = in.ShootNameExpression
+	out.ShootSelector = (*v1.LabelSelector)(unsafe.Pointer(in.ShootSelector))
+	out.TargetTemplate = (*TargetSyncTemplate)(unsafe.Pointer(in.TargetTemplate))
 	out.TokenRotation = (*TokenRotation)(unsafe.Pointer(in.TokenRotation))
 	return nil
 }
@@ -3393,9 +4076,9 @@ func Convert_core_TargetSyncSpec_To_v1alpha1_TargetSyncSpec(in *core.TargetSyncS
 
 func autoConvert_v1alpha1_TargetSyncStatus_To_core_TargetSyncStatus(in *TargetSyncStatus, out *core.TargetSyncStatus, s conversion.Scope) error {
 	out.ObservedGeneration = in.ObservedGeneration
-	out.LastUpdateTime = (*metav1.Time)(unsafe.Pointer(in.LastUpdateTime))
+	out.LastUpdateTime = (*v1.Time)(unsafe.Pointer(in.LastUpdateTime))
 	out.LastErrors = *(*[]string)(unsafe.Pointer(&in.LastErrors))
-	out.LastTokenRotationTime = (*metav1.Time)(unsafe.Pointer(in.LastTokenRotationTime))
+	out.LastTokenRotationTime = (*v1.Time)(unsafe.Pointer(in.LastTokenRotationTime))
 	return nil
 }
 
@@ -3406,9 +4089,9 @@ func Convert_v1alpha1_TargetSyncStatus_To_core_TargetSyncStatus(in *TargetSyncSt
 
 func autoConvert_core_TargetSyncStatus_To_v1alpha1_TargetSyncStatus(in *core.TargetSyncStatus, out *TargetSyncStatus, s conversion.Scope) error {
 	out.ObservedGeneration = in.ObservedGeneration
-	out.LastUpdateTime = (*metav1.Time)(unsafe.Pointer(in.LastUpdateTime))
+	out.LastUpdateTime = (*v1.Time)(unsafe.Pointer(in.LastUpdateTime))
 	out.LastErrors = *(*[]string)(unsafe.Pointer(&in.LastErrors))
-	out.LastTokenRotationTime = (*metav1.Time)(unsafe.Pointer(in.LastTokenRotationTime))
+	out.LastTokenRotationTime = (*v1.Time)(unsafe.Pointer(in.LastTokenRotationTime))
 	return nil
 }
 
@@ -3417,6 +4100,30 @@ func Convert_core_TargetSyncStatus_To_v1alpha1_TargetSyncStatus(in *core.TargetS
 	return autoConvert_core_TargetSyncStatus_To_v1alpha1_TargetSyncStatus(in, out, s)
 }
 
+func autoConvert_v1alpha1_TargetSyncTemplate_To_core_TargetSyncTemplate(in *TargetSyncTemplate, out *core.TargetSyncTemplate, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
+	return nil
+}
+
+// Convert_v1alpha1_TargetSyncTemplate_To_core_TargetSyncTemplate is an autogenerated conversion function.
+func Convert_v1alpha1_TargetSyncTemplate_To_core_TargetSyncTemplate(in *TargetSyncTemplate, out *core.TargetSyncTemplate, s conversion.Scope) error {
+	return autoConvert_v1alpha1_TargetSyncTemplate_To_core_TargetSyncTemplate(in, out, s)
+}
+
+func autoConvert_core_TargetSyncTemplate_To_v1alpha1_TargetSyncTemplate(in *core.TargetSyncTemplate, out *TargetSyncTemplate, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
+	return nil
+}
+
+// Convert_core_TargetSyncTemplate_To_v1alpha1_TargetSyncTemplate is an autogenerated conversion function.
+func Convert_core_TargetSyncTemplate_To_v1alpha1_TargetSyncTemplate(in *core.TargetSyncTemplate, out *TargetSyncTemplate, s conversion.Scope) error {
+	return autoConvert_core_TargetSyncTemplate_To_v1alpha1_TargetSyncTemplate(in, out, s)
+}
+
 func autoConvert_v1alpha1_TargetTemplate_To_core_TargetTemplate(in *TargetTemplate, out *core.TargetTemplate, s conversion.Scope) error {
 	if err := Convert_v1alpha1_TargetSpec_To_core_TargetSpec(&in.TargetSpec, &out.TargetSpec, s); err != nil {
 		return err
@@ -3496,10 +4203,10 @@ func Convert_core_TokenRotation_To_v1alpha1_TokenRotation(in *core.TokenRotation
 }
 
 func autoConvert_v1alpha1_TransitionTimes_To_core_TransitionTimes(in *TransitionTimes, out *core.TransitionTimes, s conversion.Scope) error {
-	out.TriggerTime = (*metav1.Time)(unsafe.Pointer(in.TriggerTime))
-	out.InitTime = (*metav1.Time)(unsafe.Pointer(in.InitTime))
-	out.WaitTime = (*metav1.Time)(unsafe.Pointer(in.WaitTime))
-	out.FinishedTime = (*metav1.Time)(unsafe.Pointer(in.FinishedTime))
+	out.TriggerTime = (*v1.Time)(unsafe.Pointer(in.TriggerTime))
+	out.InitTime = (*v1.Time)(unsafe.Pointer(in.InitTime))
+	out.WaitTime = (*v1.Time)(unsafe.Pointer(in.WaitTime))
+	out.FinishedTime = (*v1.Time)(unsafe.Pointer(in.FinishedTime))
 	return nil
 }
 
@@ -3509,10 +4216,10 @@ func Convert_v1alpha1_TransitionTimes_To_core_TransitionTimes(in *TransitionTime
 }
 
 func autoConvert_core_TransitionTimes_To_v1alpha1_TransitionTimes(in *core.TransitionTimes, out *TransitionTimes, s conversion.Scope) error {
-	out.TriggerTime = (*metav1.Time)(unsafe.Pointer(in.TriggerTime))
-	out.InitTime = (*metav1.Time)(unsafe.Pointer(in.InitTime))
-	out.WaitTime = (*metav1.Time)(unsafe.Pointer(in.WaitTime))
-	out.FinishedTime = (*metav1.Time)(unsafe.Pointer(in.FinishedTime))
+	out.TriggerTime = (*v1.Time)(unsafe.Pointer(in.TriggerTime))
+	out.InitTime = (*v1.Time)(unsafe.Pointer(in.InitTime))
+	out.WaitTime = (*v1.Time)(unsafe.Pointer(in.WaitTime))
+	out.FinishedTime = (*v1.Time)(unsafe.Pointer(in.FinishedTime))
 	return nil
 }
 
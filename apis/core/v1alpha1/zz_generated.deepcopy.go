@@ -13,7 +13,9 @@ import (
 
 	v2 "github.com/gardener/component-spec/bindings-go/apis/v2"
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	intstr "k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -37,6 +39,27 @@ func (in *AnyJSON) DeepCopy() *AnyJSON {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApprovalRequest) DeepCopyInto(out *ApprovalRequest) {
+	*out = *in
+	in.RequestTime.DeepCopyInto(&out.RequestTime)
+	if in.ApprovalTime != nil {
+		in, out := &in.ApprovalTime, &out.ApprovalTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApprovalRequest.
+func (in *ApprovalRequest) DeepCopy() *ApprovalRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(ApprovalRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AutomaticReconcile) DeepCopyInto(out *AutomaticReconcile) {
 	*out = *in
@@ -112,6 +135,13 @@ func (in *Blueprint) DeepCopyInto(out *Blueprint) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.GeneratedSecrets != nil {
+		in, out := &in.GeneratedSecrets, &out.GeneratedSecrets
+		*out = make([]GeneratedSecretDefinition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Exports != nil {
 		in, out := &in.Exports, &out.Exports
 		*out = make(ExportDefinitionList, len(*in))
@@ -502,6 +532,11 @@ func (in *ContextConfiguration) DeepCopyInto(out *ContextConfiguration) {
 			(*out)[key] = *val.DeepCopy()
 		}
 	}
+	if in.MaintenanceWindows != nil {
+		in, out := &in.MaintenanceWindows, &out.MaintenanceWindows
+		*out = make([]MaintenanceWindow, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -854,6 +889,40 @@ func (in *DeployItemCache) DeepCopy() *DeployItemCache {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeployItemHistoryEntry) DeepCopyInto(out *DeployItemHistoryEntry) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.EndTime != nil {
+		in, out := &in.EndTime, &out.EndTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Error != nil {
+		in, out := &in.Error, &out.Error
+		*out = new(Error)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ManagedResources != nil {
+		in, out := &in.ManagedResources, &out.ManagedResources
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployItemHistoryEntry.
+func (in *DeployItemHistoryEntry) DeepCopy() *DeployItemHistoryEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(DeployItemHistoryEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DeployItemList) DeepCopyInto(out *DeployItemList) {
 	*out = *in
@@ -983,6 +1052,18 @@ func (in *DeployItemStatus) DeepCopyInto(out *DeployItemStatus) {
 		*out = new(TransitionTimes)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ApprovalRequest != nil {
+		in, out := &in.ApprovalRequest, &out.ApprovalRequest
+		*out = new(ApprovalRequest)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.History != nil {
+		in, out := &in.History, &out.History
+		*out = make([]DeployItemHistoryEntry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -1213,6 +1294,18 @@ func (in *ExecutionSpec) DeepCopyInto(out *ExecutionSpec) {
 		*out = make([]byte, len(*in))
 		copy(*out, *in)
 	}
+	if in.DeployItemsSecretRef != nil {
+		in, out := &in.DeployItemsSecretRef, &out.DeployItemsSecretRef
+		*out = new(ObjectReference)
+		**out = **in
+	}
+	if in.Rollouts != nil {
+		in, out := &in.Rollouts, &out.Rollouts
+		*out = make([]Rollout, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -1260,6 +1353,13 @@ func (in *ExecutionStatus) DeepCopyInto(out *ExecutionStatus) {
 		*out = new(TransitionTimes)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Rollouts != nil {
+		in, out := &in.Rollouts, &out.Rollouts
+		*out = make([]RolloutStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -1359,6 +1459,182 @@ func (in *FieldValueDefinition) DeepCopy() *FieldValueDefinition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GeneratedCertificate) DeepCopyInto(out *GeneratedCertificate) {
+	*out = *in
+	if in.Organization != nil {
+		in, out := &in.Organization, &out.Organization
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DNSNames != nil {
+		in, out := &in.DNSNames, &out.DNSNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.IPAddresses != nil {
+		in, out := &in.IPAddresses, &out.IPAddresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SANsFromImports != nil {
+		in, out := &in.SANsFromImports, &out.SANsFromImports
+		*out = make([]GeneratedSecretImportReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.Validity != nil {
+		in, out := &in.Validity, &out.Validity
+		*out = new(Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GeneratedCertificate.
+func (in *GeneratedCertificate) DeepCopy() *GeneratedCertificate {
+	if in == nil {
+		return nil
+	}
+	out := new(GeneratedCertificate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GeneratedPassword) DeepCopyInto(out *GeneratedPassword) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GeneratedPassword.
+func (in *GeneratedPassword) DeepCopy() *GeneratedPassword {
+	if in == nil {
+		return nil
+	}
+	out := new(GeneratedPassword)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GeneratedSSHKeyPair) DeepCopyInto(out *GeneratedSSHKeyPair) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GeneratedSSHKeyPair.
+func (in *GeneratedSSHKeyPair) DeepCopy() *GeneratedSSHKeyPair {
+	if in == nil {
+		return nil
+	}
+	out := new(GeneratedSSHKeyPair)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GeneratedSecretDefinition) DeepCopyInto(out *GeneratedSecretDefinition) {
+	*out = *in
+	if in.Certificate != nil {
+		in, out := &in.Certificate, &out.Certificate
+		*out = new(GeneratedCertificate)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SSHKeyPair != nil {
+		in, out := &in.SSHKeyPair, &out.SSHKeyPair
+		*out = new(GeneratedSSHKeyPair)
+		**out = **in
+	}
+	if in.Password != nil {
+		in, out := &in.Password, &out.Password
+		*out = new(GeneratedPassword)
+		**out = **in
+	}
+	if in.Rotation != nil {
+		in, out := &in.Rotation, &out.Rotation
+		*out = new(GeneratedSecretRotation)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GeneratedSecretDefinition.
+func (in *GeneratedSecretDefinition) DeepCopy() *GeneratedSecretDefinition {
+	if in == nil {
+		return nil
+	}
+	out := new(GeneratedSecretDefinition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GeneratedSecretImportReference) DeepCopyInto(out *GeneratedSecretImportReference) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GeneratedSecretImportReference.
+func (in *GeneratedSecretImportReference) DeepCopy() *GeneratedSecretImportReference {
+	if in == nil {
+		return nil
+	}
+	out := new(GeneratedSecretImportReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GeneratedSecretRotation) DeepCopyInto(out *GeneratedSecretRotation) {
+	*out = *in
+	if in.RenewBefore != nil {
+		in, out := &in.RenewBefore, &out.RenewBefore
+		*out = new(Duration)
+		**out = **in
+	}
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GeneratedSecretRotation.
+func (in *GeneratedSecretRotation) DeepCopy() *GeneratedSecretRotation {
+	if in == nil {
+		return nil
+	}
+	out := new(GeneratedSecretRotation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GeneratedSecretStatus) DeepCopyInto(out *GeneratedSecretStatus) {
+	*out = *in
+	in.GenerationTime.DeepCopyInto(&out.GenerationTime)
+	if in.ExpirationTime != nil {
+		in, out := &in.ExpirationTime, &out.ExpirationTime
+		*out = (*in).DeepCopy()
+	}
+	if in.RotationTime != nil {
+		in, out := &in.RotationTime, &out.RotationTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GeneratedSecretStatus.
+func (in *GeneratedSecretStatus) DeepCopy() *GeneratedSecretStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GeneratedSecretStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ImportDefinition) DeepCopyInto(out *ImportDefinition) {
 	*out = *in
@@ -1411,6 +1687,39 @@ func (in ImportDefinitionList) DeepCopy() ImportDefinitionList {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImportSource) DeepCopyInto(out *ImportSource) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImportSource.
+func (in *ImportSource) DeepCopy() *ImportSource {
+	if in == nil {
+		return nil
+	}
+	out := new(ImportSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImportValidationError) DeepCopyInto(out *ImportValidationError) {
+	*out = *in
+	out.Source = in.Source
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImportValidationError.
+func (in *ImportValidationError) DeepCopy() *ImportValidationError {
+	if in == nil {
+		return nil
+	}
+	out := new(ImportValidationError)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InlineBlueprint) DeepCopyInto(out *InlineBlueprint) {
 	*out = *in
@@ -1642,6 +1951,36 @@ func (in *InstallationStatus) DeepCopyInto(out *InstallationStatus) {
 		*out = new(TransitionTimes)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SuspendedSince != nil {
+		in, out := &in.SuspendedSince, &out.SuspendedSince
+		*out = (*in).DeepCopy()
+	}
+	if in.GeneratedSecrets != nil {
+		in, out := &in.GeneratedSecrets, &out.GeneratedSecrets
+		*out = make([]GeneratedSecretStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ImportValidationErrors != nil {
+		in, out := &in.ImportValidationErrors, &out.ImportValidationErrors
+		*out = make([]ImportValidationError, len(*in))
+		copy(*out, *in)
+	}
+	if in.SubInstallationRollouts != nil {
+		in, out := &in.SubInstallationRollouts, &out.SubInstallationRollouts
+		*out = make([]Rollout, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Rollouts != nil {
+		in, out := &in.Rollouts, &out.Rollouts
+		*out = make([]RolloutStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -1858,6 +2197,23 @@ func (in *LsHealthCheckList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceWindow) DeepCopyInto(out *MaintenanceWindow) {
+	*out = *in
+	out.Duration = in.Duration
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindow.
+func (in *MaintenanceWindow) DeepCopy() *MaintenanceWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NamedObjectReference) DeepCopyInto(out *NamedObjectReference) {
 	*out = *in
@@ -1997,6 +2353,95 @@ func (in *ResourceReference) DeepCopy() *ResourceReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Rollout) DeepCopyInto(out *Rollout) {
+	*out = *in
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
+		*out = make([]RolloutTarget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.BatchSize != nil {
+		in, out := &in.BatchSize, &out.BatchSize
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.PauseBetweenBatches != nil {
+		in, out := &in.PauseBetweenBatches, &out.PauseBetweenBatches
+		*out = new(Duration)
+		**out = **in
+	}
+	if in.MaxFailedTargets != nil {
+		in, out := &in.MaxFailedTargets, &out.MaxFailedTargets
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Rollout.
+func (in *Rollout) DeepCopy() *Rollout {
+	if in == nil {
+		return nil
+	}
+	out := new(Rollout)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutStatus) DeepCopyInto(out *RolloutStatus) {
+	*out = *in
+	if in.FailedTargets != nil {
+		in, out := &in.FailedTargets, &out.FailedTargets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NextBatchTime != nil {
+		in, out := &in.NextBatchTime, &out.NextBatchTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutStatus.
+func (in *RolloutStatus) DeepCopy() *RolloutStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutTarget) DeepCopyInto(out *RolloutTarget) {
+	*out = *in
+	if in.DeployItems != nil {
+		in, out := &in.DeployItems, &out.DeployItems
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Installations != nil {
+		in, out := &in.Installations, &out.Installations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutTarget.
+func (in *RolloutTarget) DeepCopy() *RolloutTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecretLabelSelectorRef) DeepCopyInto(out *SecretLabelSelectorRef) {
 	*out = *in
@@ -2291,6 +2736,11 @@ func (in *Target) DeepCopyInto(out *Target) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(TargetStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2389,6 +2839,27 @@ func (in *TargetList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetResolverReference) DeepCopyInto(out *TargetResolverReference) {
+	*out = *in
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = new(AnyJSON)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetResolverReference.
+func (in *TargetResolverReference) DeepCopy() *TargetResolverReference {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetResolverReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TargetSelector) DeepCopyInto(out *TargetSelector) {
 	*out = *in
@@ -2437,6 +2908,11 @@ func (in *TargetSpec) DeepCopyInto(out *TargetSpec) {
 		*out = new(LocalSecretReference)
 		**out = **in
 	}
+	if in.ResolverRef != nil {
+		in, out := &in.ResolverRef, &out.ResolverRef
+		*out = new(TargetResolverReference)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2450,6 +2926,39 @@ func (in *TargetSpec) DeepCopy() *TargetSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetStatus) DeepCopyInto(out *TargetStatus) {
+	*out = *in
+	if in.Reachable != nil {
+		in, out := &in.Reachable, &out.Reachable
+		*out = new(bool)
+		**out = **in
+	}
+	if in.LastProbeTime != nil {
+		in, out := &in.LastProbeTime, &out.LastProbeTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastTransitionTime != nil {
+		in, out := &in.LastTransitionTime, &out.LastTransitionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CredentialExpirationTime != nil {
+		in, out := &in.CredentialExpirationTime, &out.CredentialExpirationTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetStatus.
+func (in *TargetStatus) DeepCopy() *TargetStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TargetSync) DeepCopyInto(out *TargetSync) {
 	*out = *in
@@ -2515,6 +3024,21 @@ func (in *TargetSyncList) DeepCopyObject() runtime.Object {
 func (in *TargetSyncSpec) DeepCopyInto(out *TargetSyncSpec) {
 	*out = *in
 	out.SecretRef = in.SecretRef
+	if in.SecretSelector != nil {
+		in, out := &in.SecretSelector, &out.SecretSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ShootSelector != nil {
+		in, out := &in.ShootSelector, &out.ShootSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TargetTemplate != nil {
+		in, out := &in.TargetTemplate, &out.TargetTemplate
+		*out = new(TargetSyncTemplate)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.TokenRotation != nil {
 		in, out := &in.TokenRotation, &out.TokenRotation
 		*out = new(TokenRotation)
@@ -2562,6 +3086,36 @@ func (in *TargetSyncStatus) DeepCopy() *TargetSyncStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetSyncTemplate) DeepCopyInto(out *TargetSyncTemplate) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetSyncTemplate.
+func (in *TargetSyncTemplate) DeepCopy() *TargetSyncTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetSyncTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TargetTemplate) DeepCopyInto(out *TargetTemplate) {
 	*out = *in
@@ -6,9 +6,11 @@ package validation
 
 import (
 	"fmt"
+	"net"
 	"reflect"
 	"regexp"
 	"strings"
+	"time"
 
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -31,12 +33,15 @@ func init() {
 // ValidateBlueprint validates a Blueprint
 func ValidateBlueprint(blueprint *core.Blueprint) field.ErrorList {
 	allErrs := field.ErrorList{}
-	allErrs = append(allErrs, ValidateBlueprintImportDefinitions(field.NewPath("imports"), blueprint.Imports)...)
+	allErrs = append(allErrs, ValidateJSONSchemaVersion(field.NewPath("jsonSchemaVersion"), blueprint.JSONSchemaVersion)...)
+	importNames, importErrs := validateBlueprintImportDefinitions(field.NewPath("imports"), blueprint.Imports, sets.NewString())
+	allErrs = append(allErrs, importErrs...)
 	allErrs = append(allErrs, ValidateBlueprintExportDefinitions(field.NewPath("exports"), blueprint.Exports)...)
 	allErrs = append(allErrs, ValidateTemplateExecutorList(field.NewPath("deployExecutions"), blueprint.DeployExecutions)...)
 	allErrs = append(allErrs, ValidateTemplateExecutorList(field.NewPath("exportExecutions"), blueprint.ExportExecutions)...)
 	allErrs = append(allErrs, ValidateSubinstallations(field.NewPath("subinstallations"), blueprint.Subinstallations)...)
 	allErrs = append(allErrs, ValidateTemplateExecutorList(field.NewPath("subinstallationExecutions"), blueprint.SubinstallationExecutions)...)
+	allErrs = append(allErrs, ValidateGeneratedSecrets(field.NewPath("generatedSecrets"), blueprint.GeneratedSecrets, importNames)...)
 	return allErrs
 }
 
@@ -199,12 +204,185 @@ func ValidateFieldValueDefinition(fldPath *field.Path, def core.FieldValueDefini
 	return allErrs
 }
 
+// supportedJSONSchemaVersions contains the urls of the meta schemas of the supported jsonschema drafts
+// without scheme and empty fragment.
+var supportedJSONSchemaVersions = sets.New[string](
+	"json-schema.org/draft-04/schema",
+	"json-schema.org/draft-06/schema",
+	"json-schema.org/draft-07/schema",
+	"json-schema.org/draft/2019-09/schema",
+	"json-schema.org/draft/2020-12/schema",
+	"json-schema.org/schema",
+)
+
+// ValidateJSONSchemaVersion validates the default jsonschema version of a blueprint.
+// An empty version is valid as it is defaulted.
+func ValidateJSONSchemaVersion(fldPath *field.Path, version string) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if len(version) == 0 {
+		return allErrs
+	}
+	u := strings.TrimSuffix(version, "#")
+	u = strings.TrimPrefix(u, "http://")
+	u = strings.TrimPrefix(u, "https://")
+	if !supportedJSONSchemaVersions.Has(u) {
+		allErrs = append(allErrs, field.NotSupported(fldPath, version, []string{
+			"https://json-schema.org/draft-04/schema",
+			"https://json-schema.org/draft-06/schema",
+			"https://json-schema.org/draft-07/schema",
+			"https://json-schema.org/draft/2019-09/schema",
+			"https://json-schema.org/draft/2020-12/schema",
+		}))
+	}
+	return allErrs
+}
+
 // ValidateJsonSchema validates a json schema
 func ValidateJsonSchema(fldPath *field.Path, schema *core.JSONSchemaDefinition) field.ErrorList {
 	allErrs := field.ErrorList{}
 	return allErrs
 }
 
+// ValidateGeneratedSecrets validates the definitions of generated secrets.
+// The names of the generated secrets must be unique and must not clash with the names of the imports of the blueprint.
+func ValidateGeneratedSecrets(fldPath *field.Path, secrets []core.GeneratedSecretDefinition, importNames sets.String) field.ErrorList { //nolint:staticcheck // Ignore SA1019 // TODO: change to generic set
+	allErrs := field.ErrorList{}
+
+	// types of the already validated definitions, certificate authorities have to be defined before they are used.
+	definedTypes := map[string]core.GeneratedSecretType{}
+	for i, def := range secrets {
+		defPath := fldPath.Index(i)
+		if len(def.Name) == 0 {
+			allErrs = append(allErrs, field.Required(defPath.Child("name"), "name must not be empty"))
+		} else {
+			defPath = defPath.Key(def.Name)
+			if _, ok := definedTypes[def.Name]; ok {
+				allErrs = append(allErrs, field.Duplicate(defPath, "duplicated generated secret name"))
+			}
+			if importNames.Has(def.Name) {
+				allErrs = append(allErrs, field.Duplicate(defPath, "an import with the same name is already defined"))
+			}
+		}
+
+		switch def.Type {
+		case core.GeneratedSecretTypeCA, core.GeneratedSecretTypeCertificate:
+			allErrs = append(allErrs, validateGeneratedCertificate(defPath.Child("certificate"), def, definedTypes, importNames)...)
+		case core.GeneratedSecretTypeSSHKeyPair:
+			if def.SSHKeyPair != nil {
+				allErrs = append(allErrs, validateGeneratedSSHKeyPair(defPath.Child("sshKeyPair"), def.SSHKeyPair)...)
+			}
+		case core.GeneratedSecretTypePassword:
+			if def.Password != nil && def.Password.Length < 0 {
+				allErrs = append(allErrs, field.Invalid(defPath.Child("password", "length"), def.Password.Length, "length must not be negative"))
+			}
+		default:
+			allErrs = append(allErrs, field.NotSupported(defPath.Child("type"), string(def.Type), []string{
+				string(core.GeneratedSecretTypeCA),
+				string(core.GeneratedSecretTypeCertificate),
+				string(core.GeneratedSecretTypeSSHKeyPair),
+				string(core.GeneratedSecretTypePassword),
+			}))
+		}
+
+		if def.Certificate != nil && def.Type != core.GeneratedSecretTypeCA && def.Type != core.GeneratedSecretTypeCertificate {
+			allErrs = append(allErrs, field.Forbidden(defPath.Child("certificate"), fmt.Sprintf("certificate must not be set for type %s", def.Type)))
+		}
+		if def.SSHKeyPair != nil && def.Type != core.GeneratedSecretTypeSSHKeyPair {
+			allErrs = append(allErrs, field.Forbidden(defPath.Child("sshKeyPair"), fmt.Sprintf("sshKeyPair must not be set for type %s", def.Type)))
+		}
+		if def.Password != nil && def.Type != core.GeneratedSecretTypePassword {
+			allErrs = append(allErrs, field.Forbidden(defPath.Child("password"), fmt.Sprintf("password must not be set for type %s", def.Type)))
+		}
+
+		if def.Rotation != nil {
+			rotationPath := defPath.Child("rotation")
+			if def.Rotation.RenewBefore != nil {
+				if def.Type != core.GeneratedSecretTypeCA && def.Type != core.GeneratedSecretTypeCertificate {
+					allErrs = append(allErrs, field.Forbidden(rotationPath.Child("renewBefore"), "renewBefore is only supported for certificate authorities and certificates"))
+				} else if def.Rotation.RenewBefore.Duration <= 0 {
+					allErrs = append(allErrs, field.Invalid(rotationPath.Child("renewBefore"), def.Rotation.RenewBefore.Duration.String(), "duration must be positive"))
+				} else if def.Rotation.RenewBefore.Duration >= certificateValidity(def) {
+					allErrs = append(allErrs, field.Invalid(rotationPath.Child("renewBefore"), def.Rotation.RenewBefore.Duration.String(), "duration must be shorter than the validity of the certificate"))
+				}
+			}
+			if def.Rotation.Interval != nil && def.Rotation.Interval.Duration <= 0 {
+				allErrs = append(allErrs, field.Invalid(rotationPath.Child("interval"), def.Rotation.Interval.Duration.String(), "duration must be positive"))
+			}
+		}
+
+		if len(def.Name) != 0 {
+			definedTypes[def.Name] = def.Type
+		}
+	}
+	return allErrs
+}
+
+func validateGeneratedCertificate(fldPath *field.Path, def core.GeneratedSecretDefinition, definedTypes map[string]core.GeneratedSecretType, importNames sets.String) field.ErrorList { //nolint:staticcheck // Ignore SA1019 // TODO: change to generic set
+	allErrs := field.ErrorList{}
+	cert := def.Certificate
+	if cert == nil {
+		return append(allErrs, field.Required(fldPath, fmt.Sprintf("certificate must not be empty for type %s", def.Type)))
+	}
+	if len(cert.CommonName) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("commonName"), "common name must not be empty"))
+	}
+	for i, ip := range cert.IPAddresses {
+		if net.ParseIP(ip) == nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("ipAddresses").Index(i), ip, "invalid ip address"))
+		}
+	}
+	for i, ref := range cert.SANsFromImports {
+		if !importNames.Has(ref.Import) {
+			allErrs = append(allErrs, field.NotFound(fldPath.Child("sansFromImports").Index(i).Child("import"), ref.Import))
+		}
+	}
+	if len(cert.CA) != 0 {
+		caPath := fldPath.Child("ca")
+		if def.Type == core.GeneratedSecretTypeCA {
+			allErrs = append(allErrs, field.Forbidden(caPath, "certificate authorities are always self-signed"))
+		} else if t, ok := definedTypes[cert.CA]; !ok {
+			allErrs = append(allErrs, field.NotFound(caPath, cert.CA))
+		} else if t != core.GeneratedSecretTypeCA {
+			allErrs = append(allErrs, field.Invalid(caPath, cert.CA, fmt.Sprintf("generated secret is of type %s but must be of type %s", t, core.GeneratedSecretTypeCA)))
+		}
+	}
+	if cert.Validity != nil && cert.Validity.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("validity"), cert.Validity.Duration.String(), "duration must be positive"))
+	}
+	return allErrs
+}
+
+// certificateValidity returns the validity of a generated certificate authority or certificate including its default.
+func certificateValidity(def core.GeneratedSecretDefinition) time.Duration {
+	if def.Certificate != nil && def.Certificate.Validity != nil {
+		return def.Certificate.Validity.Duration
+	}
+	if def.Type == core.GeneratedSecretTypeCA {
+		return 10 * 365 * 24 * time.Hour
+	}
+	return 365 * 24 * time.Hour
+}
+
+func validateGeneratedSSHKeyPair(fldPath *field.Path, keyPair *core.GeneratedSSHKeyPair) field.ErrorList {
+	allErrs := field.ErrorList{}
+	switch keyPair.Algorithm {
+	case "", core.SSHKeyAlgorithmEd25519:
+		if keyPair.Bits != 0 {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("bits"), "bits are only supported for rsa keys"))
+		}
+	case core.SSHKeyAlgorithmRSA:
+		if keyPair.Bits != 0 && keyPair.Bits < 2048 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("bits"), keyPair.Bits, "rsa keys must have at least 2048 bits"))
+		}
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("algorithm"), string(keyPair.Algorithm), []string{
+			string(core.SSHKeyAlgorithmEd25519),
+			string(core.SSHKeyAlgorithmRSA),
+		}))
+	}
+	return allErrs
+}
+
 // ValidateTemplateExecutorList validates a list of template executors
 func ValidateTemplateExecutorList(fldPath *field.Path, list []core.TemplateExecutor) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -8,6 +8,7 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	"github.com/gardener/landscaper/apis/core"
@@ -16,6 +17,27 @@ import (
 
 var _ = Describe("Blueprint", func() {
 
+	Context("JSONSchemaVersion", func() {
+		It("should pass if the jsonschema version is supported or empty", func() {
+			for _, version := range []string{
+				"",
+				"https://json-schema.org/draft/2019-09/schema",
+				"http://json-schema.org/draft-07/schema#",
+				"https://json-schema.org/draft/2020-12/schema",
+			} {
+				Expect(validation.ValidateJSONSchemaVersion(field.NewPath("jsonSchemaVersion"), version)).To(HaveLen(0), version)
+			}
+		})
+
+		It("should fail if the jsonschema version of a blueprint is unknown", func() {
+			allErrs := validation.ValidateBlueprint(&core.Blueprint{JSONSchemaVersion: "https://example.com/schema"})
+			Expect(allErrs).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("jsonSchemaVersion"),
+			}))))
+		})
+	})
+
 	Context("ImportDefinitions", func() {
 		It("should pass if a ImportDefinition is valid", func() {
 			impDef1 := core.ImportDefinition{}
@@ -246,6 +268,134 @@ var _ = Describe("Blueprint", func() {
 		})
 	})
 
+	Context("GeneratedSecrets", func() {
+		It("should pass if the generated secrets are valid", func() {
+			secrets := []core.GeneratedSecretDefinition{
+				{
+					Name: "ca",
+					Type: core.GeneratedSecretTypeCA,
+					Certificate: &core.GeneratedCertificate{
+						CommonName: "my-ca",
+					},
+				},
+				{
+					Name: "tls",
+					Type: core.GeneratedSecretTypeCertificate,
+					Certificate: &core.GeneratedCertificate{
+						CommonName:      "my-server",
+						IPAddresses:     []string{"10.0.0.1"},
+						SANsFromImports: []core.GeneratedSecretImportReference{{Import: "config", Path: ".hosts"}},
+						CA:              "ca",
+					},
+				},
+				{
+					Name:       "ssh",
+					Type:       core.GeneratedSecretTypeSSHKeyPair,
+					SSHKeyPair: &core.GeneratedSSHKeyPair{Algorithm: core.SSHKeyAlgorithmRSA, Bits: 4096},
+				},
+				{
+					Name: "password",
+					Type: core.GeneratedSecretTypePassword,
+				},
+			}
+
+			allErrs := validation.ValidateGeneratedSecrets(field.NewPath(""), secrets, sets.NewString("config"))
+			Expect(allErrs).To(HaveLen(0))
+		})
+
+		It("should fail if the name of a generated secret clashes with an import", func() {
+			secrets := []core.GeneratedSecretDefinition{
+				{Name: "password", Type: core.GeneratedSecretTypePassword},
+			}
+
+			allErrs := validation.ValidateGeneratedSecrets(field.NewPath("b"), secrets, sets.NewString("password"))
+			Expect(allErrs).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeDuplicate),
+				"Field": Equal("b[0][password]"),
+			}))))
+		})
+
+		It("should fail if the type of a generated secret is not supported", func() {
+			secrets := []core.GeneratedSecretDefinition{
+				{Name: "token", Type: "token"},
+			}
+
+			allErrs := validation.ValidateGeneratedSecrets(field.NewPath("b"), secrets, sets.NewString())
+			Expect(allErrs).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("b[0][token].type"),
+			}))))
+		})
+
+		It("should fail if a certificate has no configuration", func() {
+			secrets := []core.GeneratedSecretDefinition{
+				{Name: "tls", Type: core.GeneratedSecretTypeCertificate},
+			}
+
+			allErrs := validation.ValidateGeneratedSecrets(field.NewPath("b"), secrets, sets.NewString())
+			Expect(allErrs).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("b[0][tls].certificate"),
+			}))))
+		})
+
+		It("should fail if a certificate references a certificate authority that is not defined before", func() {
+			secrets := []core.GeneratedSecretDefinition{
+				{
+					Name:        "tls",
+					Type:        core.GeneratedSecretTypeCertificate,
+					Certificate: &core.GeneratedCertificate{CommonName: "my-server", CA: "ca"},
+				},
+				{
+					Name:        "ca",
+					Type:        core.GeneratedSecretTypeCA,
+					Certificate: &core.GeneratedCertificate{CommonName: "my-ca"},
+				},
+			}
+
+			allErrs := validation.ValidateGeneratedSecrets(field.NewPath("b"), secrets, sets.NewString())
+			Expect(allErrs).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotFound),
+				"Field": Equal("b[0][tls].certificate.ca"),
+			}))))
+		})
+
+		It("should fail if the subject alternative names reference an unknown import", func() {
+			secrets := []core.GeneratedSecretDefinition{
+				{
+					Name: "tls",
+					Type: core.GeneratedSecretTypeCertificate,
+					Certificate: &core.GeneratedCertificate{
+						CommonName:      "my-server",
+						SANsFromImports: []core.GeneratedSecretImportReference{{Import: "config"}},
+					},
+				},
+			}
+
+			allErrs := validation.ValidateGeneratedSecrets(field.NewPath("b"), secrets, sets.NewString())
+			Expect(allErrs).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotFound),
+				"Field": Equal("b[0][tls].certificate.sansFromImports[0].import"),
+			}))))
+		})
+
+		It("should fail if a configuration of another type is given", func() {
+			secrets := []core.GeneratedSecretDefinition{
+				{
+					Name:     "ssh",
+					Type:     core.GeneratedSecretTypeSSHKeyPair,
+					Password: &core.GeneratedPassword{Length: 16},
+				},
+			}
+
+			allErrs := validation.ValidateGeneratedSecrets(field.NewPath("b"), secrets, sets.NewString())
+			Expect(allErrs).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("b[0][ssh].password"),
+			}))))
+		})
+	})
+
 	Context("InstallationTemplate", func() {
 		It("should pass if a InstallationTemplate is valid", func() {
 			installationTemplate := &core.InstallationTemplate{}
@@ -0,0 +1,40 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"github.com/robfig/cron/v3"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/landscaper/apis/core"
+)
+
+// ValidateContext validates a Context
+func ValidateContext(lsCtx *core.Context) field.ErrorList {
+	allErrs := field.ErrorList{}
+	allErrs = append(allErrs, ValidateMaintenanceWindows(lsCtx.MaintenanceWindows, field.NewPath("maintenanceWindows"))...)
+	return allErrs
+}
+
+// ValidateMaintenanceWindows validates the maintenance windows of a context.
+func ValidateMaintenanceWindows(windows []core.MaintenanceWindow, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for i, w := range windows {
+		wPath := fldPath.Index(i)
+		if len(w.CronSpec) == 0 {
+			allErrs = append(allErrs, field.Required(wPath.Child("cronSpec"), "the cron spec of the maintenance window must be set"))
+		} else if _, err := cron.ParseStandard(w.CronSpec); err != nil {
+			allErrs = append(allErrs, field.Invalid(wPath.Child("cronSpec"), w.CronSpec,
+				"field must be a valid cron spec: "+err.Error()))
+		}
+		if w.Duration.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(wPath.Child("duration"), w.Duration.Duration.String(),
+				"the duration of the maintenance window must be positive"))
+		}
+	}
+
+	return allErrs
+}
@@ -0,0 +1,72 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/landscaper/apis/core"
+	"github.com/gardener/landscaper/apis/core/validation"
+)
+
+var _ = Describe("Context", func() {
+	Context("MaintenanceWindows", func() {
+
+		It("should accept valid maintenance windows", func() {
+			lsCtx := &core.Context{
+				ContextConfiguration: core.ContextConfiguration{
+					MaintenanceWindows: []core.MaintenanceWindow{
+						{CronSpec: "0 22 * * 1-5", Duration: core.Duration{Duration: 4 * time.Hour}},
+						{CronSpec: "CRON_TZ=Europe/Berlin 0 10 * * 6", Duration: core.Duration{Duration: time.Hour}},
+					},
+				},
+			}
+
+			allErrs := validation.ValidateContext(lsCtx)
+			Expect(allErrs).To(BeEmpty())
+		})
+
+		It("should reject an invalid cron spec", func() {
+			lsCtx := &core.Context{
+				ContextConfiguration: core.ContextConfiguration{
+					MaintenanceWindows: []core.MaintenanceWindow{
+						{CronSpec: "0 25 * * *", Duration: core.Duration{Duration: time.Hour}},
+					},
+				},
+			}
+
+			allErrs := validation.ValidateContext(lsCtx)
+			Expect(allErrs).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("maintenanceWindows[0].cronSpec"),
+			}))))
+		})
+
+		It("should reject a missing cron spec and a non-positive duration", func() {
+			lsCtx := &core.Context{
+				ContextConfiguration: core.ContextConfiguration{
+					MaintenanceWindows: []core.MaintenanceWindow{{}},
+				},
+			}
+
+			allErrs := validation.ValidateContext(lsCtx)
+			Expect(allErrs).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("maintenanceWindows[0].cronSpec"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("maintenanceWindows[0].duration"),
+				})),
+			))
+		})
+	})
+})
@@ -6,6 +6,7 @@ package validation
 
 import (
 	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
@@ -23,6 +24,104 @@ func ValidateExecution(execution *core.Execution) field.ErrorList {
 func ValidateExecutionSpec(fldpath *field.Path, spec core.ExecutionSpec) field.ErrorList {
 	allErrs := field.ErrorList{}
 	allErrs = append(allErrs, ValidateDeployItemTemplateList(fldpath.Child("deployItems"), spec.DeployItems)...)
+	allErrs = append(allErrs, ValidateRollouts(fldpath.Child("rollouts"), spec.Rollouts, spec.DeployItems)...)
+	return allErrs
+}
+
+// ValidateRollouts validates the rollouts of an execution.
+// Every deploy item of a rollout must be defined in the deploy item templates and may belong to at most one rollout.
+// The check of the deploy items is skipped if no deploy item templates are given, e.g. because they are stored in a secret.
+func ValidateRollouts(fldPath *field.Path, rollouts []core.Rollout, deployItems core.DeployItemTemplateList) field.ErrorList {
+	diNames := sets.NewString()
+	for _, tmpl := range deployItems {
+		diNames.Insert(tmpl.Name)
+	}
+
+	return validateRollouts(fldPath, rollouts, "deployItems", diNames, func(target core.RolloutTarget) []string {
+		return target.DeployItems
+	})
+}
+
+// ValidateSubInstallationRollouts validates the rollouts of the subinstallations of an installation.
+// Every subinstallation of a rollout must be defined by an installation template and may belong to at most one rollout.
+func ValidateSubInstallationRollouts(fldPath *field.Path, rollouts []core.Rollout, installationTemplateNames []string) field.ErrorList {
+	return validateRollouts(fldPath, rollouts, "installations", sets.NewString(installationTemplateNames...), func(target core.RolloutTarget) []string {
+		return target.Installations
+	})
+}
+
+// validateRollouts validates rollouts whose targets consist of the objects returned by getMembers.
+// The members are checked against the given names, unless no names are given.
+func validateRollouts(fldPath *field.Path, rollouts []core.Rollout, membersField string, names sets.String, //nolint:staticcheck // Ignore SA1019 // TODO: change to generic set
+	getMembers func(target core.RolloutTarget) []string) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	rolloutNames := sets.NewString()
+	rolledOutMembers := sets.NewString()
+	for i, rollout := range rollouts {
+		rolloutPath := fldPath.Index(i)
+		if len(rollout.Name) == 0 {
+			allErrs = append(allErrs, field.Required(rolloutPath.Child("name"), "name must not be empty"))
+		} else if rolloutNames.Has(rollout.Name) {
+			allErrs = append(allErrs, field.Duplicate(rolloutPath.Child("name"), rollout.Name))
+		}
+		rolloutNames.Insert(rollout.Name)
+
+		keys := sets.NewString()
+		for j, target := range rollout.Targets {
+			targetPath := rolloutPath.Child("targets").Index(j)
+			if keys.Has(target.Key) {
+				allErrs = append(allErrs, field.Duplicate(targetPath.Child("key"), target.Key))
+			}
+			keys.Insert(target.Key)
+
+			for k, name := range getMembers(target) {
+				memberPath := targetPath.Child(membersField).Index(k)
+				if names.Len() > 0 && !names.Has(name) {
+					allErrs = append(allErrs, field.NotFound(memberPath, name))
+				}
+				if rolledOutMembers.Has(name) {
+					allErrs = append(allErrs, field.Duplicate(memberPath, name))
+				}
+				rolledOutMembers.Insert(name)
+			}
+		}
+
+		if len(rollout.Canary) != 0 && !keys.Has(rollout.Canary) {
+			allErrs = append(allErrs, field.NotFound(rolloutPath.Child("canary"), rollout.Canary))
+		}
+
+		if rollout.BatchSize != nil {
+			allErrs = append(allErrs, validateBatchSize(rolloutPath.Child("batchSize"), *rollout.BatchSize)...)
+		}
+
+		if rollout.PauseBetweenBatches != nil && rollout.PauseBetweenBatches.Duration < 0 {
+			allErrs = append(allErrs, field.Invalid(rolloutPath.Child("pauseBetweenBatches"), rollout.PauseBetweenBatches.Duration.String(), "must not be negative"))
+		}
+
+		if rollout.MaxFailedTargets != nil && *rollout.MaxFailedTargets < 0 {
+			allErrs = append(allErrs, field.Invalid(rolloutPath.Child("maxFailedTargets"), *rollout.MaxFailedTargets, "must not be negative"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateBatchSize(fldPath *field.Path, batchSize intstr.IntOrString) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if batchSize.Type == intstr.Int {
+		if batchSize.IntVal < 1 {
+			allErrs = append(allErrs, field.Invalid(fldPath, batchSize.IntVal, "must be at least 1"))
+		}
+		return allErrs
+	}
+
+	percent, err := intstr.GetScaledValueFromIntOrPercent(&batchSize, 100, true)
+	if err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath, batchSize.StrVal, err.Error()))
+	} else if percent < 1 || percent > 100 {
+		allErrs = append(allErrs, field.Invalid(fldPath, batchSize.StrVal, "must be between 1% and 100%"))
+	}
 	return allErrs
 }
 
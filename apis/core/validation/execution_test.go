@@ -8,6 +8,7 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	"github.com/gardener/landscaper/apis/core"
@@ -150,4 +151,87 @@ var _ = Describe("Execution", func() {
 
 	})
 
+	Context("ValidateRollouts", func() {
+		deployItems := core.DeployItemTemplateList{
+			{Name: "a", Type: "mytype"},
+			{Name: "b", Type: "mytype"},
+		}
+
+		It("should pass if a rollout is valid", func() {
+			batchSize := intstr.FromString("50%")
+			rollouts := []core.Rollout{{
+				Name: "clusters",
+				Targets: []core.RolloutTarget{
+					{Key: "x", DeployItems: []string{"a"}},
+					{Key: "y", DeployItems: []string{"b"}},
+				},
+				Canary:    "x",
+				BatchSize: &batchSize,
+			}}
+
+			allErrs := validation.ValidateRollouts(field.NewPath("r"), rollouts, deployItems)
+			Expect(allErrs).To(HaveLen(0))
+		})
+
+		It("should fail if a rollout references unknown deploy items and targets", func() {
+			batchSize := intstr.FromInt(0)
+			rollouts := []core.Rollout{{
+				Name: "clusters",
+				Targets: []core.RolloutTarget{
+					{Key: "x", DeployItems: []string{"c"}},
+				},
+				Canary:    "y",
+				BatchSize: &batchSize,
+			}}
+
+			allErrs := validation.ValidateRollouts(field.NewPath("r"), rollouts, deployItems)
+			Expect(allErrs).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotFound),
+				"Field": Equal("r[0].targets[0].deployItems[0]"),
+			}))))
+			Expect(allErrs).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotFound),
+				"Field": Equal("r[0].canary"),
+			}))))
+			Expect(allErrs).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("r[0].batchSize"),
+			}))))
+		})
+
+		It("should fail if a deploy item belongs to several rollouts", func() {
+			rollouts := []core.Rollout{
+				{Name: "r1", Targets: []core.RolloutTarget{{Key: "x", DeployItems: []string{"a"}}}},
+				{Name: "r2", Targets: []core.RolloutTarget{{Key: "x", DeployItems: []string{"a"}}}},
+			}
+
+			allErrs := validation.ValidateRollouts(field.NewPath("r"), rollouts, deployItems)
+			Expect(allErrs).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeDuplicate),
+				"Field": Equal("r[1].targets[0].deployItems[0]"),
+			}))))
+		})
+
+		It("should validate the subinstallations of a rollout", func() {
+			rollouts := []core.Rollout{{
+				Name: "clusters",
+				Targets: []core.RolloutTarget{
+					{Key: "x", Installations: []string{"sub-x"}},
+					{Key: "y", Installations: []string{"sub-y", "sub-x"}},
+				},
+			}}
+
+			allErrs := validation.ValidateSubInstallationRollouts(field.NewPath("r"), rollouts, []string{"sub-x"})
+			Expect(allErrs).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotFound),
+					"Field": Equal("r[0].targets[1].installations[0]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("r[0].targets[1].installations[1]"),
+				})),
+			))
+		})
+	})
 })
@@ -24,5 +24,14 @@ func ValidateTargetSpec(spec *core.TargetSpec, fldPath *field.Path) field.ErrorL
 		allErrs = append(allErrs, field.Invalid(fldPath, spec, "either config or secretRef may be set, not both"))
 	}
 
+	if spec.ResolverRef != nil {
+		if spec.Configuration != nil || spec.SecretRef != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath, spec, "resolverRef must not be set together with config or secretRef"))
+		}
+		if len(spec.ResolverRef.Name) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("resolverRef", "name"), "the name of the target resolver must be set"))
+		}
+	}
+
 	return allErrs
 }
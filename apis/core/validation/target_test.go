@@ -67,5 +67,52 @@ var _ = Describe("Target", func() {
 			Expect(allErrs).To(BeEmpty())
 		})
 
+		It("should accept a Target with a resolverRef", func() {
+			t := &core.Target{
+				Spec: core.TargetSpec{
+					ResolverRef: &core.TargetResolverReference{
+						Name:   "exec",
+						Config: core.NewAnyJSONPointer([]byte("{}")),
+					},
+				},
+			}
+
+			allErrs := validation.ValidateTarget(t)
+			Expect(allErrs).To(BeEmpty())
+		})
+
+		It("should reject a Target with a resolverRef and a secretRef", func() {
+			t := &core.Target{
+				Spec: core.TargetSpec{
+					SecretRef: &core.LocalSecretReference{
+						Name: "foo",
+					},
+					ResolverRef: &core.TargetResolverReference{
+						Name: "exec",
+					},
+				},
+			}
+
+			allErrs := validation.ValidateTarget(t)
+			Expect(allErrs).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec"),
+			}))))
+		})
+
+		It("should reject a Target with a resolverRef without name", func() {
+			t := &core.Target{
+				Spec: core.TargetSpec{
+					ResolverRef: &core.TargetResolverReference{},
+				},
+			}
+
+			allErrs := validation.ValidateTarget(t)
+			Expect(allErrs).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.resolverRef.name"),
+			}))))
+		})
+
 	})
 })
@@ -89,6 +89,13 @@ const TargetFileName = "target.json"
 // TargetPath is the path to the target content file.
 var TargetPath = filepath.Join(SharedBasePath, "targets", TargetFileName)
 
+// TargetsDirName is the name of the env var that points to the directory that contains the target of the deploy item
+// and the additional targets of the provider configuration.
+const TargetsDirName = "TARGETS_DIR"
+
+// TargetsDir is the directory that contains the target content files.
+var TargetsDir = filepath.Dir(TargetPath)
+
 // TargetInitDir is the directory in which the target is mounted in the init container, which then copies it to TargetPath.
 var TargetInitDir = filepath.Join(BasePath, "targets")
 
@@ -98,6 +105,12 @@ const ContentPathName = "CONTENT_PATH"
 // ContentPath is the path to the content directory.
 var ContentPath = filepath.Join(SharedBasePath, "content")
 
+// ProgressPathName is the name of the env var that points to the file in which the main container can report its progress.
+const ProgressPathName = "PROGRESS_PATH"
+
+// ProgressPath is the path to the progress file.
+var ProgressPath = filepath.Join(SharedBasePath, "progress", "progress.json")
+
 // StatePathName is the name of the env var that points to the directory where the state can be stored.
 const StatePathName = "STATE_PATH"
 
@@ -116,6 +129,10 @@ var ConfigurationPath = filepath.Join(BasePath, "internal", ConfigurationFilenam
 // RegistrySecretBasePath is the path to all OCI pull secrets
 var RegistrySecretBasePath = filepath.Join(BasePath, "registry_secrets")
 
+// BlueprintStoreConfigurationName is the name of the env var that contains the json encoded configuration
+// of the blueprint store that is shared by the init containers.
+const BlueprintStoreConfigurationName = "BLUEPRINT_STORE_CONFIGURATION"
+
 // OCMConfigPathName is the name of the env var that points to the ocm config file.
 const OCMConfigPathName = "OCM_CONFIGURATION_PATH"
 
@@ -149,6 +166,10 @@ const InitContainerName = "init"
 // WaitContainerName is the name of the container running the sidecar container.
 const WaitContainerName = "wait"
 
+// ContainerDeployerProgressAnnotation is the annotation of a pod that contains the last progress reported by the main container.
+// It is set by the wait container and copied into the status of the deploy item by the container deployer.
+const ContainerDeployerProgressAnnotation = "container.deployer.landscaper.gardener.cloud/progress"
+
 // ContainerDeployerStateUUIDAnnotation is a annotation that is used to group chunks
 // that are stored in the secrets.
 const ContainerDeployerStateUUIDAnnotation = "container.deployer.landscaper.gardener.cloud/uuid"
@@ -175,10 +196,18 @@ var (
 			Name:  TargetPathName,
 			Value: TargetPath,
 		},
+		{
+			Name:  TargetsDirName,
+			Value: TargetsDir,
+		},
 		{
 			Name:  ContentPathName,
 			Value: ContentPath,
 		},
+		{
+			Name:  ProgressPathName,
+			Value: ProgressPath,
+		},
 		{
 			Name:  StatePathName,
 			Value: StatePath,
@@ -30,6 +30,9 @@ type Configuration struct {
 
 	// TargetSelector describes all selectors the deployer should depend on.
 	TargetSelector []lsv1alpha1.TargetSelector `json:"targetSelector,omitempty"`
+	// TargetResolvers enables built-in target resolvers that are disabled by default.
+	// +optional
+	TargetResolvers *lsconfigv1alpha1.TargetResolversConfiguration `json:"targetResolvers,omitempty"`
 
 	// Namespace defines the namespace where the pods should be executed.
 	Namespace string `json:"namespace"`
@@ -58,6 +61,19 @@ type Configuration struct {
 
 	// Controller contains configuration concerning the controller framework.
 	Controller Controller `json:"controller,omitempty"`
+
+	// BlueprintStore configures a blueprint store for the init containers.
+	// The store is located on the volume that is defined by BlueprintStoreVolumeClaimName.
+	// +optional
+	BlueprintStore *lsconfigv1alpha1.BlueprintStore `json:"blueprintStore,omitempty"`
+
+	// BlueprintStoreVolumeClaimName is the name of a persistent volume claim in the namespace of the pods
+	// that contains the blueprint store. The claim has to be provisioned by the operator of the deployer.
+	// Every namespace of deploy items uses its own sub directory of the volume, so that the stored blueprints
+	// and component descriptors are only shared by the pods of the same namespace.
+	// If no claim is defined, the store is located on an emptyDir volume that is not shared with other pods.
+	// +optional
+	BlueprintStoreVolumeClaimName string `json:"blueprintStoreVolumeClaimName,omitempty"`
 }
 
 // ContainerSpec defines a container specification
@@ -63,6 +63,46 @@ type ProviderConfiguration struct {
 	// ContinuousReconcile contains the schedule for continuous reconciliation.
 	// +optional
 	ContinuousReconcile *cr.ContinuousReconcileSpec `json:"continuousReconcile,omitempty"`
+	// OnDelete defines the container that is executed when the deploy item is deleted.
+	// By default, the same container is executed for the reconcile and the delete operation.
+	// +optional
+	OnDelete *DeleteConfiguration `json:"onDelete,omitempty"`
+	// Targets are additional targets that are provided to the container.
+	// Every target is written as "<name>.json" into the directory defined by the env var TARGETS_DIR,
+	// which also contains the target of the deploy item as "target.json".
+	// +optional
+	Targets []TargetReference `json:"targets,omitempty"`
+}
+
+// DeleteConfiguration defines the container that is executed on the deletion of a deploy item.
+// Fields that are not set are taken from the provider configuration.
+// The command and the arguments are only taken from the provider configuration if no image is set.
+type DeleteConfiguration struct {
+	// SkipDeleteRun defines that no container is executed when the deploy item is deleted.
+	// Only the resources that have been created by the container deployer are cleaned up.
+	// +optional
+	SkipDeleteRun bool `json:"skipDeleteRun,omitempty"`
+	// Image is the docker image that is executed on deletion.
+	// +optional
+	Image string `json:"image,omitempty"`
+	// Command is the entrypoint array of the delete container.
+	// +optional
+	Command []string `json:"command,omitempty"`
+	// Args are the arguments to the entrypoint of the delete container.
+	// +optional
+	Args []string `json:"args,omitempty"`
+	// ImportValues contains the import values for the delete container.
+	// +optional
+	ImportValues json.RawMessage `json:"importValues,omitempty"`
+}
+
+// TargetReference references an additional target of a container deploy item.
+type TargetReference struct {
+	// Name is the name under which the target is provided to the container.
+	Name string `json:"name"`
+	// Target is the reference to the target.
+	// The target must be in the namespace of the deploy item, so the namespace must not be set.
+	Target lsv1alpha1.ObjectReference `json:"target"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -93,6 +133,25 @@ type PodStatus struct {
 	InitContainerStatus ContainerStatus `json:"initContainerStatus"`
 	// WaitContainerStatus contains the status of the wait container.
 	WaitContainerStatus ContainerStatus `json:"waitContainerStatus"`
+	// Progress is the last progress that has been reported by the main container.
+	// +optional
+	Progress *Progress `json:"progress,omitempty"`
+}
+
+// Progress is the progress of the main container, which is reported through the file defined by the env var PROGRESS_PATH.
+type Progress struct {
+	// Percentage is the completion of the current operation in percent.
+	// +optional
+	Percentage *int32 `json:"percentage,omitempty"`
+	// Step is the name of the current step of the operation.
+	// +optional
+	Step string `json:"step,omitempty"`
+	// Message is a human readable description of the current state of the operation.
+	// +optional
+	Message string `json:"message,omitempty"`
+	// LastUpdateTime is the time when the progress has been reported.
+	// +optional
+	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty"`
 }
 
 // ContainerStatus describes the status of a pod with its init, wait and main container.
@@ -5,11 +5,16 @@
 package validation
 
 import (
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	apivalidation "k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	"github.com/gardener/landscaper/apis/core"
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	"github.com/gardener/landscaper/apis/core/validation"
+	"github.com/gardener/landscaper/apis/deployer/container"
 	containerv1alpha1 "github.com/gardener/landscaper/apis/deployer/container/v1alpha1"
 	crval "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile/validation"
 )
@@ -26,5 +31,61 @@ func ValidateProviderConfiguration(config *containerv1alpha1.ProviderConfigurati
 	}
 
 	allErrs = append(allErrs, crval.ValidateContinuousReconcileSpec(field.NewPath("continuousReconcile"), config.ContinuousReconcile)...)
+	allErrs = append(allErrs, ValidateTargetReferences(field.NewPath("targets"), config.Targets)...)
+	allErrs = append(allErrs, ValidateDeleteConfiguration(field.NewPath("onDelete"), config.OnDelete)...)
 	return allErrs.ToAggregate()
 }
+
+// ValidateDeleteConfiguration validates the delete configuration of a container deploy item.
+func ValidateDeleteConfiguration(fldPath *field.Path, config *containerv1alpha1.DeleteConfiguration) field.ErrorList {
+	var allErrs field.ErrorList
+	if config == nil || !config.SkipDeleteRun {
+		return allErrs
+	}
+	if len(config.Image) != 0 {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("image"), "must not be set if the delete run is skipped"))
+	}
+	if len(config.Command) != 0 {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("command"), "must not be set if the delete run is skipped"))
+	}
+	if len(config.Args) != 0 {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("args"), "must not be set if the delete run is skipped"))
+	}
+	if len(config.ImportValues) != 0 {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("importValues"), "must not be set if the delete run is skipped"))
+	}
+	return allErrs
+}
+
+// ValidateTargetReferences validates the additional targets of a container deploy item.
+// The names are used as file names and must therefore be unique dns labels.
+func ValidateTargetReferences(fldPath *field.Path, targets []containerv1alpha1.TargetReference) field.ErrorList {
+	var allErrs field.ErrorList
+	names := sets.New[string]()
+	for i, target := range targets {
+		targetPath := fldPath.Index(i)
+		if len(target.Name) == 0 {
+			allErrs = append(allErrs, field.Required(targetPath.Child("name"), "name must be defined"))
+		} else {
+			for _, msg := range apivalidation.IsDNS1123Label(target.Name) {
+				allErrs = append(allErrs, field.Invalid(targetPath.Child("name"), target.Name, msg))
+			}
+			if target.Name == strings.TrimSuffix(container.TargetFileName, ".json") {
+				allErrs = append(allErrs, field.Invalid(targetPath.Child("name"), target.Name,
+					"name is reserved for the target of the deploy item"))
+			}
+			if names.Has(target.Name) {
+				allErrs = append(allErrs, field.Duplicate(targetPath.Child("name"), target.Name))
+			}
+			names.Insert(target.Name)
+		}
+		if len(target.Target.Name) == 0 {
+			allErrs = append(allErrs, field.Required(targetPath.Child("target", "name"), "target name must be defined"))
+		}
+		if len(target.Target.Namespace) != 0 {
+			allErrs = append(allErrs, field.Forbidden(targetPath.Child("target", "namespace"),
+				"target must be in the namespace of the deploy item"))
+		}
+	}
+	return allErrs
+}
@@ -18,6 +18,7 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 
 	config "github.com/gardener/landscaper/apis/config"
+	configv1alpha1 "github.com/gardener/landscaper/apis/config/v1alpha1"
 	corev1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	container "github.com/gardener/landscaper/apis/deployer/container"
 	continuousreconcile "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile"
@@ -80,6 +81,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*DeleteConfiguration)(nil), (*container.DeleteConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DeleteConfiguration_To_container_DeleteConfiguration(a.(*DeleteConfiguration), b.(*container.DeleteConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*container.DeleteConfiguration)(nil), (*DeleteConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_container_DeleteConfiguration_To_v1alpha1_DeleteConfiguration(a.(*container.DeleteConfiguration), b.(*DeleteConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*GarbageCollection)(nil), (*container.GarbageCollection)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_GarbageCollection_To_container_GarbageCollection(a.(*GarbageCollection), b.(*container.GarbageCollection), scope)
 	}); err != nil {
@@ -110,6 +121,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*Progress)(nil), (*container.Progress)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Progress_To_container_Progress(a.(*Progress), b.(*container.Progress), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*container.Progress)(nil), (*Progress)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_container_Progress_To_v1alpha1_Progress(a.(*container.Progress), b.(*Progress), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ProviderConfiguration)(nil), (*container.ProviderConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ProviderConfiguration_To_container_ProviderConfiguration(a.(*ProviderConfiguration), b.(*container.ProviderConfiguration), scope)
 	}); err != nil {
@@ -130,6 +151,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*TargetReference)(nil), (*container.TargetReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TargetReference_To_container_TargetReference(a.(*TargetReference), b.(*container.TargetReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*container.TargetReference)(nil), (*TargetReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_container_TargetReference_To_v1alpha1_TargetReference(a.(*container.TargetReference), b.(*TargetReference), scope)
+	}); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -138,6 +169,7 @@ func autoConvert_v1alpha1_Configuration_To_container_Configuration(in *Configura
 	out.OCI = (*config.OCIConfiguration)(unsafe.Pointer(in.OCI))
 	out.Namespace = in.Namespace
 	out.TargetSelector = *(*[]corev1alpha1.TargetSelector)(unsafe.Pointer(&in.TargetSelector))
+	out.TargetResolvers = (*configv1alpha1.TargetResolversConfiguration)(unsafe.Pointer(in.TargetResolvers))
 	if err := Convert_v1alpha1_ContainerSpec_To_container_ContainerSpec(&in.DefaultImage, &out.DefaultImage, s); err != nil {
 		return err
 	}
@@ -155,6 +187,8 @@ func autoConvert_v1alpha1_Configuration_To_container_Configuration(in *Configura
 	if err := Convert_v1alpha1_Controller_To_container_Controller(&in.Controller, &out.Controller, s); err != nil {
 		return err
 	}
+	out.BlueprintStore = (*configv1alpha1.BlueprintStore)(unsafe.Pointer(in.BlueprintStore))
+	out.BlueprintStoreVolumeClaimName = in.BlueprintStoreVolumeClaimName
 	return nil
 }
 
@@ -167,6 +201,7 @@ func autoConvert_container_Configuration_To_v1alpha1_Configuration(in *container
 	out.Identity = in.Identity
 	out.OCI = (*config.OCIConfiguration)(unsafe.Pointer(in.OCI))
 	out.TargetSelector = *(*[]corev1alpha1.TargetSelector)(unsafe.Pointer(&in.TargetSelector))
+	out.TargetResolvers = (*configv1alpha1.TargetResolversConfiguration)(unsafe.Pointer(in.TargetResolvers))
 	out.Namespace = in.Namespace
 	if err := Convert_container_ContainerSpec_To_v1alpha1_ContainerSpec(&in.DefaultImage, &out.DefaultImage, s); err != nil {
 		return err
@@ -185,6 +220,8 @@ func autoConvert_container_Configuration_To_v1alpha1_Configuration(in *container
 	if err := Convert_container_Controller_To_v1alpha1_Controller(&in.Controller, &out.Controller, s); err != nil {
 		return err
 	}
+	out.BlueprintStore = (*configv1alpha1.BlueprintStore)(unsafe.Pointer(in.BlueprintStore))
+	out.BlueprintStoreVolumeClaimName = in.BlueprintStoreVolumeClaimName
 	return nil
 }
 
@@ -289,6 +326,34 @@ func Convert_container_DebugOptions_To_v1alpha1_DebugOptions(in *container.Debug
 	return autoConvert_container_DebugOptions_To_v1alpha1_DebugOptions(in, out, s)
 }
 
+func autoConvert_v1alpha1_DeleteConfiguration_To_container_DeleteConfiguration(in *DeleteConfiguration, out *container.DeleteConfiguration, s conversion.Scope) error {
+	out.SkipDeleteRun = in.SkipDeleteRun
+	out.Image = in.Image
+	out.Command = *(*[]string)(unsafe.Pointer(&in.Command))
+	out.Args = *(*[]string)(unsafe.Pointer(&in.Args))
+	out.ImportValues = *(*json.RawMessage)(unsafe.Pointer(&in.ImportValues))
+	return nil
+}
+
+// Convert_v1alpha1_DeleteConfiguration_To_container_DeleteConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_DeleteConfiguration_To_container_DeleteConfiguration(in *DeleteConfiguration, out *container.DeleteConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DeleteConfiguration_To_container_DeleteConfiguration(in, out, s)
+}
+
+func autoConvert_container_DeleteConfiguration_To_v1alpha1_DeleteConfiguration(in *container.DeleteConfiguration, out *DeleteConfiguration, s conversion.Scope) error {
+	out.SkipDeleteRun = in.SkipDeleteRun
+	out.Image = in.Image
+	out.Command = *(*[]string)(unsafe.Pointer(&in.Command))
+	out.Args = *(*[]string)(unsafe.Pointer(&in.Args))
+	out.ImportValues = *(*json.RawMessage)(unsafe.Pointer(&in.ImportValues))
+	return nil
+}
+
+// Convert_container_DeleteConfiguration_To_v1alpha1_DeleteConfiguration is an autogenerated conversion function.
+func Convert_container_DeleteConfiguration_To_v1alpha1_DeleteConfiguration(in *container.DeleteConfiguration, out *DeleteConfiguration, s conversion.Scope) error {
+	return autoConvert_container_DeleteConfiguration_To_v1alpha1_DeleteConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_GarbageCollection_To_container_GarbageCollection(in *GarbageCollection, out *container.GarbageCollection, s conversion.Scope) error {
 	out.Disable = in.Disable
 	out.Worker = in.Worker
@@ -346,6 +411,7 @@ func autoConvert_v1alpha1_PodStatus_To_container_PodStatus(in *PodStatus, out *c
 	if err := Convert_v1alpha1_ContainerStatus_To_container_ContainerStatus(&in.WaitContainerStatus, &out.WaitContainerStatus, s); err != nil {
 		return err
 	}
+	out.Progress = (*container.Progress)(unsafe.Pointer(in.Progress))
 	return nil
 }
 
@@ -367,6 +433,7 @@ func autoConvert_container_PodStatus_To_v1alpha1_PodStatus(in *container.PodStat
 	if err := Convert_container_ContainerStatus_To_v1alpha1_ContainerStatus(&in.WaitContainerStatus, &out.WaitContainerStatus, s); err != nil {
 		return err
 	}
+	out.Progress = (*Progress)(unsafe.Pointer(in.Progress))
 	return nil
 }
 
@@ -375,6 +442,32 @@ func Convert_container_PodStatus_To_v1alpha1_PodStatus(in *container.PodStatus,
 	return autoConvert_container_PodStatus_To_v1alpha1_PodStatus(in, out, s)
 }
 
+func autoConvert_v1alpha1_Progress_To_container_Progress(in *Progress, out *container.Progress, s conversion.Scope) error {
+	out.Percentage = (*int32)(unsafe.Pointer(in.Percentage))
+	out.Step = in.Step
+	out.Message = in.Message
+	out.LastUpdateTime = (*metav1.Time)(unsafe.Pointer(in.LastUpdateTime))
+	return nil
+}
+
+// Convert_v1alpha1_Progress_To_container_Progress is an autogenerated conversion function.
+func Convert_v1alpha1_Progress_To_container_Progress(in *Progress, out *container.Progress, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Progress_To_container_Progress(in, out, s)
+}
+
+func autoConvert_container_Progress_To_v1alpha1_Progress(in *container.Progress, out *Progress, s conversion.Scope) error {
+	out.Percentage = (*int32)(unsafe.Pointer(in.Percentage))
+	out.Step = in.Step
+	out.Message = in.Message
+	out.LastUpdateTime = (*metav1.Time)(unsafe.Pointer(in.LastUpdateTime))
+	return nil
+}
+
+// Convert_container_Progress_To_v1alpha1_Progress is an autogenerated conversion function.
+func Convert_container_Progress_To_v1alpha1_Progress(in *container.Progress, out *Progress, s conversion.Scope) error {
+	return autoConvert_container_Progress_To_v1alpha1_Progress(in, out, s)
+}
+
 func autoConvert_v1alpha1_ProviderConfiguration_To_container_ProviderConfiguration(in *ProviderConfiguration, out *container.ProviderConfiguration, s conversion.Scope) error {
 	out.Image = in.Image
 	out.Command = *(*[]string)(unsafe.Pointer(&in.Command))
@@ -384,6 +477,8 @@ func autoConvert_v1alpha1_ProviderConfiguration_To_container_ProviderConfigurati
 	out.ComponentDescriptor = (*corev1alpha1.ComponentDescriptorDefinition)(unsafe.Pointer(in.ComponentDescriptor))
 	out.RegistryPullSecrets = *(*[]corev1alpha1.ObjectReference)(unsafe.Pointer(&in.RegistryPullSecrets))
 	out.ContinuousReconcile = (*continuousreconcile.ContinuousReconcileSpec)(unsafe.Pointer(in.ContinuousReconcile))
+	out.OnDelete = (*container.DeleteConfiguration)(unsafe.Pointer(in.OnDelete))
+	out.Targets = *(*[]container.TargetReference)(unsafe.Pointer(&in.Targets))
 	return nil
 }
 
@@ -401,6 +496,8 @@ func autoConvert_container_ProviderConfiguration_To_v1alpha1_ProviderConfigurati
 	out.ComponentDescriptor = (*corev1alpha1.ComponentDescriptorDefinition)(unsafe.Pointer(in.ComponentDescriptor))
 	out.RegistryPullSecrets = *(*[]corev1alpha1.ObjectReference)(unsafe.Pointer(&in.RegistryPullSecrets))
 	out.ContinuousReconcile = (*continuousreconcile.ContinuousReconcileSpec)(unsafe.Pointer(in.ContinuousReconcile))
+	out.OnDelete = (*DeleteConfiguration)(unsafe.Pointer(in.OnDelete))
+	out.Targets = *(*[]TargetReference)(unsafe.Pointer(&in.Targets))
 	return nil
 }
 
@@ -430,3 +527,25 @@ func autoConvert_container_ProviderStatus_To_v1alpha1_ProviderStatus(in *contain
 func Convert_container_ProviderStatus_To_v1alpha1_ProviderStatus(in *container.ProviderStatus, out *ProviderStatus, s conversion.Scope) error {
 	return autoConvert_container_ProviderStatus_To_v1alpha1_ProviderStatus(in, out, s)
 }
+
+func autoConvert_v1alpha1_TargetReference_To_container_TargetReference(in *TargetReference, out *container.TargetReference, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Target = in.Target
+	return nil
+}
+
+// Convert_v1alpha1_TargetReference_To_container_TargetReference is an autogenerated conversion function.
+func Convert_v1alpha1_TargetReference_To_container_TargetReference(in *TargetReference, out *container.TargetReference, s conversion.Scope) error {
+	return autoConvert_v1alpha1_TargetReference_To_container_TargetReference(in, out, s)
+}
+
+func autoConvert_container_TargetReference_To_v1alpha1_TargetReference(in *container.TargetReference, out *TargetReference, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Target = in.Target
+	return nil
+}
+
+// Convert_container_TargetReference_To_v1alpha1_TargetReference is an autogenerated conversion function.
+func Convert_container_TargetReference_To_v1alpha1_TargetReference(in *container.TargetReference, out *TargetReference, s conversion.Scope) error {
+	return autoConvert_container_TargetReference_To_v1alpha1_TargetReference(in, out, s)
+}
@@ -14,6 +14,7 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 
 	config "github.com/gardener/landscaper/apis/config"
+	configv1alpha1 "github.com/gardener/landscaper/apis/config/v1alpha1"
 	corev1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	continuousreconcile "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile"
 )
@@ -34,6 +35,11 @@ func (in *Configuration) DeepCopyInto(out *Configuration) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.TargetResolvers != nil {
+		in, out := &in.TargetResolvers, &out.TargetResolvers
+		*out = new(configv1alpha1.TargetResolversConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	in.DefaultImage.DeepCopyInto(&out.DefaultImage)
 	in.InitContainer.DeepCopyInto(&out.InitContainer)
 	in.WaitContainer.DeepCopyInto(&out.WaitContainer)
@@ -49,6 +55,11 @@ func (in *Configuration) DeepCopyInto(out *Configuration) {
 		**out = **in
 	}
 	in.Controller.DeepCopyInto(&out.Controller)
+	if in.BlueprintStore != nil {
+		in, out := &in.BlueprintStore, &out.BlueprintStore
+		*out = new(configv1alpha1.BlueprintStore)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -151,6 +162,37 @@ func (in *DebugOptions) DeepCopy() *DebugOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeleteConfiguration) DeepCopyInto(out *DeleteConfiguration) {
+	*out = *in
+	if in.Command != nil {
+		in, out := &in.Command, &out.Command
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ImportValues != nil {
+		in, out := &in.ImportValues, &out.ImportValues
+		*out = make(json.RawMessage, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeleteConfiguration.
+func (in *DeleteConfiguration) DeepCopy() *DeleteConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(DeleteConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GarbageCollection) DeepCopyInto(out *GarbageCollection) {
 	*out = *in
@@ -198,6 +240,11 @@ func (in *PodStatus) DeepCopyInto(out *PodStatus) {
 	in.ContainerStatus.DeepCopyInto(&out.ContainerStatus)
 	in.InitContainerStatus.DeepCopyInto(&out.InitContainerStatus)
 	in.WaitContainerStatus.DeepCopyInto(&out.WaitContainerStatus)
+	if in.Progress != nil {
+		in, out := &in.Progress, &out.Progress
+		*out = new(Progress)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -211,6 +258,31 @@ func (in *PodStatus) DeepCopy() *PodStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Progress) DeepCopyInto(out *Progress) {
+	*out = *in
+	if in.Percentage != nil {
+		in, out := &in.Percentage, &out.Percentage
+		*out = new(int32)
+		**out = **in
+	}
+	if in.LastUpdateTime != nil {
+		in, out := &in.LastUpdateTime, &out.LastUpdateTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Progress.
+func (in *Progress) DeepCopy() *Progress {
+	if in == nil {
+		return nil
+	}
+	out := new(Progress)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProviderConfiguration) DeepCopyInto(out *ProviderConfiguration) {
 	*out = *in
@@ -250,6 +322,16 @@ func (in *ProviderConfiguration) DeepCopyInto(out *ProviderConfiguration) {
 		*out = new(continuousreconcile.ContinuousReconcileSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.OnDelete != nil {
+		in, out := &in.OnDelete, &out.OnDelete
+		*out = new(DeleteConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
+		*out = make([]TargetReference, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -300,3 +382,20 @@ func (in *ProviderStatus) DeepCopyObject() runtime.Object {
 	}
 	return nil
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetReference) DeepCopyInto(out *TargetReference) {
+	*out = *in
+	out.Target = in.Target
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetReference.
+func (in *TargetReference) DeepCopy() *TargetReference {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetReference)
+	in.DeepCopyInto(out)
+	return out
+}
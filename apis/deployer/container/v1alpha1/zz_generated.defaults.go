@@ -26,4 +26,7 @@ func SetObjectDefaults_Configuration(in *Configuration) {
 	SetDefaults_Configuration(in)
 	SetDefaults_GarbageCollection(&in.GarbageCollection)
 	v1alpha1.SetDefaults_CommonControllerConfig(&in.Controller.CommonControllerConfig)
+	if in.BlueprintStore != nil {
+		v1alpha1.SetDefaults_BlueprintStore(in.BlueprintStore)
+	}
 }
@@ -24,4 +24,7 @@ func RegisterDefaults(scheme *runtime.Scheme) error {
 
 func SetObjectDefaults_Configuration(in *Configuration) {
 	v1alpha1.SetDefaults_CommonControllerConfig(&in.Controller.CommonControllerConfig)
+	if in.BlueprintStore != nil {
+		v1alpha1.SetDefaults_BlueprintStore(in.BlueprintStore)
+	}
 }
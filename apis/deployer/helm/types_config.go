@@ -25,12 +25,31 @@ type Configuration struct {
 	OCI *config.OCIConfiguration `json:"oci,omitempty"`
 	// TargetSelector describes all selectors the deployer should depend on.
 	TargetSelector []lsv1alpha1.TargetSelector `json:"targetSelector,omitempty"`
+	// TargetResolvers enables built-in target resolvers that are disabled by default.
+	// +optional
+	TargetResolvers *lsconfigv1alpha1.TargetResolversConfiguration `json:"targetResolvers,omitempty"`
 	// Export defines the export configuration.
 	Export ExportConfiguration `json:"export,omitempty"`
 	// HPAConfiguration contains the configuration for horizontal pod autoscaling.
 	HPAConfiguration *HPAConfiguration `json:"hpa,omitempty"`
 	// Controller contains configuration concerning the controller framework.
 	Controller Controller `json:"controller,omitempty"`
+	// HelmChartRepoIndexCache configures the cache of the index files of helm chart repositories.
+	// +optional
+	HelmChartRepoIndexCache *HelmChartRepoIndexCacheConfiguration `json:"helmChartRepoIndexCache,omitempty"`
+}
+
+// HelmChartRepoIndexCacheConfiguration configures the on-disk cache of the index files of helm chart repositories,
+// which are used to resolve version constraints of charts.
+type HelmChartRepoIndexCacheConfiguration struct {
+	// Path is the directory of the cache. The directory can be shared by several deployer instances.
+	// Defaults to a directory in the temporary directory of the deployer.
+	// +optional
+	Path string `json:"path,omitempty"`
+	// MaxAge is the duration for which a cached index file is used without revalidation.
+	// Afterwards, the index file is revalidated with its ETag. Defaults to 10 minutes.
+	// +optional
+	MaxAge *lsv1alpha1.Duration `json:"maxAge,omitempty"`
 }
 
 // ExportConfiguration defines the export configuration for the deployer.
@@ -51,6 +51,11 @@ type ProviderConfiguration struct {
 	// Values are the values that are used for templating.
 	Values json.RawMessage `json:"values,omitempty"`
 
+	// PostRenderer defines modifications of the rendered manifests of the chart, which are applied before the
+	// manifests are deployed.
+	// +optional
+	PostRenderer *PostRendererConfiguration `json:"postRenderer,omitempty"`
+
 	// ExportsFromManifests describe the exports from the templated manifests that should be exported by the helm deployer.
 	// +optional
 	// DEPRECATED
@@ -73,6 +78,11 @@ type ProviderConfiguration struct {
 	// +optional
 	HelmDeploymentConfig *HelmDeploymentConfiguration `json:"helmDeploymentConfig,omitempty"`
 
+	// ReleaseMigration configures the adoption of an existing helm release and the migration between the
+	// helm deployment and the manifest-only deployment.
+	// +optional
+	ReleaseMigration *ReleaseMigrationConfiguration `json:"releaseMigration,omitempty"`
+
 	// DeletionGroups defines the order in which objects are deleted. Only relevant if HelmDeployment is false.
 	// +optional
 	DeletionGroups []managedresource.DeletionGroupDefinition `json:"deletionGroups,omitempty"`
@@ -82,6 +92,60 @@ type ProviderConfiguration struct {
 	DeletionGroupsDuringUpdate []managedresource.DeletionGroupDefinition `json:"deletionGroupsDuringUpdate,omitempty"`
 }
 
+// PostRendererConfiguration defines modifications of the rendered manifests of a chart.
+// The modifications are applied with kustomize, whereby the rendered manifests are the only resource of the
+// kustomization.
+type PostRendererConfiguration struct {
+	// Patches are strategic merge patches or JSON patches, which are applied to the rendered manifests.
+	// +optional
+	Patches []PostRendererPatch `json:"patches,omitempty"`
+
+	// Kustomization is a kustomize overlay, which is applied to the rendered manifests,
+	// e.g. with the fields "labels", "commonAnnotations" or "images".
+	// Only the fields "patches", "images", "labels", "commonLabels", "commonAnnotations", "namespace",
+	// "namePrefix", "nameSuffix" and "replicas" are supported.
+	// +optional
+	Kustomization json.RawMessage `json:"kustomization,omitempty"`
+}
+
+// PostRendererPatch is a patch of the rendered manifests. Exactly one of StrategicMerge and JSON must be set.
+type PostRendererPatch struct {
+	// Target selects the manifests to patch.
+	// A strategic merge patch without target is applied to the manifest with the kind and name of the patch.
+	// A JSON patch requires a target.
+	// +optional
+	Target *PostRendererPatchTarget `json:"target,omitempty"`
+
+	// StrategicMerge is a strategic merge patch.
+	// +optional
+	StrategicMerge json.RawMessage `json:"strategicMerge,omitempty"`
+
+	// JSON is a JSON patch according to RFC 6902, i.e. a list of patch operations.
+	// +optional
+	JSON json.RawMessage `json:"json,omitempty"`
+}
+
+// PostRendererPatchTarget selects the manifests of a patch. All set fields must match.
+// The name and namespace are regular expressions.
+type PostRendererPatchTarget struct {
+	// +optional
+	Group string `json:"group,omitempty"`
+	// +optional
+	Version string `json:"version,omitempty"`
+	// +optional
+	Kind string `json:"kind,omitempty"`
+	// +optional
+	Name string `json:"name,omitempty"`
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	// LabelSelector is a label selector in its string representation, e.g. "app=nginx,tier!=backend".
+	// +optional
+	LabelSelector string `json:"labelSelector,omitempty"`
+	// AnnotationSelector is a selector for the annotations in the string representation of label selectors.
+	// +optional
+	AnnotationSelector string `json:"annotationSelector,omitempty"`
+}
+
 // UpdateStrategy defines the strategy that is used to apply resources to the cluster.
 type UpdateStrategy string
 
@@ -116,6 +180,8 @@ type Chart struct {
 type HelmChartRepo struct {
 	HelmChartRepoUrl string `json:"helmChartRepoUrl,omitempty"`
 	HelmChartName    string `json:"helmChartName,omitempty"`
+	// HelmChartVersion is the version of the chart or a semver version constraint like "~1.4".
+	// A constraint is resolved to the highest matching version in the index of the helm chart repo.
 	HelmChartVersion string `json:"helmChartVersion,omitempty"`
 }
 
@@ -150,6 +216,30 @@ type HelmDeploymentConfiguration struct {
 	Uninstall map[string]lscore.AnyJSON `json:"uninstall,omitempty"`
 }
 
+// ReleaseMigrationConfiguration configures the adoption of an existing helm release and the migration between the
+// helm deployment and the manifest-only deployment.
+type ReleaseMigrationConfiguration struct {
+	// AdoptRelease allows to take over an existing helm release with the release name and namespace,
+	// which has not been deployed by the deploy item, e.g. a release that has been installed outside the landscaper.
+	// +optional
+	AdoptRelease bool `json:"adoptRelease,omitempty"`
+
+	// DryRun only reports the steps of a migration or adoption in the provider status without performing them.
+	// The deployment is not executed until the dry run is switched off.
+	// +optional
+	DryRun bool `json:"dryRun,omitempty"`
+}
+
+// DeploymentMode is the mode in which a chart is deployed.
+type DeploymentMode string
+
+const (
+	// DeploymentModeHelm deploys a chart as helm release.
+	DeploymentModeHelm DeploymentMode = "helm"
+	// DeploymentModeManifest applies the rendered manifests of a chart without helm release.
+	DeploymentModeManifest DeploymentMode = "manifest"
+)
+
 // HelmInstallConfiguration defines settings for a helm install operation.
 type HelmInstallConfiguration struct {
 	Atomic bool `json:"atomic,omitempty"`
@@ -178,6 +268,56 @@ type ProviderStatus struct {
 
 	// ManagedResources contains all kubernetes resources that are deployed by the helm deployer.
 	ManagedResources managedresource.ManagedResourceStatusList `json:"managedResources,omitempty"`
+
+	// ResolvedChartVersion is the chart version which has been resolved from the version constraint of a chart
+	// from a helm chart repo.
+	// +optional
+	ResolvedChartVersion *ResolvedChartVersion `json:"resolvedChartVersion,omitempty"`
+
+	// DeploymentMode is the mode of the last successful deployment.
+	// +optional
+	DeploymentMode DeploymentMode `json:"deploymentMode,omitempty"`
+
+	// ReleaseMigration is the report of the last migration between the deployment modes
+	// or of the adoption of a helm release.
+	// +optional
+	ReleaseMigration *ReleaseMigrationStatus `json:"releaseMigration,omitempty"`
+}
+
+// ReleaseMigrationStatus describes a migration between the deployment modes or the adoption of a helm release.
+type ReleaseMigrationStatus struct {
+	// From is the deployment mode before the migration. It is empty for the adoption of a helm release.
+	// +optional
+	From DeploymentMode `json:"from,omitempty"`
+	// To is the deployment mode after the migration.
+	To DeploymentMode `json:"to"`
+	// DryRun indicates that the steps have only been planned, but not performed.
+	// +optional
+	DryRun bool `json:"dryRun,omitempty"`
+	// Steps describe the changes of the migration in the target cluster.
+	// +optional
+	Steps []string `json:"steps,omitempty"`
+	// Time is the time of the report.
+	Time metav1.Time `json:"time"`
+}
+
+// ResolvedChartVersion describes the resolution of the version constraint of a chart from a helm chart repo.
+// The resolved version is kept until the chart reference changes or a new resolution is requested with the
+// resolve-chart-version annotation.
+type ResolvedChartVersion struct {
+	// HelmChartRepoUrl is the url of the helm chart repo.
+	HelmChartRepoUrl string `json:"helmChartRepoUrl"`
+	// HelmChartName is the name of the chart.
+	HelmChartName string `json:"helmChartName"`
+	// VersionConstraint is the resolved version constraint.
+	VersionConstraint string `json:"versionConstraint"`
+	// Version is the resolved version.
+	Version string `json:"version"`
+	// ResolveRequest is the value of the resolve-chart-version annotation at the time of the resolution.
+	// +optional
+	ResolveRequest string `json:"resolveRequest,omitempty"`
+	// ResolutionTime is the time of the resolution.
+	ResolutionTime metav1.Time `json:"resolutionTime"`
 }
 
 // HelmChartRepoCredentials contains the credentials to access hepl chart repos
@@ -21,6 +21,10 @@ const ManagedInstanceLabel = "helm.deployer.landscaper.gardener.cloud/instance"
 // to define its source deploy item.
 const ManagedDeployItemLabel = "helm.deployer.landscaper.gardener.cloud/deployitem"
 
+// ResolveChartVersionAnnotation triggers a new resolution of the version constraint of a chart from a helm chart repo.
+// The resolved version of a deploy item is kept until the value of the annotation changes.
+const ResolveChartVersionAnnotation = "helm.deployer.landscaper.gardener.cloud/resolve-chart-version"
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // Configuration is the helm deployer configuration that configures the controller
@@ -33,12 +37,31 @@ type Configuration struct {
 	OCI *config.OCIConfiguration `json:"oci,omitempty"`
 	// TargetSelector describes all selectors the deployer should depend on.
 	TargetSelector []lsv1alpha1.TargetSelector `json:"targetSelector,omitempty"`
+	// TargetResolvers enables built-in target resolvers that are disabled by default.
+	// +optional
+	TargetResolvers *lsconfigv1alpha1.TargetResolversConfiguration `json:"targetResolvers,omitempty"`
 	// Export defines the export configuration.
 	Export ExportConfiguration `json:"export,omitempty"`
 	// HPAConfiguration contains the configuration for horizontal pod autoscaling.
 	HPAConfiguration *HPAConfiguration `json:"hpa,omitempty"`
 	// Controller contains configuration concerning the controller framework.
 	Controller Controller `json:"controller,omitempty"`
+	// HelmChartRepoIndexCache configures the cache of the index files of helm chart repositories.
+	// +optional
+	HelmChartRepoIndexCache *HelmChartRepoIndexCacheConfiguration `json:"helmChartRepoIndexCache,omitempty"`
+}
+
+// HelmChartRepoIndexCacheConfiguration configures the on-disk cache of the index files of helm chart repositories,
+// which are used to resolve version constraints of charts.
+type HelmChartRepoIndexCacheConfiguration struct {
+	// Path is the directory of the cache. The directory can be shared by several deployer instances.
+	// Defaults to a directory in the temporary directory of the deployer.
+	// +optional
+	Path string `json:"path,omitempty"`
+	// MaxAge is the duration for which a cached index file is used without revalidation.
+	// Afterwards, the index file is revalidated with its ETag. Defaults to 10 minutes.
+	// +optional
+	MaxAge *lsv1alpha1.Duration `json:"maxAge,omitempty"`
 }
 
 // ExportConfiguration defines the export configuration for the deployer.
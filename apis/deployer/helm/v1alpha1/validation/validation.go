@@ -5,6 +5,7 @@
 package validation
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -31,6 +32,7 @@ func ValidateProviderConfiguration(config *helmv1alpha1.ProviderConfiguration) e
 	allErrs = append(allErrs, ValidateHelmDeploymentConfiguration(field.NewPath("helmDeploymentConfig"), config.HelmDeploymentConfig)...)
 	allErrs = append(allErrs, crval.ValidateContinuousReconcileSpec(field.NewPath("continuousReconcile"), config.ContinuousReconcile)...)
 	allErrs = append(allErrs, validation.ValidateDeletionGroups(field.NewPath("deletionGroups"), config.DeletionGroups)...)
+	allErrs = append(allErrs, ValidatePostRenderer(field.NewPath("postRenderer"), config.PostRenderer)...)
 
 	if len(config.Name) == 0 {
 		allErrs = append(allErrs, field.Required(field.NewPath("name"), "must not be empty"))
@@ -76,6 +78,63 @@ func ValidateProviderConfiguration(config *helmv1alpha1.ProviderConfiguration) e
 	return allErrs.ToAggregate()
 }
 
+// unsupportedKustomizationFields are the fields of a kustomization which refer to further files or plugins.
+var unsupportedKustomizationFields = []string{
+	"resources", "bases", "components", "crds", "generators", "transformers", "validators",
+	"helmCharts", "helmGlobals", "helmChartInflationGenerator", "configurations", "openapi",
+}
+
+// ValidatePostRenderer validates the post renderer configuration.
+func ValidatePostRenderer(fldPath *field.Path, postRenderer *helmv1alpha1.PostRendererConfiguration) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if postRenderer == nil {
+		return allErrs
+	}
+
+	for i, patch := range postRenderer.Patches {
+		patchPath := fldPath.Child("patches").Index(i)
+		hasStrategicMerge := len(patch.StrategicMerge) != 0
+		hasJSON := len(patch.JSON) != 0
+
+		if hasStrategicMerge == hasJSON {
+			allErrs = append(allErrs, field.Invalid(patchPath, "", "exactly one of strategicMerge and json must be set"))
+			continue
+		}
+
+		if hasStrategicMerge {
+			obj := map[string]interface{}{}
+			if err := json.Unmarshal(patch.StrategicMerge, &obj); err != nil {
+				allErrs = append(allErrs, field.Invalid(patchPath.Child("strategicMerge"), "", "must be an object: "+err.Error()))
+			}
+		}
+
+		if hasJSON {
+			ops := []map[string]interface{}{}
+			if err := json.Unmarshal(patch.JSON, &ops); err != nil {
+				allErrs = append(allErrs, field.Invalid(patchPath.Child("json"), "", "must be a list of patch operations: "+err.Error()))
+			}
+			if patch.Target == nil {
+				allErrs = append(allErrs, field.Required(patchPath.Child("target"), "a json patch requires a target"))
+			}
+		}
+	}
+
+	if len(postRenderer.Kustomization) != 0 {
+		kustomizationPath := fldPath.Child("kustomization")
+		kustomization := map[string]interface{}{}
+		if err := json.Unmarshal(postRenderer.Kustomization, &kustomization); err != nil {
+			allErrs = append(allErrs, field.Invalid(kustomizationPath, "", "must be an object: "+err.Error()))
+		}
+		for _, key := range unsupportedKustomizationFields {
+			if _, ok := kustomization[key]; ok {
+				allErrs = append(allErrs, field.Forbidden(kustomizationPath.Child(key), "is not supported"))
+			}
+		}
+	}
+
+	return allErrs
+}
+
 // ValidateChart validates the access methods for a chart
 func ValidateChart(fldPath *field.Path, chart helmv1alpha1.Chart) field.ErrorList {
 	allErrs := field.ErrorList{}
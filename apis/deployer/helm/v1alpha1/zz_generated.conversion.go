@@ -16,6 +16,7 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 
 	config "github.com/gardener/landscaper/apis/config"
+	configv1alpha1 "github.com/gardener/landscaper/apis/config/v1alpha1"
 	core "github.com/gardener/landscaper/apis/core"
 	corev1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	helm "github.com/gardener/landscaper/apis/deployer/helm"
@@ -120,6 +121,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*HelmChartRepoIndexCacheConfiguration)(nil), (*helm.HelmChartRepoIndexCacheConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_HelmChartRepoIndexCacheConfiguration_To_helm_HelmChartRepoIndexCacheConfiguration(a.(*HelmChartRepoIndexCacheConfiguration), b.(*helm.HelmChartRepoIndexCacheConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*helm.HelmChartRepoIndexCacheConfiguration)(nil), (*HelmChartRepoIndexCacheConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_helm_HelmChartRepoIndexCacheConfiguration_To_v1alpha1_HelmChartRepoIndexCacheConfiguration(a.(*helm.HelmChartRepoIndexCacheConfiguration), b.(*HelmChartRepoIndexCacheConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*HelmDeploymentConfiguration)(nil), (*helm.HelmDeploymentConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_HelmDeploymentConfiguration_To_helm_HelmDeploymentConfiguration(a.(*HelmDeploymentConfiguration), b.(*helm.HelmDeploymentConfiguration), scope)
 	}); err != nil {
@@ -150,6 +161,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*PostRendererConfiguration)(nil), (*helm.PostRendererConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PostRendererConfiguration_To_helm_PostRendererConfiguration(a.(*PostRendererConfiguration), b.(*helm.PostRendererConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*helm.PostRendererConfiguration)(nil), (*PostRendererConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_helm_PostRendererConfiguration_To_v1alpha1_PostRendererConfiguration(a.(*helm.PostRendererConfiguration), b.(*PostRendererConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PostRendererPatch)(nil), (*helm.PostRendererPatch)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PostRendererPatch_To_helm_PostRendererPatch(a.(*PostRendererPatch), b.(*helm.PostRendererPatch), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*helm.PostRendererPatch)(nil), (*PostRendererPatch)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_helm_PostRendererPatch_To_v1alpha1_PostRendererPatch(a.(*helm.PostRendererPatch), b.(*PostRendererPatch), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PostRendererPatchTarget)(nil), (*helm.PostRendererPatchTarget)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PostRendererPatchTarget_To_helm_PostRendererPatchTarget(a.(*PostRendererPatchTarget), b.(*helm.PostRendererPatchTarget), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*helm.PostRendererPatchTarget)(nil), (*PostRendererPatchTarget)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_helm_PostRendererPatchTarget_To_v1alpha1_PostRendererPatchTarget(a.(*helm.PostRendererPatchTarget), b.(*PostRendererPatchTarget), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ProviderConfiguration)(nil), (*helm.ProviderConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ProviderConfiguration_To_helm_ProviderConfiguration(a.(*ProviderConfiguration), b.(*helm.ProviderConfiguration), scope)
 	}); err != nil {
@@ -170,6 +211,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ReleaseMigrationConfiguration)(nil), (*helm.ReleaseMigrationConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ReleaseMigrationConfiguration_To_helm_ReleaseMigrationConfiguration(a.(*ReleaseMigrationConfiguration), b.(*helm.ReleaseMigrationConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*helm.ReleaseMigrationConfiguration)(nil), (*ReleaseMigrationConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_helm_ReleaseMigrationConfiguration_To_v1alpha1_ReleaseMigrationConfiguration(a.(*helm.ReleaseMigrationConfiguration), b.(*ReleaseMigrationConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ReleaseMigrationStatus)(nil), (*helm.ReleaseMigrationStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ReleaseMigrationStatus_To_helm_ReleaseMigrationStatus(a.(*ReleaseMigrationStatus), b.(*helm.ReleaseMigrationStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*helm.ReleaseMigrationStatus)(nil), (*ReleaseMigrationStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_helm_ReleaseMigrationStatus_To_v1alpha1_ReleaseMigrationStatus(a.(*helm.ReleaseMigrationStatus), b.(*ReleaseMigrationStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*RemoteArchiveAccess)(nil), (*helm.RemoteArchiveAccess)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_RemoteArchiveAccess_To_helm_RemoteArchiveAccess(a.(*RemoteArchiveAccess), b.(*helm.RemoteArchiveAccess), scope)
 	}); err != nil {
@@ -190,6 +251,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ResolvedChartVersion)(nil), (*helm.ResolvedChartVersion)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ResolvedChartVersion_To_helm_ResolvedChartVersion(a.(*ResolvedChartVersion), b.(*helm.ResolvedChartVersion), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*helm.ResolvedChartVersion)(nil), (*ResolvedChartVersion)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_helm_ResolvedChartVersion_To_v1alpha1_ResolvedChartVersion(a.(*helm.ResolvedChartVersion), b.(*ResolvedChartVersion), scope)
+	}); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -273,6 +344,7 @@ func autoConvert_v1alpha1_Configuration_To_helm_Configuration(in *Configuration,
 	out.Identity = in.Identity
 	out.OCI = (*config.OCIConfiguration)(unsafe.Pointer(in.OCI))
 	out.TargetSelector = *(*[]corev1alpha1.TargetSelector)(unsafe.Pointer(&in.TargetSelector))
+	out.TargetResolvers = (*configv1alpha1.TargetResolversConfiguration)(unsafe.Pointer(in.TargetResolvers))
 	if err := Convert_v1alpha1_ExportConfiguration_To_helm_ExportConfiguration(&in.Export, &out.Export, s); err != nil {
 		return err
 	}
@@ -280,6 +352,7 @@ func autoConvert_v1alpha1_Configuration_To_helm_Configuration(in *Configuration,
 	if err := Convert_v1alpha1_Controller_To_helm_Controller(&in.Controller, &out.Controller, s); err != nil {
 		return err
 	}
+	out.HelmChartRepoIndexCache = (*helm.HelmChartRepoIndexCacheConfiguration)(unsafe.Pointer(in.HelmChartRepoIndexCache))
 	return nil
 }
 
@@ -292,6 +365,7 @@ func autoConvert_helm_Configuration_To_v1alpha1_Configuration(in *helm.Configura
 	out.Identity = in.Identity
 	out.OCI = (*config.OCIConfiguration)(unsafe.Pointer(in.OCI))
 	out.TargetSelector = *(*[]corev1alpha1.TargetSelector)(unsafe.Pointer(&in.TargetSelector))
+	out.TargetResolvers = (*configv1alpha1.TargetResolversConfiguration)(unsafe.Pointer(in.TargetResolvers))
 	if err := Convert_helm_ExportConfiguration_To_v1alpha1_ExportConfiguration(&in.Export, &out.Export, s); err != nil {
 		return err
 	}
@@ -299,6 +373,7 @@ func autoConvert_helm_Configuration_To_v1alpha1_Configuration(in *helm.Configura
 	if err := Convert_helm_Controller_To_v1alpha1_Controller(&in.Controller, &out.Controller, s); err != nil {
 		return err
 	}
+	out.HelmChartRepoIndexCache = (*HelmChartRepoIndexCacheConfiguration)(unsafe.Pointer(in.HelmChartRepoIndexCache))
 	return nil
 }
 
@@ -411,6 +486,28 @@ func Convert_helm_HelmChartRepoCredentials_To_v1alpha1_HelmChartRepoCredentials(
 	return autoConvert_helm_HelmChartRepoCredentials_To_v1alpha1_HelmChartRepoCredentials(in, out, s)
 }
 
+func autoConvert_v1alpha1_HelmChartRepoIndexCacheConfiguration_To_helm_HelmChartRepoIndexCacheConfiguration(in *HelmChartRepoIndexCacheConfiguration, out *helm.HelmChartRepoIndexCacheConfiguration, s conversion.Scope) error {
+	out.Path = in.Path
+	out.MaxAge = (*corev1alpha1.Duration)(unsafe.Pointer(in.MaxAge))
+	return nil
+}
+
+// Convert_v1alpha1_HelmChartRepoIndexCacheConfiguration_To_helm_HelmChartRepoIndexCacheConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_HelmChartRepoIndexCacheConfiguration_To_helm_HelmChartRepoIndexCacheConfiguration(in *HelmChartRepoIndexCacheConfiguration, out *helm.HelmChartRepoIndexCacheConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_HelmChartRepoIndexCacheConfiguration_To_helm_HelmChartRepoIndexCacheConfiguration(in, out, s)
+}
+
+func autoConvert_helm_HelmChartRepoIndexCacheConfiguration_To_v1alpha1_HelmChartRepoIndexCacheConfiguration(in *helm.HelmChartRepoIndexCacheConfiguration, out *HelmChartRepoIndexCacheConfiguration, s conversion.Scope) error {
+	out.Path = in.Path
+	out.MaxAge = (*corev1alpha1.Duration)(unsafe.Pointer(in.MaxAge))
+	return nil
+}
+
+// Convert_helm_HelmChartRepoIndexCacheConfiguration_To_v1alpha1_HelmChartRepoIndexCacheConfiguration is an autogenerated conversion function.
+func Convert_helm_HelmChartRepoIndexCacheConfiguration_To_v1alpha1_HelmChartRepoIndexCacheConfiguration(in *helm.HelmChartRepoIndexCacheConfiguration, out *HelmChartRepoIndexCacheConfiguration, s conversion.Scope) error {
+	return autoConvert_helm_HelmChartRepoIndexCacheConfiguration_To_v1alpha1_HelmChartRepoIndexCacheConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_HelmDeploymentConfiguration_To_helm_HelmDeploymentConfiguration(in *HelmDeploymentConfiguration, out *helm.HelmDeploymentConfiguration, s conversion.Scope) error {
 	out.Install = *(*map[string]core.AnyJSON)(unsafe.Pointer(&in.Install))
 	out.Upgrade = *(*map[string]core.AnyJSON)(unsafe.Pointer(&in.Upgrade))
@@ -479,6 +576,84 @@ func Convert_helm_HelmUninstallConfiguration_To_v1alpha1_HelmUninstallConfigurat
 	return autoConvert_helm_HelmUninstallConfiguration_To_v1alpha1_HelmUninstallConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_PostRendererConfiguration_To_helm_PostRendererConfiguration(in *PostRendererConfiguration, out *helm.PostRendererConfiguration, s conversion.Scope) error {
+	out.Patches = *(*[]helm.PostRendererPatch)(unsafe.Pointer(&in.Patches))
+	out.Kustomization = *(*json.RawMessage)(unsafe.Pointer(&in.Kustomization))
+	return nil
+}
+
+// Convert_v1alpha1_PostRendererConfiguration_To_helm_PostRendererConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_PostRendererConfiguration_To_helm_PostRendererConfiguration(in *PostRendererConfiguration, out *helm.PostRendererConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PostRendererConfiguration_To_helm_PostRendererConfiguration(in, out, s)
+}
+
+func autoConvert_helm_PostRendererConfiguration_To_v1alpha1_PostRendererConfiguration(in *helm.PostRendererConfiguration, out *PostRendererConfiguration, s conversion.Scope) error {
+	out.Patches = *(*[]PostRendererPatch)(unsafe.Pointer(&in.Patches))
+	out.Kustomization = *(*json.RawMessage)(unsafe.Pointer(&in.Kustomization))
+	return nil
+}
+
+// Convert_helm_PostRendererConfiguration_To_v1alpha1_PostRendererConfiguration is an autogenerated conversion function.
+func Convert_helm_PostRendererConfiguration_To_v1alpha1_PostRendererConfiguration(in *helm.PostRendererConfiguration, out *PostRendererConfiguration, s conversion.Scope) error {
+	return autoConvert_helm_PostRendererConfiguration_To_v1alpha1_PostRendererConfiguration(in, out, s)
+}
+
+func autoConvert_v1alpha1_PostRendererPatch_To_helm_PostRendererPatch(in *PostRendererPatch, out *helm.PostRendererPatch, s conversion.Scope) error {
+	out.Target = (*helm.PostRendererPatchTarget)(unsafe.Pointer(in.Target))
+	out.StrategicMerge = *(*json.RawMessage)(unsafe.Pointer(&in.StrategicMerge))
+	out.JSON = *(*json.RawMessage)(unsafe.Pointer(&in.JSON))
+	return nil
+}
+
+// Convert_v1alpha1_PostRendererPatch_To_helm_PostRendererPatch is an autogenerated conversion function.
+func Convert_v1alpha1_PostRendererPatch_To_helm_PostRendererPatch(in *PostRendererPatch, out *helm.PostRendererPatch, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PostRendererPatch_To_helm_PostRendererPatch(in, out, s)
+}
+
+func autoConvert_helm_PostRendererPatch_To_v1alpha1_PostRendererPatch(in *helm.PostRendererPatch, out *PostRendererPatch, s conversion.Scope) error {
+	out.Target = (*PostRendererPatchTarget)(unsafe.Pointer(in.Target))
+	out.StrategicMerge = *(*json.RawMessage)(unsafe.Pointer(&in.StrategicMerge))
+	out.JSON = *(*json.RawMessage)(unsafe.Pointer(&in.JSON))
+	return nil
+}
+
+// Convert_helm_PostRendererPatch_To_v1alpha1_PostRendererPatch is an autogenerated conversion function.
+func Convert_helm_PostRendererPatch_To_v1alpha1_PostRendererPatch(in *helm.PostRendererPatch, out *PostRendererPatch, s conversion.Scope) error {
+	return autoConvert_helm_PostRendererPatch_To_v1alpha1_PostRendererPatch(in, out, s)
+}
+
+func autoConvert_v1alpha1_PostRendererPatchTarget_To_helm_PostRendererPatchTarget(in *PostRendererPatchTarget, out *helm.PostRendererPatchTarget, s conversion.Scope) error {
+	out.Group = in.Group
+	out.Version = in.Version
+	out.Kind = in.Kind
+	out.Name = in.Name
+	out.Namespace = in.Namespace
+	out.LabelSelector = in.LabelSelector
+	out.AnnotationSelector = in.AnnotationSelector
+	return nil
+}
+
+// Convert_v1alpha1_PostRendererPatchTarget_To_helm_PostRendererPatchTarget is an autogenerated conversion function.
+func Convert_v1alpha1_PostRendererPatchTarget_To_helm_PostRendererPatchTarget(in *PostRendererPatchTarget, out *helm.PostRendererPatchTarget, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PostRendererPatchTarget_To_helm_PostRendererPatchTarget(in, out, s)
+}
+
+func autoConvert_helm_PostRendererPatchTarget_To_v1alpha1_PostRendererPatchTarget(in *helm.PostRendererPatchTarget, out *PostRendererPatchTarget, s conversion.Scope) error {
+	out.Group = in.Group
+	out.Version = in.Version
+	out.Kind = in.Kind
+	out.Name = in.Name
+	out.Namespace = in.Namespace
+	out.LabelSelector = in.LabelSelector
+	out.AnnotationSelector = in.AnnotationSelector
+	return nil
+}
+
+// Convert_helm_PostRendererPatchTarget_To_v1alpha1_PostRendererPatchTarget is an autogenerated conversion function.
+func Convert_helm_PostRendererPatchTarget_To_v1alpha1_PostRendererPatchTarget(in *helm.PostRendererPatchTarget, out *PostRendererPatchTarget, s conversion.Scope) error {
+	return autoConvert_helm_PostRendererPatchTarget_To_v1alpha1_PostRendererPatchTarget(in, out, s)
+}
+
 func autoConvert_v1alpha1_ProviderConfiguration_To_helm_ProviderConfiguration(in *ProviderConfiguration, out *helm.ProviderConfiguration, s conversion.Scope) error {
 	out.UpdateStrategy = helm.UpdateStrategy(in.UpdateStrategy)
 	out.ReadinessChecks = in.ReadinessChecks
@@ -489,11 +664,13 @@ func autoConvert_v1alpha1_ProviderConfiguration_To_helm_ProviderConfiguration(in
 	out.Namespace = in.Namespace
 	out.CreateNamespace = in.CreateNamespace
 	out.Values = *(*json.RawMessage)(unsafe.Pointer(&in.Values))
+	out.PostRenderer = (*helm.PostRendererConfiguration)(unsafe.Pointer(in.PostRenderer))
 	out.ExportsFromManifests = *(*[]managedresource.Export)(unsafe.Pointer(&in.ExportsFromManifests))
 	out.Exports = (*managedresource.Exports)(unsafe.Pointer(in.Exports))
 	out.ContinuousReconcile = (*continuousreconcile.ContinuousReconcileSpec)(unsafe.Pointer(in.ContinuousReconcile))
 	out.HelmDeployment = (*bool)(unsafe.Pointer(in.HelmDeployment))
 	out.HelmDeploymentConfig = (*helm.HelmDeploymentConfiguration)(unsafe.Pointer(in.HelmDeploymentConfig))
+	out.ReleaseMigration = (*helm.ReleaseMigrationConfiguration)(unsafe.Pointer(in.ReleaseMigration))
 	out.DeletionGroups = *(*[]managedresource.DeletionGroupDefinition)(unsafe.Pointer(&in.DeletionGroups))
 	out.DeletionGroupsDuringUpdate = *(*[]managedresource.DeletionGroupDefinition)(unsafe.Pointer(&in.DeletionGroupsDuringUpdate))
 	return nil
@@ -514,11 +691,13 @@ func autoConvert_helm_ProviderConfiguration_To_v1alpha1_ProviderConfiguration(in
 	out.Namespace = in.Namespace
 	out.CreateNamespace = in.CreateNamespace
 	out.Values = *(*json.RawMessage)(unsafe.Pointer(&in.Values))
+	out.PostRenderer = (*PostRendererConfiguration)(unsafe.Pointer(in.PostRenderer))
 	out.ExportsFromManifests = *(*[]managedresource.Export)(unsafe.Pointer(&in.ExportsFromManifests))
 	out.Exports = (*managedresource.Exports)(unsafe.Pointer(in.Exports))
 	out.ContinuousReconcile = (*continuousreconcile.ContinuousReconcileSpec)(unsafe.Pointer(in.ContinuousReconcile))
 	out.HelmDeployment = (*bool)(unsafe.Pointer(in.HelmDeployment))
 	out.HelmDeploymentConfig = (*HelmDeploymentConfiguration)(unsafe.Pointer(in.HelmDeploymentConfig))
+	out.ReleaseMigration = (*ReleaseMigrationConfiguration)(unsafe.Pointer(in.ReleaseMigration))
 	out.DeletionGroups = *(*[]managedresource.DeletionGroupDefinition)(unsafe.Pointer(&in.DeletionGroups))
 	out.DeletionGroupsDuringUpdate = *(*[]managedresource.DeletionGroupDefinition)(unsafe.Pointer(&in.DeletionGroupsDuringUpdate))
 	return nil
@@ -531,6 +710,9 @@ func Convert_helm_ProviderConfiguration_To_v1alpha1_ProviderConfiguration(in *he
 
 func autoConvert_v1alpha1_ProviderStatus_To_helm_ProviderStatus(in *ProviderStatus, out *helm.ProviderStatus, s conversion.Scope) error {
 	out.ManagedResources = *(*managedresource.ManagedResourceStatusList)(unsafe.Pointer(&in.ManagedResources))
+	out.ResolvedChartVersion = (*helm.ResolvedChartVersion)(unsafe.Pointer(in.ResolvedChartVersion))
+	out.DeploymentMode = helm.DeploymentMode(in.DeploymentMode)
+	out.ReleaseMigration = (*helm.ReleaseMigrationStatus)(unsafe.Pointer(in.ReleaseMigration))
 	return nil
 }
 
@@ -541,6 +723,9 @@ func Convert_v1alpha1_ProviderStatus_To_helm_ProviderStatus(in *ProviderStatus,
 
 func autoConvert_helm_ProviderStatus_To_v1alpha1_ProviderStatus(in *helm.ProviderStatus, out *ProviderStatus, s conversion.Scope) error {
 	out.ManagedResources = *(*managedresource.ManagedResourceStatusList)(unsafe.Pointer(&in.ManagedResources))
+	out.ResolvedChartVersion = (*ResolvedChartVersion)(unsafe.Pointer(in.ResolvedChartVersion))
+	out.DeploymentMode = DeploymentMode(in.DeploymentMode)
+	out.ReleaseMigration = (*ReleaseMigrationStatus)(unsafe.Pointer(in.ReleaseMigration))
 	return nil
 }
 
@@ -549,6 +734,56 @@ func Convert_helm_ProviderStatus_To_v1alpha1_ProviderStatus(in *helm.ProviderSta
 	return autoConvert_helm_ProviderStatus_To_v1alpha1_ProviderStatus(in, out, s)
 }
 
+func autoConvert_v1alpha1_ReleaseMigrationConfiguration_To_helm_ReleaseMigrationConfiguration(in *ReleaseMigrationConfiguration, out *helm.ReleaseMigrationConfiguration, s conversion.Scope) error {
+	out.AdoptRelease = in.AdoptRelease
+	out.DryRun = in.DryRun
+	return nil
+}
+
+// Convert_v1alpha1_ReleaseMigrationConfiguration_To_helm_ReleaseMigrationConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_ReleaseMigrationConfiguration_To_helm_ReleaseMigrationConfiguration(in *ReleaseMigrationConfiguration, out *helm.ReleaseMigrationConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ReleaseMigrationConfiguration_To_helm_ReleaseMigrationConfiguration(in, out, s)
+}
+
+func autoConvert_helm_ReleaseMigrationConfiguration_To_v1alpha1_ReleaseMigrationConfiguration(in *helm.ReleaseMigrationConfiguration, out *ReleaseMigrationConfiguration, s conversion.Scope) error {
+	out.AdoptRelease = in.AdoptRelease
+	out.DryRun = in.DryRun
+	return nil
+}
+
+// Convert_helm_ReleaseMigrationConfiguration_To_v1alpha1_ReleaseMigrationConfiguration is an autogenerated conversion function.
+func Convert_helm_ReleaseMigrationConfiguration_To_v1alpha1_ReleaseMigrationConfiguration(in *helm.ReleaseMigrationConfiguration, out *ReleaseMigrationConfiguration, s conversion.Scope) error {
+	return autoConvert_helm_ReleaseMigrationConfiguration_To_v1alpha1_ReleaseMigrationConfiguration(in, out, s)
+}
+
+func autoConvert_v1alpha1_ReleaseMigrationStatus_To_helm_ReleaseMigrationStatus(in *ReleaseMigrationStatus, out *helm.ReleaseMigrationStatus, s conversion.Scope) error {
+	out.From = helm.DeploymentMode(in.From)
+	out.To = helm.DeploymentMode(in.To)
+	out.DryRun = in.DryRun
+	out.Steps = *(*[]string)(unsafe.Pointer(&in.Steps))
+	out.Time = in.Time
+	return nil
+}
+
+// Convert_v1alpha1_ReleaseMigrationStatus_To_helm_ReleaseMigrationStatus is an autogenerated conversion function.
+func Convert_v1alpha1_ReleaseMigrationStatus_To_helm_ReleaseMigrationStatus(in *ReleaseMigrationStatus, out *helm.ReleaseMigrationStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ReleaseMigrationStatus_To_helm_ReleaseMigrationStatus(in, out, s)
+}
+
+func autoConvert_helm_ReleaseMigrationStatus_To_v1alpha1_ReleaseMigrationStatus(in *helm.ReleaseMigrationStatus, out *ReleaseMigrationStatus, s conversion.Scope) error {
+	out.From = DeploymentMode(in.From)
+	out.To = DeploymentMode(in.To)
+	out.DryRun = in.DryRun
+	out.Steps = *(*[]string)(unsafe.Pointer(&in.Steps))
+	out.Time = in.Time
+	return nil
+}
+
+// Convert_helm_ReleaseMigrationStatus_To_v1alpha1_ReleaseMigrationStatus is an autogenerated conversion function.
+func Convert_helm_ReleaseMigrationStatus_To_v1alpha1_ReleaseMigrationStatus(in *helm.ReleaseMigrationStatus, out *ReleaseMigrationStatus, s conversion.Scope) error {
+	return autoConvert_helm_ReleaseMigrationStatus_To_v1alpha1_ReleaseMigrationStatus(in, out, s)
+}
+
 func autoConvert_v1alpha1_RemoteArchiveAccess_To_helm_RemoteArchiveAccess(in *RemoteArchiveAccess, out *helm.RemoteArchiveAccess, s conversion.Scope) error {
 	out.URL = in.URL
 	return nil
@@ -590,3 +825,33 @@ func autoConvert_helm_RemoteChartReference_To_v1alpha1_RemoteChartReference(in *
 func Convert_helm_RemoteChartReference_To_v1alpha1_RemoteChartReference(in *helm.RemoteChartReference, out *RemoteChartReference, s conversion.Scope) error {
 	return autoConvert_helm_RemoteChartReference_To_v1alpha1_RemoteChartReference(in, out, s)
 }
+
+func autoConvert_v1alpha1_ResolvedChartVersion_To_helm_ResolvedChartVersion(in *ResolvedChartVersion, out *helm.ResolvedChartVersion, s conversion.Scope) error {
+	out.HelmChartRepoUrl = in.HelmChartRepoUrl
+	out.HelmChartName = in.HelmChartName
+	out.VersionConstraint = in.VersionConstraint
+	out.Version = in.Version
+	out.ResolveRequest = in.ResolveRequest
+	out.ResolutionTime = in.ResolutionTime
+	return nil
+}
+
+// Convert_v1alpha1_ResolvedChartVersion_To_helm_ResolvedChartVersion is an autogenerated conversion function.
+func Convert_v1alpha1_ResolvedChartVersion_To_helm_ResolvedChartVersion(in *ResolvedChartVersion, out *helm.ResolvedChartVersion, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ResolvedChartVersion_To_helm_ResolvedChartVersion(in, out, s)
+}
+
+func autoConvert_helm_ResolvedChartVersion_To_v1alpha1_ResolvedChartVersion(in *helm.ResolvedChartVersion, out *ResolvedChartVersion, s conversion.Scope) error {
+	out.HelmChartRepoUrl = in.HelmChartRepoUrl
+	out.HelmChartName = in.HelmChartName
+	out.VersionConstraint = in.VersionConstraint
+	out.Version = in.Version
+	out.ResolveRequest = in.ResolveRequest
+	out.ResolutionTime = in.ResolutionTime
+	return nil
+}
+
+// Convert_helm_ResolvedChartVersion_To_v1alpha1_ResolvedChartVersion is an autogenerated conversion function.
+func Convert_helm_ResolvedChartVersion_To_v1alpha1_ResolvedChartVersion(in *helm.ResolvedChartVersion, out *ResolvedChartVersion, s conversion.Scope) error {
+	return autoConvert_helm_ResolvedChartVersion_To_v1alpha1_ResolvedChartVersion(in, out, s)
+}
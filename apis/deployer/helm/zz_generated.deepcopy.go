@@ -14,6 +14,7 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 
 	config "github.com/gardener/landscaper/apis/config"
+	configv1alpha1 "github.com/gardener/landscaper/apis/config/v1alpha1"
 	core "github.com/gardener/landscaper/apis/core"
 	v1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	continuousreconcile "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile"
@@ -109,6 +110,11 @@ func (in *Configuration) DeepCopyInto(out *Configuration) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.TargetResolvers != nil {
+		in, out := &in.TargetResolvers, &out.TargetResolvers
+		*out = new(configv1alpha1.TargetResolversConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	in.Export.DeepCopyInto(&out.Export)
 	if in.HPAConfiguration != nil {
 		in, out := &in.HPAConfiguration, &out.HPAConfiguration
@@ -116,6 +122,11 @@ func (in *Configuration) DeepCopyInto(out *Configuration) {
 		**out = **in
 	}
 	in.Controller.DeepCopyInto(&out.Controller)
+	if in.HelmChartRepoIndexCache != nil {
+		in, out := &in.HelmChartRepoIndexCache, &out.HelmChartRepoIndexCache
+		*out = new(HelmChartRepoIndexCacheConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -230,6 +241,27 @@ func (in *HelmChartRepoCredentials) DeepCopy() *HelmChartRepoCredentials {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HelmChartRepoIndexCacheConfiguration) DeepCopyInto(out *HelmChartRepoIndexCacheConfiguration) {
+	*out = *in
+	if in.MaxAge != nil {
+		in, out := &in.MaxAge, &out.MaxAge
+		*out = new(v1alpha1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HelmChartRepoIndexCacheConfiguration.
+func (in *HelmChartRepoIndexCacheConfiguration) DeepCopy() *HelmChartRepoIndexCacheConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(HelmChartRepoIndexCacheConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HelmDeploymentConfiguration) DeepCopyInto(out *HelmDeploymentConfiguration) {
 	*out = *in
@@ -309,6 +341,81 @@ func (in *HelmUninstallConfiguration) DeepCopy() *HelmUninstallConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostRendererConfiguration) DeepCopyInto(out *PostRendererConfiguration) {
+	*out = *in
+	if in.Patches != nil {
+		in, out := &in.Patches, &out.Patches
+		*out = make([]PostRendererPatch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Kustomization != nil {
+		in, out := &in.Kustomization, &out.Kustomization
+		*out = make(json.RawMessage, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostRendererConfiguration.
+func (in *PostRendererConfiguration) DeepCopy() *PostRendererConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(PostRendererConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostRendererPatch) DeepCopyInto(out *PostRendererPatch) {
+	*out = *in
+	if in.Target != nil {
+		in, out := &in.Target, &out.Target
+		*out = new(PostRendererPatchTarget)
+		**out = **in
+	}
+	if in.StrategicMerge != nil {
+		in, out := &in.StrategicMerge, &out.StrategicMerge
+		*out = make(json.RawMessage, len(*in))
+		copy(*out, *in)
+	}
+	if in.JSON != nil {
+		in, out := &in.JSON, &out.JSON
+		*out = make(json.RawMessage, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostRendererPatch.
+func (in *PostRendererPatch) DeepCopy() *PostRendererPatch {
+	if in == nil {
+		return nil
+	}
+	out := new(PostRendererPatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostRendererPatchTarget) DeepCopyInto(out *PostRendererPatchTarget) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostRendererPatchTarget.
+func (in *PostRendererPatchTarget) DeepCopy() *PostRendererPatchTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(PostRendererPatchTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProviderConfiguration) DeepCopyInto(out *ProviderConfiguration) {
 	*out = *in
@@ -320,6 +427,11 @@ func (in *ProviderConfiguration) DeepCopyInto(out *ProviderConfiguration) {
 		*out = make(json.RawMessage, len(*in))
 		copy(*out, *in)
 	}
+	if in.PostRenderer != nil {
+		in, out := &in.PostRenderer, &out.PostRenderer
+		*out = new(PostRendererConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ExportsFromManifests != nil {
 		in, out := &in.ExportsFromManifests, &out.ExportsFromManifests
 		*out = make([]managedresource.Export, len(*in))
@@ -347,6 +459,11 @@ func (in *ProviderConfiguration) DeepCopyInto(out *ProviderConfiguration) {
 		*out = new(HelmDeploymentConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ReleaseMigration != nil {
+		in, out := &in.ReleaseMigration, &out.ReleaseMigration
+		*out = new(ReleaseMigrationConfiguration)
+		**out = **in
+	}
 	if in.DeletionGroups != nil {
 		in, out := &in.DeletionGroups, &out.DeletionGroups
 		*out = make([]managedresource.DeletionGroupDefinition, len(*in))
@@ -393,6 +510,16 @@ func (in *ProviderStatus) DeepCopyInto(out *ProviderStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ResolvedChartVersion != nil {
+		in, out := &in.ResolvedChartVersion, &out.ResolvedChartVersion
+		*out = new(ResolvedChartVersion)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ReleaseMigration != nil {
+		in, out := &in.ReleaseMigration, &out.ReleaseMigration
+		*out = new(ReleaseMigrationStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -414,6 +541,44 @@ func (in *ProviderStatus) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReleaseMigrationConfiguration) DeepCopyInto(out *ReleaseMigrationConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseMigrationConfiguration.
+func (in *ReleaseMigrationConfiguration) DeepCopy() *ReleaseMigrationConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ReleaseMigrationConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReleaseMigrationStatus) DeepCopyInto(out *ReleaseMigrationStatus) {
+	*out = *in
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.Time.DeepCopyInto(&out.Time)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseMigrationStatus.
+func (in *ReleaseMigrationStatus) DeepCopy() *ReleaseMigrationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ReleaseMigrationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RemoteArchiveAccess) DeepCopyInto(out *RemoteArchiveAccess) {
 	*out = *in
@@ -446,3 +611,20 @@ func (in *RemoteChartReference) DeepCopy() *RemoteChartReference {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResolvedChartVersion) DeepCopyInto(out *ResolvedChartVersion) {
+	*out = *in
+	in.ResolutionTime.DeepCopyInto(&out.ResolutionTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResolvedChartVersion.
+func (in *ResolvedChartVersion) DeepCopy() *ResolvedChartVersion {
+	if in == nil {
+		return nil
+	}
+	out := new(ResolvedChartVersion)
+	in.DeepCopyInto(out)
+	return out
+}
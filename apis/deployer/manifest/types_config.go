@@ -30,6 +30,9 @@ type Configuration struct {
 	Identity string `json:"identity,omitempty"`
 	// TargetSelector describes all selectors the deployer should depend on.
 	TargetSelector []lsv1alpha1.TargetSelector `json:"targetSelector,omitempty"`
+	// TargetResolvers enables built-in target resolvers that are disabled by default.
+	// +optional
+	TargetResolvers *lsconfigv1alpha1.TargetResolversConfiguration `json:"targetResolvers,omitempty"`
 	// Export defines the export configuration.
 	Export ExportConfiguration `json:"export,omitempty"`
 	// HPAConfiguration contains the configuration for horizontal pod autoscaling.
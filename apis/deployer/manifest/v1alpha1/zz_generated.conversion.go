@@ -14,6 +14,7 @@ import (
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 
+	configv1alpha1 "github.com/gardener/landscaper/apis/config/v1alpha1"
 	corev1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	manifest "github.com/gardener/landscaper/apis/deployer/manifest"
 )
@@ -91,6 +92,7 @@ func RegisterConversions(s *runtime.Scheme) error {
 func autoConvert_v1alpha1_Configuration_To_manifest_Configuration(in *Configuration, out *manifest.Configuration, s conversion.Scope) error {
 	out.Identity = in.Identity
 	out.TargetSelector = *(*[]corev1alpha1.TargetSelector)(unsafe.Pointer(&in.TargetSelector))
+	out.TargetResolvers = (*configv1alpha1.TargetResolversConfiguration)(unsafe.Pointer(in.TargetResolvers))
 	if err := Convert_v1alpha1_ExportConfiguration_To_manifest_ExportConfiguration(&in.Export, &out.Export, s); err != nil {
 		return err
 	}
@@ -109,6 +111,7 @@ func Convert_v1alpha1_Configuration_To_manifest_Configuration(in *Configuration,
 func autoConvert_manifest_Configuration_To_v1alpha1_Configuration(in *manifest.Configuration, out *Configuration, s conversion.Scope) error {
 	out.Identity = in.Identity
 	out.TargetSelector = *(*[]corev1alpha1.TargetSelector)(unsafe.Pointer(&in.TargetSelector))
+	out.TargetResolvers = (*configv1alpha1.TargetResolversConfiguration)(unsafe.Pointer(in.TargetResolvers))
 	if err := Convert_manifest_ExportConfiguration_To_v1alpha1_ExportConfiguration(&in.Export, &out.Export, s); err != nil {
 		return err
 	}
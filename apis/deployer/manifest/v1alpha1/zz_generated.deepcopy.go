@@ -11,6 +11,7 @@ package v1alpha1
 import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 
+	configv1alpha1 "github.com/gardener/landscaper/apis/config/v1alpha1"
 	corev1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	managedresource "github.com/gardener/landscaper/apis/deployer/utils/managedresource"
 )
@@ -26,6 +27,11 @@ func (in *Configuration) DeepCopyInto(out *Configuration) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.TargetResolvers != nil {
+		in, out := &in.TargetResolvers, &out.TargetResolvers
+		*out = new(configv1alpha1.TargetResolversConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	in.Export.DeepCopyInto(&out.Export)
 	if in.HPAConfiguration != nil {
 		in, out := &in.HPAConfiguration, &out.HPAConfiguration
@@ -14,6 +14,7 @@ import (
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 
+	configv1alpha1 "github.com/gardener/landscaper/apis/config/v1alpha1"
 	v1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	manifest "github.com/gardener/landscaper/apis/deployer/manifest"
 	continuousreconcile "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile"
@@ -93,6 +94,7 @@ func RegisterConversions(s *runtime.Scheme) error {
 func autoConvert_v1alpha2_Configuration_To_manifest_Configuration(in *Configuration, out *manifest.Configuration, s conversion.Scope) error {
 	out.Identity = in.Identity
 	out.TargetSelector = *(*[]v1alpha1.TargetSelector)(unsafe.Pointer(&in.TargetSelector))
+	out.TargetResolvers = (*configv1alpha1.TargetResolversConfiguration)(unsafe.Pointer(in.TargetResolvers))
 	if err := Convert_v1alpha2_ExportConfiguration_To_manifest_ExportConfiguration(&in.Export, &out.Export, s); err != nil {
 		return err
 	}
@@ -111,6 +113,7 @@ func Convert_v1alpha2_Configuration_To_manifest_Configuration(in *Configuration,
 func autoConvert_manifest_Configuration_To_v1alpha2_Configuration(in *manifest.Configuration, out *Configuration, s conversion.Scope) error {
 	out.Identity = in.Identity
 	out.TargetSelector = *(*[]v1alpha1.TargetSelector)(unsafe.Pointer(&in.TargetSelector))
+	out.TargetResolvers = (*configv1alpha1.TargetResolversConfiguration)(unsafe.Pointer(in.TargetResolvers))
 	if err := Convert_manifest_ExportConfiguration_To_v1alpha2_ExportConfiguration(&in.Export, &out.Export, s); err != nil {
 		return err
 	}
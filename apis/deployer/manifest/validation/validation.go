@@ -21,6 +21,12 @@ func ValidateProviderConfiguration(config *manifestv1alpha2.ProviderConfiguratio
 	allErrs = append(allErrs, health.ValidateReadinessCheckConfiguration(field.NewPath(""), &config.ReadinessChecks)...)
 	allErrs = append(allErrs, crval.ValidateContinuousReconcileSpec(field.NewPath("continuousReconcile"), config.ContinuousReconcile)...)
 	allErrs = append(allErrs, validation.ValidateDeletionGroups(field.NewPath("deletionGroups"), config.DeletionGroups)...)
+	if config.Exports != nil {
+		// the other fields of the exports are not validated for backwards compatibility
+		for i := range config.Exports.Exports {
+			allErrs = append(allErrs, validation.ValidateExportSelectorAndCondition(field.NewPath("exports", "exports").Index(i), &config.Exports.Exports[i])...)
+		}
+	}
 	return allErrs.ToAggregate()
 }
 
@@ -11,6 +11,7 @@ package manifest
 import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 
+	configv1alpha1 "github.com/gardener/landscaper/apis/config/v1alpha1"
 	v1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	continuousreconcile "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile"
 	managedresource "github.com/gardener/landscaper/apis/deployer/utils/managedresource"
@@ -27,6 +28,11 @@ func (in *Configuration) DeepCopyInto(out *Configuration) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.TargetResolvers != nil {
+		in, out := &in.TargetResolvers, &out.TargetResolvers
+		*out = new(configv1alpha1.TargetResolversConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	in.Export.DeepCopyInto(&out.Export)
 	if in.HPAConfiguration != nil {
 		in, out := &in.HPAConfiguration, &out.HPAConfiguration
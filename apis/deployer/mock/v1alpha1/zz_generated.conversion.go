@@ -15,6 +15,7 @@ import (
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 
+	configv1alpha1 "github.com/gardener/landscaper/apis/config/v1alpha1"
 	corev1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	mock "github.com/gardener/landscaper/apis/deployer/mock"
 	continuousreconcile "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile"
@@ -53,6 +54,7 @@ func RegisterConversions(s *runtime.Scheme) error {
 func autoConvert_v1alpha1_Configuration_To_mock_Configuration(in *Configuration, out *mock.Configuration, s conversion.Scope) error {
 	out.Identity = in.Identity
 	out.TargetSelector = *(*[]corev1alpha1.TargetSelector)(unsafe.Pointer(&in.TargetSelector))
+	out.TargetResolvers = (*configv1alpha1.TargetResolversConfiguration)(unsafe.Pointer(in.TargetResolvers))
 	return nil
 }
 
@@ -64,6 +66,7 @@ func Convert_v1alpha1_Configuration_To_mock_Configuration(in *Configuration, out
 func autoConvert_mock_Configuration_To_v1alpha1_Configuration(in *mock.Configuration, out *Configuration, s conversion.Scope) error {
 	out.Identity = in.Identity
 	out.TargetSelector = *(*[]corev1alpha1.TargetSelector)(unsafe.Pointer(&in.TargetSelector))
+	out.TargetResolvers = (*configv1alpha1.TargetResolversConfiguration)(unsafe.Pointer(in.TargetResolvers))
 	return nil
 }
 
@@ -6,6 +6,7 @@ package managedresource
 
 import (
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
@@ -110,10 +111,22 @@ type Export struct {
 	// FromResource specifies the name of the resource where the value should be read.
 	FromResource *lsv1alpha1.TypedObjectReference `json:"fromResource,omitempty"`
 
+	// FromResources selects multiple resources from which the values are read.
+	// The values of all selected resources are exported as list ordered by the namespace and name of the resources.
+	// FromResource and FromResources are mutually exclusive.
+	// +optional
+	FromResources *ResourceSelector `json:"fromResources,omitempty"`
+
 	// FromObjectReference describes that the jsonpath points to a object reference where the actual value is read from.
 	// This is helpful if for example a deployed resource referenced a secret and that exported value is in that secret.
 	FromObjectReference *FromObjectReference `json:"fromObjectRef,omitempty"`
 
+	// WaitFor defines a condition that the exported value has to fulfill.
+	// The export is retried until the condition is fulfilled or the timeout of the deploy item is exceeded.
+	// If the values are read from multiple resources, the value of every selected resource has to fulfill the condition.
+	// +optional
+	WaitFor *ExportCondition `json:"waitFor,omitempty"`
+
 	// TargetName specifies the target from which the objects for the export are read.
 	// The value typically comes from a target import parameter, for example: {{.imports.myCluster.metadata.name}}.
 	// TargetName is optional; the default is the target specified in the deployitem.
@@ -121,6 +134,47 @@ type Export struct {
 	TargetName *string `json:"targetName,omitempty"`
 }
 
+// IsResourceExport returns true if the value of the export is read from one or multiple resources.
+func (e Export) IsResourceExport() bool {
+	return e.FromResource != nil || e.FromResources != nil
+}
+
+// ResourceSelector selects multiple resources of the same kind.
+type ResourceSelector struct {
+	// APIVersion is the group and version of the selected resources.
+	APIVersion string `json:"apiVersion"`
+	// Kind is the kind of the selected resources.
+	Kind string `json:"kind"`
+	// Namespace is the namespace of the selected resources.
+	// Resources of all namespaces are selected if the namespace is empty.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	// LabelSelector selects the resources by their labels.
+	// All resources of the kind are selected if no label selector is defined.
+	// +optional
+	LabelSelector *metav1.LabelSelector `json:"labelSelector,omitempty"`
+	// MinCount is the minimal number of resources that have to be selected.
+	// The export is retried until enough resources exist.
+	// Defaults to 1.
+	// +optional
+	MinCount *int32 `json:"minCount,omitempty"`
+}
+
+// ExportCondition describes a condition that an exported value has to fulfill.
+// All defined checks have to be fulfilled.
+type ExportCondition struct {
+	// NotEmpty requires the value to be set and not to be an empty string, list or map.
+	// +optional
+	NotEmpty bool `json:"notEmpty,omitempty"`
+	// Matches is a regular expression that the value has to match.
+	// Values that are not strings are matched in their json encoded form.
+	// +optional
+	Matches string `json:"matches,omitempty"`
+	// Equals is the value that the exported value has to be equal to.
+	// +optional
+	Equals *lsv1alpha1.AnyJSON `json:"equals,omitempty"`
+}
+
 // FromObjectReference describes that the jsonpath points to a object reference where the actual value is read from.
 // This is helpful if for example a deployed resource referenced a secret and that exported value is in that secret.
 type FromObjectReference struct {
@@ -5,6 +5,9 @@
 package validation
 
 import (
+	"regexp"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
@@ -45,7 +48,57 @@ func ValidateManifestExport(fldPath *field.Path, export *managedresource.Export)
 	if export.FromObjectReference != nil {
 		allErrs = append(allErrs, ValidateFromObjectReference(fldPath.Child("fromObjectRef"), export.FromObjectReference)...)
 	}
+	allErrs = append(allErrs, ValidateExportSelectorAndCondition(fldPath, export)...)
+
+	return allErrs
+}
+
+// ValidateExportSelectorAndCondition validates the resource selector and the condition of an export.
+func ValidateExportSelectorAndCondition(fldPath *field.Path, export *managedresource.Export) field.ErrorList {
+	var allErrs field.ErrorList
+	if export.FromResources != nil {
+		if export.FromResource != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("fromResources"), "fromResource and fromResources are mutually exclusive"))
+		}
+		allErrs = append(allErrs, ValidateResourceSelector(fldPath.Child("fromResources"), export.FromResources)...)
+	}
+	if export.WaitFor != nil {
+		allErrs = append(allErrs, ValidateExportCondition(fldPath.Child("waitFor"), export.WaitFor)...)
+	}
+	return allErrs
+}
 
+// ValidateResourceSelector validates a selector of multiple resources.
+func ValidateResourceSelector(fldPath *field.Path, selector *managedresource.ResourceSelector) field.ErrorList {
+	var allErrs field.ErrorList
+	if len(selector.APIVersion) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("apiVersion"), "must not be empty"))
+	}
+	if len(selector.Kind) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("kind"), "must not be empty"))
+	}
+	if selector.LabelSelector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(selector.LabelSelector); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("labelSelector"), selector.LabelSelector, err.Error()))
+		}
+	}
+	if selector.MinCount != nil && *selector.MinCount < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("minCount"), *selector.MinCount, "must not be negative"))
+	}
+	return allErrs
+}
+
+// ValidateExportCondition validates the condition of an export.
+func ValidateExportCondition(fldPath *field.Path, condition *managedresource.ExportCondition) field.ErrorList {
+	var allErrs field.ErrorList
+	if !condition.NotEmpty && len(condition.Matches) == 0 && condition.Equals == nil {
+		allErrs = append(allErrs, field.Required(fldPath, "at least one of notEmpty, matches or equals must be defined"))
+	}
+	if len(condition.Matches) != 0 {
+		if _, err := regexp.Compile(condition.Matches); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("matches"), condition.Matches, err.Error()))
+		}
+	}
 	return allErrs
 }
 
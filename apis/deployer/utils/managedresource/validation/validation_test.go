@@ -10,6 +10,7 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
@@ -104,6 +105,70 @@ var _ = Describe("Validation", func() {
 				"Field": Equal("a.fromObjectRef.jsonPath"),
 			}))))
 		})
+
+		It("should accept an export from selected resources with a condition", func() {
+			export := &managedresource.Export{
+				Key:      "abc",
+				JSONPath: "status.loadBalancer.ingress[0].ip",
+				FromResources: &managedresource.ResourceSelector{
+					APIVersion: "v1",
+					Kind:       "Service",
+					LabelSelector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{"app": "test"},
+					},
+				},
+				WaitFor: &managedresource.ExportCondition{
+					NotEmpty: true,
+					Matches:  `^\d+\.\d+\.\d+\.\d+$`,
+				},
+			}
+			allErrs := validation.ValidateManifestExport(fld, export)
+			Expect(allErrs).To(HaveLen(0))
+		})
+
+		It("should deny an export from a resource and selected resources", func() {
+			export := &managedresource.Export{
+				Key:      "abc",
+				JSONPath: "b",
+				FromResource: &lsv1alpha1.TypedObjectReference{
+					APIVersion: "v1",
+					Kind:       "Secret",
+					ObjectReference: lsv1alpha1.ObjectReference{
+						Name: "abc",
+					},
+				},
+				FromResources: &managedresource.ResourceSelector{},
+			}
+			allErrs := validation.ValidateManifestExport(fld, export)
+			Expect(allErrs).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("a.fromResources"),
+			}))))
+			Expect(allErrs).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("a.fromResources.kind"),
+			}))))
+		})
+
+		It("should deny an invalid condition", func() {
+			export := &managedresource.Export{
+				Key:      "abc",
+				JSONPath: "b",
+				WaitFor:  &managedresource.ExportCondition{},
+			}
+			allErrs := validation.ValidateManifestExport(fld, export)
+			Expect(allErrs).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("a.waitFor"),
+			}))))
+
+			export.WaitFor.Matches = "(abc"
+			allErrs = validation.ValidateManifestExport(fld, export)
+			Expect(allErrs).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("a.waitFor.matches"),
+			}))))
+		})
 	})
 
 	Context("Deletion groups", func() {
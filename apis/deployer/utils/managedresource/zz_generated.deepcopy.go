@@ -9,6 +9,7 @@
 package managedresource
 
 import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 
 	v1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
@@ -76,11 +77,21 @@ func (in *Export) DeepCopyInto(out *Export) {
 		*out = new(v1alpha1.TypedObjectReference)
 		**out = **in
 	}
+	if in.FromResources != nil {
+		in, out := &in.FromResources, &out.FromResources
+		*out = new(ResourceSelector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.FromObjectReference != nil {
 		in, out := &in.FromObjectReference, &out.FromObjectReference
 		*out = new(FromObjectReference)
 		**out = **in
 	}
+	if in.WaitFor != nil {
+		in, out := &in.WaitFor, &out.WaitFor
+		*out = new(ExportCondition)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.TargetName != nil {
 		in, out := &in.TargetName, &out.TargetName
 		*out = new(string)
@@ -99,6 +110,27 @@ func (in *Export) DeepCopy() *Export {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExportCondition) DeepCopyInto(out *ExportCondition) {
+	*out = *in
+	if in.Equals != nil {
+		in, out := &in.Equals, &out.Equals
+		*out = new(v1alpha1.AnyJSON)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExportCondition.
+func (in *ExportCondition) DeepCopy() *ExportCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(ExportCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Exports) DeepCopyInto(out *Exports) {
 	*out = *in
@@ -250,6 +282,32 @@ func (in *PredefinedResourceGroup) DeepCopy() *PredefinedResourceGroup {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceSelector) DeepCopyInto(out *ResourceSelector) {
+	*out = *in
+	if in.LabelSelector != nil {
+		in, out := &in.LabelSelector, &out.LabelSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MinCount != nil {
+		in, out := &in.MinCount, &out.MinCount
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceSelector.
+func (in *ResourceSelector) DeepCopy() *ResourceSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceType) DeepCopyInto(out *ResourceType) {
 	*out = *in
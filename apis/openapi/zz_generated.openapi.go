@@ -48,6 +48,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/landscaper/apis/config.GarbageCollectionConfiguration":                            schema_gardener_landscaper_apis_config_GarbageCollectionConfiguration(ref),
 		"github.com/gardener/landscaper/apis/config.HPAMainConfiguration":                                      schema_gardener_landscaper_apis_config_HPAMainConfiguration(ref),
 		"github.com/gardener/landscaper/apis/config.InstallationsController":                                   schema_gardener_landscaper_apis_config_InstallationsController(ref),
+		"github.com/gardener/landscaper/apis/config.JSONSchemaConfiguration":                                   schema_gardener_landscaper_apis_config_JSONSchemaConfiguration(ref),
 		"github.com/gardener/landscaper/apis/config.LandscaperConfiguration":                                   schema_gardener_landscaper_apis_config_LandscaperConfiguration(ref),
 		"github.com/gardener/landscaper/apis/config.LocalRegistryConfiguration":                                schema_gardener_landscaper_apis_config_LocalRegistryConfiguration(ref),
 		"github.com/gardener/landscaper/apis/config.LsDeployments":                                             schema_gardener_landscaper_apis_config_LsDeployments(ref),
@@ -55,6 +56,8 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/landscaper/apis/config.OCICacheConfiguration":                                     schema_gardener_landscaper_apis_config_OCICacheConfiguration(ref),
 		"github.com/gardener/landscaper/apis/config.OCIConfiguration":                                          schema_gardener_landscaper_apis_config_OCIConfiguration(ref),
 		"github.com/gardener/landscaper/apis/config.RegistryConfiguration":                                     schema_gardener_landscaper_apis_config_RegistryConfiguration(ref),
+		"github.com/gardener/landscaper/apis/config.TargetControllerConfig":                                    schema_gardener_landscaper_apis_config_TargetControllerConfig(ref),
+		"github.com/gardener/landscaper/apis/config.TargetsController":                                         schema_gardener_landscaper_apis_config_TargetsController(ref),
 		"github.com/gardener/landscaper/apis/config/v1alpha1.AdditionalDeployments":                            schema_landscaper_apis_config_v1alpha1_AdditionalDeployments(ref),
 		"github.com/gardener/landscaper/apis/config/v1alpha1.BlueprintStore":                                   schema_landscaper_apis_config_v1alpha1_BlueprintStore(ref),
 		"github.com/gardener/landscaper/apis/config/v1alpha1.CommonControllerConfig":                           schema_landscaper_apis_config_v1alpha1_CommonControllerConfig(ref),
@@ -65,10 +68,13 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/landscaper/apis/config/v1alpha1.CrdManagementConfiguration":                       schema_landscaper_apis_config_v1alpha1_CrdManagementConfiguration(ref),
 		"github.com/gardener/landscaper/apis/config/v1alpha1.DeployItemTimeouts":                               schema_landscaper_apis_config_v1alpha1_DeployItemTimeouts(ref),
 		"github.com/gardener/landscaper/apis/config/v1alpha1.DeployItemsController":                            schema_landscaper_apis_config_v1alpha1_DeployItemsController(ref),
+		"github.com/gardener/landscaper/apis/config/v1alpha1.ExecTargetResolverConfiguration":                  schema_landscaper_apis_config_v1alpha1_ExecTargetResolverConfiguration(ref),
 		"github.com/gardener/landscaper/apis/config/v1alpha1.ExecutionsController":                             schema_landscaper_apis_config_v1alpha1_ExecutionsController(ref),
 		"github.com/gardener/landscaper/apis/config/v1alpha1.GarbageCollectionConfiguration":                   schema_landscaper_apis_config_v1alpha1_GarbageCollectionConfiguration(ref),
 		"github.com/gardener/landscaper/apis/config/v1alpha1.HPAMainConfiguration":                             schema_landscaper_apis_config_v1alpha1_HPAMainConfiguration(ref),
 		"github.com/gardener/landscaper/apis/config/v1alpha1.InstallationsController":                          schema_landscaper_apis_config_v1alpha1_InstallationsController(ref),
+		"github.com/gardener/landscaper/apis/config/v1alpha1.JSONSchemaConfiguration":                          schema_landscaper_apis_config_v1alpha1_JSONSchemaConfiguration(ref),
+		"github.com/gardener/landscaper/apis/config/v1alpha1.KubeconfigDirectoryTargetResolverConfiguration":   schema_landscaper_apis_config_v1alpha1_KubeconfigDirectoryTargetResolverConfiguration(ref),
 		"github.com/gardener/landscaper/apis/config/v1alpha1.LandscaperConfiguration":                          schema_landscaper_apis_config_v1alpha1_LandscaperConfiguration(ref),
 		"github.com/gardener/landscaper/apis/config/v1alpha1.LocalRegistryConfiguration":                       schema_landscaper_apis_config_v1alpha1_LocalRegistryConfiguration(ref),
 		"github.com/gardener/landscaper/apis/config/v1alpha1.LsDeployments":                                    schema_landscaper_apis_config_v1alpha1_LsDeployments(ref),
@@ -76,7 +82,11 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/landscaper/apis/config/v1alpha1.OCICacheConfiguration":                            schema_landscaper_apis_config_v1alpha1_OCICacheConfiguration(ref),
 		"github.com/gardener/landscaper/apis/config/v1alpha1.OCIConfiguration":                                 schema_landscaper_apis_config_v1alpha1_OCIConfiguration(ref),
 		"github.com/gardener/landscaper/apis/config/v1alpha1.RegistryConfiguration":                            schema_landscaper_apis_config_v1alpha1_RegistryConfiguration(ref),
+		"github.com/gardener/landscaper/apis/config/v1alpha1.TargetControllerConfig":                           schema_landscaper_apis_config_v1alpha1_TargetControllerConfig(ref),
+		"github.com/gardener/landscaper/apis/config/v1alpha1.TargetResolversConfiguration":                     schema_landscaper_apis_config_v1alpha1_TargetResolversConfiguration(ref),
+		"github.com/gardener/landscaper/apis/config/v1alpha1.TargetsController":                                schema_landscaper_apis_config_v1alpha1_TargetsController(ref),
 		"github.com/gardener/landscaper/apis/core.AnyJSON":                                                     schema_gardener_landscaper_apis_core_AnyJSON(ref),
+		"github.com/gardener/landscaper/apis/core.ApprovalRequest":                                             schema_gardener_landscaper_apis_core_ApprovalRequest(ref),
 		"github.com/gardener/landscaper/apis/core.AutomaticReconcile":                                          schema_gardener_landscaper_apis_core_AutomaticReconcile(ref),
 		"github.com/gardener/landscaper/apis/core.AutomaticReconcileStatus":                                    schema_gardener_landscaper_apis_core_AutomaticReconcileStatus(ref),
 		"github.com/gardener/landscaper/apis/core.Blueprint":                                                   schema_gardener_landscaper_apis_core_Blueprint(ref),
@@ -107,6 +117,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/landscaper/apis/core.DependentToTrigger":                                          schema_gardener_landscaper_apis_core_DependentToTrigger(ref),
 		"github.com/gardener/landscaper/apis/core.DeployItem":                                                  schema_gardener_landscaper_apis_core_DeployItem(ref),
 		"github.com/gardener/landscaper/apis/core.DeployItemCache":                                             schema_gardener_landscaper_apis_core_DeployItemCache(ref),
+		"github.com/gardener/landscaper/apis/core.DeployItemHistoryEntry":                                      schema_gardener_landscaper_apis_core_DeployItemHistoryEntry(ref),
 		"github.com/gardener/landscaper/apis/core.DeployItemList":                                              schema_gardener_landscaper_apis_core_DeployItemList(ref),
 		"github.com/gardener/landscaper/apis/core.DeployItemSpec":                                              schema_gardener_landscaper_apis_core_DeployItemSpec(ref),
 		"github.com/gardener/landscaper/apis/core.DeployItemStatus":                                            schema_gardener_landscaper_apis_core_DeployItemStatus(ref),
@@ -122,7 +133,16 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/landscaper/apis/core.ExportDefinition":                                            schema_gardener_landscaper_apis_core_ExportDefinition(ref),
 		"github.com/gardener/landscaper/apis/core.FailedReconcile":                                             schema_gardener_landscaper_apis_core_FailedReconcile(ref),
 		"github.com/gardener/landscaper/apis/core.FieldValueDefinition":                                        schema_gardener_landscaper_apis_core_FieldValueDefinition(ref),
+		"github.com/gardener/landscaper/apis/core.GeneratedCertificate":                                        schema_gardener_landscaper_apis_core_GeneratedCertificate(ref),
+		"github.com/gardener/landscaper/apis/core.GeneratedPassword":                                           schema_gardener_landscaper_apis_core_GeneratedPassword(ref),
+		"github.com/gardener/landscaper/apis/core.GeneratedSSHKeyPair":                                         schema_gardener_landscaper_apis_core_GeneratedSSHKeyPair(ref),
+		"github.com/gardener/landscaper/apis/core.GeneratedSecretDefinition":                                   schema_gardener_landscaper_apis_core_GeneratedSecretDefinition(ref),
+		"github.com/gardener/landscaper/apis/core.GeneratedSecretImportReference":                              schema_gardener_landscaper_apis_core_GeneratedSecretImportReference(ref),
+		"github.com/gardener/landscaper/apis/core.GeneratedSecretRotation":                                     schema_gardener_landscaper_apis_core_GeneratedSecretRotation(ref),
+		"github.com/gardener/landscaper/apis/core.GeneratedSecretStatus":                                       schema_gardener_landscaper_apis_core_GeneratedSecretStatus(ref),
 		"github.com/gardener/landscaper/apis/core.ImportDefinition":                                            schema_gardener_landscaper_apis_core_ImportDefinition(ref),
+		"github.com/gardener/landscaper/apis/core.ImportSource":                                                schema_gardener_landscaper_apis_core_ImportSource(ref),
+		"github.com/gardener/landscaper/apis/core.ImportValidationError":                                       schema_gardener_landscaper_apis_core_ImportValidationError(ref),
 		"github.com/gardener/landscaper/apis/core.InlineBlueprint":                                             schema_gardener_landscaper_apis_core_InlineBlueprint(ref),
 		"github.com/gardener/landscaper/apis/core.Installation":                                                schema_gardener_landscaper_apis_core_Installation(ref),
 		"github.com/gardener/landscaper/apis/core.InstallationExports":                                         schema_gardener_landscaper_apis_core_InstallationExports(ref),
@@ -137,6 +157,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/landscaper/apis/core.LocalSecretReference":                                        schema_gardener_landscaper_apis_core_LocalSecretReference(ref),
 		"github.com/gardener/landscaper/apis/core.LsHealthCheck":                                               schema_gardener_landscaper_apis_core_LsHealthCheck(ref),
 		"github.com/gardener/landscaper/apis/core.LsHealthCheckList":                                           schema_gardener_landscaper_apis_core_LsHealthCheckList(ref),
+		"github.com/gardener/landscaper/apis/core.MaintenanceWindow":                                           schema_gardener_landscaper_apis_core_MaintenanceWindow(ref),
 		"github.com/gardener/landscaper/apis/core.NamedObjectReference":                                        schema_gardener_landscaper_apis_core_NamedObjectReference(ref),
 		"github.com/gardener/landscaper/apis/core.ObjectReference":                                             schema_gardener_landscaper_apis_core_ObjectReference(ref),
 		"github.com/gardener/landscaper/apis/core.OnDeleteConfig":                                              schema_gardener_landscaper_apis_core_OnDeleteConfig(ref),
@@ -145,6 +166,9 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/landscaper/apis/core.Requirement":                                                 schema_gardener_landscaper_apis_core_Requirement(ref),
 		"github.com/gardener/landscaper/apis/core.ResolvedTarget":                                              schema_gardener_landscaper_apis_core_ResolvedTarget(ref),
 		"github.com/gardener/landscaper/apis/core.ResourceReference":                                           schema_gardener_landscaper_apis_core_ResourceReference(ref),
+		"github.com/gardener/landscaper/apis/core.Rollout":                                                     schema_gardener_landscaper_apis_core_Rollout(ref),
+		"github.com/gardener/landscaper/apis/core.RolloutStatus":                                               schema_gardener_landscaper_apis_core_RolloutStatus(ref),
+		"github.com/gardener/landscaper/apis/core.RolloutTarget":                                               schema_gardener_landscaper_apis_core_RolloutTarget(ref),
 		"github.com/gardener/landscaper/apis/core.SecretLabelSelectorRef":                                      schema_gardener_landscaper_apis_core_SecretLabelSelectorRef(ref),
 		"github.com/gardener/landscaper/apis/core.SecretReference":                                             schema_gardener_landscaper_apis_core_SecretReference(ref),
 		"github.com/gardener/landscaper/apis/core.StaticDataSource":                                            schema_gardener_landscaper_apis_core_StaticDataSource(ref),
@@ -161,12 +185,15 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/landscaper/apis/core.TargetExport":                                                schema_gardener_landscaper_apis_core_TargetExport(ref),
 		"github.com/gardener/landscaper/apis/core.TargetImport":                                                schema_gardener_landscaper_apis_core_TargetImport(ref),
 		"github.com/gardener/landscaper/apis/core.TargetList":                                                  schema_gardener_landscaper_apis_core_TargetList(ref),
+		"github.com/gardener/landscaper/apis/core.TargetResolverReference":                                     schema_gardener_landscaper_apis_core_TargetResolverReference(ref),
 		"github.com/gardener/landscaper/apis/core.TargetSelector":                                              schema_gardener_landscaper_apis_core_TargetSelector(ref),
 		"github.com/gardener/landscaper/apis/core.TargetSpec":                                                  schema_gardener_landscaper_apis_core_TargetSpec(ref),
+		"github.com/gardener/landscaper/apis/core.TargetStatus":                                                schema_gardener_landscaper_apis_core_TargetStatus(ref),
 		"github.com/gardener/landscaper/apis/core.TargetSync":                                                  schema_gardener_landscaper_apis_core_TargetSync(ref),
 		"github.com/gardener/landscaper/apis/core.TargetSyncList":                                              schema_gardener_landscaper_apis_core_TargetSyncList(ref),
 		"github.com/gardener/landscaper/apis/core.TargetSyncSpec":                                              schema_gardener_landscaper_apis_core_TargetSyncSpec(ref),
 		"github.com/gardener/landscaper/apis/core.TargetSyncStatus":                                            schema_gardener_landscaper_apis_core_TargetSyncStatus(ref),
+		"github.com/gardener/landscaper/apis/core.TargetSyncTemplate":                                          schema_gardener_landscaper_apis_core_TargetSyncTemplate(ref),
 		"github.com/gardener/landscaper/apis/core.TargetTemplate":                                              schema_gardener_landscaper_apis_core_TargetTemplate(ref),
 		"github.com/gardener/landscaper/apis/core.TemplateExecutor":                                            schema_gardener_landscaper_apis_core_TemplateExecutor(ref),
 		"github.com/gardener/landscaper/apis/core.TokenRotation":                                               schema_gardener_landscaper_apis_core_TokenRotation(ref),
@@ -178,6 +205,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/landscaper/apis/core.VersionedObjectReference":                                    schema_gardener_landscaper_apis_core_VersionedObjectReference(ref),
 		"github.com/gardener/landscaper/apis/core.VersionedResourceReference":                                  schema_gardener_landscaper_apis_core_VersionedResourceReference(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON":                                            schema_landscaper_apis_core_v1alpha1_AnyJSON(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.ApprovalRequest":                                    schema_landscaper_apis_core_v1alpha1_ApprovalRequest(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.AutomaticReconcile":                                 schema_landscaper_apis_core_v1alpha1_AutomaticReconcile(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.AutomaticReconcileStatus":                           schema_landscaper_apis_core_v1alpha1_AutomaticReconcileStatus(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.Blueprint":                                          schema_landscaper_apis_core_v1alpha1_Blueprint(ref),
@@ -208,6 +236,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/landscaper/apis/core/v1alpha1.DependentToTrigger":                                 schema_landscaper_apis_core_v1alpha1_DependentToTrigger(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.DeployItem":                                         schema_landscaper_apis_core_v1alpha1_DeployItem(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.DeployItemCache":                                    schema_landscaper_apis_core_v1alpha1_DeployItemCache(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.DeployItemHistoryEntry":                             schema_landscaper_apis_core_v1alpha1_DeployItemHistoryEntry(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.DeployItemList":                                     schema_landscaper_apis_core_v1alpha1_DeployItemList(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.DeployItemSpec":                                     schema_landscaper_apis_core_v1alpha1_DeployItemSpec(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.DeployItemStatus":                                   schema_landscaper_apis_core_v1alpha1_DeployItemStatus(ref),
@@ -223,7 +252,16 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/landscaper/apis/core/v1alpha1.ExportDefinition":                                   schema_landscaper_apis_core_v1alpha1_ExportDefinition(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.FailedReconcile":                                    schema_landscaper_apis_core_v1alpha1_FailedReconcile(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.FieldValueDefinition":                               schema_landscaper_apis_core_v1alpha1_FieldValueDefinition(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.GeneratedCertificate":                               schema_landscaper_apis_core_v1alpha1_GeneratedCertificate(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.GeneratedPassword":                                  schema_landscaper_apis_core_v1alpha1_GeneratedPassword(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.GeneratedSSHKeyPair":                                schema_landscaper_apis_core_v1alpha1_GeneratedSSHKeyPair(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.GeneratedSecretDefinition":                          schema_landscaper_apis_core_v1alpha1_GeneratedSecretDefinition(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.GeneratedSecretImportReference":                     schema_landscaper_apis_core_v1alpha1_GeneratedSecretImportReference(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.GeneratedSecretRotation":                            schema_landscaper_apis_core_v1alpha1_GeneratedSecretRotation(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.GeneratedSecretStatus":                              schema_landscaper_apis_core_v1alpha1_GeneratedSecretStatus(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.ImportDefinition":                                   schema_landscaper_apis_core_v1alpha1_ImportDefinition(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.ImportSource":                                       schema_landscaper_apis_core_v1alpha1_ImportSource(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.ImportValidationError":                              schema_landscaper_apis_core_v1alpha1_ImportValidationError(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.InlineBlueprint":                                    schema_landscaper_apis_core_v1alpha1_InlineBlueprint(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.Installation":                                       schema_landscaper_apis_core_v1alpha1_Installation(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.InstallationExports":                                schema_landscaper_apis_core_v1alpha1_InstallationExports(ref),
@@ -238,6 +276,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference":                               schema_landscaper_apis_core_v1alpha1_LocalSecretReference(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.LsHealthCheck":                                      schema_landscaper_apis_core_v1alpha1_LsHealthCheck(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.LsHealthCheckList":                                  schema_landscaper_apis_core_v1alpha1_LsHealthCheckList(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.MaintenanceWindow":                                  schema_landscaper_apis_core_v1alpha1_MaintenanceWindow(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.NamedObjectReference":                               schema_landscaper_apis_core_v1alpha1_NamedObjectReference(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference":                                    schema_landscaper_apis_core_v1alpha1_ObjectReference(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.OnDeleteConfig":                                     schema_landscaper_apis_core_v1alpha1_OnDeleteConfig(ref),
@@ -246,6 +285,9 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/landscaper/apis/core/v1alpha1.Requirement":                                        schema_landscaper_apis_core_v1alpha1_Requirement(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.ResolvedTarget":                                     schema_landscaper_apis_core_v1alpha1_ResolvedTarget(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.ResourceReference":                                  schema_landscaper_apis_core_v1alpha1_ResourceReference(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.Rollout":                                            schema_landscaper_apis_core_v1alpha1_Rollout(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.RolloutStatus":                                      schema_landscaper_apis_core_v1alpha1_RolloutStatus(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.RolloutTarget":                                      schema_landscaper_apis_core_v1alpha1_RolloutTarget(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.SecretLabelSelectorRef":                             schema_landscaper_apis_core_v1alpha1_SecretLabelSelectorRef(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.SecretReference":                                    schema_landscaper_apis_core_v1alpha1_SecretReference(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.StaticDataSource":                                   schema_landscaper_apis_core_v1alpha1_StaticDataSource(ref),
@@ -262,12 +304,15 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/landscaper/apis/core/v1alpha1.TargetExport":                                       schema_landscaper_apis_core_v1alpha1_TargetExport(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.TargetImport":                                       schema_landscaper_apis_core_v1alpha1_TargetImport(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.TargetList":                                         schema_landscaper_apis_core_v1alpha1_TargetList(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.TargetResolverReference":                            schema_landscaper_apis_core_v1alpha1_TargetResolverReference(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector":                                     schema_landscaper_apis_core_v1alpha1_TargetSelector(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.TargetSpec":                                         schema_landscaper_apis_core_v1alpha1_TargetSpec(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.TargetStatus":                                       schema_landscaper_apis_core_v1alpha1_TargetStatus(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.TargetSync":                                         schema_landscaper_apis_core_v1alpha1_TargetSync(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.TargetSyncList":                                     schema_landscaper_apis_core_v1alpha1_TargetSyncList(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.TargetSyncSpec":                                     schema_landscaper_apis_core_v1alpha1_TargetSyncSpec(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.TargetSyncStatus":                                   schema_landscaper_apis_core_v1alpha1_TargetSyncStatus(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.TargetSyncTemplate":                                 schema_landscaper_apis_core_v1alpha1_TargetSyncTemplate(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.TargetTemplate":                                     schema_landscaper_apis_core_v1alpha1_TargetTemplate(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.TemplateExecutor":                                   schema_landscaper_apis_core_v1alpha1_TemplateExecutor(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.TokenRotation":                                      schema_landscaper_apis_core_v1alpha1_TokenRotation(ref),
@@ -283,21 +328,27 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/landscaper/apis/deployer/container.ContainerStatus":                               schema_landscaper_apis_deployer_container_ContainerStatus(ref),
 		"github.com/gardener/landscaper/apis/deployer/container.Controller":                                    schema_landscaper_apis_deployer_container_Controller(ref),
 		"github.com/gardener/landscaper/apis/deployer/container.DebugOptions":                                  schema_landscaper_apis_deployer_container_DebugOptions(ref),
+		"github.com/gardener/landscaper/apis/deployer/container.DeleteConfiguration":                           schema_landscaper_apis_deployer_container_DeleteConfiguration(ref),
 		"github.com/gardener/landscaper/apis/deployer/container.GarbageCollection":                             schema_landscaper_apis_deployer_container_GarbageCollection(ref),
 		"github.com/gardener/landscaper/apis/deployer/container.HPAConfiguration":                              schema_landscaper_apis_deployer_container_HPAConfiguration(ref),
 		"github.com/gardener/landscaper/apis/deployer/container.PodStatus":                                     schema_landscaper_apis_deployer_container_PodStatus(ref),
+		"github.com/gardener/landscaper/apis/deployer/container.Progress":                                      schema_landscaper_apis_deployer_container_Progress(ref),
 		"github.com/gardener/landscaper/apis/deployer/container.ProviderConfiguration":                         schema_landscaper_apis_deployer_container_ProviderConfiguration(ref),
 		"github.com/gardener/landscaper/apis/deployer/container.ProviderStatus":                                schema_landscaper_apis_deployer_container_ProviderStatus(ref),
+		"github.com/gardener/landscaper/apis/deployer/container.TargetReference":                               schema_landscaper_apis_deployer_container_TargetReference(ref),
 		"github.com/gardener/landscaper/apis/deployer/container/v1alpha1.Configuration":                        schema_apis_deployer_container_v1alpha1_Configuration(ref),
 		"github.com/gardener/landscaper/apis/deployer/container/v1alpha1.ContainerSpec":                        schema_apis_deployer_container_v1alpha1_ContainerSpec(ref),
 		"github.com/gardener/landscaper/apis/deployer/container/v1alpha1.ContainerStatus":                      schema_apis_deployer_container_v1alpha1_ContainerStatus(ref),
 		"github.com/gardener/landscaper/apis/deployer/container/v1alpha1.Controller":                           schema_apis_deployer_container_v1alpha1_Controller(ref),
 		"github.com/gardener/landscaper/apis/deployer/container/v1alpha1.DebugOptions":                         schema_apis_deployer_container_v1alpha1_DebugOptions(ref),
+		"github.com/gardener/landscaper/apis/deployer/container/v1alpha1.DeleteConfiguration":                  schema_apis_deployer_container_v1alpha1_DeleteConfiguration(ref),
 		"github.com/gardener/landscaper/apis/deployer/container/v1alpha1.GarbageCollection":                    schema_apis_deployer_container_v1alpha1_GarbageCollection(ref),
 		"github.com/gardener/landscaper/apis/deployer/container/v1alpha1.HPAConfiguration":                     schema_apis_deployer_container_v1alpha1_HPAConfiguration(ref),
 		"github.com/gardener/landscaper/apis/deployer/container/v1alpha1.PodStatus":                            schema_apis_deployer_container_v1alpha1_PodStatus(ref),
+		"github.com/gardener/landscaper/apis/deployer/container/v1alpha1.Progress":                             schema_apis_deployer_container_v1alpha1_Progress(ref),
 		"github.com/gardener/landscaper/apis/deployer/container/v1alpha1.ProviderConfiguration":                schema_apis_deployer_container_v1alpha1_ProviderConfiguration(ref),
 		"github.com/gardener/landscaper/apis/deployer/container/v1alpha1.ProviderStatus":                       schema_apis_deployer_container_v1alpha1_ProviderStatus(ref),
+		"github.com/gardener/landscaper/apis/deployer/container/v1alpha1.TargetReference":                      schema_apis_deployer_container_v1alpha1_TargetReference(ref),
 		"github.com/gardener/landscaper/apis/deployer/helm.ArchiveAccess":                                      schema_landscaper_apis_deployer_helm_ArchiveAccess(ref),
 		"github.com/gardener/landscaper/apis/deployer/helm.Auth":                                               schema_landscaper_apis_deployer_helm_Auth(ref),
 		"github.com/gardener/landscaper/apis/deployer/helm.Chart":                                              schema_landscaper_apis_deployer_helm_Chart(ref),
@@ -307,13 +358,20 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/landscaper/apis/deployer/helm.HPAConfiguration":                                   schema_landscaper_apis_deployer_helm_HPAConfiguration(ref),
 		"github.com/gardener/landscaper/apis/deployer/helm.HelmChartRepo":                                      schema_landscaper_apis_deployer_helm_HelmChartRepo(ref),
 		"github.com/gardener/landscaper/apis/deployer/helm.HelmChartRepoCredentials":                           schema_landscaper_apis_deployer_helm_HelmChartRepoCredentials(ref),
+		"github.com/gardener/landscaper/apis/deployer/helm.HelmChartRepoIndexCacheConfiguration":               schema_landscaper_apis_deployer_helm_HelmChartRepoIndexCacheConfiguration(ref),
 		"github.com/gardener/landscaper/apis/deployer/helm.HelmDeploymentConfiguration":                        schema_landscaper_apis_deployer_helm_HelmDeploymentConfiguration(ref),
 		"github.com/gardener/landscaper/apis/deployer/helm.HelmInstallConfiguration":                           schema_landscaper_apis_deployer_helm_HelmInstallConfiguration(ref),
 		"github.com/gardener/landscaper/apis/deployer/helm.HelmUninstallConfiguration":                         schema_landscaper_apis_deployer_helm_HelmUninstallConfiguration(ref),
+		"github.com/gardener/landscaper/apis/deployer/helm.PostRendererConfiguration":                          schema_landscaper_apis_deployer_helm_PostRendererConfiguration(ref),
+		"github.com/gardener/landscaper/apis/deployer/helm.PostRendererPatch":                                  schema_landscaper_apis_deployer_helm_PostRendererPatch(ref),
+		"github.com/gardener/landscaper/apis/deployer/helm.PostRendererPatchTarget":                            schema_landscaper_apis_deployer_helm_PostRendererPatchTarget(ref),
 		"github.com/gardener/landscaper/apis/deployer/helm.ProviderConfiguration":                              schema_landscaper_apis_deployer_helm_ProviderConfiguration(ref),
 		"github.com/gardener/landscaper/apis/deployer/helm.ProviderStatus":                                     schema_landscaper_apis_deployer_helm_ProviderStatus(ref),
+		"github.com/gardener/landscaper/apis/deployer/helm.ReleaseMigrationConfiguration":                      schema_landscaper_apis_deployer_helm_ReleaseMigrationConfiguration(ref),
+		"github.com/gardener/landscaper/apis/deployer/helm.ReleaseMigrationStatus":                             schema_landscaper_apis_deployer_helm_ReleaseMigrationStatus(ref),
 		"github.com/gardener/landscaper/apis/deployer/helm.RemoteArchiveAccess":                                schema_landscaper_apis_deployer_helm_RemoteArchiveAccess(ref),
 		"github.com/gardener/landscaper/apis/deployer/helm.RemoteChartReference":                               schema_landscaper_apis_deployer_helm_RemoteChartReference(ref),
+		"github.com/gardener/landscaper/apis/deployer/helm.ResolvedChartVersion":                               schema_landscaper_apis_deployer_helm_ResolvedChartVersion(ref),
 		"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ArchiveAccess":                             schema_apis_deployer_helm_v1alpha1_ArchiveAccess(ref),
 		"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.Auth":                                      schema_apis_deployer_helm_v1alpha1_Auth(ref),
 		"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.Chart":                                     schema_apis_deployer_helm_v1alpha1_Chart(ref),
@@ -323,13 +381,20 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.HPAConfiguration":                          schema_apis_deployer_helm_v1alpha1_HPAConfiguration(ref),
 		"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.HelmChartRepo":                             schema_apis_deployer_helm_v1alpha1_HelmChartRepo(ref),
 		"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.HelmChartRepoCredentials":                  schema_apis_deployer_helm_v1alpha1_HelmChartRepoCredentials(ref),
+		"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.HelmChartRepoIndexCacheConfiguration":      schema_apis_deployer_helm_v1alpha1_HelmChartRepoIndexCacheConfiguration(ref),
 		"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.HelmDeploymentConfiguration":               schema_apis_deployer_helm_v1alpha1_HelmDeploymentConfiguration(ref),
 		"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.HelmInstallConfiguration":                  schema_apis_deployer_helm_v1alpha1_HelmInstallConfiguration(ref),
 		"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.HelmUninstallConfiguration":                schema_apis_deployer_helm_v1alpha1_HelmUninstallConfiguration(ref),
+		"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.PostRendererConfiguration":                 schema_apis_deployer_helm_v1alpha1_PostRendererConfiguration(ref),
+		"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.PostRendererPatch":                         schema_apis_deployer_helm_v1alpha1_PostRendererPatch(ref),
+		"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.PostRendererPatchTarget":                   schema_apis_deployer_helm_v1alpha1_PostRendererPatchTarget(ref),
 		"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ProviderConfiguration":                     schema_apis_deployer_helm_v1alpha1_ProviderConfiguration(ref),
 		"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ProviderStatus":                            schema_apis_deployer_helm_v1alpha1_ProviderStatus(ref),
+		"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ReleaseMigrationConfiguration":             schema_apis_deployer_helm_v1alpha1_ReleaseMigrationConfiguration(ref),
+		"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ReleaseMigrationStatus":                    schema_apis_deployer_helm_v1alpha1_ReleaseMigrationStatus(ref),
 		"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.RemoteArchiveAccess":                       schema_apis_deployer_helm_v1alpha1_RemoteArchiveAccess(ref),
 		"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.RemoteChartReference":                      schema_apis_deployer_helm_v1alpha1_RemoteChartReference(ref),
+		"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ResolvedChartVersion":                      schema_apis_deployer_helm_v1alpha1_ResolvedChartVersion(ref),
 		"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ResourceRef":                               schema_apis_deployer_helm_v1alpha1_ResourceRef(ref),
 		"github.com/gardener/landscaper/apis/deployer/manifest.Configuration":                                  schema_landscaper_apis_deployer_manifest_Configuration(ref),
 		"github.com/gardener/landscaper/apis/deployer/manifest.Controller":                                     schema_landscaper_apis_deployer_manifest_Controller(ref),
@@ -357,11 +422,13 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/landscaper/apis/deployer/utils/managedresource.CustomResourceGroup":               schema_apis_deployer_utils_managedresource_CustomResourceGroup(ref),
 		"github.com/gardener/landscaper/apis/deployer/utils/managedresource.DeletionGroupDefinition":           schema_apis_deployer_utils_managedresource_DeletionGroupDefinition(ref),
 		"github.com/gardener/landscaper/apis/deployer/utils/managedresource.Export":                            schema_apis_deployer_utils_managedresource_Export(ref),
+		"github.com/gardener/landscaper/apis/deployer/utils/managedresource.ExportCondition":                   schema_apis_deployer_utils_managedresource_ExportCondition(ref),
 		"github.com/gardener/landscaper/apis/deployer/utils/managedresource.Exports":                           schema_apis_deployer_utils_managedresource_Exports(ref),
 		"github.com/gardener/landscaper/apis/deployer/utils/managedresource.FromObjectReference":               schema_apis_deployer_utils_managedresource_FromObjectReference(ref),
 		"github.com/gardener/landscaper/apis/deployer/utils/managedresource.ManagedResourceStatus":             schema_apis_deployer_utils_managedresource_ManagedResourceStatus(ref),
 		"github.com/gardener/landscaper/apis/deployer/utils/managedresource.Manifest":                          schema_apis_deployer_utils_managedresource_Manifest(ref),
 		"github.com/gardener/landscaper/apis/deployer/utils/managedresource.PredefinedResourceGroup":           schema_apis_deployer_utils_managedresource_PredefinedResourceGroup(ref),
+		"github.com/gardener/landscaper/apis/deployer/utils/managedresource.ResourceSelector":                  schema_apis_deployer_utils_managedresource_ResourceSelector(ref),
 		"github.com/gardener/landscaper/apis/deployer/utils/managedresource.ResourceType":                      schema_apis_deployer_utils_managedresource_ResourceType(ref),
 		"github.com/gardener/landscaper/apis/deployer/utils/readinesschecks.CustomReadinessCheckConfiguration": schema_apis_deployer_utils_readinesschecks_CustomReadinessCheckConfiguration(ref),
 		"github.com/gardener/landscaper/apis/deployer/utils/readinesschecks.LabelSelectorSpec":                 schema_apis_deployer_utils_readinesschecks_LabelSelectorSpec(ref),
@@ -1586,12 +1653,19 @@ func schema_gardener_landscaper_apis_config_Controllers(ref common.ReferenceCall
 							Ref:         ref("github.com/gardener/landscaper/apis/config.ContextsController"),
 						},
 					},
+					"Targets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Targets contains the controller config that probes the health of targets.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/config.TargetsController"),
+						},
+					},
 				},
-				Required: []string{"SyncPeriod", "Installations", "Executions", "DeployItems", "Contexts"},
+				Required: []string{"SyncPeriod", "Installations", "Executions", "DeployItems", "Contexts", "Targets"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/config.ContextsController", "github.com/gardener/landscaper/apis/config.DeployItemsController", "github.com/gardener/landscaper/apis/config.ExecutionsController", "github.com/gardener/landscaper/apis/config.InstallationsController", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+			"github.com/gardener/landscaper/apis/config.ContextsController", "github.com/gardener/landscaper/apis/config.DeployItemsController", "github.com/gardener/landscaper/apis/config.ExecutionsController", "github.com/gardener/landscaper/apis/config.InstallationsController", "github.com/gardener/landscaper/apis/config.TargetsController", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
@@ -1789,6 +1863,27 @@ func schema_gardener_landscaper_apis_config_InstallationsController(ref common.R
 	}
 }
 
+func schema_gardener_landscaper_apis_config_JSONSchemaConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "JSONSchemaConfiguration contains the configuration for the validation of jsonschemas.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"AssertAllFormats": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AssertAllFormats enables the validation of the formats duration, period and semver. These formats are ignored by default, as previous versions of the landscaper did not validate them.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_gardener_landscaper_apis_config_LandscaperConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1829,6 +1924,12 @@ func schema_gardener_landscaper_apis_config_LandscaperConfiguration(ref common.R
 							Ref:         ref("github.com/gardener/landscaper/apis/config.BlueprintStore"),
 						},
 					},
+					"JSONSchema": {
+						SchemaProps: spec.SchemaProps{
+							Description: "JSONSchema contains the configuration for the validation of jsonschemas.",
+							Ref:         ref("github.com/gardener/landscaper/apis/config.JSONSchemaConfiguration"),
+						},
+					},
 					"Metrics": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Metrics allows to configure how metrics are exposed",
@@ -1873,7 +1974,7 @@ func schema_gardener_landscaper_apis_config_LandscaperConfiguration(ref common.R
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/component-spec/bindings-go/apis/v2.UnstructuredTypedObject", "github.com/gardener/landscaper/apis/config.BlueprintStore", "github.com/gardener/landscaper/apis/config.Controllers", "github.com/gardener/landscaper/apis/config.CrdManagementConfiguration", "github.com/gardener/landscaper/apis/config.DeployItemTimeouts", "github.com/gardener/landscaper/apis/config.HPAMainConfiguration", "github.com/gardener/landscaper/apis/config.LsDeployments", "github.com/gardener/landscaper/apis/config.MetricsConfiguration", "github.com/gardener/landscaper/apis/config.RegistryConfiguration", "k8s.io/apimachinery/pkg/apis/meta/v1.TypeMeta"},
+			"github.com/gardener/component-spec/bindings-go/apis/v2.UnstructuredTypedObject", "github.com/gardener/landscaper/apis/config.BlueprintStore", "github.com/gardener/landscaper/apis/config.Controllers", "github.com/gardener/landscaper/apis/config.CrdManagementConfiguration", "github.com/gardener/landscaper/apis/config.DeployItemTimeouts", "github.com/gardener/landscaper/apis/config.HPAMainConfiguration", "github.com/gardener/landscaper/apis/config.JSONSchemaConfiguration", "github.com/gardener/landscaper/apis/config.LsDeployments", "github.com/gardener/landscaper/apis/config.MetricsConfiguration", "github.com/gardener/landscaper/apis/config.RegistryConfiguration", "k8s.io/apimachinery/pkg/apis/meta/v1.TypeMeta"},
 	}
 }
 
@@ -2091,6 +2192,70 @@ func schema_gardener_landscaper_apis_config_RegistryConfiguration(ref common.Ref
 	}
 }
 
+func schema_gardener_landscaper_apis_config_TargetControllerConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TargetControllerConfig contains the target health specific configuration.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"Disable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Disable disables the target health controller. If disabled the status of targets is not maintained anymore.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"ProbeInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ProbeInterval defines the interval in which the api server of a target is probed. Defaults to 5 minutes.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"ProbeTimeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ProbeTimeout defines the timeout of a single probe. Defaults to 10 seconds.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+				Required: []string{"Disable"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_gardener_landscaper_apis_config_TargetsController(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TargetsController contains all configuration for the target health controller.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"CommonControllerConfig": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/gardener/landscaper/apis/config.CommonControllerConfig"),
+						},
+					},
+					"Config": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/gardener/landscaper/apis/config.TargetControllerConfig"),
+						},
+					},
+				},
+				Required: []string{"CommonControllerConfig", "Config"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/config.CommonControllerConfig", "github.com/gardener/landscaper/apis/config.TargetControllerConfig"},
+	}
+}
+
 func schema_landscaper_apis_config_v1alpha1_AdditionalDeployments(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -2332,12 +2497,19 @@ func schema_landscaper_apis_config_v1alpha1_Controllers(ref common.ReferenceCall
 							Ref:         ref("github.com/gardener/landscaper/apis/config/v1alpha1.ContextsController"),
 						},
 					},
+					"targets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Targets contains the controller config that probes the health of targets.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/config/v1alpha1.TargetsController"),
+						},
+					},
 				},
-				Required: []string{"syncPeriod", "installations", "executions", "deployItems", "contexts"},
+				Required: []string{"syncPeriod", "installations", "executions", "deployItems", "contexts", "targets"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/config/v1alpha1.ContextsController", "github.com/gardener/landscaper/apis/config/v1alpha1.DeployItemsController", "github.com/gardener/landscaper/apis/config/v1alpha1.ExecutionsController", "github.com/gardener/landscaper/apis/config/v1alpha1.InstallationsController", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+			"github.com/gardener/landscaper/apis/config/v1alpha1.ContextsController", "github.com/gardener/landscaper/apis/config/v1alpha1.DeployItemsController", "github.com/gardener/landscaper/apis/config/v1alpha1.ExecutionsController", "github.com/gardener/landscaper/apis/config/v1alpha1.InstallationsController", "github.com/gardener/landscaper/apis/config/v1alpha1.TargetsController", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
@@ -2418,6 +2590,35 @@ func schema_landscaper_apis_config_v1alpha1_DeployItemsController(ref common.Ref
 	}
 }
 
+func schema_landscaper_apis_config_v1alpha1_ExecTargetResolverConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ExecTargetResolverConfiguration configures the exec target resolver.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"allowedCommands": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AllowedCommands are the commands that Targets may use as exec credential plugin. Targets with other commands are not resolved.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"allowedCommands"},
+			},
+		},
+	}
+}
+
 func schema_landscaper_apis_config_v1alpha1_ExecutionsController(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -2532,6 +2733,46 @@ func schema_landscaper_apis_config_v1alpha1_InstallationsController(ref common.R
 	}
 }
 
+func schema_landscaper_apis_config_v1alpha1_JSONSchemaConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "JSONSchemaConfiguration contains the configuration for the validation of jsonschemas.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"assertAllFormats": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AssertAllFormats enables the validation of the formats duration, period and semver. These formats are ignored by default, as previous versions of the landscaper did not validate them.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_landscaper_apis_config_v1alpha1_KubeconfigDirectoryTargetResolverConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "KubeconfigDirectoryTargetResolverConfiguration configures the kubeconfig-directory target resolver.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"directory": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Directory is the directory from which the kubeconfigs are read. Defaults to /etc/landscaper/kubeconfigs.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_landscaper_apis_config_v1alpha1_LandscaperConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -2580,6 +2821,12 @@ func schema_landscaper_apis_config_v1alpha1_LandscaperConfiguration(ref common.R
 							Ref:         ref("github.com/gardener/landscaper/apis/config/v1alpha1.BlueprintStore"),
 						},
 					},
+					"jsonSchema": {
+						SchemaProps: spec.SchemaProps{
+							Description: "JSONSchema contains the configuration for the validation of jsonschemas.",
+							Ref:         ref("github.com/gardener/landscaper/apis/config/v1alpha1.JSONSchemaConfiguration"),
+						},
+					},
 					"metrics": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Metrics allows to configure how metrics are exposed",
@@ -2624,7 +2871,7 @@ func schema_landscaper_apis_config_v1alpha1_LandscaperConfiguration(ref common.R
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/component-spec/bindings-go/apis/v2.UnstructuredTypedObject", "github.com/gardener/landscaper/apis/config/v1alpha1.BlueprintStore", "github.com/gardener/landscaper/apis/config/v1alpha1.Controllers", "github.com/gardener/landscaper/apis/config/v1alpha1.CrdManagementConfiguration", "github.com/gardener/landscaper/apis/config/v1alpha1.DeployItemTimeouts", "github.com/gardener/landscaper/apis/config/v1alpha1.HPAMainConfiguration", "github.com/gardener/landscaper/apis/config/v1alpha1.LsDeployments", "github.com/gardener/landscaper/apis/config/v1alpha1.MetricsConfiguration", "github.com/gardener/landscaper/apis/config/v1alpha1.RegistryConfiguration"},
+			"github.com/gardener/component-spec/bindings-go/apis/v2.UnstructuredTypedObject", "github.com/gardener/landscaper/apis/config/v1alpha1.BlueprintStore", "github.com/gardener/landscaper/apis/config/v1alpha1.Controllers", "github.com/gardener/landscaper/apis/config/v1alpha1.CrdManagementConfiguration", "github.com/gardener/landscaper/apis/config/v1alpha1.DeployItemTimeouts", "github.com/gardener/landscaper/apis/config/v1alpha1.HPAMainConfiguration", "github.com/gardener/landscaper/apis/config/v1alpha1.JSONSchemaConfiguration", "github.com/gardener/landscaper/apis/config/v1alpha1.LsDeployments", "github.com/gardener/landscaper/apis/config/v1alpha1.MetricsConfiguration", "github.com/gardener/landscaper/apis/config/v1alpha1.RegistryConfiguration"},
 	}
 }
 
@@ -2841,6 +3088,97 @@ func schema_landscaper_apis_config_v1alpha1_RegistryConfiguration(ref common.Ref
 	}
 }
 
+func schema_landscaper_apis_config_v1alpha1_TargetControllerConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TargetControllerConfig contains the target health specific configuration.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"disable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Disable disables the target health controller. If disabled the status of targets is not maintained anymore.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"probeInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ProbeInterval defines the interval in which the api server of a target is probed. Defaults to 5 minutes.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"probeTimeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ProbeTimeout defines the timeout of a single probe. Defaults to 10 seconds.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+				Required: []string{"disable"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_landscaper_apis_config_v1alpha1_TargetResolversConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TargetResolversConfiguration enables built-in target resolvers of a deployer that are disabled by default, because they execute commands or read files in the deployer.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"exec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Exec enables the exec target resolver.",
+							Ref:         ref("github.com/gardener/landscaper/apis/config/v1alpha1.ExecTargetResolverConfiguration"),
+						},
+					},
+					"kubeconfigDirectory": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KubeconfigDirectory enables the kubeconfig-directory target resolver.",
+							Ref:         ref("github.com/gardener/landscaper/apis/config/v1alpha1.KubeconfigDirectoryTargetResolverConfiguration"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/config/v1alpha1.ExecTargetResolverConfiguration", "github.com/gardener/landscaper/apis/config/v1alpha1.KubeconfigDirectoryTargetResolverConfiguration"},
+	}
+}
+
+func schema_landscaper_apis_config_v1alpha1_TargetsController(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TargetsController contains all configuration for the target health controller.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"CommonControllerConfig": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/gardener/landscaper/apis/config/v1alpha1.CommonControllerConfig"),
+						},
+					},
+					"config": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/gardener/landscaper/apis/config/v1alpha1.TargetControllerConfig"),
+						},
+					},
+				},
+				Required: []string{"CommonControllerConfig", "config"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/config/v1alpha1.CommonControllerConfig", "github.com/gardener/landscaper/apis/config/v1alpha1.TargetControllerConfig"},
+	}
+}
+
 func schema_gardener_landscaper_apis_core_AnyJSON(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -2853,6 +3191,56 @@ func schema_gardener_landscaper_apis_core_AnyJSON(ref common.ReferenceCallback)
 	}
 }
 
+func schema_gardener_landscaper_apis_core_ApprovalRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ApprovalRequest describes the request to approve a job of a deploy item.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"jobID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "JobID is the job of the deploy item that must be approved.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"summary": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Summary is a human-readable summary of the changes that are applied by the job.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"requestTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RequestTime is the time when the approval has been requested.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"approvedBy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ApprovedBy is the name of the user who approved the job.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"approvalTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ApprovalTime is the time when the approval has been recognized by the landscaper.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"jobID", "requestTime"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_gardener_landscaper_apis_core_AutomaticReconcile(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -3023,21 +3411,35 @@ func schema_gardener_landscaper_apis_core_Blueprint(ref common.ReferenceCallback
 							},
 						},
 					},
-					"subinstallations": {
+					"generatedSecrets": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Subinstallations defines an optional list of subinstallations (for aggregating blueprints).",
+							Description: "GeneratedSecrets defines certificates, keys and passwords that are generated by the landscaper. The generated values are stored in secrets owned by the installation and are available in the templates as imports with the names of the definitions.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core.SubinstallationTemplate"),
+										Ref:     ref("github.com/gardener/landscaper/apis/core.GeneratedSecretDefinition"),
 									},
 								},
 							},
 						},
 					},
-					"subinstallationExecutions": {
+					"subinstallations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Subinstallations defines an optional list of subinstallations (for aggregating blueprints).",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core.SubinstallationTemplate"),
+									},
+								},
+							},
+						},
+					},
+					"subinstallationExecutions": {
 						SchemaProps: spec.SchemaProps{
 							Description: "SubinstallationExecutions defines the templating executors that are sequentially executed by the landscaper. The templates must return a list of installation templates. Both subinstallations and SubinstallationExecutions are valid options and will be merged.",
 							Type:        []string{"array"},
@@ -3083,7 +3485,7 @@ func schema_gardener_landscaper_apis_core_Blueprint(ref common.ReferenceCallback
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.ExportDefinition", "github.com/gardener/landscaper/apis/core.ImportDefinition", "github.com/gardener/landscaper/apis/core.JSONSchemaDefinition", "github.com/gardener/landscaper/apis/core.SubinstallationTemplate", "github.com/gardener/landscaper/apis/core.TemplateExecutor"},
+			"github.com/gardener/landscaper/apis/core.ExportDefinition", "github.com/gardener/landscaper/apis/core.GeneratedSecretDefinition", "github.com/gardener/landscaper/apis/core.ImportDefinition", "github.com/gardener/landscaper/apis/core.JSONSchemaDefinition", "github.com/gardener/landscaper/apis/core.SubinstallationTemplate", "github.com/gardener/landscaper/apis/core.TemplateExecutor"},
 	}
 }
 
@@ -3594,11 +3996,25 @@ func schema_gardener_landscaper_apis_core_Context(ref common.ReferenceCallback)
 							},
 						},
 					},
+					"maintenanceWindows": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaintenanceWindows restrict the automatic reconciles of installations and the continuous reconciles of deploy items that use this context to the given time windows. Work that becomes due outside of the windows is queued until the begin of the next window. If no window is defined, there is no restriction.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core.MaintenanceWindow"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/component-spec/bindings-go/apis/v2.UnstructuredTypedObject", "github.com/gardener/landscaper/apis/core.AnyJSON", "github.com/gardener/landscaper/apis/core.VerificationSignature", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/gardener/component-spec/bindings-go/apis/v2.UnstructuredTypedObject", "github.com/gardener/landscaper/apis/core.AnyJSON", "github.com/gardener/landscaper/apis/core.MaintenanceWindow", "github.com/gardener/landscaper/apis/core.VerificationSignature", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
@@ -3671,11 +4087,25 @@ func schema_gardener_landscaper_apis_core_ContextConfiguration(ref common.Refere
 							},
 						},
 					},
+					"maintenanceWindows": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaintenanceWindows restrict the automatic reconciles of installations and the continuous reconciles of deploy items that use this context to the given time windows. Work that becomes due outside of the windows is queued until the begin of the next window. If no window is defined, there is no restriction.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core.MaintenanceWindow"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/component-spec/bindings-go/apis/v2.UnstructuredTypedObject", "github.com/gardener/landscaper/apis/core.AnyJSON", "github.com/gardener/landscaper/apis/core.VerificationSignature", "k8s.io/api/core/v1.LocalObjectReference"},
+			"github.com/gardener/component-spec/bindings-go/apis/v2.UnstructuredTypedObject", "github.com/gardener/landscaper/apis/core.AnyJSON", "github.com/gardener/landscaper/apis/core.MaintenanceWindow", "github.com/gardener/landscaper/apis/core.VerificationSignature", "k8s.io/api/core/v1.LocalObjectReference"},
 	}
 }
 
@@ -4203,6 +4633,78 @@ func schema_gardener_landscaper_apis_core_DeployItemCache(ref common.ReferenceCa
 	}
 }
 
+func schema_gardener_landscaper_apis_core_DeployItemHistoryEntry(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DeployItemHistoryEntry is the result of a finished job of a deploy item.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"jobID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "JobID is the ID of the job.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"generation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Generation is the generation of the deploy item that was processed by the job.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase is the final phase of the job.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"startTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StartTime is the time when the job was started by the deployer.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"endTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EndTime is the time when the job was finished.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"error": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Error is the error of the job, if any. Long error messages are truncated.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.Error"),
+						},
+					},
+					"configurationHash": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConfigurationHash is the sha256 hash of the provider configuration that was applied by the job.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"managedResources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ManagedResources is the number of resources that are managed by the deploy item after the job, if the deployer reports managed resources in its provider status.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"jobID", "generation", "phase"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.Error", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_gardener_landscaper_apis_core_DeployItemList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -4433,12 +4935,32 @@ func schema_gardener_landscaper_apis_core_DeployItemStatus(ref common.ReferenceC
 							Ref:         ref("github.com/gardener/landscaper/apis/core.TransitionTimes"),
 						},
 					},
+					"approvalRequest": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ApprovalRequest describes the pending or granted approval of the current job of a deploy item which requires an approval.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.ApprovalRequest"),
+						},
+					},
+					"history": {
+						SchemaProps: spec.SchemaProps{
+							Description: "History contains the results of the most recent finished jobs of the deploy item, the latest job last. The number of entries is bounded; the oldest entries are removed.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core.DeployItemHistoryEntry"),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"observedGeneration"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.Condition", "github.com/gardener/landscaper/apis/core.DeployerInformation", "github.com/gardener/landscaper/apis/core.Error", "github.com/gardener/landscaper/apis/core.ObjectReference", "github.com/gardener/landscaper/apis/core.TransitionTimes", "k8s.io/apimachinery/pkg/apis/meta/v1.Time", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
+			"github.com/gardener/landscaper/apis/core.ApprovalRequest", "github.com/gardener/landscaper/apis/core.Condition", "github.com/gardener/landscaper/apis/core.DeployItemHistoryEntry", "github.com/gardener/landscaper/apis/core.DeployerInformation", "github.com/gardener/landscaper/apis/core.Error", "github.com/gardener/landscaper/apis/core.ObjectReference", "github.com/gardener/landscaper/apis/core.TransitionTimes", "k8s.io/apimachinery/pkg/apis/meta/v1.Time", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
 	}
 }
 
@@ -4527,6 +5049,13 @@ func schema_gardener_landscaper_apis_core_DeployItemTemplate(ref common.Referenc
 							Ref:         ref("github.com/gardener/landscaper/apis/core.OnDeleteConfig"),
 						},
 					},
+					"requireApproval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RequireApproval specifies that every job of the deploy item must be approved before it is picked up by a deployer.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"name", "type", "config"},
 			},
@@ -4811,11 +5340,31 @@ func schema_gardener_landscaper_apis_core_ExecutionSpec(ref common.ReferenceCall
 							Format:      "byte",
 						},
 					},
+					"deployItemsSecretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeployItemsSecretRef references a secret that contains the deploy items of the execution. It is used instead of the inline deploy items if the deploy items contain sensitive values.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.ObjectReference"),
+						},
+					},
+					"rollouts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rollouts define the progressive rollout of groups of deploy items, e.g. of the deploy items for the targets of a target map.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core.Rollout"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.DeployItemTemplate"},
+			"github.com/gardener/landscaper/apis/core.DeployItemTemplate", "github.com/gardener/landscaper/apis/core.ObjectReference", "github.com/gardener/landscaper/apis/core.Rollout"},
 	}
 }
 
@@ -4899,12 +5448,26 @@ func schema_gardener_landscaper_apis_core_ExecutionStatus(ref common.ReferenceCa
 							Ref:         ref("github.com/gardener/landscaper/apis/core.TransitionTimes"),
 						},
 					},
+					"rollouts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rollouts contains the progress of the rollouts of the current job.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core.RolloutStatus"),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"observedGeneration"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.Condition", "github.com/gardener/landscaper/apis/core.DeployItemCache", "github.com/gardener/landscaper/apis/core.Error", "github.com/gardener/landscaper/apis/core.ObjectReference", "github.com/gardener/landscaper/apis/core.TransitionTimes", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/gardener/landscaper/apis/core.Condition", "github.com/gardener/landscaper/apis/core.DeployItemCache", "github.com/gardener/landscaper/apis/core.Error", "github.com/gardener/landscaper/apis/core.ObjectReference", "github.com/gardener/landscaper/apis/core.RolloutStatus", "github.com/gardener/landscaper/apis/core.TransitionTimes", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -5024,502 +5587,506 @@ func schema_gardener_landscaper_apis_core_FieldValueDefinition(ref common.Refere
 	}
 }
 
-func schema_gardener_landscaper_apis_core_ImportDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_GeneratedCertificate(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ImportDefinition defines a imported value",
+				Description: "GeneratedCertificate configures a generated certificate authority or certificate.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"commonName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name defines the field name to search for the value and map to exports. Ref: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#selecting-fields",
+							Description: "CommonName is the common name of the certificate.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"schema": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Schema defines the imported value as jsonschema.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.JSONSchemaDefinition"),
-						},
-					},
-					"targetType": {
-						SchemaProps: spec.SchemaProps{
-							Description: "TargetType defines the type of the imported target.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"type": {
+					"organization": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type specifies which kind of object is being imported. This field should be set and will likely be mandatory in future.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Organization is the list of organizations of the certificate.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"required": {
+					"dnsNames": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Required specifies whether the import is required for the component to run. Defaults to true.",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "DNSNames is the list of dns names of the certificate.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"default": {
+					"ipAddresses": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Default sets a default value for the current import that is used if the key is not set.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core.Default"),
+							Description: "IPAddresses is the list of ip addresses of the certificate.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"imports": {
+					"sansFromImports": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ConditionalImports are Imports that are only valid if this imports is satisfied. Does only make sense for optional imports. todo: maybe restrict only for required=false todo: see if this works with recursion",
+							Description: "SANsFromImports adds the values of imports as subject alternative names. The referenced values must be strings or lists of strings. Ip addresses are added as ip addresses, all other values as dns names.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core.ImportDefinition"),
+										Ref:     ref("github.com/gardener/landscaper/apis/core.GeneratedSecretImportReference"),
 									},
 								},
 							},
 						},
 					},
+					"ca": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CA is the name of the generated certificate authority that signs the certificate. The certificate is self-signed if no certificate authority is set.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"validity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Validity is the duration for which the certificate is valid. Defaults to 10 years for certificate authorities and to 1 year for certificates.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.Duration"),
+						},
+					},
 				},
-				Required: []string{"name"},
+				Required: []string{"commonName"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.Default", "github.com/gardener/landscaper/apis/core.ImportDefinition", "github.com/gardener/landscaper/apis/core.JSONSchemaDefinition"},
+			"github.com/gardener/landscaper/apis/core.Duration", "github.com/gardener/landscaper/apis/core.GeneratedSecretImportReference"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_InlineBlueprint(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_GeneratedPassword(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "InlineBlueprint defines an inline blueprint with component descriptor and filesystem.",
+				Description: "GeneratedPassword configures a generated password.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"filesystem": {
+					"length": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Filesystem defines a inline yaml filesystem with a blueprint.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.AnyJSON"),
+							Description: "Length is the length of the password. Defaults to 32.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
 				},
-				Required: []string{"filesystem"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.AnyJSON"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_Installation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_GeneratedSSHKeyPair(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Installation contains the configuration of a installation",
+				Description: "GeneratedSSHKeyPair configures a generated ssh key pair.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"algorithm": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "Algorithm is the algorithm of the key pair. Defaults to ed25519.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"bits": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "Bits is the size of rsa keys. Defaults to 4096.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_GeneratedSecretDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GeneratedSecretDefinition defines a secret that is generated once and stored in a secret owned by the installation.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the generated secret and of the import under which its value is available.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Description: "Type is the type of the generated secret.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"spec": {
+					"certificate": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec contains the specification for a installation.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core.InstallationSpec"),
+							Description: "Certificate configures a generated certificate authority or certificate.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.GeneratedCertificate"),
 						},
 					},
-					"status": {
+					"sshKeyPair": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Status contains the status of the installation.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core.InstallationStatus"),
+							Description: "SSHKeyPair configures a generated ssh key pair.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.GeneratedSSHKeyPair"),
+						},
+					},
+					"password": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Password configures a generated password.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.GeneratedPassword"),
+						},
+					},
+					"rotation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rotation defines when the generated secret is replaced by a new one.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.GeneratedSecretRotation"),
 						},
 					},
 				},
-				Required: []string{"spec"},
+				Required: []string{"name", "type"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.InstallationSpec", "github.com/gardener/landscaper/apis/core.InstallationStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/gardener/landscaper/apis/core.GeneratedCertificate", "github.com/gardener/landscaper/apis/core.GeneratedPassword", "github.com/gardener/landscaper/apis/core.GeneratedSSHKeyPair", "github.com/gardener/landscaper/apis/core.GeneratedSecretRotation"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_InstallationExports(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_GeneratedSecretImportReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "InstallationExports defines exports of data objects and targets.",
+				Description: "GeneratedSecretImportReference references the value of an import.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"data": {
+					"import": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Data defines all data object exports.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core.DataExport"),
-									},
-								},
-							},
+							Description: "Import is the name of the import.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"targets": {
+					"path": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Targets defines all target exports.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core.TargetExport"),
-									},
-								},
-							},
+							Description: "Path is an optional jsonpath to a value within the import, e.g. \".ingress.hosts\".",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
+				Required: []string{"import"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.DataExport", "github.com/gardener/landscaper/apis/core.TargetExport"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_InstallationImports(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_GeneratedSecretRotation(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "InstallationImports defines import of data objects and targets.",
+				Description: "GeneratedSecretRotation defines when a generated secret is replaced by a new one.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"data": {
+					"renewBefore": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Data defines all data object imports.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core.DataImport"),
-									},
-								},
-							},
+							Description: "RenewBefore is the remaining validity of certificate authorities and certificates at which they are renewed. Defaults to a third of their validity.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.Duration"),
 						},
 					},
-					"targets": {
+					"interval": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Targets defines all target imports.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core.TargetImport"),
-									},
-								},
-							},
+							Description: "Interval is the duration after which a secret is regenerated. Passwords and ssh key pairs are not rotated if no interval is set.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.Duration"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.DataImport", "github.com/gardener/landscaper/apis/core.TargetImport"},
+			"github.com/gardener/landscaper/apis/core.Duration"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_InstallationList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_GeneratedSecretStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "InstallationList contains a list of Components",
+				Description: "GeneratedSecretStatus describes the status of a generated secret.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "Name is the name of the generated secret definition in the blueprint.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"secretName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "SecretName is the name of the secret in the namespace of the installation that contains the generated values.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"generationTime": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Description: "GenerationTime is the time when the values were generated.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"items": {
+					"expirationTime": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core.Installation"),
-									},
-								},
-							},
+							Description: "ExpirationTime is the time when a generated certificate expires.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"rotationTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RotationTime is the time from which on the values are regenerated.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
 				},
-				Required: []string{"items"},
+				Required: []string{"name", "secretName", "generationTime"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.Installation", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_InstallationSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_ImportDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "InstallationSpec defines a component installation.",
+				Description: "ImportDefinition defines a imported value",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"context": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Context defines the current context of the installation.",
+							Description: "Name defines the field name to search for the value and map to exports. Ref: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#selecting-fields",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"verification": {
+					"schema": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Verification defines the necessary data to verify the signature of the refered component",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.Verification"),
+							Description: "Schema defines the imported value as jsonschema.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.JSONSchemaDefinition"),
 						},
 					},
-					"componentDescriptor": {
+					"targetType": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ComponentDescriptor is a reference to the installation's component descriptor",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.ComponentDescriptorDefinition"),
+							Description: "TargetType defines the type of the imported target.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"blueprint": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Blueprint is the resolved reference to the definition.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core.BlueprintDefinition"),
+							Description: "Type specifies which kind of object is being imported. This field should be set and will likely be mandatory in future.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"imports": {
+					"required": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Imports define the imported data objects and targets.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core.InstallationImports"),
+							Description: "Required specifies whether the import is required for the component to run. Defaults to true.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"importDataMappings": {
+					"default": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ImportDataMappings contains a template for restructuring imports. It is expected to contain a key for every blueprint-defined data import. Missing keys will be defaulted to their respective data import. Example: namespace: (( installation.imports.namespace ))",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/landscaper/apis/core.AnyJSON"),
-									},
-								},
-							},
+							Description: "Default sets a default value for the current import that is used if the key is not set.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core.Default"),
 						},
 					},
-					"exports": {
+					"sensitive": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Exports define the exported data objects and targets.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core.InstallationExports"),
+							Description: "Sensitive marks the imported value as sensitive. Sensitive values are stored in secrets instead of data objects, are masked in error messages and logs and the deploy items of the installation are not stored in clear text in the execution.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"exportDataMappings": {
+					"imports": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ExportDataMappings contains a template for restructuring exports. It is expected to contain a key for every blueprint-defined data export. Missing keys will be defaulted to their respective data export. Example: namespace: (( blueprint.exports.namespace ))",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "ConditionalImports are Imports that are only valid if this imports is satisfied. Does only make sense for optional imports. todo: maybe restrict only for required=false todo: see if this works with recursion",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/landscaper/apis/core.AnyJSON"),
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core.ImportDefinition"),
 									},
 								},
 							},
 						},
 					},
-					"automaticReconcile": {
-						SchemaProps: spec.SchemaProps{
-							Description: "AutomaticReconcile allows to configure automatically repeated reconciliations.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.AutomaticReconcile"),
-						},
-					},
-					"optimization": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Optimization contains settings to improve execution performance.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.Optimization"),
-						},
-					},
 				},
-				Required: []string{"blueprint"},
+				Required: []string{"name"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.AnyJSON", "github.com/gardener/landscaper/apis/core.AutomaticReconcile", "github.com/gardener/landscaper/apis/core.BlueprintDefinition", "github.com/gardener/landscaper/apis/core.ComponentDescriptorDefinition", "github.com/gardener/landscaper/apis/core.InstallationExports", "github.com/gardener/landscaper/apis/core.InstallationImports", "github.com/gardener/landscaper/apis/core.Optimization", "github.com/gardener/landscaper/apis/core.Verification"},
+			"github.com/gardener/landscaper/apis/core.Default", "github.com/gardener/landscaper/apis/core.ImportDefinition", "github.com/gardener/landscaper/apis/core.JSONSchemaDefinition"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_InstallationStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_ImportSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "InstallationStatus contains the current status of a Installation.",
+				Description: "ImportSource describes where the value of an import comes from.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"observedGeneration": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ObservedGeneration is the most recent generation observed for this ControllerInstallations. It corresponds to the ControllerInstallations generation, which is updated on mutation by the landscaper.",
-							Default:     0,
-							Type:        []string{"integer"},
-							Format:      "int64",
-						},
-					},
-					"conditions": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Conditions contains the actual condition of a installation",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core.Condition"),
-									},
-								},
-							},
-						},
-					},
-					"lastError": {
-						SchemaProps: spec.SchemaProps{
-							Description: "LastError describes the last error that occurred.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.Error"),
-						},
-					},
-					"subInstCache": {
-						SchemaProps: spec.SchemaProps{
-							Description: "SubInstCache contains the currently existing sub installations belonging to the execution. If nil undefined.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.SubInstCache"),
-						},
-					},
-					"executionRef": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ExecutionReference is the reference to the execution that schedules the templated execution items.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.ObjectReference"),
-						},
-					},
-					"jobID": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "JobID is the ID of the current working request.",
+							Description: "Type is the type of the source.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"jobIDFinished": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "JobIDFinished is the ID of the finished working request.",
+							Description: "Name is the name of the data object, secret or configmap that contains the value.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"phase": {
+					"key": {
 						SchemaProps: spec.SchemaProps{
-							Description: "InstallationPhase is the current phase of the installation.",
+							Description: "Key is the key of the secret or configmap that contains the value.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"phaseTransitionTime": {
-						SchemaProps: spec.SchemaProps{
-							Description: "PhaseTransitionTime is the time when the phase last changed.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
-						},
-					},
-					"importsHash": {
+					"installation": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ImportsHash is the hash of the import data.",
+							Description: "Installation is the name of the installation that has created the data object. This is either a sibling installation that exports the data object or the parent installation that passes on one of its own imports or the result of one of its import data mappings.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"automaticReconcileStatus": {
+				},
+				Required: []string{"type"},
+			},
+		},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_ImportValidationError(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ImportValidationError describes a value of a data import that violates the schema of its import definition.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"import": {
 						SchemaProps: spec.SchemaProps{
-							Description: "AutomaticReconcileStatus describes the status of automatically triggered reconciles.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.AutomaticReconcileStatus"),
+							Description: "Import is the name of the import.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"dependentsToTrigger": {
+					"source": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DependentsToTrigger lists dependent installations to be triggered",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core.DependentToTrigger"),
-									},
-								},
-							},
+							Description: "Source describes where the value of the import comes from.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core.ImportSource"),
 						},
 					},
-					"transitionTimes": {
+					"pointer": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TransitionTimes contains timestamps of status transitions",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.TransitionTimes"),
+							Description: "Pointer is the json pointer of the invalid value within the value of the import. The pointer is empty if the value of the import itself is invalid.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message describes the violation.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"observedGeneration"},
+				Required: []string{"import", "source", "message"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.AutomaticReconcileStatus", "github.com/gardener/landscaper/apis/core.Condition", "github.com/gardener/landscaper/apis/core.DependentToTrigger", "github.com/gardener/landscaper/apis/core.Error", "github.com/gardener/landscaper/apis/core.ObjectReference", "github.com/gardener/landscaper/apis/core.SubInstCache", "github.com/gardener/landscaper/apis/core.TransitionTimes", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/gardener/landscaper/apis/core.ImportSource"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_InstallationTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_InlineBlueprint(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "InstallationTemplate defines a subinstallation in a blueprint.",
+				Description: "InlineBlueprint defines an inline blueprint with component descriptor and filesystem.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"filesystem": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Filesystem defines a inline yaml filesystem with a blueprint.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.AnyJSON"),
+						},
+					},
+				},
+				Required: []string{"filesystem"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.AnyJSON"},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_Installation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Installation contains the configuration of a installation",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -5536,208 +6103,1655 @@ func schema_gardener_landscaper_apis_core_InstallationTemplate(ref common.Refere
 							Format:      "",
 						},
 					},
-					"name": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the unique name of the step",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"blueprint": {
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Reference defines a reference to a Blueprint. The blueprint can reside in an OCI or other supported location.",
+							Description: "Spec contains the specification for a installation.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core.InstallationTemplateBlueprintDefinition"),
+							Ref:         ref("github.com/gardener/landscaper/apis/core.InstallationSpec"),
 						},
 					},
-					"imports": {
+					"status": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Imports define the imported data objects and targets.",
+							Description: "Status contains the status of the installation.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core.InstallationImports"),
+							Ref:         ref("github.com/gardener/landscaper/apis/core.InstallationStatus"),
 						},
 					},
-					"importDataMappings": {
+				},
+				Required: []string{"spec"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.InstallationSpec", "github.com/gardener/landscaper/apis/core.InstallationStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_InstallationExports(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InstallationExports defines exports of data objects and targets.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"data": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ImportDataMappings contains a template for restructuring imports. It is expected to contain a key for every blueprint-defined data import. Missing keys will be defaulted to their respective data import. Example: namespace: (( installation.imports.namespace ))",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "Data defines all data object exports.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/landscaper/apis/core.AnyJSON"),
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core.DataExport"),
 									},
 								},
 							},
 						},
 					},
-					"exports": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Exports define the exported data objects and targets.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core.InstallationExports"),
-						},
-					},
-					"exportDataMappings": {
+					"targets": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ExportDataMappings contains a template for restructuring exports. It is expected to contain a key for every blueprint-defined data export. Missing keys will be defaulted to their respective data export. Example: namespace: (( blueprint.exports.namespace ))",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "Targets defines all target exports.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/landscaper/apis/core.AnyJSON"),
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core.TargetExport"),
 									},
 								},
 							},
 						},
 					},
-					"optimization": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Optimization contains settings to improve execution performance.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.Optimization"),
-						},
-					},
 				},
-				Required: []string{"name", "blueprint"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.AnyJSON", "github.com/gardener/landscaper/apis/core.InstallationExports", "github.com/gardener/landscaper/apis/core.InstallationImports", "github.com/gardener/landscaper/apis/core.InstallationTemplateBlueprintDefinition", "github.com/gardener/landscaper/apis/core.Optimization"},
+			"github.com/gardener/landscaper/apis/core.DataExport", "github.com/gardener/landscaper/apis/core.TargetExport"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_InstallationTemplateBlueprintDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_InstallationImports(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "InstallationTemplateBlueprintDefinition contains either a reference to a blueprint or an inline definition.",
+				Description: "InstallationImports defines import of data objects and targets.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"ref": {
+					"data": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Ref is a reference to a blueprint. Only blueprints that are defined by the component descriptor of the current blueprint can be referenced here. Example: cd://componentReference/dns/resources/blueprint",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Data defines all data object imports.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core.DataImport"),
+									},
+								},
+							},
 						},
 					},
-					"filesystem": {
+					"targets": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Filesystem defines a virtual filesystem with all files needed for a blueprint. The filesystem must be a YAML filesystem.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.AnyJSON"),
+							Description: "Targets defines all target imports.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core.TargetImport"),
+									},
+								},
+							},
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.AnyJSON"},
-	}
-}
-
-func schema_gardener_landscaper_apis_core_JSONSchemaDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "JSONSchemaDefinition defines a jsonschema.",
-				Type:        core.JSONSchemaDefinition{}.OpenAPISchemaType(),
-				Format:      core.JSONSchemaDefinition{}.OpenAPISchemaFormat(),
-			},
-		},
+			"github.com/gardener/landscaper/apis/core.DataImport", "github.com/gardener/landscaper/apis/core.TargetImport"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_LocalConfigMapReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_InstallationList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "LocalConfigMapReference is a reference to data in a configmap.",
+				Description: "InstallationList contains a list of Components",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the configmap",
-							Default:     "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"key": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Key is the name of the key in the configmap that holds the data.",
-							Default:     "",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-				},
-				Required: []string{"name"},
-			},
-		},
-	}
-}
-
-func schema_gardener_landscaper_apis_core_LocalSecretReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "LocalSecretReference is a reference to data in a secret.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"name": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the secret",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
 						},
 					},
-					"key": {
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Key is the name of the key in the secret that holds the data.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core.Installation"),
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"name"},
+				Required: []string{"items"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.Installation", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_LsHealthCheck(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_InstallationSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "LsHealthCheck is a resource containing information about problems with the landscaper installation",
+				Description: "InstallationSpec defines a component installation.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"context": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "Context defines the current context of the installation.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"verification": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Verification defines the necessary data to verify the signature of the refered component",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.Verification"),
+						},
+					},
+					"componentDescriptor": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ComponentDescriptor is a reference to the installation's component descriptor",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.ComponentDescriptorDefinition"),
+						},
+					},
+					"blueprint": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Blueprint is the resolved reference to the definition.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core.BlueprintDefinition"),
+						},
+					},
+					"imports": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Imports define the imported data objects and targets.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core.InstallationImports"),
+						},
+					},
+					"importDataMappings": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ImportDataMappings contains a template for restructuring imports. It is expected to contain a key for every blueprint-defined data import. Missing keys will be defaulted to their respective data import. Example: namespace: (( installation.imports.namespace ))",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/landscaper/apis/core.AnyJSON"),
+									},
+								},
+							},
+						},
+					},
+					"exports": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Exports define the exported data objects and targets.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core.InstallationExports"),
+						},
+					},
+					"exportDataMappings": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExportDataMappings contains a template for restructuring exports. It is expected to contain a key for every blueprint-defined data export. Missing keys will be defaulted to their respective data export. Example: namespace: (( blueprint.exports.namespace ))",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/landscaper/apis/core.AnyJSON"),
+									},
+								},
+							},
+						},
+					},
+					"automaticReconcile": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AutomaticReconcile allows to configure automatically repeated reconciliations.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.AutomaticReconcile"),
+						},
+					},
+					"optimization": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Optimization contains settings to improve execution performance.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.Optimization"),
+						},
+					},
+					"suspend": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Suspend stops the processing of the installation and of all its subinstallations, executions and deploy items. Changes and operations are kept and processed after the installation has been resumed.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"requireApproval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RequireApproval specifies that the deploy items of the installation and of all its subinstallations must be approved before they are deployed.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"blueprint"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.AnyJSON", "github.com/gardener/landscaper/apis/core.AutomaticReconcile", "github.com/gardener/landscaper/apis/core.BlueprintDefinition", "github.com/gardener/landscaper/apis/core.ComponentDescriptorDefinition", "github.com/gardener/landscaper/apis/core.InstallationExports", "github.com/gardener/landscaper/apis/core.InstallationImports", "github.com/gardener/landscaper/apis/core.Optimization", "github.com/gardener/landscaper/apis/core.Verification"},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_InstallationStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InstallationStatus contains the current status of a Installation.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedGeneration is the most recent generation observed for this ControllerInstallations. It corresponds to the ControllerInstallations generation, which is updated on mutation by the landscaper.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions contains the actual condition of a installation",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core.Condition"),
+									},
+								},
+							},
+						},
+					},
+					"lastError": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastError describes the last error that occurred.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.Error"),
+						},
+					},
+					"subInstCache": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SubInstCache contains the currently existing sub installations belonging to the execution. If nil undefined.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.SubInstCache"),
+						},
+					},
+					"executionRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExecutionReference is the reference to the execution that schedules the templated execution items.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.ObjectReference"),
+						},
+					},
+					"jobID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "JobID is the ID of the current working request.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"jobIDFinished": {
+						SchemaProps: spec.SchemaProps{
+							Description: "JobIDFinished is the ID of the finished working request.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InstallationPhase is the current phase of the installation.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"phaseTransitionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PhaseTransitionTime is the time when the phase last changed.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"importsHash": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ImportsHash is the hash of the import data.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"automaticReconcileStatus": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AutomaticReconcileStatus describes the status of automatically triggered reconciles.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.AutomaticReconcileStatus"),
+						},
+					},
+					"dependentsToTrigger": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DependentsToTrigger lists dependent installations to be triggered",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core.DependentToTrigger"),
+									},
+								},
+							},
+						},
+					},
+					"transitionTimes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TransitionTimes contains timestamps of status transitions",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.TransitionTimes"),
+						},
+					},
+					"suspendedSince": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SuspendedSince is the time since when the installation is suspended, either by its own spec or by a suspended parent installation.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"generatedSecrets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GeneratedSecrets contains the status of the secrets that are generated for the blueprint.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core.GeneratedSecretStatus"),
+									},
+								},
+							},
+						},
+					},
+					"importValidationErrors": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ImportValidationErrors lists the values of data imports that violate the schema of their import definition. It is reset as soon as all imports are valid.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core.ImportValidationError"),
+									},
+								},
+							},
+						},
+					},
+					"subInstallationRollouts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SubInstallationRollouts define the progressive rollout of the subinstallations of the current job, e.g. of the subinstallations for the targets of a target map.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core.Rollout"),
+									},
+								},
+							},
+						},
+					},
+					"rollouts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rollouts contains the progress of the rollouts of the subinstallations of the current job.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core.RolloutStatus"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"observedGeneration"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.AutomaticReconcileStatus", "github.com/gardener/landscaper/apis/core.Condition", "github.com/gardener/landscaper/apis/core.DependentToTrigger", "github.com/gardener/landscaper/apis/core.Error", "github.com/gardener/landscaper/apis/core.GeneratedSecretStatus", "github.com/gardener/landscaper/apis/core.ImportValidationError", "github.com/gardener/landscaper/apis/core.ObjectReference", "github.com/gardener/landscaper/apis/core.Rollout", "github.com/gardener/landscaper/apis/core.RolloutStatus", "github.com/gardener/landscaper/apis/core.SubInstCache", "github.com/gardener/landscaper/apis/core.TransitionTimes", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_InstallationTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InstallationTemplate defines a subinstallation in a blueprint.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the unique name of the step",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"blueprint": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reference defines a reference to a Blueprint. The blueprint can reside in an OCI or other supported location.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core.InstallationTemplateBlueprintDefinition"),
+						},
+					},
+					"imports": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Imports define the imported data objects and targets.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core.InstallationImports"),
+						},
+					},
+					"importDataMappings": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ImportDataMappings contains a template for restructuring imports. It is expected to contain a key for every blueprint-defined data import. Missing keys will be defaulted to their respective data import. Example: namespace: (( installation.imports.namespace ))",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/landscaper/apis/core.AnyJSON"),
+									},
+								},
+							},
+						},
+					},
+					"exports": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Exports define the exported data objects and targets.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core.InstallationExports"),
+						},
+					},
+					"exportDataMappings": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExportDataMappings contains a template for restructuring exports. It is expected to contain a key for every blueprint-defined data export. Missing keys will be defaulted to their respective data export. Example: namespace: (( blueprint.exports.namespace ))",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/landscaper/apis/core.AnyJSON"),
+									},
+								},
+							},
+						},
+					},
+					"optimization": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Optimization contains settings to improve execution performance.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.Optimization"),
+						},
+					},
+				},
+				Required: []string{"name", "blueprint"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.AnyJSON", "github.com/gardener/landscaper/apis/core.InstallationExports", "github.com/gardener/landscaper/apis/core.InstallationImports", "github.com/gardener/landscaper/apis/core.InstallationTemplateBlueprintDefinition", "github.com/gardener/landscaper/apis/core.Optimization"},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_InstallationTemplateBlueprintDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InstallationTemplateBlueprintDefinition contains either a reference to a blueprint or an inline definition.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"ref": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Ref is a reference to a blueprint. Only blueprints that are defined by the component descriptor of the current blueprint can be referenced here. Example: cd://componentReference/dns/resources/blueprint",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"filesystem": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Filesystem defines a virtual filesystem with all files needed for a blueprint. The filesystem must be a YAML filesystem.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.AnyJSON"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.AnyJSON"},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_JSONSchemaDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "JSONSchemaDefinition defines a jsonschema.",
+				Type:        core.JSONSchemaDefinition{}.OpenAPISchemaType(),
+				Format:      core.JSONSchemaDefinition{}.OpenAPISchemaFormat(),
+			},
+		},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_LocalConfigMapReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LocalConfigMapReference is a reference to data in a configmap.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the configmap",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"key": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Key is the name of the key in the configmap that holds the data.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_LocalSecretReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LocalSecretReference is a reference to data in a secret.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the secret",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"key": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Key is the name of the key in the secret that holds the data.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_LsHealthCheck(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LsHealthCheck is a resource containing information about problems with the landscaper installation",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status defines the overall status of the landscaper and its standard deployers.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastUpdateTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastUpdateTime contains last time the check was updated.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Description contains description of the problem(s)",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"status", "lastUpdateTime", "description"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_LsHealthCheckList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LsHealthCheckList contains a list of LsHealthChecks",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core.LsHealthCheck"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.LsHealthCheck", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_MaintenanceWindow(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MaintenanceWindow defines a recurring time window.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"cronSpec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CronSpec defines the begin of the window as cron expression, e.g. \"0 22 * * 1-5\". A time zone can be specified with the prefix \"CRON_TZ=\", e.g. \"CRON_TZ=Europe/Berlin 0 22 * * 1-5\". The default time zone is UTC.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"duration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Duration is the length of the window.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.Duration"),
+						},
+					},
+				},
+				Required: []string{"cronSpec", "duration"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.Duration"},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_NamedObjectReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NamedObjectReference is a named reference to a specific resource.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the unique name of the reference.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ref": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reference is the reference to an object.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core.ObjectReference"),
+						},
+					},
+				},
+				Required: []string{"name", "ref"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.ObjectReference"},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_ObjectReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ObjectReference is the reference to a kubernetes object.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the kubernetes object.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace is the namespace of kubernetes object.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_OnDeleteConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OnDeleteConfig specifies particular setting when deleting a deploy item",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"skipUninstallIfClusterRemoved": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SkipUninstallIfClusterRemoved specifies that uninstall is skipped if the target cluster is already deleted. Works only in the context of an existing target sync object which is used to check the Garden project with the shoot cluster resources",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_Optimization(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Optimization contains settings to improve execution preformance",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"hasNoSiblingImports": {
+						SchemaProps: spec.SchemaProps{
+							Description: "set this on true if the installation does not import data from its siblings or has no siblings at all",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"hasNoSiblingExports": {
+						SchemaProps: spec.SchemaProps{
+							Description: "set this on true if the installation does not export data to its siblings or has no siblings at all",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_RemoteBlueprintReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RemoteBlueprintReference describes a reference to a blueprint defined by a component descriptor.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"resourceName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ResourceName is the name of the blueprint as defined by a component descriptor.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"resourceName"},
+			},
+		},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_Requirement(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Requirement contains values, a key, and an operator that relates the key and values. The zero value of Requirement is invalid. Requirement implements both set based match and exact match Requirement should be initialized via NewRequirement constructor for creating a valid Requirement.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"key": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"operator": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"values": {
+						SchemaProps: spec.SchemaProps{
+							Description: "In huge majority of cases we have at most one value here. It is generally faster to operate on a single-element slice than on a single-element map, so we have a slice here.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"key", "operator"},
+			},
+		},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_ResolvedTarget(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ResolvedTarget is a helper struct to store a target together with the content of its resolved secret reference.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"target": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Target contains the original target.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.Target"),
+						},
+					},
+					"content": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Content contains the content of the target. If the target has a secret reference, this field should be filled by a TargetResolver. Otherwise, the inline configuration of the target is put here.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"target", "content"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.Target"},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_ResourceReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ResourceReference defines the reference to a resource defined in a component descriptor.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"componentName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ComponentName defines the unique of the component containing the resource.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"resourceName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ResourceName defines the name of the resource.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"componentName", "resourceName"},
+			},
+		},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_Rollout(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Rollout defines the progressive rollout of a group of deploy items or subinstallations. The deploy items or subinstallations are rolled out target by target in batches. A batch is started when the previous batch has finished, the pause between the batches has passed, and not more targets have failed than allowed.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the unique name of the rollout.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"targets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Targets are the targets of the rollout with their deploy items or subinstallations in the order in which they are rolled out.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core.RolloutTarget"),
+									},
+								},
+							},
+						},
+					},
+					"canary": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Canary is the key of a target that is rolled out first and must succeed before the other targets are rolled out.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"batchSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BatchSize is the number or the percentage of targets that are rolled out in parallel. The canary is not counted. Defaults to all targets.",
+							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+						},
+					},
+					"pauseBetweenBatches": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PauseBetweenBatches is the time between the end of a batch and the start of the next batch.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.Duration"),
+						},
+					},
+					"maxFailedTargets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxFailedTargets is the number of failed targets that is tolerated. If more targets fail, no further batch is started. Defaults to 0.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"name", "targets"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.Duration", "github.com/gardener/landscaper/apis/core.RolloutTarget", "k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_RolloutStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RolloutStatus contains the progress of a rollout.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the rollout.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"batches": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Batches is the number of batches of the rollout including the canary.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"completedBatches": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CompletedBatches is the number of finished batches.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"failedTargets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FailedTargets contains the keys of the failed targets.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"stopped": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Stopped is true if the rollout has been stopped because the canary or too many targets failed.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"nextBatchTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NextBatchTime is the time when the next batch is started after a pause.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"name", "batches", "completedBatches"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_RolloutTarget(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RolloutTarget defines the deploy items or the subinstallations of a target of a rollout.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"key": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Key is the key of the target in the target map.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"deployItems": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeployItems are the names of the deploy items that deploy to the target.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"installations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Installations are the names of the installation templates of the subinstallations that import the target.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"key"},
+			},
+		},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_SecretLabelSelectorRef(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SecretLabelSelectorRef selects secrets with the given label and key.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"selector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Selector is a map of labels to select specific secrets.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"key": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The key of the secret to select from.  Must be a valid secret key.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"selector", "key"},
+			},
+		},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_SecretReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SecretReference is reference to data in a secret. The secret can also be in a different namespace.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the kubernetes object.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace is the namespace of kubernetes object.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"key": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Key is the name of the key in the secret that holds the data.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_StaticDataSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "StaticDataSource defines a static data source",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"value": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Value defined inline a raw data",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.AnyJSON"),
+						},
+					},
+					"valueFrom": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ValueFrom defines data from an external resource",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.StaticDataValueFrom"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.AnyJSON", "github.com/gardener/landscaper/apis/core.StaticDataValueFrom"},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_StaticDataValueFrom(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "StaticDataValueFrom defines a static data that is read from a external resource.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"secretKeyRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Selects a key of a secret in the installations's namespace",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
+					"secretLabelSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Selects a key from multiple secrets in the installations's namespace that matches the given labels.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.SecretLabelSelectorRef"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.SecretLabelSelectorRef", "k8s.io/api/core/v1.SecretKeySelector"},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_SubInstCache(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SubInstCache contains the existing sub installations",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"activeSubs": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core.SubNamePair"),
+									},
+								},
+							},
+						},
+					},
+					"orphanedSubs": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.SubNamePair"},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_SubNamePair(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DiNamePair contains the spec name and the real name of a deploy item",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"specName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"objectName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_SubinstallationTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SubinstallationTemplate defines a subinstallation template.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"file": {
+						SchemaProps: spec.SchemaProps{
+							Description: "File references a subinstallation template stored in another file.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the unique name of the step",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"blueprint": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reference defines a reference to a Blueprint. The blueprint can reside in an OCI or other supported location.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core.InstallationTemplateBlueprintDefinition"),
+						},
+					},
+					"imports": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Imports define the imported data objects and targets.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core.InstallationImports"),
+						},
+					},
+					"importDataMappings": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ImportDataMappings contains a template for restructuring imports. It is expected to contain a key for every blueprint-defined data import. Missing keys will be defaulted to their respective data import. Example: namespace: (( installation.imports.namespace ))",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/landscaper/apis/core.AnyJSON"),
+									},
+								},
+							},
+						},
+					},
+					"exports": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Exports define the exported data objects and targets.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core.InstallationExports"),
+						},
+					},
+					"exportDataMappings": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExportDataMappings contains a template for restructuring exports. It is expected to contain a key for every blueprint-defined data export. Missing keys will be defaulted to their respective data export. Example: namespace: (( blueprint.exports.namespace ))",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/landscaper/apis/core.AnyJSON"),
+									},
+								},
+							},
+						},
+					},
+					"optimization": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Optimization contains settings to improve execution performance.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.Optimization"),
+						},
+					},
+				},
+				Required: []string{"name", "blueprint"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.AnyJSON", "github.com/gardener/landscaper/apis/core.InstallationExports", "github.com/gardener/landscaper/apis/core.InstallationImports", "github.com/gardener/landscaper/apis/core.InstallationTemplateBlueprintDefinition", "github.com/gardener/landscaper/apis/core.Optimization"},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_SucceededReconcile(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SucceededReconcile allows to configure automatically repeated reconciliations for succeeded installations",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"interval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Interval specifies the interval between two subsequent repeated reconciliations. If not set, a default of 24 hours is used.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.Duration"),
+						},
+					},
+					"cronSpec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CronSpec describes the reconcile intervals according to the cron syntax \"https://pkg.go.dev/github.com/robfig/cron#hdr-CRON_Expression_Format\". If not empty, this specification is used instead of Interval.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.Duration"},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_SyncObject(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "The SyncObject helps to sync access to deploy items.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec contains the specification",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core.SyncObjectSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status contains the status",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core.SyncObjectStatus"),
+						},
+					},
+				},
+				Required: []string{"spec"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.SyncObjectSpec", "github.com/gardener/landscaper/apis/core.SyncObjectStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_SyncObjectList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SyncObjectList contains a list of SyncObject objects",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core.SyncObject"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.SyncObject", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_SyncObjectSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SyncObjectSpec contains the specification.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"podName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PodName describes the name of the pod of the responsible deployer",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Description: "Kind describes the kind of object that is being locked by this SyncObject",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"status": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Status defines the overall status of the landscaper and its standard deployers.",
+							Description: "Name is the name of the object that is being locked by this SyncObject",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
@@ -5745,32 +7759,43 @@ func schema_gardener_landscaper_apis_core_LsHealthCheck(ref common.ReferenceCall
 					},
 					"lastUpdateTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LastUpdateTime contains last time the check was updated.",
+							Description: "LastUpdateTime contains last time the object was updated.",
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"description": {
+					"prefix": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Description contains description of the problem(s)",
+							Description: "Prefix is the prefix of the name of the object.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"status", "lastUpdateTime", "description"},
+				Required: []string{"podName", "kind", "name", "lastUpdateTime", "prefix"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_LsHealthCheckList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_SyncObjectStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "LsHealthCheckList contains a list of LsHealthChecks",
+				Description: "SyncObjectStatus contains the status.",
+				Type:        []string{"object"},
+			},
+		},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_Target(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Target defines a specific data object that defines target environment. Every deploy item can have a target which is used by the deployer to install the specific application.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -5790,85 +7815,124 @@ func schema_gardener_landscaper_apis_core_LsHealthCheckList(ref common.Reference
 					"metadata": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"items": {
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core.LsHealthCheck"),
-									},
-								},
-							},
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/gardener/landscaper/apis/core.TargetSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status contains the observed health of the target.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.TargetStatus"),
 						},
 					},
 				},
-				Required: []string{"items"},
+				Required: []string{"spec"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.LsHealthCheck", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/gardener/landscaper/apis/core.TargetSpec", "github.com/gardener/landscaper/apis/core.TargetStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_NamedObjectReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_TargetExport(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "NamedObjectReference is a named reference to a specific resource.",
+				Description: "TargetExport is a single target export.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the unique name of the reference.",
+							Description: "Name the internal name of the exported target.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"ref": {
+					"target": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Reference is the reference to an object.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core.ObjectReference"),
+							Description: "Target is the name of the in-cluster target object.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"name", "ref"},
+				Required: []string{"name"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.ObjectReference"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_ObjectReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_TargetImport(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ObjectReference is the reference to a kubernetes object.",
+				Description: "TargetImport is either a single target or a target list import.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the kubernetes object.",
+							Description: "Name the internal name of the imported target.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"namespace": {
+					"target": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Namespace is the namespace of kubernetes object.",
-							Default:     "",
+							Description: "Target is the name of the in-cluster target object. Exactly one of Target, Targets, and TargetListReference has to be specified.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"targets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Targets is a list of in-cluster target objects. Exactly one of Target, Targets, and TargetListReference has to be specified.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"targetListRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetListReference can (only) be used to import a targetlist that has been imported by the parent installation. Exactly one of Target, Targets, and TargetListReference has to be specified.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"targetMap": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"targetMapRef": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
 				},
 				Required: []string{"name"},
 			},
@@ -5876,331 +7940,461 @@ func schema_gardener_landscaper_apis_core_ObjectReference(ref common.ReferenceCa
 	}
 }
 
-func schema_gardener_landscaper_apis_core_OnDeleteConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_TargetList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "OnDeleteConfig specifies particular setting when deleting a deploy item",
+				Description: "TargetList contains a list of Targets",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"skipUninstallIfClusterRemoved": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SkipUninstallIfClusterRemoved specifies that uninstall is skipped if the target cluster is already deleted. Works only in the context of an existing target sync object which is used to check the Garden project with the shoot cluster resources",
-							Type:        []string{"boolean"},
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core.Target"),
+									},
+								},
+							},
+						},
+					},
 				},
+				Required: []string{"items"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.Target", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_Optimization(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_TargetResolverReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Optimization contains settings to improve execution preformance",
+				Description: "TargetResolverReference references a target resolver and contains its configuration.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"hasNoSiblingImports": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "set this on true if the installation does not import data from its siblings or has no siblings at all",
-							Type:        []string{"boolean"},
+							Description: "Name is the name of the target resolver. Built-in resolvers are \"serviceaccount-token\", \"exec\" and \"kubeconfig-directory\". Deployers may register additional resolvers.",
+							Default:     "",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"hasNoSiblingExports": {
+					"config": {
 						SchemaProps: spec.SchemaProps{
-							Description: "set this on true if the installation does not export data to its siblings or has no siblings at all",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "Config contains the resolver specific configuration.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.AnyJSON"),
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.AnyJSON"},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_TargetSelector(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TargetSelector describes a selector that matches specific targets.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"targets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Targets defines a list of specific targets (name and namespace) that should be reconciled.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core.ObjectReference"),
+									},
+								},
+							},
+						},
+					},
+					"annotations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Annotations matches a target based on annotations.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core.Requirement"),
+									},
+								},
+							},
+						},
+					},
+					"labels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Labels matches a target based on its labels.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core.Requirement"),
+									},
+								},
+							},
 						},
 					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.ObjectReference", "github.com/gardener/landscaper/apis/core.Requirement"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_RemoteBlueprintReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_TargetSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "RemoteBlueprintReference describes a reference to a blueprint defined by a component descriptor.",
+				Description: "TargetSpec contains the definition of a target.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"resourceName": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ResourceName is the name of the blueprint as defined by a component descriptor.",
+							Description: "Type is the type of the target that defines its data structure. The actual schema may be defined by a target type crd in the future.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-				},
-				Required: []string{"resourceName"},
-			},
-		},
-	}
-}
-
-func schema_gardener_landscaper_apis_core_Requirement(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "Requirement contains values, a key, and an operator that relates the key and values. The zero value of Requirement is invalid. Requirement implements both set based match and exact match Requirement should be initialized via NewRequirement constructor for creating a valid Requirement.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"key": {
+					"config": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "Configuration contains the target type specific configuration. At most one of the fields Configuration, SecretRef and ResolverRef must be set",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.AnyJSON"),
 						},
 					},
-					"operator": {
+					"secretRef": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "Reference to a secret containing the target type specific configuration. At most one of the fields Configuration, SecretRef and ResolverRef must be set",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.LocalSecretReference"),
 						},
 					},
-					"values": {
+					"resolverRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "In huge majority of cases we have at most one value here. It is generally faster to operate on a single-element slice than on a single-element map, so we have a slice here.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "ResolverRef references a target resolver that computes the target type specific configuration. At most one of the fields Configuration, SecretRef and ResolverRef must be set.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.TargetResolverReference"),
 						},
 					},
 				},
-				Required: []string{"key", "operator"},
+				Required: []string{"type"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.AnyJSON", "github.com/gardener/landscaper/apis/core.LocalSecretReference", "github.com/gardener/landscaper/apis/core.TargetResolverReference"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_ResolvedTarget(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_TargetStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ResolvedTarget is a helper struct to store a target together with the content of its resolved secret reference.",
+				Description: "TargetStatus contains the observed health of a target. The status is only maintained for targets of type kubernetes-cluster.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"target": {
+					"observedGeneration": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Target contains the original target.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.Target"),
+							Description: "ObservedGeneration is the most recent generation of the target that has been probed.",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"content": {
+					"reachable": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Content contains the content of the target. If the target has a secret reference, this field should be filled by a TargetResolver. Otherwise, the inline configuration of the target is put here.",
-							Default:     "",
+							Description: "Reachable indicates whether the api server of the target cluster could be accessed with the credentials of the target during the last probe.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"lastProbeTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastProbeTime is the time when the target has been probed the last time.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lastTransitionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastTransitionTime is the time when the reachability of the target has changed the last time.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"serverVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ServerVersion is the version of the api server of the target cluster.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"credentialExpirationTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CredentialExpirationTime is the time when the credentials of the target expire. It is derived from the client certificate or the token of the target.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message describes why the target is not reachable.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"target", "content"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.Target"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_ResourceReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_TargetSync(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ResourceReference defines the reference to a resource defined in a component descriptor.",
+				Description: "The TargetSync is created targets from secrets.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"componentName": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ComponentName defines the unique of the component containing the resource.",
-							Default:     "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"resourceName": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ResourceName defines the name of the resource.",
-							Default:     "",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec contains the specification",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core.TargetSyncSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status contains the status",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core.TargetSyncStatus"),
+						},
+					},
 				},
-				Required: []string{"componentName", "resourceName"},
+				Required: []string{"spec"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.TargetSyncSpec", "github.com/gardener/landscaper/apis/core.TargetSyncStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_SecretLabelSelectorRef(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_TargetSyncList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SecretLabelSelectorRef selects secrets with the given label and key.",
+				Description: "TargetSyncList contains a list of TargetSync objects",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"selector": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Selector is a map of labels to select specific secrets.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"key": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The key of the secret to select from.  Must be a valid secret key.",
-							Default:     "",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core.TargetSync"),
+									},
+								},
+							},
+						},
+					},
 				},
-				Required: []string{"selector", "key"},
+				Required: []string{"items"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.TargetSync", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_SecretReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_TargetSyncSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SecretReference is reference to data in a secret. The secret can also be in a different namespace.",
+				Description: "TargetSyncSpec contains the specification for a TargetSync.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"sourceNamespace": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the kubernetes object.",
+							Description: "SourceNamespace describes the namespace from where the secrets should be synced",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"namespace": {
+					"secretRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Namespace is the namespace of kubernetes object.",
-							Default:     "",
+							Description: "SecretRef references the secret that contains the kubeconfig to the namespace of the secrets to be synced.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core.LocalSecretReference"),
+						},
+					},
+					"createTargetToSource": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CreateTargetToSource specifies if set on true, that also a target is created, which references the secret in SecretRef",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"targetToSourceName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetToSourceName is the name of the target referencing the secret defined in SecretRef if CreateTargetToSource is set on true. If TargetToSourceName is empty SourceNamespace is used instead.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"key": {
+					"secretNameExpression": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Key is the name of the key in the secret that holds the data.",
+							Description: "SecretNameExpression defines the names of the secrets which should be synced via a regular expression according to https://github.com/google/re2/wiki/Syntax with the extension that * is also a valid expression and matches all names. if neither SecretNameExpression, SecretSelector nor ClusterAPISecrets is set no secrets are synced",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-				},
-				Required: []string{"name"},
-			},
-		},
-	}
-}
-
-func schema_gardener_landscaper_apis_core_StaticDataSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "StaticDataSource defines a static data source",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"value": {
+					"secretSelector": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Value defined inline a raw data",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.AnyJSON"),
+							Description: "SecretSelector selects the secrets which should be synced by their labels. If it is set together with SecretNameExpression, a secret must match both to be synced.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
 						},
 					},
-					"valueFrom": {
+					"clusterAPISecrets": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ValueFrom defines data from an external resource",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.StaticDataValueFrom"),
+							Description: "ClusterAPISecrets specifies that the secrets to be synced are kubeconfig secrets of ClusterAPI clusters. Such secrets are named \"<cluster>-kubeconfig\" and contain the kubeconfig in the data key \"value\". Other secrets are not synced. The targets are named after the clusters and reference the key \"value\".",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.AnyJSON", "github.com/gardener/landscaper/apis/core.StaticDataValueFrom"},
-	}
-}
-
-func schema_gardener_landscaper_apis_core_StaticDataValueFrom(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "StaticDataValueFrom defines a static data that is read from a external resource.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"secretKeyRef": {
+					"shootNameExpression": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ShootNameExpression defines the names of shoot clusters for which targets with short living access data to the shoots are created via a regular expression according to https://github.com/google/re2/wiki/Syntax with the extension that * is also a valid expression and matches all names. if neither ShootNameExpression nor ShootSelector is set no targets for the shoots are created",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"shootSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ShootSelector selects the shoot clusters for which targets are created by their labels. If it is set together with ShootNameExpression, a shoot must match both.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+					"targetTemplate": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Selects a key of a secret in the installations's namespace",
-							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+							Description: "TargetTemplate defines the name, labels and annotations of the synced targets.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.TargetSyncTemplate"),
 						},
 					},
-					"secretLabelSelector": {
+					"tokenRotation": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Selects a key from multiple secrets in the installations's namespace that matches the given labels.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.SecretLabelSelectorRef"),
+							Description: "TokenRotation defines the data to perform an automatic rotation of the token to access the source cluster with the secrets to sync. The token expires after 90 days and will be rotated every 60 days.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.TokenRotation"),
 						},
 					},
 				},
+				Required: []string{"sourceNamespace", "secretRef"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.SecretLabelSelectorRef", "k8s.io/api/core/v1.SecretKeySelector"},
+			"github.com/gardener/landscaper/apis/core.LocalSecretReference", "github.com/gardener/landscaper/apis/core.TargetSyncTemplate", "github.com/gardener/landscaper/apis/core.TokenRotation", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_SubInstCache(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_TargetSyncStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SubInstCache contains the existing sub installations",
+				Description: "TargetSyncStatus contains the status of a TargetSync.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"activeSubs": {
+					"observedGeneration": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core.SubNamePair"),
-									},
-								},
-							},
+							Description: "ObservedGeneration is the most recent generation observed.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"orphanedSubs": {
+					"lastUpdateTime": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Description: "Last time the status was updated",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lastErrors": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastErrors describe the last errors",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
@@ -6212,31 +8406,64 @@ func schema_gardener_landscaper_apis_core_SubInstCache(ref common.ReferenceCallb
 							},
 						},
 					},
+					"lastTokenRotationTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Last time the token was rotated",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.SubNamePair"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_SubNamePair(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_TargetSyncTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DiNamePair contains the spec name and the real name of a deploy item",
+				Description: "TargetSyncTemplate defines the metadata of the synced targets. All fields are go templates, which are executed with the metadata of the synced secret or shoot: .name, .namespace, .labels and .annotations, as well as .clusterName, which is the name of the cluster for ClusterAPI secrets and the name of the secret or shoot otherwise. The hermetic sprig functions are available in the templates.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"specName": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Name is the template for the name of the targets. Defaults to the name of the synced secret or shoot, or the cluster name for ClusterAPI secrets. The secrets referenced by the targets have the same name as the targets.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"objectName": {
+					"labels": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Labels are templates for additional labels of the targets.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"annotations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Annotations are templates for additional annotations of the targets.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
 				},
@@ -6245,243 +8472,192 @@ func schema_gardener_landscaper_apis_core_SubNamePair(ref common.ReferenceCallba
 	}
 }
 
-func schema_gardener_landscaper_apis_core_SubinstallationTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_TargetTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SubinstallationTemplate defines a subinstallation template.",
+				Description: "TargetTemplate exposes specific parts of a target that are used in the exports to export a target",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"file": {
-						SchemaProps: spec.SchemaProps{
-							Description: "File references a subinstallation template stored in another file.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"kind": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"apiVersion": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "Type is the type of the target that defines its data structure. The actual schema may be defined by a target type crd in the future.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"name": {
+					"config": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the unique name of the step",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Configuration contains the target type specific configuration. At most one of the fields Configuration, SecretRef and ResolverRef must be set",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.AnyJSON"),
 						},
 					},
-					"blueprint": {
+					"secretRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Reference defines a reference to a Blueprint. The blueprint can reside in an OCI or other supported location.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core.InstallationTemplateBlueprintDefinition"),
+							Description: "Reference to a secret containing the target type specific configuration. At most one of the fields Configuration, SecretRef and ResolverRef must be set",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.LocalSecretReference"),
 						},
 					},
-					"imports": {
+					"resolverRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Imports define the imported data objects and targets.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core.InstallationImports"),
+							Description: "ResolverRef references a target resolver that computes the target type specific configuration. At most one of the fields Configuration, SecretRef and ResolverRef must be set.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.TargetResolverReference"),
 						},
 					},
-					"importDataMappings": {
+					"labels": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ImportDataMappings contains a template for restructuring imports. It is expected to contain a key for every blueprint-defined data import. Missing keys will be defaulted to their respective data import. Example: namespace: (( installation.imports.namespace ))",
+							Description: "Map of string keys and values that can be used to organize and categorize (scope and select) objects. May match selectors of replication controllers and services. More info: http://kubernetes.io/docs/user-guide/labels",
 							Type:        []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/landscaper/apis/core.AnyJSON"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
-					"exports": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Exports define the exported data objects and targets.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core.InstallationExports"),
-						},
-					},
-					"exportDataMappings": {
+					"annotations": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ExportDataMappings contains a template for restructuring exports. It is expected to contain a key for every blueprint-defined data export. Missing keys will be defaulted to their respective data export. Example: namespace: (( blueprint.exports.namespace ))",
+							Description: "Annotations is an unstructured key value map stored with a resource that may be set by external tools to store and retrieve arbitrary metadata. They are not queryable and should be preserved when modifying objects. More info: http://kubernetes.io/docs/user-guide/annotations",
 							Type:        []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/landscaper/apis/core.AnyJSON"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
-					"optimization": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Optimization contains settings to improve execution performance.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.Optimization"),
-						},
-					},
 				},
-				Required: []string{"name", "blueprint"},
+				Required: []string{"type"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.AnyJSON", "github.com/gardener/landscaper/apis/core.InstallationExports", "github.com/gardener/landscaper/apis/core.InstallationImports", "github.com/gardener/landscaper/apis/core.InstallationTemplateBlueprintDefinition", "github.com/gardener/landscaper/apis/core.Optimization"},
+			"github.com/gardener/landscaper/apis/core.AnyJSON", "github.com/gardener/landscaper/apis/core.LocalSecretReference", "github.com/gardener/landscaper/apis/core.TargetResolverReference"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_SucceededReconcile(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_TemplateExecutor(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SucceededReconcile allows to configure automatically repeated reconciliations for succeeded installations",
+				Description: "TemplateExecutor describes a templating mechanism and configuration.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"interval": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Interval specifies the interval between two subsequent repeated reconciliations. If not set, a default of 24 hours is used.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.Duration"),
+							Description: "Name is the unique name of the template",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"cronSpec": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CronSpec describes the reconcile intervals according to the cron syntax \"https://pkg.go.dev/github.com/robfig/cron#hdr-CRON_Expression_Format\". If not empty, this specification is used instead of Interval.",
+							Description: "Type describes the templating mechanism.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"file": {
+						SchemaProps: spec.SchemaProps{
+							Description: "File is the path to the template in the blueprint's content.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"template": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Template contains an optional inline template. The template has to be of string for go template and either a string or valid yaml/json for spiff.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.AnyJSON"),
+						},
+					},
 				},
+				Required: []string{"name", "type"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.Duration"},
+			"github.com/gardener/landscaper/apis/core.AnyJSON"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_SyncObject(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_TokenRotation(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "The SyncObject helps to sync access to deploy items.",
-				Type:        []string{"object"},
+				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"apiVersion": {
+					"enabled": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
+							Description: "Enabled defines if automatic token is executed",
+							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
-						},
-					},
-					"spec": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Spec contains the specification",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core.SyncObjectSpec"),
-						},
-					},
-					"status": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Status contains the status",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core.SyncObjectStatus"),
-						},
-					},
 				},
-				Required: []string{"spec"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.SyncObjectSpec", "github.com/gardener/landscaper/apis/core.SyncObjectStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_SyncObjectList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_TransitionTimes(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SyncObjectList contains a list of SyncObject objects",
-				Type:        []string{"object"},
+				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"triggerTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "TriggerTime is the time when the jobID is set.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"apiVersion": {
+					"initTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "InitTime is the time when the Init phase starts.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+					"waitTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WaitTime is the time when the work is done.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"items": {
+					"finishedTime": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core.SyncObject"),
-									},
-								},
-							},
+							Description: "FinishedTime is the time when the finished phase is set.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
 				},
-				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.SyncObject", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_SyncObjectSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_TypedObjectReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SyncObjectSpec contains the specification.",
+				Description: "TypedObjectReference is a reference to a typed kubernetes object.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"podName": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "PodName describes the name of the pod of the responsible deployer",
+							Description: "APIVersion is the group and version for the resource being referenced. If APIVersion is not specified, the specified Kind must be in the core API group. For any other third-party types, APIVersion is required.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
@@ -6489,7 +8665,7 @@ func schema_gardener_landscaper_apis_core_SyncObjectSpec(ref common.ReferenceCal
 					},
 					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind describes the kind of object that is being locked by this SyncObject",
+							Description: "Kind is the type of resource being referenced",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
@@ -6497,670 +8673,558 @@ func schema_gardener_landscaper_apis_core_SyncObjectSpec(ref common.ReferenceCal
 					},
 					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the object that is being locked by this SyncObject",
+							Description: "Name is the name of the kubernetes object.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"lastUpdateTime": {
-						SchemaProps: spec.SchemaProps{
-							Description: "LastUpdateTime contains last time the object was updated.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
-						},
-					},
-					"prefix": {
+					"namespace": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Prefix is the prefix of the name of the object.",
+							Description: "Namespace is the namespace of kubernetes object.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"podName", "kind", "name", "lastUpdateTime", "prefix"},
+				Required: []string{"apiVersion", "kind", "name"},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_SyncObjectStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_Verification(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SyncObjectStatus contains the status.",
+				Description: "Verification defines the necessary data to verify the signature of the refered component",
 				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"signatureName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SignatureName defines the name of the signature that is verified",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"signatureName"},
 			},
 		},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_Target(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_VerificationSignature(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Target defines a specific data object that defines target environment. Every deploy item can have a target which is used by the deployer to install the specific application.",
+				Description: "VerificationSignatures contains the trusted verification information",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"apiVersion": {
-						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"metadata": {
+					"publicKeySecretReference": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Description: "PublicKeySecretReference contains a secret reference to a public key in PEM format that is used to verify the component signature",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.SecretReference"),
 						},
 					},
-					"spec": {
+					"caCertificateSecretReference": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/gardener/landscaper/apis/core.TargetSpec"),
+							Description: "CaCertificateSecretReference contains a secret reference to one or more certificates in PEM format that are used to verify the compnent signature",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.SecretReference"),
 						},
 					},
 				},
-				Required: []string{"spec"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.TargetSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/gardener/landscaper/apis/core.SecretReference"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_TargetExport(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_VersionedNamedObjectReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TargetExport is a single target export.",
+				Description: "VersionedNamedObjectReference is a named reference to a object with its last observed resource generation. This struct is used by status fields.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name the internal name of the exported target.",
+							Description: "Name is the unique name of the reference.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"target": {
+					"ref": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Target is the name of the in-cluster target object.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Reference is the reference to an object.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core.VersionedObjectReference"),
 						},
 					},
 				},
-				Required: []string{"name"},
+				Required: []string{"name", "ref"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.VersionedObjectReference"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_TargetImport(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_VersionedObjectReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TargetImport is either a single target or a target list import.",
+				Description: "VersionedObjectReference is a reference to a object with its last observed resource generation. This struct is used by status fields.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name the internal name of the imported target.",
+							Description: "Name is the name of the kubernetes object.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"target": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Target is the name of the in-cluster target object. Exactly one of Target, Targets, and TargetListReference has to be specified.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"targets": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Targets is a list of in-cluster target objects. Exactly one of Target, Targets, and TargetListReference has to be specified.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
-						},
-					},
-					"targetListRef": {
+					"namespace": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TargetListReference can (only) be used to import a targetlist that has been imported by the parent installation. Exactly one of Target, Targets, and TargetListReference has to be specified.",
+							Description: "Namespace is the namespace of kubernetes object.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"targetMap": {
-						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
-						},
-					},
-					"targetMapRef": {
+					"observedGeneration": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "ObservedGeneration defines the last observed generation of the referenced resource.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
 				},
-				Required: []string{"name"},
+				Required: []string{"name", "observedGeneration"},
 			},
 		},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_TargetList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_VersionedResourceReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TargetList contains a list of Targets",
+				Description: "VersionedResourceReference defines the reference to a resource with its version.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"componentName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "ComponentName defines the unique of the component containing the resource.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"resourceName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "ResourceName defines the name of the resource.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
-						},
-					},
-					"items": {
+					"version": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core.Target"),
-									},
-								},
-							},
+							Description: "Version defines the version of the component.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"items"},
+				Required: []string{"componentName", "resourceName", "version"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.Target", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_TargetSelector(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_AnyJSON(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TargetSelector describes a selector that matches specific targets.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"targets": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Targets defines a list of specific targets (name and namespace) that should be reconciled.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core.ObjectReference"),
-									},
-								},
-							},
-						},
-					},
-					"annotations": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Annotations matches a target based on annotations.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core.Requirement"),
-									},
-								},
-							},
-						},
-					},
-					"labels": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Labels matches a target based on its labels.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core.Requirement"),
-									},
-								},
-							},
-						},
-					},
-				},
+				Description: "AnyJSON enhances the json.RawMessages with a dedicated openapi definition so that all it is correctly generated.",
+				Type:        v1alpha1.AnyJSON{}.OpenAPISchemaType(),
+				Format:      v1alpha1.AnyJSON{}.OpenAPISchemaFormat(),
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.ObjectReference", "github.com/gardener/landscaper/apis/core.Requirement"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_TargetSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_ApprovalRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TargetSpec contains the definition of a target.",
+				Description: "ApprovalRequest describes the request to approve a job of a deploy item.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"type": {
+					"jobID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type is the type of the target that defines its data structure. The actual schema may be defined by a target type crd in the future.",
+							Description: "JobID is the job of the deploy item that must be approved.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"config": {
+					"summary": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Configuration contains the target type specific configuration. Exactly one of the fields Configuration and SecretRef must be set",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.AnyJSON"),
+							Description: "Summary is a human-readable summary of the changes that are applied by the job.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"secretRef": {
+					"requestTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Reference to a secret containing the target type specific configuration. Exactly one of the fields Configuration and SecretRef must be set",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.LocalSecretReference"),
+							Description: "RequestTime is the time when the approval has been requested.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"approvedBy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ApprovedBy is the name of the user who approved the job.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"approvalTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ApprovalTime is the time when the approval has been recognized by the landscaper.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
 				},
-				Required: []string{"type"},
+				Required: []string{"jobID", "requestTime"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.AnyJSON", "github.com/gardener/landscaper/apis/core.LocalSecretReference"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_TargetSync(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_AutomaticReconcile(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "The TargetSync is created targets from secrets.",
+				Description: "AutomaticReconcile allows to configure automatically repeated reconciliations.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"apiVersion": {
-						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
-						},
-					},
-					"spec": {
+					"succeededReconcile": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec contains the specification",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core.TargetSyncSpec"),
+							Description: "SucceededReconcile allows to configure automatically repeated reconciliations for succeeded installations. If not set, no such automatically repeated reconciliations are triggered.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.SucceededReconcile"),
 						},
 					},
-					"status": {
+					"failedReconcile": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Status contains the status",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core.TargetSyncStatus"),
+							Description: "FailedReconcile allows to configure automatically repeated reconciliations for failed installations. If not set, no such automatically repeated reconciliations are triggered.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.FailedReconcile"),
 						},
 					},
 				},
-				Required: []string{"spec"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.TargetSyncSpec", "github.com/gardener/landscaper/apis/core.TargetSyncStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.FailedReconcile", "github.com/gardener/landscaper/apis/core/v1alpha1.SucceededReconcile"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_TargetSyncList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_AutomaticReconcileStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TargetSyncList contains a list of TargetSync objects",
+				Description: "AutomaticReconcileStatus describes the status of automatically triggered reconciles.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"generation": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Generation describes the generation of the installation for which the status holds.",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"apiVersion": {
+					"numberOfReconciles": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "NumberOfReconciles is the number of automatic reconciles for the installation with the stored generation.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"metadata": {
+					"lastReconcileTime": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Description: "LastReconcileTime is the time of the last automatically triggered reconcile.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"items": {
+					"onFailed": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core.TargetSync"),
-									},
-								},
-							},
+							Description: "OnFailed is true if the last automatically triggered reconcile was done for a failed installation.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.TargetSync", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_TargetSyncSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_Blueprint(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TargetSyncSpec contains the specification for a TargetSync.",
+				Description: "Blueprint contains the configuration of a component",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"sourceNamespace": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SourceNamespace describes the namespace from where the secrets should be synced",
-							Default:     "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"secretRef": {
-						SchemaProps: spec.SchemaProps{
-							Description: "SecretRef references the secret that contains the kubeconfig to the namespace of the secrets to be synced.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core.LocalSecretReference"),
-						},
-					},
-					"createTargetToSource": {
-						SchemaProps: spec.SchemaProps{
-							Description: "CreateTargetToSource specifies if set on true, that also a target is created, which references the secret in SecretRef",
-							Type:        []string{"boolean"},
-							Format:      "",
-						},
-					},
-					"targetToSourceName": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TargetToSourceName is the name of the target referencing the secret defined in SecretRef if CreateTargetToSource is set on true. If TargetToSourceName is empty SourceNamespace is used instead.",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"secretNameExpression": {
+					"annotations": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SecretNameExpression defines the names of the secrets which should be synced via a regular expression according to https://github.com/google/re2/wiki/Syntax with the extension that * is also a valid expression and matches all names. if not set no secrets are synced",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Annotations is an unstructured key value map stored with a resource that may be set by external tools to store and retrieve arbitrary metadata.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"shootNameExpression": {
+					"jsonSchemaVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ShootNameExpression defines the names of shoot clusters for which targets with short living access data to the shoots are created via a regular expression according to https://github.com/google/re2/wiki/Syntax with the extension that * is also a valid expression and matches all names. if not set no targets for the shoots are created",
+							Description: "JSONSchemaVersion defines the default jsonschema version of the blueprint. e.g. \"https://json-schema.org/draft/2019-09/schema\"",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"tokenRotation": {
-						SchemaProps: spec.SchemaProps{
-							Description: "TokenRotation defines the data to perform an automatic rotation of the token to access the source cluster with the secrets to sync. The token expires after 90 days and will be rotated every 60 days.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.TokenRotation"),
-						},
-					},
-				},
-				Required: []string{"sourceNamespace", "secretRef"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.LocalSecretReference", "github.com/gardener/landscaper/apis/core.TokenRotation"},
-	}
-}
-
-func schema_gardener_landscaper_apis_core_TargetSyncStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "TargetSyncStatus contains the status of a TargetSync.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"observedGeneration": {
+					"localTypes": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ObservedGeneration is the most recent generation observed.",
-							Default:     0,
-							Type:        []string{"integer"},
-							Format:      "int64",
+							Description: "LocalTypes defines additional blueprint local schemas",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/landscaper/apis/core/v1alpha1.JSONSchemaDefinition"),
+									},
+								},
+							},
 						},
 					},
-					"lastUpdateTime": {
+					"imports": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Last time the status was updated",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "Imports define the import values that are needed for the definition and its sub-definitions.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.ImportDefinition"),
+									},
+								},
+							},
 						},
 					},
-					"lastErrors": {
+					"importExecutions": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LastErrors describe the last errors",
+							Description: "ImportExecutions defines the templating executors that are sequentially executed by the landscaper. The templates must return a list of errors",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.TemplateExecutor"),
 									},
 								},
 							},
 						},
 					},
-					"lastTokenRotationTime": {
+					"generatedSecrets": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Last time the token was rotated",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "GeneratedSecrets defines certificates, keys and passwords that are generated by the landscaper. The generated values are stored in secrets owned by the installation and are available in the templates as imports with the names of the definitions.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.GeneratedSecretDefinition"),
+									},
+								},
+							},
 						},
 					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
-	}
-}
-
-func schema_gardener_landscaper_apis_core_TargetTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "TargetTemplate exposes specific parts of a target that are used in the exports to export a target",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"type": {
+					"exports": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type is the type of the target that defines its data structure. The actual schema may be defined by a target type crd in the future.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Exports define the exported values of the definition and its sub-definitions",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.ExportDefinition"),
+									},
+								},
+							},
 						},
 					},
-					"config": {
+					"subinstallations": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Configuration contains the target type specific configuration. Exactly one of the fields Configuration and SecretRef must be set",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.AnyJSON"),
+							Description: "Subinstallations defines an optional list of subinstallations (for aggregating blueprints).",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.SubinstallationTemplate"),
+									},
+								},
+							},
 						},
 					},
-					"secretRef": {
+					"subinstallationExecutions": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Reference to a secret containing the target type specific configuration. Exactly one of the fields Configuration and SecretRef must be set",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.LocalSecretReference"),
+							Description: "SubinstallationExecutions defines the templating executors that are sequentially executed by the landscaper. The templates must return a list of installation templates. Both subinstallations and SubinstallationExecutions are valid options and will be merged.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.TemplateExecutor"),
+									},
+								},
+							},
 						},
 					},
-					"labels": {
+					"deployExecutions": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Map of string keys and values that can be used to organize and categorize (scope and select) objects. May match selectors of replication controllers and services. More info: http://kubernetes.io/docs/user-guide/labels",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "DeployExecutions defines the templating executors that are sequentially executed by the landscaper. The templates must return a list of deploy item templates.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.TemplateExecutor"),
 									},
 								},
 							},
 						},
 					},
-					"annotations": {
+					"exportExecutions": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Annotations is an unstructured key value map stored with a resource that may be set by external tools to store and retrieve arbitrary metadata. They are not queryable and should be preserved when modifying objects. More info: http://kubernetes.io/docs/user-guide/annotations",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "ExportExecutions defines the templating executors that are used to generate the exports.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.TemplateExecutor"),
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"type"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.AnyJSON", "github.com/gardener/landscaper/apis/core.LocalSecretReference"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.ExportDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.GeneratedSecretDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.ImportDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.JSONSchemaDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.SubinstallationTemplate", "github.com/gardener/landscaper/apis/core/v1alpha1.TemplateExecutor"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_TemplateExecutor(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_BlueprintDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TemplateExecutor describes a templating mechanism and configuration.",
+				Description: "BlueprintDefinition defines the blueprint that should be used for the installation.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"ref": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the unique name of the template",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Reference defines a remote reference to a blueprint",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.RemoteBlueprintReference"),
 						},
 					},
-					"type": {
+					"inline": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type describes the templating mechanism.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Inline defines a inline yaml filesystem with a blueprint.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.InlineBlueprint"),
 						},
 					},
-					"file": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.InlineBlueprint", "github.com/gardener/landscaper/apis/core/v1alpha1.RemoteBlueprintReference"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_BlueprintStaticDataSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BlueprintStaticDataSource defines a static data source for a blueprint",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"value": {
 						SchemaProps: spec.SchemaProps{
-							Description: "File is the path to the template in the blueprint's content.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Value defined inline a raw data",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
 						},
 					},
-					"template": {
+					"valueFrom": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Template contains an optional inline template. The template has to be of string for go template and either a string or valid yaml/json for spiff.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.AnyJSON"),
+							Description: "ValueFrom defines data from an external resource",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.StaticDataValueFrom"),
 						},
 					},
 				},
-				Required: []string{"name", "type"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.AnyJSON"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON", "github.com/gardener/landscaper/apis/core/v1alpha1.StaticDataValueFrom"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_TokenRotation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_BlueprintStaticDataValueFrom(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "BlueprintStaticDataValueFrom defines static data that is read from a external resource.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"enabled": {
+					"localPath": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Enabled defines if automatic token is executed",
-							Type:        []string{"boolean"},
+							Description: "Selects a key of a secret in the installations's namespace",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
@@ -7170,332 +9234,354 @@ func schema_gardener_landscaper_apis_core_TokenRotation(ref common.ReferenceCall
 	}
 }
 
-func schema_gardener_landscaper_apis_core_TransitionTimes(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_ComponentDescriptorDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "ComponentDescriptorDefinition defines the component descriptor that should be used for the installation",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"triggerTime": {
-						SchemaProps: spec.SchemaProps{
-							Description: "TriggerTime is the time when the jobID is set.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
-						},
-					},
-					"initTime": {
-						SchemaProps: spec.SchemaProps{
-							Description: "InitTime is the time when the Init phase starts.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
-						},
-					},
-					"waitTime": {
+					"ref": {
 						SchemaProps: spec.SchemaProps{
-							Description: "WaitTime is the time when the work is done.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "ComponentDescriptorReference is the reference to a component descriptor",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ComponentDescriptorReference"),
 						},
 					},
-					"finishedTime": {
+					"inline": {
 						SchemaProps: spec.SchemaProps{
-							Description: "FinishedTime is the time when the finished phase is set.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "InlineDescriptorReference defines an inline component descriptor",
+							Ref:         ref("github.com/gardener/component-spec/bindings-go/apis/v2.ComponentDescriptor"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/gardener/component-spec/bindings-go/apis/v2.ComponentDescriptor", "github.com/gardener/landscaper/apis/core/v1alpha1.ComponentDescriptorReference"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_TypedObjectReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_ComponentDescriptorReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TypedObjectReference is a reference to a typed kubernetes object.",
+				Description: "ComponentDescriptorReference is the reference to a component descriptor. given an optional context.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"apiVersion": {
-						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion is the group and version for the resource being referenced. If APIVersion is not specified, the specified Kind must be in the core API group. For any other third-party types, APIVersion is required.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"kind": {
+					"repositoryContext": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is the type of resource being referenced",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "RepositoryContext defines the context of the component repository to resolve blueprints.",
+							Ref:         ref("github.com/gardener/component-spec/bindings-go/apis/v2.UnstructuredTypedObject"),
 						},
 					},
-					"name": {
+					"componentName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the kubernetes object.",
+							Description: "ComponentName defines the unique of the component containing the resource.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"namespace": {
+					"version": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Namespace is the namespace of kubernetes object.",
+							Description: "Version defines the version of the component.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"apiVersion", "kind", "name"},
+				Required: []string{"componentName", "version"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/component-spec/bindings-go/apis/v2.UnstructuredTypedObject"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_Verification(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_ComponentVersionOverwrite(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Verification defines the necessary data to verify the signature of the refered component",
+				Description: "ComponentVersionOverwrite defines an overwrite for a specific component and/or version of a component.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"signatureName": {
+					"source": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SignatureName defines the name of the signature that is verified",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Source defines the component that should be replaced.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ComponentVersionOverwriteReference"),
+						},
+					},
+					"substitution": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Substitution defines the replacement target for the component or version.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ComponentVersionOverwriteReference"),
 						},
 					},
 				},
-				Required: []string{"signatureName"},
+				Required: []string{"source", "substitution"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.ComponentVersionOverwriteReference"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_VerificationSignature(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_ComponentVersionOverwriteReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "VerificationSignatures contains the trusted verification information",
+				Description: "ComponentVersionOverwriteReference defines a component reference by",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"publicKeySecretReference": {
+					"repositoryContext": {
 						SchemaProps: spec.SchemaProps{
-							Description: "PublicKeySecretReference contains a secret reference to a public key in PEM format that is used to verify the component signature",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.SecretReference"),
+							Description: "RepositoryContext defines the context of the component repository to resolve blueprints.",
+							Ref:         ref("github.com/gardener/component-spec/bindings-go/apis/v2.UnstructuredTypedObject"),
 						},
 					},
-					"caCertificateSecretReference": {
+					"componentName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CaCertificateSecretReference contains a secret reference to one or more certificates in PEM format that are used to verify the compnent signature",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.SecretReference"),
+							Description: "ComponentName defines the unique of the component containing the resource.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Version defines the version of the component.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.SecretReference"},
+			"github.com/gardener/component-spec/bindings-go/apis/v2.UnstructuredTypedObject"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_VersionedNamedObjectReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_ComponentVersionOverwrites(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "VersionedNamedObjectReference is a named reference to a object with its last observed resource generation. This struct is used by status fields.",
+				Description: "ComponentVersionOverwrites contain overwrites for specific (versions of) components.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the unique name of the reference.",
-							Default:     "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"ref": {
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"overwrites": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Reference is the reference to an object.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core.VersionedObjectReference"),
+							Description: "Overwrites defines a list of component overwrites",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.ComponentVersionOverwrite"),
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"name", "ref"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.VersionedObjectReference"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.ComponentVersionOverwrite", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_VersionedObjectReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_ComponentVersionOverwritesList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "VersionedObjectReference is a reference to a object with its last observed resource generation. This struct is used by status fields.",
+				Description: "ComponentVersionOverwritesList contains a list of ComponentVersionOverwrites",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the kubernetes object.",
-							Default:     "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"namespace": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Namespace is the namespace of kubernetes object.",
-							Default:     "",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"observedGeneration": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ObservedGeneration defines the last observed generation of the referenced resource.",
-							Default:     0,
-							Type:        []string{"integer"},
-							Format:      "int64",
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.ComponentVersionOverwrites"),
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"name", "observedGeneration"},
+				Required: []string{"items"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.ComponentVersionOverwrites", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_VersionedResourceReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_Condition(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "VersionedResourceReference defines the reference to a resource with its version.",
+				Description: "Condition holds the information about the state of a resource.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"componentName": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ComponentName defines the unique of the component containing the resource.",
+							Description: "DataType of the Shoot condition.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"resourceName": {
+					"status": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ResourceName defines the name of the resource.",
+							Description: "Status of the condition, one of True, False, Unknown.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"version": {
+					"lastTransitionTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Version defines the version of the component.",
+							Description: "Last time the condition transitioned from one status to another.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lastUpdateTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Last time the condition was updated.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The reason for the condition's last transition.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-				},
-				Required: []string{"componentName", "resourceName", "version"},
-			},
-		},
-	}
-}
-
-func schema_landscaper_apis_core_v1alpha1_AnyJSON(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "AnyJSON enhances the json.RawMessages with a dedicated openapi definition so that all it is correctly generated.",
-				Type:        v1alpha1.AnyJSON{}.OpenAPISchemaType(),
-				Format:      v1alpha1.AnyJSON{}.OpenAPISchemaFormat(),
-			},
-		},
-	}
-}
-
-func schema_landscaper_apis_core_v1alpha1_AutomaticReconcile(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "AutomaticReconcile allows to configure automatically repeated reconciliations.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"succeededReconcile": {
+					"message": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SucceededReconcile allows to configure automatically repeated reconciliations for succeeded installations. If not set, no such automatically repeated reconciliations are triggered.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.SucceededReconcile"),
+							Description: "A human readable message indicating details about the transition.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"failedReconcile": {
+					"codes": {
 						SchemaProps: spec.SchemaProps{
-							Description: "FailedReconcile allows to configure automatically repeated reconciliations for failed installations. If not set, no such automatically repeated reconciliations are triggered.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.FailedReconcile"),
+							Description: "Well-defined error codes in case the condition reports a problem.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
 				},
+				Required: []string{"type", "status", "lastTransitionTime", "lastUpdateTime", "reason", "message"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.FailedReconcile", "github.com/gardener/landscaper/apis/core/v1alpha1.SucceededReconcile"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_AutomaticReconcileStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_ConfigMapReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "AutomaticReconcileStatus describes the status of automatically triggered reconciles.",
+				Description: "ConfigMapReference is reference to data in a configmap. The configmap can also be in a different namespace.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"generation": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Generation describes the generation of the installation for which the status holds.",
-							Type:        []string{"integer"},
-							Format:      "int64",
-						},
-					},
-					"numberOfReconciles": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "NumberOfReconciles is the number of automatic reconciles for the installation with the stored generation.",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "Name is the name of the kubernetes object.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"lastReconcileTime": {
+					"namespace": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LastReconcileTime is the time of the last automatically triggered reconcile.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "Namespace is the namespace of kubernetes object.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"onFailed": {
+					"key": {
 						SchemaProps: spec.SchemaProps{
-							Description: "OnFailed is true if the last automatically triggered reconcile was done for a failed installation.",
-							Type:        []string{"boolean"},
+							Description: "Key is the name of the key in the configmap that holds the data.",
+							Default:     "",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
+				Required: []string{"name"},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_Blueprint(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_Context(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Blueprint contains the configuration of a component",
+				Description: "Context is a resource that contains shared information of installations. This includes information about the repository context like the context itself or secrets to access the oci artifacts. But it can also contain deployer specific config.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -7512,137 +9598,175 @@ func schema_landscaper_apis_core_v1alpha1_Blueprint(ref common.ReferenceCallback
 							Format:      "",
 						},
 					},
-					"annotations": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Annotations is an unstructured key value map stored with a resource that may be set by external tools to store and retrieve arbitrary metadata.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"jsonSchemaVersion": {
+					"repositoryContext": {
 						SchemaProps: spec.SchemaProps{
-							Description: "JSONSchemaVersion defines the default jsonschema version of the blueprint. e.g. \"https://json-schema.org/draft/2019-09/schema\"",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "RepositoryContext defines the context of the component repository to resolve blueprints.",
+							Ref:         ref("github.com/gardener/component-spec/bindings-go/apis/v2.UnstructuredTypedObject"),
 						},
 					},
-					"localTypes": {
+					"ocmConfig": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LocalTypes defines additional blueprint local schemas",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "OCMConfig references a k8s config map object that contains the ocm configuration data in the format of an ocm configfile. For more info see: https://github.com/open-component-model/ocm/blob/main/docs/reference/ocm_configfile.md",
+							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+						},
+					},
+					"registryPullSecrets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RegistryPullSecrets defines a list of registry credentials that are used to pull blueprints, component descriptors and jsonschemas from the respective registry. For more info see: https://kubernetes.io/docs/tasks/configure-pod-container/pull-image-private-registry/ Note that the type information is used to determine the secret key and the type of the secret.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/landscaper/apis/core/v1alpha1.JSONSchemaDefinition"),
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.LocalObjectReference"),
 									},
 								},
 							},
 						},
 					},
-					"imports": {
+					"configurations": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Imports define the import values that are needed for the definition and its sub-definitions.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
+							Description: "Configurations contains arbitrary configuration information for dedicated purposes given by a string key. The key should use a dns-like syntax to express the purpose and avoid conflicts.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.ImportDefinition"),
+										Ref: ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
 									},
 								},
 							},
 						},
 					},
-					"importExecutions": {
+					"componentVersionOverwrites": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ImportExecutions defines the templating executors that are sequentially executed by the landscaper. The templates must return a list of errors",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
+							Description: "ComponentVersionOverwritesReference is a reference to a ComponentVersionOverwrites object The overwrites object has to be in the same namespace as the context. If the string is empty, no overwrites will be used.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"verificationSignatures": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VerificationSignatures maps a signature name to the trusted verification information",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.TemplateExecutor"),
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.VerificationSignature"),
 									},
 								},
 							},
 						},
 					},
-					"exports": {
+					"maintenanceWindows": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Exports define the exported values of the definition and its sub-definitions",
+							Description: "MaintenanceWindows restrict the automatic reconciles of installations and the continuous reconciles of deploy items that use this context to the given time windows. Work that becomes due outside of the windows is queued until the begin of the next window. If no window is defined, there is no restriction.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.ExportDefinition"),
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.MaintenanceWindow"),
 									},
 								},
 							},
 						},
 					},
-					"subinstallations": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/component-spec/bindings-go/apis/v2.UnstructuredTypedObject", "github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON", "github.com/gardener/landscaper/apis/core/v1alpha1.MaintenanceWindow", "github.com/gardener/landscaper/apis/core/v1alpha1.VerificationSignature", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_ContextConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"repositoryContext": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Subinstallations defines an optional list of subinstallations (for aggregating blueprints).",
+							Description: "RepositoryContext defines the context of the component repository to resolve blueprints.",
+							Ref:         ref("github.com/gardener/component-spec/bindings-go/apis/v2.UnstructuredTypedObject"),
+						},
+					},
+					"ocmConfig": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OCMConfig references a k8s config map object that contains the ocm configuration data in the format of an ocm configfile. For more info see: https://github.com/open-component-model/ocm/blob/main/docs/reference/ocm_configfile.md",
+							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+						},
+					},
+					"registryPullSecrets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RegistryPullSecrets defines a list of registry credentials that are used to pull blueprints, component descriptors and jsonschemas from the respective registry. For more info see: https://kubernetes.io/docs/tasks/configure-pod-container/pull-image-private-registry/ Note that the type information is used to determine the secret key and the type of the secret.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.SubinstallationTemplate"),
+										Ref:     ref("k8s.io/api/core/v1.LocalObjectReference"),
 									},
 								},
 							},
 						},
 					},
-					"subinstallationExecutions": {
+					"configurations": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SubinstallationExecutions defines the templating executors that are sequentially executed by the landscaper. The templates must return a list of installation templates. Both subinstallations and SubinstallationExecutions are valid options and will be merged.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
+							Description: "Configurations contains arbitrary configuration information for dedicated purposes given by a string key. The key should use a dns-like syntax to express the purpose and avoid conflicts.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.TemplateExecutor"),
+										Ref: ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
 									},
 								},
 							},
 						},
 					},
-					"deployExecutions": {
+					"componentVersionOverwrites": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DeployExecutions defines the templating executors that are sequentially executed by the landscaper. The templates must return a list of deploy item templates.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
+							Description: "ComponentVersionOverwritesReference is a reference to a ComponentVersionOverwrites object The overwrites object has to be in the same namespace as the context. If the string is empty, no overwrites will be used.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"verificationSignatures": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VerificationSignatures maps a signature name to the trusted verification information",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.TemplateExecutor"),
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.VerificationSignature"),
 									},
 								},
 							},
 						},
 					},
-					"exportExecutions": {
+					"maintenanceWindows": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ExportExecutions defines the templating executors that are used to generate the exports.",
+							Description: "MaintenanceWindows restrict the automatic reconciles of installations and the continuous reconciles of deploy items that use this context to the given time windows. Work that becomes due outside of the windows is queued until the begin of the next window. If no window is defined, there is no restriction.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.TemplateExecutor"),
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.MaintenanceWindow"),
 									},
 								},
 							},
@@ -7652,221 +9776,317 @@ func schema_landscaper_apis_core_v1alpha1_Blueprint(ref common.ReferenceCallback
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.ExportDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.ImportDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.JSONSchemaDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.SubinstallationTemplate", "github.com/gardener/landscaper/apis/core/v1alpha1.TemplateExecutor"},
+			"github.com/gardener/component-spec/bindings-go/apis/v2.UnstructuredTypedObject", "github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON", "github.com/gardener/landscaper/apis/core/v1alpha1.MaintenanceWindow", "github.com/gardener/landscaper/apis/core/v1alpha1.VerificationSignature", "k8s.io/api/core/v1.LocalObjectReference"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_BlueprintDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_ContextList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "BlueprintDefinition defines the blueprint that should be used for the installation.",
+				Description: "ContextList contains a list of Contexts",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"ref": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Reference defines a remote reference to a blueprint",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.RemoteBlueprintReference"),
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"inline": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Inline defines a inline yaml filesystem with a blueprint.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.InlineBlueprint"),
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.Context"),
+									},
+								},
+							},
 						},
 					},
 				},
+				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.InlineBlueprint", "github.com/gardener/landscaper/apis/core/v1alpha1.RemoteBlueprintReference"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Context", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_BlueprintStaticDataSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_CriticalProblem(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "BlueprintStaticDataSource defines a static data source for a blueprint",
+				Description: "CriticalProblem contains information about one critical problem.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"value": {
+					"podName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Value defined inline a raw data",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
+							Description: "PodName contains the name of the pod where the problem occurred",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"valueFrom": {
+					"creationTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ValueFrom defines data from an external resource",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.StaticDataValueFrom"),
+							Description: "CreationTime contains the timestamp when the problem occured",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Description contains an error description",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON", "github.com/gardener/landscaper/apis/core/v1alpha1.StaticDataValueFrom"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_BlueprintStaticDataValueFrom(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_CriticalProblems(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "BlueprintStaticDataValueFrom defines static data that is read from a external resource.",
+				Description: "CriticalProblems contains a list of critical landscaper problems.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"localPath": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Selects a key of a secret in the installations's namespace",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec contains the specification",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.CriticalProblemsSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status contains the status",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.CriticalProblemsStatus"),
+						},
+					},
 				},
+				Required: []string{"spec"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.CriticalProblemsSpec", "github.com/gardener/landscaper/apis/core/v1alpha1.CriticalProblemsStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_ComponentDescriptorDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_CriticalProblemsList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ComponentDescriptorDefinition defines the component descriptor that should be used for the installation",
+				Description: "CriticalProblemsList contains a list of critical landscaper problems objects",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"ref": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ComponentDescriptorReference is the reference to a component descriptor",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ComponentDescriptorReference"),
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"inline": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "InlineDescriptorReference defines an inline component descriptor",
-							Ref:         ref("github.com/gardener/component-spec/bindings-go/apis/v2.ComponentDescriptor"),
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.CriticalProblems"),
+									},
+								},
+							},
 						},
 					},
 				},
+				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/component-spec/bindings-go/apis/v2.ComponentDescriptor", "github.com/gardener/landscaper/apis/core/v1alpha1.ComponentDescriptorReference"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.CriticalProblems", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_ComponentDescriptorReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_CriticalProblemsSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ComponentDescriptorReference is the reference to a component descriptor. given an optional context.",
+				Description: "CriticalProblemsSpec contains the specification for a CriticalProblems object.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"repositoryContext": {
-						SchemaProps: spec.SchemaProps{
-							Description: "RepositoryContext defines the context of the component repository to resolve blueprints.",
-							Ref:         ref("github.com/gardener/component-spec/bindings-go/apis/v2.UnstructuredTypedObject"),
-						},
-					},
-					"componentName": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ComponentName defines the unique of the component containing the resource.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"version": {
+					"criticalProblem": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Version defines the version of the component.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.CriticalProblem"),
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"componentName", "version"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/component-spec/bindings-go/apis/v2.UnstructuredTypedObject"},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.CriticalProblem"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_CriticalProblemsStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CriticalProblemsStatus contains the status of a CriticalProblems object.",
+				Type:        []string{"object"},
+			},
+		},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_ComponentVersionOverwrite(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_DataExport(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ComponentVersionOverwrite defines an overwrite for a specific component and/or version of a component.",
+				Description: "DataExport is a data object export.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"source": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Source defines the component that should be replaced.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ComponentVersionOverwriteReference"),
+							Description: "Name the internal name of the imported/exported data.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"substitution": {
+					"dataRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Substitution defines the replacement target for the component or version.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ComponentVersionOverwriteReference"),
+							Description: "DataRef is the name of the in-cluster data object.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"source", "substitution"},
+				Required: []string{"name", "dataRef"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.ComponentVersionOverwriteReference"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_ComponentVersionOverwriteReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_DataImport(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ComponentVersionOverwriteReference defines a component reference by",
+				Description: "DataImport is a data object import.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"repositoryContext": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "RepositoryContext defines the context of the component repository to resolve blueprints.",
-							Ref:         ref("github.com/gardener/component-spec/bindings-go/apis/v2.UnstructuredTypedObject"),
+							Description: "Name the internal name of the imported/exported data.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"componentName": {
+					"dataRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ComponentName defines the unique of the component containing the resource.",
-							Default:     "",
+							Description: "DataRef is the name of the in-cluster data object. The reference can also be a namespaces name. E.g. \"default/mydataref\"",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 					"version": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Version defines the version of the component.",
-							Default:     "",
+							Description: "Version specifies the imported data version. defaults to \"v1\"",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"secretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretRef defines a data reference from a secret. This method is not allowed in installation templates.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference"),
+						},
+					},
+					"configMapRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConfigMapRef defines a data reference from a configmap. This method is not allowed in installation templates.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.LocalConfigMapReference"),
+						},
+					},
 				},
+				Required: []string{"name"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/component-spec/bindings-go/apis/v2.UnstructuredTypedObject"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.LocalConfigMapReference", "github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_ComponentVersionOverwrites(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_DataObject(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ComponentVersionOverwrites contain overwrites for specific (versions of) components.",
+				Description: "DataObject are resources that can hold any kind json or yaml data.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -7889,33 +10109,26 @@ func schema_landscaper_apis_core_v1alpha1_ComponentVersionOverwrites(ref common.
 							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"overwrites": {
+					"data": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Overwrites defines a list of component overwrites",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.ComponentVersionOverwrite"),
-									},
-								},
-							},
+							Description: "Data contains the data of the object as string.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
 						},
 					},
 				},
+				Required: []string{"data"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.ComponentVersionOverwrite", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_ComponentVersionOverwritesList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_DataObjectList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ComponentVersionOverwritesList contains a list of ComponentVersionOverwrites",
+				Description: "DataObjectList contains a list of DataObject",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -7945,7 +10158,7 @@ func schema_landscaper_apis_core_v1alpha1_ComponentVersionOverwritesList(ref com
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.ComponentVersionOverwrites"),
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.DataObject"),
 									},
 								},
 							},
@@ -7956,128 +10169,55 @@ func schema_landscaper_apis_core_v1alpha1_ComponentVersionOverwritesList(ref com
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.ComponentVersionOverwrites", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.DataObject", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_Condition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_Default(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Condition holds the information about the state of a resource.",
+				Description: "Default defines a default value (future idea: also reference?).",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"type": {
-						SchemaProps: spec.SchemaProps{
-							Description: "DataType of the Shoot condition.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"status": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Status of the condition, one of True, False, Unknown.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"lastTransitionTime": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Last time the condition transitioned from one status to another.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
-						},
-					},
-					"lastUpdateTime": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Last time the condition was updated.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
-						},
-					},
-					"reason": {
-						SchemaProps: spec.SchemaProps{
-							Description: "The reason for the condition's last transition.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"message": {
-						SchemaProps: spec.SchemaProps{
-							Description: "A human readable message indicating details about the transition.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"codes": {
+					"value": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Well-defined error codes in case the condition reports a problem.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Ref: ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
 						},
 					},
 				},
-				Required: []string{"type", "status", "lastTransitionTime", "lastUpdateTime", "reason", "message"},
+				Required: []string{"value"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_ConfigMapReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_DependentToTrigger(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ConfigMapReference is reference to data in a configmap. The configmap can also be in a different namespace.",
-				Type:        []string{"object"},
+				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
 					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the kubernetes object.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"namespace": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Namespace is the namespace of kubernetes object.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"key": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Key is the name of the key in the configmap that holds the data.",
-							Default:     "",
+							Description: "Name is the name of the dependent installation",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"name"},
 			},
 		},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_Context(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_DeployItem(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Context is a resource that contains shared information of installations. This includes information about the repository context like the context itself or secrets to access the oci artifacts. But it can also contain deployer specific config.",
+				Description: "DeployItem defines a resource that should be processed by a external deployer",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -8100,64 +10240,56 @@ func schema_landscaper_apis_core_v1alpha1_Context(ref common.ReferenceCallback)
 							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"repositoryContext": {
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "RepositoryContext defines the context of the component repository to resolve blueprints.",
-							Ref:         ref("github.com/gardener/component-spec/bindings-go/apis/v2.UnstructuredTypedObject"),
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.DeployItemSpec"),
 						},
 					},
-					"ocmConfig": {
+					"status": {
 						SchemaProps: spec.SchemaProps{
-							Description: "OCMConfig references a k8s config map object that contains the ocm configuration data in the format of an ocm configfile. For more info see: https://github.com/open-component-model/ocm/blob/main/docs/reference/ocm_configfile.md",
-							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.DeployItemStatus"),
 						},
 					},
-					"registryPullSecrets": {
+				},
+				Required: []string{"spec"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.DeployItemSpec", "github.com/gardener/landscaper/apis/core/v1alpha1.DeployItemStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_DeployItemCache(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DeployItemCache contains the existing deploy items",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"activeDIs": {
 						SchemaProps: spec.SchemaProps{
-							Description: "RegistryPullSecrets defines a list of registry credentials that are used to pull blueprints, component descriptors and jsonschemas from the respective registry. For more info see: https://kubernetes.io/docs/tasks/configure-pod-container/pull-image-private-registry/ Note that the type information is used to determine the secret key and the type of the secret.",
-							Type:        []string{"array"},
+							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("k8s.io/api/core/v1.LocalObjectReference"),
-									},
-								},
-							},
-						},
-					},
-					"configurations": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Configurations contains arbitrary configuration information for dedicated purposes given by a string key. The key should use a dns-like syntax to express the purpose and avoid conflicts.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.DiNamePair"),
 									},
 								},
 							},
 						},
 					},
-					"componentVersionOverwrites": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ComponentVersionOverwritesReference is a reference to a ComponentVersionOverwrites object The overwrites object has to be in the same namespace as the context. If the string is empty, no overwrites will be used.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"verificationSignatures": {
+					"orphanedDIs": {
 						SchemaProps: spec.SchemaProps{
-							Description: "VerificationSignatures maps a signature name to the trusted verification information",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.VerificationSignature"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
@@ -8167,92 +10299,87 @@ func schema_landscaper_apis_core_v1alpha1_Context(ref common.ReferenceCallback)
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/component-spec/bindings-go/apis/v2.UnstructuredTypedObject", "github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON", "github.com/gardener/landscaper/apis/core/v1alpha1.VerificationSignature", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.DiNamePair"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_ContextConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_DeployItemHistoryEntry(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "DeployItemHistoryEntry is the result of a finished job of a deploy item.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"repositoryContext": {
+					"jobID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "RepositoryContext defines the context of the component repository to resolve blueprints.",
-							Ref:         ref("github.com/gardener/component-spec/bindings-go/apis/v2.UnstructuredTypedObject"),
+							Description: "JobID is the ID of the job.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"ocmConfig": {
+					"generation": {
 						SchemaProps: spec.SchemaProps{
-							Description: "OCMConfig references a k8s config map object that contains the ocm configuration data in the format of an ocm configfile. For more info see: https://github.com/open-component-model/ocm/blob/main/docs/reference/ocm_configfile.md",
-							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+							Description: "Generation is the generation of the deploy item that was processed by the job.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"registryPullSecrets": {
+					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Description: "RegistryPullSecrets defines a list of registry credentials that are used to pull blueprints, component descriptors and jsonschemas from the respective registry. For more info see: https://kubernetes.io/docs/tasks/configure-pod-container/pull-image-private-registry/ Note that the type information is used to determine the secret key and the type of the secret.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("k8s.io/api/core/v1.LocalObjectReference"),
-									},
-								},
-							},
+							Description: "Phase is the final phase of the job.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"configurations": {
+					"startTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Configurations contains arbitrary configuration information for dedicated purposes given by a string key. The key should use a dns-like syntax to express the purpose and avoid conflicts.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
-									},
-								},
-							},
+							Description: "StartTime is the time when the job was started by the deployer.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"componentVersionOverwrites": {
+					"endTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ComponentVersionOverwritesReference is a reference to a ComponentVersionOverwrites object The overwrites object has to be in the same namespace as the context. If the string is empty, no overwrites will be used.",
-							Default:     "",
+							Description: "EndTime is the time when the job was finished.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"error": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Error is the error of the job, if any. Long error messages are truncated.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Error"),
+						},
+					},
+					"configurationHash": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConfigurationHash is the sha256 hash of the provider configuration that was applied by the job.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"verificationSignatures": {
+					"managedResources": {
 						SchemaProps: spec.SchemaProps{
-							Description: "VerificationSignatures maps a signature name to the trusted verification information",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.VerificationSignature"),
-									},
-								},
-							},
+							Description: "ManagedResources is the number of resources that are managed by the deploy item after the job, if the deployer reports managed resources in its provider status.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
 				},
+				Required: []string{"jobID", "generation", "phase"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/component-spec/bindings-go/apis/v2.UnstructuredTypedObject", "github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON", "github.com/gardener/landscaper/apis/core/v1alpha1.VerificationSignature", "k8s.io/api/core/v1.LocalObjectReference"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Error", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_ContextList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_DeployItemList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ContextList contains a list of Contexts",
+				Description: "DeployItemList contains a list of DeployItems",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -8282,7 +10409,7 @@ func schema_landscaper_apis_core_v1alpha1_ContextList(ref common.ReferenceCallba
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.Context"),
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.DeployItem"),
 									},
 								},
 							},
@@ -8293,399 +10420,468 @@ func schema_landscaper_apis_core_v1alpha1_ContextList(ref common.ReferenceCallba
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.Context", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.DeployItem", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_CriticalProblem(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_DeployItemSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "CriticalProblem contains information about one critical problem.",
+				Description: "DeployItemSpec contains the definition of a deploy item.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"podName": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "PodName contains the name of the pod where the problem occurred",
+							Description: "Type is the type of the deployer that should handle the item.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"creationTime": {
+					"target": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CreationTime contains the timestamp when the problem occured",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "Target specifies an optional target of the deploy item. In most cases it contains the secrets to access a evironment. It is also used by the deployers to determine the ownernship.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"),
 						},
 					},
-					"description": {
+					"context": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Description contains an error description",
+							Description: "Context defines the current context of the deployitem.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"config": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Configuration contains the deployer type specific configuration.",
+							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
+						},
+					},
+					"timeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timeout specifies how long the deployer may take to apply the deploy item. When the time is exceeded, the deploy item fails. Value has to be parsable by time.ParseDuration (or 'none' to deactivate the timeout). Defaults to ten minutes if not specified.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
+						},
+					},
+					"updateOnChangeOnly": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UpdateOnChangeOnly specifies if redeployment is executed only if the specification of the deploy item has changed.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"onDelete": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OnDelete specifies particular setting when deleting a deploy item",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.OnDeleteConfig"),
+						},
+					},
 				},
+				Required: []string{"type"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration", "github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference", "github.com/gardener/landscaper/apis/core/v1alpha1.OnDeleteConfig", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_CriticalProblems(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_DeployItemStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "CriticalProblems contains a list of critical landscaper problems.",
+				Description: "DeployItemStatus contains the status of a deploy item.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "Phase is the current phase of the DeployItem",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"observedGeneration": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "ObservedGeneration is the most recent generation observed for this DeployItem. It corresponds to the DeployItem generation, which is updated on mutation by the landscaper.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"metadata": {
+					"conditions": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Description: "Conditions contains the actual condition of a deploy item",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.Condition"),
+									},
+								},
+							},
 						},
 					},
-					"spec": {
+					"lastError": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec contains the specification",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.CriticalProblemsSpec"),
+							Description: "LastError describes the last error that occurred.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Error"),
 						},
 					},
-					"status": {
+					"lastErrors": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Status contains the status",
+							Description: "ErrorHistory describes the last n errors that occurred since JobID was changed the last time.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/landscaper/apis/core/v1alpha1.Error"),
+									},
+								},
+							},
+						},
+					},
+					"firstError": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FirstError describes the first error that occurred since JobID was changed the last time.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Error"),
+						},
+					},
+					"lastReconcileTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastReconcileTime indicates when the reconciliation of the last change to the deploy item has started",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"deployer": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Deployer describes the deployer that has reconciled the deploy item.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.CriticalProblemsStatus"),
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.DeployerInformation"),
 						},
 					},
-				},
-				Required: []string{"spec"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.CriticalProblemsSpec", "github.com/gardener/landscaper/apis/core/v1alpha1.CriticalProblemsStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
-	}
-}
-
-func schema_landscaper_apis_core_v1alpha1_CriticalProblemsList(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "CriticalProblemsList contains a list of critical landscaper problems objects",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"kind": {
+					"providerStatus": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "ProviderStatus contains the provider specific status",
+							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
+						},
+					},
+					"exportRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExportReference is the reference to the object that contains the exported values.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"),
+						},
+					},
+					"jobID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "JobID is the ID of the current working request.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"jobIDFinished": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "JobIDFinished is the ID of the finished working request.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"jobIDGenerationTime": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Description: "JobIDGenerationTime is the timestamp when the JobID was set.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"items": {
+					"deployItemPhase": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.CriticalProblems"),
-									},
-								},
-							},
+							Description: "DeployerPhase is DEPRECATED and will soon be removed.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"transitionTimes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TransitionTimes contains timestamps of status transitions",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.TransitionTimes"),
 						},
 					},
-				},
-				Required: []string{"items"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.CriticalProblems", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
-	}
-}
-
-func schema_landscaper_apis_core_v1alpha1_CriticalProblemsSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "CriticalProblemsSpec contains the specification for a CriticalProblems object.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"criticalProblem": {
+					"approvalRequest": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Description: "ApprovalRequest describes the pending or granted approval of the current job of a deploy item which requires an approval.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ApprovalRequest"),
+						},
+					},
+					"history": {
+						SchemaProps: spec.SchemaProps{
+							Description: "History contains the results of the most recent finished jobs of the deploy item, the latest job last. The number of entries is bounded; the oldest entries are removed.",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.CriticalProblem"),
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.DeployItemHistoryEntry"),
 									},
 								},
 							},
 						},
 					},
 				},
+				Required: []string{"observedGeneration"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.CriticalProblem"},
-	}
-}
-
-func schema_landscaper_apis_core_v1alpha1_CriticalProblemsStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "CriticalProblemsStatus contains the status of a CriticalProblems object.",
-				Type:        []string{"object"},
-			},
-		},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.ApprovalRequest", "github.com/gardener/landscaper/apis/core/v1alpha1.Condition", "github.com/gardener/landscaper/apis/core/v1alpha1.DeployItemHistoryEntry", "github.com/gardener/landscaper/apis/core/v1alpha1.DeployerInformation", "github.com/gardener/landscaper/apis/core/v1alpha1.Error", "github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference", "github.com/gardener/landscaper/apis/core/v1alpha1.TransitionTimes", "k8s.io/apimachinery/pkg/apis/meta/v1.Time", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_DataExport(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_DeployItemTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DataExport is a data object export.",
+				Description: "DeployItemTemplate defines a execution element that is translated into a deploy item.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name the internal name of the imported/exported data.",
+							Description: "Name is the unique name of the execution.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"dataRef": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DataRef is the name of the in-cluster data object.",
+							Description: "DataType is the DeployItem type of the execution.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-				},
-				Required: []string{"name", "dataRef"},
-			},
-		},
-	}
-}
-
-func schema_landscaper_apis_core_v1alpha1_DataImport(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "DataImport is a data object import.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"name": {
+					"target": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name the internal name of the imported/exported data.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Target is the object reference to the target that the deploy item should deploy to.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"),
 						},
 					},
-					"dataRef": {
+					"labels": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DataRef is the name of the in-cluster data object. The reference can also be a namespaces name. E.g. \"default/mydataref\"",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Labels is the map of labels to be added to the deploy item.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"version": {
+					"config": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Version specifies the imported data version. defaults to \"v1\"",
-							Type:        []string{"string"},
+							Description: "ProviderConfiguration contains the type specific configuration for the execution.",
+							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
+						},
+					},
+					"dependsOn": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DependsOn lists deploy items that need to be executed before this one",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"timeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timeout specifies how long the deployer may take to apply the deploy item. When the time is exceeded, the deploy item fails. Value has to be parsable by time.ParseDuration (or 'none' to deactivate the timeout). Defaults to ten minutes if not specified.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
+						},
+					},
+					"updateOnChangeOnly": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UpdateOnChangeOnly specifies if redeployment is executed only if the specification of the deploy item has changed.",
+							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
-					"secretRef": {
+					"onDelete": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SecretRef defines a data reference from a secret. This method is not allowed in installation templates.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference"),
+							Description: "OnDelete specifies particular setting when deleting a deploy item",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.OnDeleteConfig"),
 						},
 					},
-					"configMapRef": {
+					"requireApproval": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ConfigMapRef defines a data reference from a configmap. This method is not allowed in installation templates.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.LocalConfigMapReference"),
+							Description: "RequireApproval specifies that every job of the deploy item must be approved before it is picked up by a deployer.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"name"},
+				Required: []string{"name", "type", "config"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.LocalConfigMapReference", "github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration", "github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference", "github.com/gardener/landscaper/apis/core/v1alpha1.OnDeleteConfig", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_DataObject(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_DeployerInformation(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DataObject are resources that can hold any kind json or yaml data.",
+				Description: "DeployerInformation holds additional information about the deployer that has reconciled or is reconciling the deploy item.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"identity": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "Identity describes the unique identity of the deployer.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "Name is the name of the deployer.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
-						},
-					},
-					"data": {
+					"version": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Data contains the data of the object as string.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
+							Description: "Version is the version of the deployer.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"data"},
+				Required: []string{"identity", "name", "version"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_DataObjectList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_DiNamePair(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DataObjectList contains a list of DataObject",
+				Description: "DiNamePair contains the spec name and the real name of a deploy item",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"apiVersion": {
-						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"metadata": {
+					"specName": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"items": {
+					"objectName": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.DataObject"),
-									},
-								},
-							},
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
 				},
-				Required: []string{"items"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.DataObject", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_Default(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_Duration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Default defines a default value (future idea: also reference?).",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"value": {
-						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
-						},
-					},
-				},
-				Required: []string{"value"},
+				Description: "Duration is a wrapper for time.Duration that implements JSON marshalling and openapi scheme.",
+				Type:        v1alpha1.Duration{}.OpenAPISchemaType(),
+				Format:      v1alpha1.Duration{}.OpenAPISchemaFormat(),
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_DependentToTrigger(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_Error(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "Error holds information about an error that occurred.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"operation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Operation describes the operator where the error occurred.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastTransitionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Last time the condition transitioned from one status to another.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lastUpdateTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Last time the condition was updated.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The reason for the condition's last transition.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the dependent installation",
+							Description: "A human readable message indicating details about the transition.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"codes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Well-defined error codes in case the condition reports a problem.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 				},
+				Required: []string{"operation", "lastTransitionTime", "lastUpdateTime", "reason", "message"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_DeployItem(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_Execution(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DeployItem defines a resource that should be processed by a external deployer",
+				Description: "Execution contains the configuration of a execution and deploy item",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -8710,14 +10906,15 @@ func schema_landscaper_apis_core_v1alpha1_DeployItem(ref common.ReferenceCallbac
 					},
 					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.DeployItemSpec"),
+							Description: "Spec defines a execution and its items",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ExecutionSpec"),
 						},
 					},
 					"status": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.DeployItemStatus"),
+							Description: "Status contains the current status of the execution.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ExecutionStatus"),
 						},
 					},
 				},
@@ -8725,57 +10922,15 @@ func schema_landscaper_apis_core_v1alpha1_DeployItem(ref common.ReferenceCallbac
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.DeployItemSpec", "github.com/gardener/landscaper/apis/core/v1alpha1.DeployItemStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
-	}
-}
-
-func schema_landscaper_apis_core_v1alpha1_DeployItemCache(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "DeployItemCache contains the existing deploy items",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"activeDIs": {
-						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.DiNamePair"),
-									},
-								},
-							},
-						},
-					},
-					"orphanedDIs": {
-						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
-						},
-					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.DiNamePair"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.ExecutionSpec", "github.com/gardener/landscaper/apis/core/v1alpha1.ExecutionStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_DeployItemList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_ExecutionList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DeployItemList contains a list of DeployItems",
+				Description: "ExecutionList contains a list of Executions‚",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -8805,7 +10960,7 @@ func schema_landscaper_apis_core_v1alpha1_DeployItemList(ref common.ReferenceCal
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.DeployItem"),
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.Execution"),
 									},
 								},
 							},
@@ -8816,89 +10971,83 @@ func schema_landscaper_apis_core_v1alpha1_DeployItemList(ref common.ReferenceCal
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.DeployItem", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Execution", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_DeployItemSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_ExecutionSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DeployItemSpec contains the definition of a deploy item.",
+				Description: "ExecutionSpec defines a execution plan.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"type": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Type is the type of the deployer that should handle the item.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"target": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Target specifies an optional target of the deploy item. In most cases it contains the secrets to access a evironment. It is also used by the deployers to determine the ownernship.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"),
-						},
-					},
 					"context": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Context defines the current context of the deployitem.",
+							Description: "Context defines the current context of the execution.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"config": {
+					"deployItems": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Configuration contains the deployer type specific configuration.",
-							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
+							Description: "DeployItems defines all execution items that need to be scheduled.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.DeployItemTemplate"),
+									},
+								},
+							},
 						},
 					},
-					"timeout": {
+					"deployItemsCompressed": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Timeout specifies how long the deployer may take to apply the deploy item. When the time is exceeded, the deploy item fails. Value has to be parsable by time.ParseDuration (or 'none' to deactivate the timeout). Defaults to ten minutes if not specified.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
+							Description: "DeployItemsCompressed as zipped byte array",
+							Type:        []string{"string"},
+							Format:      "byte",
 						},
 					},
-					"updateOnChangeOnly": {
+					"deployItemsSecretRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "UpdateOnChangeOnly specifies if redeployment is executed only if the specification of the deploy item has changed.",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "DeployItemsSecretRef references a secret that contains the deploy items of the execution. It is used instead of the inline deploy items if the deploy items contain sensitive values.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"),
 						},
 					},
-					"onDelete": {
+					"rollouts": {
 						SchemaProps: spec.SchemaProps{
-							Description: "OnDelete specifies particular setting when deleting a deploy item",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.OnDeleteConfig"),
+							Description: "Rollouts define the progressive rollout of groups of deploy items, e.g. of the deploy items for the targets of a target map.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.Rollout"),
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"type"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration", "github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference", "github.com/gardener/landscaper/apis/core/v1alpha1.OnDeleteConfig", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.DeployItemTemplate", "github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference", "github.com/gardener/landscaper/apis/core/v1alpha1.Rollout"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_DeployItemStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_ExecutionStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DeployItemStatus contains the status of a deploy item.",
+				Description: "ExecutionStatus contains the current status of a execution.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"phase": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Phase is the current phase of the DeployItem",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
 					"observedGeneration": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ObservedGeneration is the most recent generation observed for this DeployItem. It corresponds to the DeployItem generation, which is updated on mutation by the landscaper.",
+							Description: "ObservedGeneration is the most recent generation observed for this Execution. It corresponds to the Execution generation, which is updated on mutation by the landscaper.",
 							Default:     0,
 							Type:        []string{"integer"},
 							Format:      "int64",
@@ -8906,7 +11055,7 @@ func schema_landscaper_apis_core_v1alpha1_DeployItemStatus(ref common.ReferenceC
 					},
 					"conditions": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Conditions contains the actual condition of a deploy item",
+							Description: "Conditions contains the actual condition of a execution",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -8924,307 +11073,237 @@ func schema_landscaper_apis_core_v1alpha1_DeployItemStatus(ref common.ReferenceC
 							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Error"),
 						},
 					},
-					"lastErrors": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ErrorHistory describes the last n errors that occurred since JobID was changed the last time.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/landscaper/apis/core/v1alpha1.Error"),
-									},
-								},
-							},
-						},
-					},
-					"firstError": {
-						SchemaProps: spec.SchemaProps{
-							Description: "FirstError describes the first error that occurred since JobID was changed the last time.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Error"),
-						},
-					},
-					"lastReconcileTime": {
-						SchemaProps: spec.SchemaProps{
-							Description: "LastReconcileTime indicates when the reconciliation of the last change to the deploy item has started",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
-						},
-					},
-					"deployer": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Deployer describes the deployer that has reconciled the deploy item.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.DeployerInformation"),
-						},
-					},
-					"providerStatus": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ProviderStatus contains the provider specific status",
-							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
-						},
-					},
 					"exportRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ExportReference is the reference to the object that contains the exported values.",
+							Description: "ExportReference references the object that contains the exported values. only used for operation purpose.",
 							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"),
 						},
 					},
-					"jobID": {
-						SchemaProps: spec.SchemaProps{
-							Description: "JobID is the ID of the current working request.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"jobIDFinished": {
-						SchemaProps: spec.SchemaProps{
-							Description: "JobIDFinished is the ID of the finished working request.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"jobIDGenerationTime": {
-						SchemaProps: spec.SchemaProps{
-							Description: "JobIDGenerationTime is the timestamp when the JobID was set.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
-						},
-					},
-					"deployItemPhase": {
-						SchemaProps: spec.SchemaProps{
-							Description: "DeployerPhase is DEPRECATED and will soon be removed.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"transitionTimes": {
+					"deployItemCache": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TransitionTimes contains timestamps of status transitions",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.TransitionTimes"),
+							Description: "DeployItemCache contains the currently existing deploy item belonging to the execution. If nil undefined.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.DeployItemCache"),
 						},
 					},
-				},
-				Required: []string{"observedGeneration"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.Condition", "github.com/gardener/landscaper/apis/core/v1alpha1.DeployerInformation", "github.com/gardener/landscaper/apis/core/v1alpha1.Error", "github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference", "github.com/gardener/landscaper/apis/core/v1alpha1.TransitionTimes", "k8s.io/apimachinery/pkg/apis/meta/v1.Time", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
-	}
-}
-
-func schema_landscaper_apis_core_v1alpha1_DeployItemTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "DeployItemTemplate defines a execution element that is translated into a deploy item.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"name": {
+					"jobID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the unique name of the execution.",
-							Default:     "",
+							Description: "JobID is the ID of the current working request.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"type": {
+					"jobIDFinished": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DataType is the DeployItem type of the execution.",
-							Default:     "",
+							Description: "JobIDFinished is the ID of the finished working request.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"target": {
+					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Target is the object reference to the target that the deploy item should deploy to.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"),
+							Description: "ExecutionPhase is the current phase of the execution.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"labels": {
+					"phaseTransitionTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Labels is the map of labels to be added to the deploy item.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "PhaseTransitionTime is the time when the phase last changed.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"config": {
+					"transitionTimes": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ProviderConfiguration contains the type specific configuration for the execution.",
-							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
+							Description: "TransitionTimes contains timestamps of status transitions",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.TransitionTimes"),
 						},
 					},
-					"dependsOn": {
+					"rollouts": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DependsOn lists deploy items that need to be executed before this one",
+							Description: "Rollouts contains the progress of the rollouts of the current job.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.RolloutStatus"),
 									},
 								},
 							},
 						},
 					},
-					"timeout": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Timeout specifies how long the deployer may take to apply the deploy item. When the time is exceeded, the deploy item fails. Value has to be parsable by time.ParseDuration (or 'none' to deactivate the timeout). Defaults to ten minutes if not specified.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
-						},
-					},
-					"updateOnChangeOnly": {
-						SchemaProps: spec.SchemaProps{
-							Description: "UpdateOnChangeOnly specifies if redeployment is executed only if the specification of the deploy item has changed.",
-							Type:        []string{"boolean"},
-							Format:      "",
-						},
-					},
-					"onDelete": {
-						SchemaProps: spec.SchemaProps{
-							Description: "OnDelete specifies particular setting when deleting a deploy item",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.OnDeleteConfig"),
-						},
-					},
 				},
-				Required: []string{"name", "type", "config"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration", "github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference", "github.com/gardener/landscaper/apis/core/v1alpha1.OnDeleteConfig", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Condition", "github.com/gardener/landscaper/apis/core/v1alpha1.DeployItemCache", "github.com/gardener/landscaper/apis/core/v1alpha1.Error", "github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference", "github.com/gardener/landscaper/apis/core/v1alpha1.RolloutStatus", "github.com/gardener/landscaper/apis/core/v1alpha1.TransitionTimes", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_DeployerInformation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_ExportDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DeployerInformation holds additional information about the deployer that has reconciled or is reconciling the deploy item.",
+				Description: "ExportDefinition defines a exported value",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"identity": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Identity describes the unique identity of the deployer.",
+							Description: "Name defines the field name to search for the value and map to exports. Ref: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#selecting-fields",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"name": {
+					"schema": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the deployer.",
-							Default:     "",
+							Description: "Schema defines the imported value as jsonschema.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.JSONSchemaDefinition"),
+						},
+					},
+					"targetType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetType defines the type of the imported target.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"version": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Version is the version of the deployer.",
-							Default:     "",
+							Description: "Type specifies which kind of object is being exported. This field should be set and will likely be mandatory in future.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"identity", "name", "version"},
+				Required: []string{"name"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.JSONSchemaDefinition"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_DiNamePair(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_FailedReconcile(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DiNamePair contains the spec name and the real name of a deploy item",
+				Description: "FailedReconcile allows to configure automatically repeated reconciliations for failed installations",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"specName": {
+					"numberOfReconciles": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "NumberOfReconciles specifies the maximal number of automatically repeated reconciliations. If not set, no upper limit exists.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"objectName": {
+					"interval": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Interval specifies the interval between two subsequent repeated reconciliations. If not set, a default of 5 minutes is used.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
+						},
+					},
+					"cronSpec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CronSpec describes the reconcile intervals according to the cron syntax \"https://pkg.go.dev/github.com/robfig/cron#hdr-CRON_Expression_Format\". If not empty, this specification is used instead of Interval.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_Duration(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "Duration is a wrapper for time.Duration that implements JSON marshalling and openapi scheme.",
-				Type:        v1alpha1.Duration{}.OpenAPISchemaType(),
-				Format:      v1alpha1.Duration{}.OpenAPISchemaFormat(),
-			},
-		},
-	}
-}
-
-func schema_landscaper_apis_core_v1alpha1_Error(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_FieldValueDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Error holds information about an error that occurred.",
+				Description: "FieldValueDefinition defines a im- or exported field. Either schema or target type have to be defined",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"operation": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Operation describes the operator where the error occurred.",
+							Description: "Name defines the field name to search for the value and map to exports. Ref: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#selecting-fields",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"lastTransitionTime": {
+					"schema": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Last time the condition transitioned from one status to another.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "Schema defines the imported value as jsonschema.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.JSONSchemaDefinition"),
 						},
 					},
-					"lastUpdateTime": {
+					"targetType": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Last time the condition was updated.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "TargetType defines the type of the imported target.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"reason": {
+				},
+				Required: []string{"name"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.JSONSchemaDefinition"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_GeneratedCertificate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GeneratedCertificate configures a generated certificate authority or certificate.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"commonName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The reason for the condition's last transition.",
+							Description: "CommonName is the common name of the certificate.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"message": {
+					"organization": {
 						SchemaProps: spec.SchemaProps{
-							Description: "A human readable message indicating details about the transition.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Organization is the list of organizations of the certificate.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"codes": {
+					"dnsNames": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Well-defined error codes in case the condition reports a problem.",
+							Description: "DNSNames is the list of dns names of the certificate.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"ipAddresses": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IPAddresses is the list of ip addresses of the certificate.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -9237,249 +11316,256 @@ func schema_landscaper_apis_core_v1alpha1_Error(ref common.ReferenceCallback) co
 							},
 						},
 					},
+					"sansFromImports": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SANsFromImports adds the values of imports as subject alternative names. The referenced values must be strings or lists of strings. Ip addresses are added as ip addresses, all other values as dns names.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.GeneratedSecretImportReference"),
+									},
+								},
+							},
+						},
+					},
+					"ca": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CA is the name of the generated certificate authority that signs the certificate. The certificate is self-signed if no certificate authority is set.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"validity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Validity is the duration for which the certificate is valid. Defaults to 10 years for certificate authorities and to 1 year for certificates.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
+						},
+					},
 				},
-				Required: []string{"operation", "lastTransitionTime", "lastUpdateTime", "reason", "message"},
+				Required: []string{"commonName"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration", "github.com/gardener/landscaper/apis/core/v1alpha1.GeneratedSecretImportReference"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_Execution(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_GeneratedPassword(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Execution contains the configuration of a execution and deploy item",
+				Description: "GeneratedPassword configures a generated password.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"apiVersion": {
-						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"metadata": {
+					"length": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Description: "Length is the length of the password. Defaults to 32.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"spec": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Spec defines a execution and its items",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ExecutionSpec"),
+				},
+			},
+		},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_GeneratedSSHKeyPair(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GeneratedSSHKeyPair configures a generated ssh key pair.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"algorithm": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Algorithm is the algorithm of the key pair. Defaults to ed25519.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"status": {
+					"bits": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Status contains the current status of the execution.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ExecutionStatus"),
+							Description: "Bits is the size of rsa keys. Defaults to 4096.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
 				},
-				Required: []string{"spec"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.ExecutionSpec", "github.com/gardener/landscaper/apis/core/v1alpha1.ExecutionStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_ExecutionList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_GeneratedSecretDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ExecutionList contains a list of Executions‚",
+				Description: "GeneratedSecretDefinition defines a secret that is generated once and stored in a secret owned by the installation.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "Name is the name of the generated secret and of the import under which its value is available.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "Type is the type of the generated secret.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"certificate": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Description: "Certificate configures a generated certificate authority or certificate.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.GeneratedCertificate"),
 						},
 					},
-					"items": {
+					"sshKeyPair": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.Execution"),
-									},
-								},
-							},
+							Description: "SSHKeyPair configures a generated ssh key pair.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.GeneratedSSHKeyPair"),
+						},
+					},
+					"password": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Password configures a generated password.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.GeneratedPassword"),
+						},
+					},
+					"rotation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rotation defines when the generated secret is replaced by a new one.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.GeneratedSecretRotation"),
 						},
 					},
 				},
-				Required: []string{"items"},
+				Required: []string{"name", "type"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.Execution", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.GeneratedCertificate", "github.com/gardener/landscaper/apis/core/v1alpha1.GeneratedPassword", "github.com/gardener/landscaper/apis/core/v1alpha1.GeneratedSSHKeyPair", "github.com/gardener/landscaper/apis/core/v1alpha1.GeneratedSecretRotation"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_ExecutionSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_GeneratedSecretImportReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ExecutionSpec defines a execution plan.",
+				Description: "GeneratedSecretImportReference references the value of an import.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"context": {
+					"import": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Context defines the current context of the execution.",
+							Description: "Import is the name of the import.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"deployItems": {
-						SchemaProps: spec.SchemaProps{
-							Description: "DeployItems defines all execution items that need to be scheduled.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.DeployItemTemplate"),
-									},
-								},
-							},
-						},
-					},
-					"deployItemsCompressed": {
+					"path": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DeployItemsCompressed as zipped byte array",
+							Description: "Path is an optional jsonpath to a value within the import, e.g. \".ingress.hosts\".",
 							Type:        []string{"string"},
-							Format:      "byte",
+							Format:      "",
 						},
 					},
 				},
+				Required: []string{"import"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.DeployItemTemplate"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_ExecutionStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_GeneratedSecretRotation(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ExecutionStatus contains the current status of a execution.",
+				Description: "GeneratedSecretRotation defines when a generated secret is replaced by a new one.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"observedGeneration": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ObservedGeneration is the most recent generation observed for this Execution. It corresponds to the Execution generation, which is updated on mutation by the landscaper.",
-							Default:     0,
-							Type:        []string{"integer"},
-							Format:      "int64",
-						},
-					},
-					"conditions": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Conditions contains the actual condition of a execution",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.Condition"),
-									},
-								},
-							},
-						},
-					},
-					"lastError": {
-						SchemaProps: spec.SchemaProps{
-							Description: "LastError describes the last error that occurred.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Error"),
-						},
-					},
-					"exportRef": {
+					"renewBefore": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ExportReference references the object that contains the exported values. only used for operation purpose.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"),
+							Description: "RenewBefore is the remaining validity of certificate authorities and certificates at which they are renewed. Defaults to a third of their validity.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
 						},
 					},
-					"deployItemCache": {
+					"interval": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DeployItemCache contains the currently existing deploy item belonging to the execution. If nil undefined.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.DeployItemCache"),
+							Description: "Interval is the duration after which a secret is regenerated. Passwords and ssh key pairs are not rotated if no interval is set.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
 						},
 					},
-					"jobID": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_GeneratedSecretStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GeneratedSecretStatus describes the status of a generated secret.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "JobID is the ID of the current working request.",
+							Description: "Name is the name of the generated secret definition in the blueprint.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"jobIDFinished": {
+					"secretName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "JobIDFinished is the ID of the finished working request.",
+							Description: "SecretName is the name of the secret in the namespace of the installation that contains the generated values.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"phase": {
+					"generationTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ExecutionPhase is the current phase of the execution.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "GenerationTime is the time when the values were generated.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"phaseTransitionTime": {
+					"expirationTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "PhaseTransitionTime is the time when the phase last changed.",
+							Description: "ExpirationTime is the time when a generated certificate expires.",
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"transitionTimes": {
+					"rotationTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TransitionTimes contains timestamps of status transitions",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.TransitionTimes"),
+							Description: "RotationTime is the time from which on the values are regenerated.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
 				},
+				Required: []string{"name", "secretName", "generationTime"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.Condition", "github.com/gardener/landscaper/apis/core/v1alpha1.DeployItemCache", "github.com/gardener/landscaper/apis/core/v1alpha1.Error", "github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference", "github.com/gardener/landscaper/apis/core/v1alpha1.TransitionTimes", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_ExportDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_ImportDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ExportDefinition defines a exported value",
+				Description: "ImportDefinition defines a imported value",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"name": {
@@ -9505,161 +11591,141 @@ func schema_landscaper_apis_core_v1alpha1_ExportDefinition(ref common.ReferenceC
 					},
 					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type specifies which kind of object is being exported. This field should be set and will likely be mandatory in future.",
+							Description: "Type specifies which kind of object is being imported. This field should be set and will likely be mandatory in future.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-				},
-				Required: []string{"name"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.JSONSchemaDefinition"},
-	}
-}
-
-func schema_landscaper_apis_core_v1alpha1_FailedReconcile(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "FailedReconcile allows to configure automatically repeated reconciliations for failed installations",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"numberOfReconciles": {
+					"required": {
 						SchemaProps: spec.SchemaProps{
-							Description: "NumberOfReconciles specifies the maximal number of automatically repeated reconciliations. If not set, no upper limit exists.",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "Required specifies whether the import is required for the component to run. Defaults to true.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"interval": {
+					"default": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Interval specifies the interval between two subsequent repeated reconciliations. If not set, a default of 5 minutes is used.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
+							Description: "Default sets a default value for the current import that is used if the key is not set.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Default"),
 						},
 					},
-					"cronSpec": {
+					"sensitive": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CronSpec describes the reconcile intervals according to the cron syntax \"https://pkg.go.dev/github.com/robfig/cron#hdr-CRON_Expression_Format\". If not empty, this specification is used instead of Interval.",
-							Type:        []string{"string"},
+							Description: "Sensitive marks the imported value as sensitive. Sensitive values are stored in secrets instead of data objects, are masked in error messages and logs and the deploy items of the installation are not stored in clear text in the execution.",
+							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
+					"imports": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConditionalImports are Imports that are only valid if this imports is satisfied. Does only make sense for optional imports.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.ImportDefinition"),
+									},
+								},
+							},
+						},
+					},
 				},
+				Required: []string{"name"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Default", "github.com/gardener/landscaper/apis/core/v1alpha1.ImportDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.JSONSchemaDefinition"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_FieldValueDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_ImportSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "FieldValueDefinition defines a im- or exported field. Either schema or target type have to be defined",
+				Description: "ImportSource describes where the value of an import comes from.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name defines the field name to search for the value and map to exports. Ref: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#selecting-fields",
+							Description: "Type is the type of the source.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"schema": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Schema defines the imported value as jsonschema.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.JSONSchemaDefinition"),
+							Description: "Name is the name of the data object, secret or configmap that contains the value.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"targetType": {
+					"key": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TargetType defines the type of the imported target.",
+							Description: "Key is the key of the secret or configmap that contains the value.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"installation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Installation is the name of the installation that has created the data object. This is either a sibling installation that exports the data object or the parent installation that passes on one of its own imports or the result of one of its import data mappings.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"name"},
+				Required: []string{"type"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.JSONSchemaDefinition"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_ImportDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_ImportValidationError(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ImportDefinition defines a imported value",
+				Description: "ImportValidationError describes a value of a data import that violates the schema of its import definition.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"import": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name defines the field name to search for the value and map to exports. Ref: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#selecting-fields",
+							Description: "Import is the name of the import.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"schema": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Schema defines the imported value as jsonschema.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.JSONSchemaDefinition"),
-						},
-					},
-					"targetType": {
-						SchemaProps: spec.SchemaProps{
-							Description: "TargetType defines the type of the imported target.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"type": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Type specifies which kind of object is being imported. This field should be set and will likely be mandatory in future.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"required": {
+					"source": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Required specifies whether the import is required for the component to run. Defaults to true.",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "Source describes where the value of the import comes from.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ImportSource"),
 						},
 					},
-					"default": {
+					"pointer": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Default sets a default value for the current import that is used if the key is not set.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Default"),
+							Description: "Pointer is the json pointer of the invalid value within the value of the import. The pointer is empty if the value of the import itself is invalid.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"imports": {
+					"message": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ConditionalImports are Imports that are only valid if this imports is satisfied. Does only make sense for optional imports.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.ImportDefinition"),
-									},
-								},
-							},
+							Description: "Message describes the violation.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"name"},
+				Required: []string{"import", "source", "message"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.Default", "github.com/gardener/landscaper/apis/core/v1alpha1.ImportDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.JSONSchemaDefinition"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.ImportSource"},
 	}
 }
 
@@ -9957,6 +12023,20 @@ func schema_landscaper_apis_core_v1alpha1_InstallationSpec(ref common.ReferenceC
 							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Optimization"),
 						},
 					},
+					"suspend": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Suspend stops the processing of the installation and of all its subinstallations, executions and deploy items. Changes and operations are kept and processed after the installation has been resumed.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"requireApproval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RequireApproval specifies that the deploy items of the installation and of all its subinstallations must be approved before they are deployed.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"blueprint"},
 			},
@@ -10073,12 +12153,74 @@ func schema_landscaper_apis_core_v1alpha1_InstallationStatus(ref common.Referenc
 							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.TransitionTimes"),
 						},
 					},
+					"suspendedSince": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SuspendedSince is the time since when the installation is suspended, either by its own spec or by a suspended parent installation.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"generatedSecrets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GeneratedSecrets contains the status of the secrets that are generated for the blueprint.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.GeneratedSecretStatus"),
+									},
+								},
+							},
+						},
+					},
+					"importValidationErrors": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ImportValidationErrors lists the values of data imports that violate the schema of their import definition. It is reset as soon as all imports are valid.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.ImportValidationError"),
+									},
+								},
+							},
+						},
+					},
+					"subInstallationRollouts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SubInstallationRollouts define the progressive rollout of the subinstallations of the current job, e.g. of the subinstallations for the targets of a target map.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.Rollout"),
+									},
+								},
+							},
+						},
+					},
+					"rollouts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rollouts contains the progress of the rollouts of the subinstallations of the current job.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.RolloutStatus"),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"observedGeneration"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.AutomaticReconcileStatus", "github.com/gardener/landscaper/apis/core/v1alpha1.Condition", "github.com/gardener/landscaper/apis/core/v1alpha1.DependentToTrigger", "github.com/gardener/landscaper/apis/core/v1alpha1.Error", "github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference", "github.com/gardener/landscaper/apis/core/v1alpha1.SubInstCache", "github.com/gardener/landscaper/apis/core/v1alpha1.TransitionTimes", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.AutomaticReconcileStatus", "github.com/gardener/landscaper/apis/core/v1alpha1.Condition", "github.com/gardener/landscaper/apis/core/v1alpha1.DependentToTrigger", "github.com/gardener/landscaper/apis/core/v1alpha1.Error", "github.com/gardener/landscaper/apis/core/v1alpha1.GeneratedSecretStatus", "github.com/gardener/landscaper/apis/core/v1alpha1.ImportValidationError", "github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference", "github.com/gardener/landscaper/apis/core/v1alpha1.Rollout", "github.com/gardener/landscaper/apis/core/v1alpha1.RolloutStatus", "github.com/gardener/landscaper/apis/core/v1alpha1.SubInstCache", "github.com/gardener/landscaper/apis/core/v1alpha1.TransitionTimes", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -10382,6 +12524,36 @@ func schema_landscaper_apis_core_v1alpha1_LsHealthCheckList(ref common.Reference
 	}
 }
 
+func schema_landscaper_apis_core_v1alpha1_MaintenanceWindow(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MaintenanceWindow defines a recurring time window.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"cronSpec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CronSpec defines the begin of the window as cron expression, e.g. \"0 22 * * 1-5\". A time zone can be specified with the prefix \"CRON_TZ=\", e.g. \"CRON_TZ=Europe/Berlin 0 22 * * 1-5\". The default time zone is UTC.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"duration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Duration is the length of the window.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
+						},
+					},
+				},
+				Required: []string{"cronSpec", "duration"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration"},
+	}
+}
+
 func schema_landscaper_apis_core_v1alpha1_NamedObjectReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -10528,14 +12700,191 @@ func schema_landscaper_apis_core_v1alpha1_Requirement(ref common.ReferenceCallba
 					},
 					"operator": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"values": {
+						SchemaProps: spec.SchemaProps{
+							Description: "In huge majority of cases we have at most one value here. It is generally faster to operate on a single-element slice than on a single-element map, so we have a slice here.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"key", "operator"},
+			},
+		},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_ResolvedTarget(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ResolvedTarget is a helper struct to store a target together with the content of its resolved secret reference.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"target": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Target contains the original target.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Target"),
+						},
+					},
+					"content": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Content contains the content of the target. If the target has a secret reference, this field should be filled by a TargetResolver. Otherwise, the inline configuration of the target is put here.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"target", "content"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Target"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_ResourceReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ResourceReference defines the reference to a resource defined in a component descriptor.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"componentName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ComponentName defines the unique of the component containing the resource.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"resourceName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ResourceName defines the name of the resource.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"componentName", "resourceName"},
+			},
+		},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_Rollout(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Rollout defines the progressive rollout of a group of deploy items or subinstallations. The deploy items or subinstallations are rolled out target by target in batches. A batch is started when the previous batch has finished, the pause between the batches has passed, and not more targets have failed than allowed.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the unique name of the rollout.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"targets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Targets are the targets of the rollout with their deploy items or subinstallations in the order in which they are rolled out.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.RolloutTarget"),
+									},
+								},
+							},
+						},
+					},
+					"canary": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Canary is the key of a target that is rolled out first and must succeed before the other targets are rolled out.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"batchSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BatchSize is the number or the percentage of targets that are rolled out in parallel. The canary is not counted. Defaults to all targets.",
+							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+						},
+					},
+					"pauseBetweenBatches": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PauseBetweenBatches is the time between the end of a batch and the start of the next batch.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
+						},
+					},
+					"maxFailedTargets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxFailedTargets is the number of failed targets that is tolerated. If more targets fail, no further batch is started. Defaults to 0.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"name", "targets"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration", "github.com/gardener/landscaper/apis/core/v1alpha1.RolloutTarget", "k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_RolloutStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RolloutStatus contains the progress of a rollout.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the rollout.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"batches": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Batches is the number of batches of the rollout including the canary.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"completedBatches": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CompletedBatches is the number of finished batches.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"values": {
+					"failedTargets": {
 						SchemaProps: spec.SchemaProps{
-							Description: "In huge majority of cases we have at most one value here. It is generally faster to operate on a single-element slice than on a single-element map, so we have a slice here.",
+							Description: "FailedTargets contains the keys of the failed targets.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -10548,68 +12897,75 @@ func schema_landscaper_apis_core_v1alpha1_Requirement(ref common.ReferenceCallba
 							},
 						},
 					},
-				},
-				Required: []string{"key", "operator"},
-			},
-		},
-	}
-}
-
-func schema_landscaper_apis_core_v1alpha1_ResolvedTarget(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "ResolvedTarget is a helper struct to store a target together with the content of its resolved secret reference.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"target": {
+					"stopped": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Target contains the original target.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Target"),
+							Description: "Stopped is true if the rollout has been stopped because the canary or too many targets failed.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"content": {
+					"nextBatchTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Content contains the content of the target. If the target has a secret reference, this field should be filled by a TargetResolver. Otherwise, the inline configuration of the target is put here.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "NextBatchTime is the time when the next batch is started after a pause.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
 				},
-				Required: []string{"target", "content"},
+				Required: []string{"name", "batches", "completedBatches"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.Target"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_ResourceReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_RolloutTarget(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ResourceReference defines the reference to a resource defined in a component descriptor.",
+				Description: "RolloutTarget defines the deploy items or the subinstallations of a target of a rollout.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"componentName": {
+					"key": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ComponentName defines the unique of the component containing the resource.",
+							Description: "Key is the key of the target in the target map.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"resourceName": {
+					"deployItems": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ResourceName defines the name of the resource.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "DeployItems are the names of the deploy items that deploy to the target.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"installations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Installations are the names of the installation templates of the subinstallations that import the target.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"componentName", "resourceName"},
+				Required: []string{"key"},
 			},
 		},
 	}
@@ -11137,12 +13493,18 @@ func schema_landscaper_apis_core_v1alpha1_Target(ref common.ReferenceCallback) c
 							Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.TargetSpec"),
 						},
 					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status contains the observed health of the target.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.TargetStatus"),
+						},
+					},
 				},
 				Required: []string{"spec"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.TargetSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.TargetSpec", "github.com/gardener/landscaper/apis/core/v1alpha1.TargetStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
@@ -11296,6 +13658,36 @@ func schema_landscaper_apis_core_v1alpha1_TargetList(ref common.ReferenceCallbac
 	}
 }
 
+func schema_landscaper_apis_core_v1alpha1_TargetResolverReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TargetResolverReference references a target resolver and contains its configuration.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the target resolver. Built-in resolvers are \"serviceaccount-token\", \"exec\" and \"kubeconfig-directory\". Deployers may register additional resolvers.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"config": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Config contains the resolver specific configuration.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"},
+	}
+}
+
 func schema_landscaper_apis_core_v1alpha1_TargetSelector(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -11370,22 +13762,89 @@ func schema_landscaper_apis_core_v1alpha1_TargetSpec(ref common.ReferenceCallbac
 					},
 					"config": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Configuration contains the target type specific configuration. Exactly one of the fields Configuration and SecretRef must be set",
+							Description: "Configuration contains the target type specific configuration. At most one of the fields Configuration, SecretRef and ResolverRef must be set",
 							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
 						},
 					},
 					"secretRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Reference to a secret containing the target type specific configuration. Exactly one of the fields Configuration and SecretRef must be set",
+							Description: "Reference to a secret containing the target type specific configuration. At most one of the fields Configuration, SecretRef and ResolverRef must be set",
 							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference"),
 						},
 					},
+					"resolverRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ResolverRef references a target resolver that computes the target type specific configuration. At most one of the fields Configuration, SecretRef and ResolverRef must be set.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.TargetResolverReference"),
+						},
+					},
 				},
 				Required: []string{"type"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON", "github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON", "github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference", "github.com/gardener/landscaper/apis/core/v1alpha1.TargetResolverReference"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_TargetStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TargetStatus contains the observed health of a target. The status is only maintained for targets of type kubernetes-cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedGeneration is the most recent generation of the target that has been probed.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"reachable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reachable indicates whether the api server of the target cluster could be accessed with the credentials of the target during the last probe.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"lastProbeTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastProbeTime is the time when the target has been probed the last time.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lastTransitionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastTransitionTime is the time when the reachability of the target has changed the last time.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"serverVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ServerVersion is the version of the api server of the target cluster.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"credentialExpirationTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CredentialExpirationTime is the time when the credentials of the target expire. It is derived from the client certificate or the token of the target.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message describes why the target is not reachable.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -11526,20 +13985,45 @@ func schema_landscaper_apis_core_v1alpha1_TargetSyncSpec(ref common.ReferenceCal
 					},
 					"secretNameExpression": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SecretNameExpression defines the names of the secrets which should be synced via a regular expression according to https://github.com/google/re2/wiki/Syntax with the extension that * is also a valid expression and matches all names. if not set no secrets are synced",
+							Description: "SecretNameExpression defines the names of the secrets which should be synced via a regular expression according to https://github.com/google/re2/wiki/Syntax with the extension that * is also a valid expression and matches all names. if neither SecretNameExpression, SecretSelector nor ClusterAPISecrets is set no secrets are synced",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"secretSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretSelector selects the secrets which should be synced by their labels. If it is set together with SecretNameExpression, a secret must match both to be synced.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+					"clusterAPISecrets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClusterAPISecrets specifies that the secrets to be synced are kubeconfig secrets of ClusterAPI clusters. Such secrets are named \"<cluster>-kubeconfig\" and contain the kubeconfig in the data key \"value\". Other secrets are not synced. The targets are named after the clusters and reference the key \"value\".",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 					"shootNameExpression": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ShootNameExpression defines the names of shoot clusters for which targets with short living access data to the shoots are created via a regular expression according to https://github.com/google/re2/wiki/Syntax with the extension that * is also a valid expression and matches all names. if not set no targets for the shoots are created",
+							Description: "ShootNameExpression defines the names of shoot clusters for which targets with short living access data to the shoots are created via a regular expression according to https://github.com/google/re2/wiki/Syntax with the extension that * is also a valid expression and matches all names. if neither ShootNameExpression nor ShootSelector is set no targets for the shoots are created",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"shootSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ShootSelector selects the shoot clusters for which targets are created by their labels. If it is set together with ShootNameExpression, a shoot must match both.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+					"targetTemplate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetTemplate defines the name, labels and annotations of the synced targets.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.TargetSyncTemplate"),
+						},
+					},
 					"tokenRotation": {
 						SchemaProps: spec.SchemaProps{
 							Description: "TokenRotation defines the data to perform an automatic rotation of the token to access the source cluster with the secrets to sync. The token expires after 90 days and will be rotated every 60 days.",
@@ -11551,7 +14035,7 @@ func schema_landscaper_apis_core_v1alpha1_TargetSyncSpec(ref common.ReferenceCal
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference", "github.com/gardener/landscaper/apis/core/v1alpha1.TokenRotation"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference", "github.com/gardener/landscaper/apis/core/v1alpha1.TargetSyncTemplate", "github.com/gardener/landscaper/apis/core/v1alpha1.TokenRotation", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
 	}
 }
 
@@ -11605,6 +14089,58 @@ func schema_landscaper_apis_core_v1alpha1_TargetSyncStatus(ref common.ReferenceC
 	}
 }
 
+func schema_landscaper_apis_core_v1alpha1_TargetSyncTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TargetSyncTemplate defines the metadata of the synced targets. All fields are go templates, which are executed with the metadata of the synced secret or shoot: .name, .namespace, .labels and .annotations, as well as .clusterName, which is the name of the cluster for ClusterAPI secrets and the name of the secret or shoot otherwise. The hermetic sprig functions are available in the templates.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the template for the name of the targets. Defaults to the name of the synced secret or shoot, or the cluster name for ClusterAPI secrets. The secrets referenced by the targets have the same name as the targets.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"labels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Labels are templates for additional labels of the targets.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"annotations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Annotations are templates for additional annotations of the targets.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_landscaper_apis_core_v1alpha1_TargetTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -11622,16 +14158,22 @@ func schema_landscaper_apis_core_v1alpha1_TargetTemplate(ref common.ReferenceCal
 					},
 					"config": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Configuration contains the target type specific configuration. Exactly one of the fields Configuration and SecretRef must be set",
+							Description: "Configuration contains the target type specific configuration. At most one of the fields Configuration, SecretRef and ResolverRef must be set",
 							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
 						},
 					},
 					"secretRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Reference to a secret containing the target type specific configuration. Exactly one of the fields Configuration and SecretRef must be set",
+							Description: "Reference to a secret containing the target type specific configuration. At most one of the fields Configuration, SecretRef and ResolverRef must be set",
 							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference"),
 						},
 					},
+					"resolverRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ResolverRef references a target resolver that computes the target type specific configuration. At most one of the fields Configuration, SecretRef and ResolverRef must be set.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.TargetResolverReference"),
+						},
+					},
 					"labels": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Map of string keys and values that can be used to organize and categorize (scope and select) objects. May match selectors of replication controllers and services. More info: http://kubernetes.io/docs/user-guide/labels",
@@ -11669,7 +14211,7 @@ func schema_landscaper_apis_core_v1alpha1_TargetTemplate(ref common.ReferenceCal
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON", "github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON", "github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference", "github.com/gardener/landscaper/apis/core/v1alpha1.TargetResolverReference"},
 	}
 }
 
@@ -12025,6 +14567,12 @@ func schema_landscaper_apis_deployer_container_Configuration(ref common.Referenc
 							},
 						},
 					},
+					"targetResolvers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetResolvers enables built-in target resolvers that are disabled by default.",
+							Ref:         ref("github.com/gardener/landscaper/apis/config/v1alpha1.TargetResolversConfiguration"),
+						},
+					},
 					"namespace": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Namespace defines the namespace where the pods should be executed.",
@@ -12080,12 +14628,25 @@ func schema_landscaper_apis_deployer_container_Configuration(ref common.Referenc
 							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container.Controller"),
 						},
 					},
+					"blueprintStore": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BlueprintStore configures a blueprint store for the init containers. The store is located on the volume that is defined by BlueprintStoreVolumeClaimName.",
+							Ref:         ref("github.com/gardener/landscaper/apis/config/v1alpha1.BlueprintStore"),
+						},
+					},
+					"blueprintStoreVolumeClaimName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BlueprintStoreVolumeClaimName is the name of a persistent volume claim in the namespace of the pods that contains the blueprint store. The claim has to be provisioned by the operator of the deployer. Every namespace of deploy items uses its own sub directory of the volume, so that the stored blueprints and component descriptors are only shared by the pods of the same namespace. If no claim is defined, the store is located on an emptyDir volume that is not shared with other pods.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"namespace", "defaultImage", "initContainer", "waitContainer", "garbageCollection"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/config.OCIConfiguration", "github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector", "github.com/gardener/landscaper/apis/deployer/container.ContainerSpec", "github.com/gardener/landscaper/apis/deployer/container.Controller", "github.com/gardener/landscaper/apis/deployer/container.DebugOptions", "github.com/gardener/landscaper/apis/deployer/container.GarbageCollection", "github.com/gardener/landscaper/apis/deployer/container.HPAConfiguration"},
+			"github.com/gardener/landscaper/apis/config.OCIConfiguration", "github.com/gardener/landscaper/apis/config/v1alpha1.BlueprintStore", "github.com/gardener/landscaper/apis/config/v1alpha1.TargetResolversConfiguration", "github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector", "github.com/gardener/landscaper/apis/deployer/container.ContainerSpec", "github.com/gardener/landscaper/apis/deployer/container.Controller", "github.com/gardener/landscaper/apis/deployer/container.DebugOptions", "github.com/gardener/landscaper/apis/deployer/container.GarbageCollection", "github.com/gardener/landscaper/apis/deployer/container.HPAConfiguration"},
 	}
 }
 
@@ -12221,28 +14782,92 @@ func schema_landscaper_apis_deployer_container_Controller(ref common.ReferenceCa
 						},
 					},
 				},
-				Required: []string{"CommonControllerConfig"},
+				Required: []string{"CommonControllerConfig"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/config/v1alpha1.CommonControllerConfig"},
+	}
+}
+
+func schema_landscaper_apis_deployer_container_DebugOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DebugOptions defines optional debug options.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"keepPod": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KeepPod will only remove the finalizer on the pod but will not delete the pod.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/config/v1alpha1.CommonControllerConfig"},
 	}
 }
 
-func schema_landscaper_apis_deployer_container_DebugOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_deployer_container_DeleteConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DebugOptions defines optional debug options.",
+				Description: "DeleteConfiguration defines the container that is executed on the deletion of a deploy item. Fields that are not set are taken from the provider configuration. The command and the arguments are only taken from the provider configuration if no image is set.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"keepPod": {
+					"skipDeleteRun": {
 						SchemaProps: spec.SchemaProps{
-							Description: "KeepPod will only remove the finalizer on the pod but will not delete the pod.",
+							Description: "SkipDeleteRun defines that no container is executed when the deploy item is deleted. Only the resources that have been created by the container deployer are cleaned up.",
 							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
+					"image": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Image is the docker image that is executed on deletion.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"command": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Command is the entrypoint array of the delete container.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"args": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Args are the arguments to the entrypoint of the delete container.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"importValues": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ImportValues contains the import values for the delete container.",
+							Type:        []string{"string"},
+							Format:      "byte",
+						},
+					},
 				},
 			},
 		},
@@ -12355,12 +14980,60 @@ func schema_landscaper_apis_deployer_container_PodStatus(ref common.ReferenceCal
 							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container.ContainerStatus"),
 						},
 					},
+					"progress": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Progress is the last progress that has been reported by the main container.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container.Progress"),
+						},
+					},
 				},
 				Required: []string{"podName", "containerStatus", "initContainerStatus", "waitContainerStatus"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/deployer/container.ContainerStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/gardener/landscaper/apis/deployer/container.ContainerStatus", "github.com/gardener/landscaper/apis/deployer/container.Progress", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_landscaper_apis_deployer_container_Progress(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Progress is the progress of the main container, which is reported through the file defined by the env var PROGRESS_PATH.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"percentage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Percentage is the completion of the current operation in percent.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"step": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Step is the name of the current step of the operation.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message is a human readable description of the current state of the operation.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastUpdateTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastUpdateTime is the time when the progress has been reported.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -12461,11 +15134,31 @@ func schema_landscaper_apis_deployer_container_ProviderConfiguration(ref common.
 							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec"),
 						},
 					},
+					"onDelete": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OnDelete defines the container that is executed when the deploy item is deleted. By default, the same container is executed for the reconcile and the delete operation.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container.DeleteConfiguration"),
+						},
+					},
+					"targets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Targets are additional targets that are provided to the container. Every target is written as \"<name>.json\" into the directory defined by the env var TARGETS_DIR, which also contains the target of the deploy item as \"target.json\".",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/deployer/container.TargetReference"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.BlueprintDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.ComponentDescriptorDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference", "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.BlueprintDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.ComponentDescriptorDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference", "github.com/gardener/landscaper/apis/deployer/container.DeleteConfiguration", "github.com/gardener/landscaper/apis/deployer/container.TargetReference", "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec"},
 	}
 }
 
@@ -12513,6 +15206,37 @@ func schema_landscaper_apis_deployer_container_ProviderStatus(ref common.Referen
 	}
 }
 
+func schema_landscaper_apis_deployer_container_TargetReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TargetReference references an additional target of a container deploy item.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name under which the target is provided to the container.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"target": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Target is the reference to the target. The target must be in the namespace of the deploy item, so the namespace must not be set.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"),
+						},
+					},
+				},
+				Required: []string{"name", "target"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"},
+	}
+}
+
 func schema_apis_deployer_container_v1alpha1_Configuration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -12569,6 +15293,12 @@ func schema_apis_deployer_container_v1alpha1_Configuration(ref common.ReferenceC
 							},
 						},
 					},
+					"targetResolvers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetResolvers enables built-in target resolvers that are disabled by default.",
+							Ref:         ref("github.com/gardener/landscaper/apis/config/v1alpha1.TargetResolversConfiguration"),
+						},
+					},
 					"defaultImage": {
 						SchemaProps: spec.SchemaProps{
 							Description: "DefaultImage configures the default images that is used if the DeployItem does not specify one.",
@@ -12616,12 +15346,25 @@ func schema_apis_deployer_container_v1alpha1_Configuration(ref common.ReferenceC
 							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container/v1alpha1.Controller"),
 						},
 					},
+					"blueprintStore": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BlueprintStore configures a blueprint store for the init containers. The store is located on the volume that is defined by BlueprintStoreVolumeClaimName.",
+							Ref:         ref("github.com/gardener/landscaper/apis/config/v1alpha1.BlueprintStore"),
+						},
+					},
+					"blueprintStoreVolumeClaimName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BlueprintStoreVolumeClaimName is the name of a persistent volume claim in the namespace of the pods that contains the blueprint store. The claim has to be provisioned by the operator of the deployer. Every namespace of deploy items uses its own sub directory of the volume, so that the stored blueprints and component descriptors are only shared by the pods of the same namespace. If no claim is defined, the store is located on an emptyDir volume that is not shared with other pods.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"defaultImage", "initContainer", "waitContainer", "garbageCollection"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/config.OCIConfiguration", "github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector", "github.com/gardener/landscaper/apis/deployer/container/v1alpha1.ContainerSpec", "github.com/gardener/landscaper/apis/deployer/container/v1alpha1.Controller", "github.com/gardener/landscaper/apis/deployer/container/v1alpha1.DebugOptions", "github.com/gardener/landscaper/apis/deployer/container/v1alpha1.GarbageCollection", "github.com/gardener/landscaper/apis/deployer/container/v1alpha1.HPAConfiguration"},
+			"github.com/gardener/landscaper/apis/config.OCIConfiguration", "github.com/gardener/landscaper/apis/config/v1alpha1.BlueprintStore", "github.com/gardener/landscaper/apis/config/v1alpha1.TargetResolversConfiguration", "github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector", "github.com/gardener/landscaper/apis/deployer/container/v1alpha1.ContainerSpec", "github.com/gardener/landscaper/apis/deployer/container/v1alpha1.Controller", "github.com/gardener/landscaper/apis/deployer/container/v1alpha1.DebugOptions", "github.com/gardener/landscaper/apis/deployer/container/v1alpha1.GarbageCollection", "github.com/gardener/landscaper/apis/deployer/container/v1alpha1.HPAConfiguration"},
 	}
 }
 
@@ -12785,6 +15528,70 @@ func schema_apis_deployer_container_v1alpha1_DebugOptions(ref common.ReferenceCa
 	}
 }
 
+func schema_apis_deployer_container_v1alpha1_DeleteConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DeleteConfiguration defines the container that is executed on the deletion of a deploy item. Fields that are not set are taken from the provider configuration. The command and the arguments are only taken from the provider configuration if no image is set.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"skipDeleteRun": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SkipDeleteRun defines that no container is executed when the deploy item is deleted. Only the resources that have been created by the container deployer are cleaned up.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"image": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Image is the docker image that is executed on deletion.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"command": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Command is the entrypoint array of the delete container.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"args": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Args are the arguments to the entrypoint of the delete container.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"importValues": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ImportValues contains the import values for the delete container.",
+							Type:        []string{"string"},
+							Format:      "byte",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_apis_deployer_container_v1alpha1_GarbageCollection(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -12891,12 +15698,60 @@ func schema_apis_deployer_container_v1alpha1_PodStatus(ref common.ReferenceCallb
 							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container/v1alpha1.ContainerStatus"),
 						},
 					},
+					"progress": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Progress is the last progress that has been reported by the main container.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container/v1alpha1.Progress"),
+						},
+					},
 				},
 				Required: []string{"podName", "containerStatus", "initContainerStatus", "waitContainerStatus"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/deployer/container/v1alpha1.ContainerStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/gardener/landscaper/apis/deployer/container/v1alpha1.ContainerStatus", "github.com/gardener/landscaper/apis/deployer/container/v1alpha1.Progress", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_apis_deployer_container_v1alpha1_Progress(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Progress is the progress of the main container, which is reported through the file defined by the env var PROGRESS_PATH.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"percentage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Percentage is the completion of the current operation in percent.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"step": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Step is the name of the current step of the operation.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message is a human readable description of the current state of the operation.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastUpdateTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastUpdateTime is the time when the progress has been reported.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -12985,23 +15840,43 @@ func schema_apis_deployer_container_v1alpha1_ProviderConfiguration(ref common.Re
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"),
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"),
+									},
+								},
+							},
+						},
+					},
+					"continuousReconcile": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ContinuousReconcile contains the schedule for continuous reconciliation.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec"),
+						},
+					},
+					"onDelete": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OnDelete defines the container that is executed when the deploy item is deleted. By default, the same container is executed for the reconcile and the delete operation.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container/v1alpha1.DeleteConfiguration"),
+						},
+					},
+					"targets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Targets are additional targets that are provided to the container. Every target is written as \"<name>.json\" into the directory defined by the env var TARGETS_DIR, which also contains the target of the deploy item as \"target.json\".",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/deployer/container/v1alpha1.TargetReference"),
 									},
 								},
 							},
 						},
 					},
-					"continuousReconcile": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ContinuousReconcile contains the schedule for continuous reconciliation.",
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec"),
-						},
-					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.BlueprintDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.ComponentDescriptorDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference", "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.BlueprintDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.ComponentDescriptorDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference", "github.com/gardener/landscaper/apis/deployer/container/v1alpha1.DeleteConfiguration", "github.com/gardener/landscaper/apis/deployer/container/v1alpha1.TargetReference", "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec"},
 	}
 }
 
@@ -13049,6 +15924,37 @@ func schema_apis_deployer_container_v1alpha1_ProviderStatus(ref common.Reference
 	}
 }
 
+func schema_apis_deployer_container_v1alpha1_TargetReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TargetReference references an additional target of a container deploy item.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name under which the target is provided to the container.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"target": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Target is the reference to the target. The target must be in the namespace of the deploy item, so the namespace must not be set.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"),
+						},
+					},
+				},
+				Required: []string{"name", "target"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"},
+	}
+}
+
 func schema_landscaper_apis_deployer_helm_ArchiveAccess(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -13214,6 +16120,12 @@ func schema_landscaper_apis_deployer_helm_Configuration(ref common.ReferenceCall
 							},
 						},
 					},
+					"targetResolvers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetResolvers enables built-in target resolvers that are disabled by default.",
+							Ref:         ref("github.com/gardener/landscaper/apis/config/v1alpha1.TargetResolversConfiguration"),
+						},
+					},
 					"export": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Export defines the export configuration.",
@@ -13234,11 +16146,17 @@ func schema_landscaper_apis_deployer_helm_Configuration(ref common.ReferenceCall
 							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm.Controller"),
 						},
 					},
+					"helmChartRepoIndexCache": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HelmChartRepoIndexCache configures the cache of the index files of helm chart repositories.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm.HelmChartRepoIndexCacheConfiguration"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/config.OCIConfiguration", "github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector", "github.com/gardener/landscaper/apis/deployer/helm.Controller", "github.com/gardener/landscaper/apis/deployer/helm.ExportConfiguration", "github.com/gardener/landscaper/apis/deployer/helm.HPAConfiguration"},
+			"github.com/gardener/landscaper/apis/config.OCIConfiguration", "github.com/gardener/landscaper/apis/config/v1alpha1.TargetResolversConfiguration", "github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector", "github.com/gardener/landscaper/apis/deployer/helm.Controller", "github.com/gardener/landscaper/apis/deployer/helm.ExportConfiguration", "github.com/gardener/landscaper/apis/deployer/helm.HPAConfiguration", "github.com/gardener/landscaper/apis/deployer/helm.HelmChartRepoIndexCacheConfiguration"},
 	}
 }
 
@@ -13325,8 +16243,9 @@ func schema_landscaper_apis_deployer_helm_HelmChartRepo(ref common.ReferenceCall
 					},
 					"helmChartVersion": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "HelmChartVersion is the version of the chart or a semver version constraint like \"~1.4\". A constraint is resolved to the highest matching version in the index of the helm chart repo.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
@@ -13363,6 +16282,34 @@ func schema_landscaper_apis_deployer_helm_HelmChartRepoCredentials(ref common.Re
 	}
 }
 
+func schema_landscaper_apis_deployer_helm_HelmChartRepoIndexCacheConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HelmChartRepoIndexCacheConfiguration configures the on-disk cache of the index files of helm chart repositories, which are used to resolve version constraints of charts.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path is the directory of the cache. The directory can be shared by several deployer instances. Defaults to a directory in the temporary directory of the deployer.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"maxAge": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxAge is the duration for which a cached index file is used without revalidation. Afterwards, the index file is revalidated with its ETag. Defaults to 10 minutes.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration"},
+	}
+}
+
 func schema_landscaper_apis_deployer_helm_HelmDeploymentConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -13471,6 +16418,134 @@ func schema_landscaper_apis_deployer_helm_HelmUninstallConfiguration(ref common.
 	}
 }
 
+func schema_landscaper_apis_deployer_helm_PostRendererConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PostRendererConfiguration defines modifications of the rendered manifests of a chart. The modifications are applied with kustomize, whereby the rendered manifests are the only resource of the kustomization.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"patches": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Patches are strategic merge patches or JSON patches, which are applied to the rendered manifests.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/deployer/helm.PostRendererPatch"),
+									},
+								},
+							},
+						},
+					},
+					"kustomization": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kustomization is a kustomize overlay, which is applied to the rendered manifests, e.g. with the fields \"labels\", \"commonAnnotations\" or \"images\". Only the fields \"patches\", \"images\", \"labels\", \"commonLabels\", \"commonAnnotations\", \"namespace\", \"namePrefix\", \"nameSuffix\" and \"replicas\" are supported.",
+							Type:        []string{"string"},
+							Format:      "byte",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/deployer/helm.PostRendererPatch"},
+	}
+}
+
+func schema_landscaper_apis_deployer_helm_PostRendererPatch(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PostRendererPatch is a patch of the rendered manifests. Exactly one of StrategicMerge and JSON must be set.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"target": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Target selects the manifests to patch. A strategic merge patch without target is applied to the manifest with the kind and name of the patch. A JSON patch requires a target.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm.PostRendererPatchTarget"),
+						},
+					},
+					"strategicMerge": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StrategicMerge is a strategic merge patch.",
+							Type:        []string{"string"},
+							Format:      "byte",
+						},
+					},
+					"json": {
+						SchemaProps: spec.SchemaProps{
+							Description: "JSON is a JSON patch according to RFC 6902, i.e. a list of patch operations.",
+							Type:        []string{"string"},
+							Format:      "byte",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/deployer/helm.PostRendererPatchTarget"},
+	}
+}
+
+func schema_landscaper_apis_deployer_helm_PostRendererPatchTarget(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PostRendererPatchTarget selects the manifests of a patch. All set fields must match. The name and namespace are regular expressions.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"group": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"labelSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LabelSelector is a label selector in its string representation, e.g. \"app=nginx,tier!=backend\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"annotationSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AnnotationSelector is a selector for the annotations in the string representation of label selectors.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_landscaper_apis_deployer_helm_ProviderConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -13544,6 +16619,12 @@ func schema_landscaper_apis_deployer_helm_ProviderConfiguration(ref common.Refer
 							Format:      "byte",
 						},
 					},
+					"postRenderer": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PostRenderer defines modifications of the rendered manifests of the chart, which are applied before the manifests are deployed.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm.PostRendererConfiguration"),
+						},
+					},
 					"exportsFromManifests": {
 						SchemaProps: spec.SchemaProps{
 							Description: "ExportsFromManifests describe the exports from the templated manifests that should be exported by the helm deployer. DEPRECATED",
@@ -13583,6 +16664,12 @@ func schema_landscaper_apis_deployer_helm_ProviderConfiguration(ref common.Refer
 							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm.HelmDeploymentConfiguration"),
 						},
 					},
+					"releaseMigration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReleaseMigration configures the adoption of an existing helm release and the migration between the helm deployment and the manifest-only deployment.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm.ReleaseMigrationConfiguration"),
+						},
+					},
 					"deletionGroups": {
 						SchemaProps: spec.SchemaProps{
 							Description: "DeletionGroups defines the order in which objects are deleted. Only relevant if HelmDeployment is false.",
@@ -13597,69 +16684,174 @@ func schema_landscaper_apis_deployer_helm_ProviderConfiguration(ref common.Refer
 							},
 						},
 					},
-					"deletionGroupsDuringUpdate": {
+					"deletionGroupsDuringUpdate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeletionGroupsDuringUpdate defines the order in which objects are deleted during an update.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.DeletionGroupDefinition"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"chart", "name", "namespace", "createNamespace"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/deployer/helm.Chart", "github.com/gardener/landscaper/apis/deployer/helm.HelmDeploymentConfiguration", "github.com/gardener/landscaper/apis/deployer/helm.PostRendererConfiguration", "github.com/gardener/landscaper/apis/deployer/helm.ReleaseMigrationConfiguration", "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.DeletionGroupDefinition", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.Export", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.Exports", "github.com/gardener/landscaper/apis/deployer/utils/readinesschecks.ReadinessCheckConfiguration"},
+	}
+}
+
+func schema_landscaper_apis_deployer_helm_ProviderStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ProviderStatus is the helm provider specific status",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"managedResources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ManagedResources contains all kubernetes resources that are deployed by the helm deployer.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.ManagedResourceStatus"),
+									},
+								},
+							},
+						},
+					},
+					"resolvedChartVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ResolvedChartVersion is the chart version which has been resolved from the version constraint of a chart from a helm chart repo.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm.ResolvedChartVersion"),
+						},
+					},
+					"deploymentMode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeploymentMode is the mode of the last successful deployment.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"releaseMigration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReleaseMigration is the report of the last migration between the deployment modes or of the adoption of a helm release.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm.ReleaseMigrationStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/deployer/helm.ReleaseMigrationStatus", "github.com/gardener/landscaper/apis/deployer/helm.ResolvedChartVersion", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.ManagedResourceStatus"},
+	}
+}
+
+func schema_landscaper_apis_deployer_helm_ReleaseMigrationConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ReleaseMigrationConfiguration configures the adoption of an existing helm release and the migration between the helm deployment and the manifest-only deployment.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"adoptRelease": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AdoptRelease allows to take over an existing helm release with the release name and namespace, which has not been deployed by the deploy item, e.g. a release that has been installed outside the landscaper.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"dryRun": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DeletionGroupsDuringUpdate defines the order in which objects are deleted during an update.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.DeletionGroupDefinition"),
-									},
-								},
-							},
+							Description: "DryRun only reports the steps of a migration or adoption in the provider status without performing them. The deployment is not executed until the dry run is switched off.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"chart", "name", "namespace", "createNamespace"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/deployer/helm.Chart", "github.com/gardener/landscaper/apis/deployer/helm.HelmDeploymentConfiguration", "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.DeletionGroupDefinition", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.Export", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.Exports", "github.com/gardener/landscaper/apis/deployer/utils/readinesschecks.ReadinessCheckConfiguration"},
 	}
 }
 
-func schema_landscaper_apis_deployer_helm_ProviderStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_deployer_helm_ReleaseMigrationStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ProviderStatus is the helm provider specific status",
+				Description: "ReleaseMigrationStatus describes a migration between the deployment modes or the adoption of a helm release.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"from": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "From is the deployment mode before the migration. It is empty for the adoption of a helm release.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"to": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "To is the deployment mode after the migration.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"managedResources": {
+					"dryRun": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ManagedResources contains all kubernetes resources that are deployed by the helm deployer.",
+							Description: "DryRun indicates that the steps have only been planned, but not performed.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"steps": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Steps describe the changes of the migration in the target cluster.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.ManagedResourceStatus"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
+					"time": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Time is the time of the report.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
 				},
+				Required: []string{"to", "time"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/deployer/utils/managedresource.ManagedResourceStatus"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -13719,6 +16911,67 @@ func schema_landscaper_apis_deployer_helm_RemoteChartReference(ref common.Refere
 	}
 }
 
+func schema_landscaper_apis_deployer_helm_ResolvedChartVersion(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ResolvedChartVersion describes the resolution of the version constraint of a chart from a helm chart repo. The resolved version is kept until the chart reference changes or a new resolution is requested with the resolve-chart-version annotation.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"helmChartRepoUrl": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HelmChartRepoUrl is the url of the helm chart repo.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"helmChartName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HelmChartName is the name of the chart.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"versionConstraint": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VersionConstraint is the resolved version constraint.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Version is the resolved version.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"resolveRequest": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ResolveRequest is the value of the resolve-chart-version annotation at the time of the resolution.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"resolutionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ResolutionTime is the time of the resolution.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"helmChartRepoUrl", "helmChartName", "versionConstraint", "version", "resolutionTime"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_apis_deployer_helm_v1alpha1_ArchiveAccess(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -13884,6 +17137,12 @@ func schema_apis_deployer_helm_v1alpha1_Configuration(ref common.ReferenceCallba
 							},
 						},
 					},
+					"targetResolvers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetResolvers enables built-in target resolvers that are disabled by default.",
+							Ref:         ref("github.com/gardener/landscaper/apis/config/v1alpha1.TargetResolversConfiguration"),
+						},
+					},
 					"export": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Export defines the export configuration.",
@@ -13904,11 +17163,17 @@ func schema_apis_deployer_helm_v1alpha1_Configuration(ref common.ReferenceCallba
 							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.Controller"),
 						},
 					},
+					"helmChartRepoIndexCache": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HelmChartRepoIndexCache configures the cache of the index files of helm chart repositories.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.HelmChartRepoIndexCacheConfiguration"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/config.OCIConfiguration", "github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector", "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.Controller", "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ExportConfiguration", "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.HPAConfiguration"},
+			"github.com/gardener/landscaper/apis/config.OCIConfiguration", "github.com/gardener/landscaper/apis/config/v1alpha1.TargetResolversConfiguration", "github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector", "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.Controller", "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ExportConfiguration", "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.HPAConfiguration", "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.HelmChartRepoIndexCacheConfiguration"},
 	}
 }
 
@@ -13995,8 +17260,9 @@ func schema_apis_deployer_helm_v1alpha1_HelmChartRepo(ref common.ReferenceCallba
 					},
 					"helmChartVersion": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "HelmChartVersion is the version of the chart or a semver version constraint like \"~1.4\". A constraint is resolved to the highest matching version in the index of the helm chart repo.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
@@ -14033,6 +17299,34 @@ func schema_apis_deployer_helm_v1alpha1_HelmChartRepoCredentials(ref common.Refe
 	}
 }
 
+func schema_apis_deployer_helm_v1alpha1_HelmChartRepoIndexCacheConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HelmChartRepoIndexCacheConfiguration configures the on-disk cache of the index files of helm chart repositories, which are used to resolve version constraints of charts.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path is the directory of the cache. The directory can be shared by several deployer instances. Defaults to a directory in the temporary directory of the deployer.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"maxAge": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxAge is the duration for which a cached index file is used without revalidation. Afterwards, the index file is revalidated with its ETag. Defaults to 10 minutes.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration"},
+	}
+}
+
 func schema_apis_deployer_helm_v1alpha1_HelmDeploymentConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -14106,38 +17400,166 @@ func schema_apis_deployer_helm_v1alpha1_HelmInstallConfiguration(ref common.Refe
 							Format: "",
 						},
 					},
-					"timeout": {
+					"timeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timeout is the timeout for the operation in minutes.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration"},
+	}
+}
+
+func schema_apis_deployer_helm_v1alpha1_HelmUninstallConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HelmUninstallConfiguration defines settings for a helm uninstall operation.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"timeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timeout is the timeout for the operation in minutes.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration"},
+	}
+}
+
+func schema_apis_deployer_helm_v1alpha1_PostRendererConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PostRendererConfiguration defines modifications of the rendered manifests of a chart. The modifications are applied with kustomize, whereby the rendered manifests are the only resource of the kustomization.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"patches": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Patches are strategic merge patches or JSON patches, which are applied to the rendered manifests.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.PostRendererPatch"),
+									},
+								},
+							},
+						},
+					},
+					"kustomization": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kustomization is a kustomize overlay, which is applied to the rendered manifests, e.g. with the fields \"labels\", \"commonAnnotations\" or \"images\". Only the fields \"patches\", \"images\", \"labels\", \"commonLabels\", \"commonAnnotations\", \"namespace\", \"namePrefix\", \"nameSuffix\" and \"replicas\" are supported.",
+							Type:        []string{"string"},
+							Format:      "byte",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.PostRendererPatch"},
+	}
+}
+
+func schema_apis_deployer_helm_v1alpha1_PostRendererPatch(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PostRendererPatch is a patch of the rendered manifests. Exactly one of StrategicMerge and JSON must be set.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"target": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Target selects the manifests to patch. A strategic merge patch without target is applied to the manifest with the kind and name of the patch. A JSON patch requires a target.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.PostRendererPatchTarget"),
+						},
+					},
+					"strategicMerge": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StrategicMerge is a strategic merge patch.",
+							Type:        []string{"string"},
+							Format:      "byte",
+						},
+					},
+					"json": {
+						SchemaProps: spec.SchemaProps{
+							Description: "JSON is a JSON patch according to RFC 6902, i.e. a list of patch operations.",
+							Type:        []string{"string"},
+							Format:      "byte",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.PostRendererPatchTarget"},
+	}
+}
+
+func schema_apis_deployer_helm_v1alpha1_PostRendererPatchTarget(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PostRendererPatchTarget selects the manifests of a patch. All set fields must match. The name and namespace are regular expressions.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"group": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"labelSelector": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Timeout is the timeout for the operation in minutes.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
+							Description: "LabelSelector is a label selector in its string representation, e.g. \"app=nginx,tier!=backend\".",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration"},
-	}
-}
-
-func schema_apis_deployer_helm_v1alpha1_HelmUninstallConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "HelmUninstallConfiguration defines settings for a helm uninstall operation.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"timeout": {
+					"annotationSelector": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Timeout is the timeout for the operation in minutes.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
+							Description: "AnnotationSelector is a selector for the annotations in the string representation of label selectors.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration"},
 	}
 }
 
@@ -14214,6 +17636,12 @@ func schema_apis_deployer_helm_v1alpha1_ProviderConfiguration(ref common.Referen
 							Format:      "byte",
 						},
 					},
+					"postRenderer": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PostRenderer defines modifications of the rendered manifests of the chart, which are applied before the manifests are deployed.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.PostRendererConfiguration"),
+						},
+					},
 					"exportsFromManifests": {
 						SchemaProps: spec.SchemaProps{
 							Description: "ExportsFromManifests describe the exports from the templated manifests that should be exported by the helm deployer. DEPRECATED",
@@ -14253,6 +17681,12 @@ func schema_apis_deployer_helm_v1alpha1_ProviderConfiguration(ref common.Referen
 							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.HelmDeploymentConfiguration"),
 						},
 					},
+					"releaseMigration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReleaseMigration configures the adoption of an existing helm release and the migration between the helm deployment and the manifest-only deployment.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ReleaseMigrationConfiguration"),
+						},
+					},
 					"deletionGroups": {
 						SchemaProps: spec.SchemaProps{
 							Description: "DeletionGroups defines the order in which objects are deleted. Only relevant if HelmDeployment is false.",
@@ -14286,7 +17720,7 @@ func schema_apis_deployer_helm_v1alpha1_ProviderConfiguration(ref common.Referen
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.Chart", "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.HelmDeploymentConfiguration", "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.DeletionGroupDefinition", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.Export", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.Exports", "github.com/gardener/landscaper/apis/deployer/utils/readinesschecks.ReadinessCheckConfiguration"},
+			"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.Chart", "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.HelmDeploymentConfiguration", "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.PostRendererConfiguration", "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ReleaseMigrationConfiguration", "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.DeletionGroupDefinition", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.Export", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.Exports", "github.com/gardener/landscaper/apis/deployer/utils/readinesschecks.ReadinessCheckConfiguration"},
 	}
 }
 
@@ -14325,11 +17759,116 @@ func schema_apis_deployer_helm_v1alpha1_ProviderStatus(ref common.ReferenceCallb
 							},
 						},
 					},
+					"resolvedChartVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ResolvedChartVersion is the chart version which has been resolved from the version constraint of a chart from a helm chart repo.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ResolvedChartVersion"),
+						},
+					},
+					"deploymentMode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeploymentMode is the mode of the last successful deployment.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"releaseMigration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReleaseMigration is the report of the last migration between the deployment modes or of the adoption of a helm release.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ReleaseMigrationStatus"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/deployer/utils/managedresource.ManagedResourceStatus"},
+			"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ReleaseMigrationStatus", "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ResolvedChartVersion", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.ManagedResourceStatus"},
+	}
+}
+
+func schema_apis_deployer_helm_v1alpha1_ReleaseMigrationConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ReleaseMigrationConfiguration configures the adoption of an existing helm release and the migration between the helm deployment and the manifest-only deployment.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"adoptRelease": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AdoptRelease allows to take over an existing helm release with the release name and namespace, which has not been deployed by the deploy item, e.g. a release that has been installed outside the landscaper.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"dryRun": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DryRun only reports the steps of a migration or adoption in the provider status without performing them. The deployment is not executed until the dry run is switched off.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_apis_deployer_helm_v1alpha1_ReleaseMigrationStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ReleaseMigrationStatus describes a migration between the deployment modes or the adoption of a helm release.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"from": {
+						SchemaProps: spec.SchemaProps{
+							Description: "From is the deployment mode before the migration. It is empty for the adoption of a helm release.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"to": {
+						SchemaProps: spec.SchemaProps{
+							Description: "To is the deployment mode after the migration.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"dryRun": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DryRun indicates that the steps have only been planned, but not performed.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"steps": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Steps describe the changes of the migration in the target cluster.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"time": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Time is the time of the report.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"to", "time"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -14389,6 +17928,67 @@ func schema_apis_deployer_helm_v1alpha1_RemoteChartReference(ref common.Referenc
 	}
 }
 
+func schema_apis_deployer_helm_v1alpha1_ResolvedChartVersion(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ResolvedChartVersion describes the resolution of the version constraint of a chart from a helm chart repo. The resolved version is kept until the chart reference changes or a new resolution is requested with the resolve-chart-version annotation.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"helmChartRepoUrl": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HelmChartRepoUrl is the url of the helm chart repo.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"helmChartName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HelmChartName is the name of the chart.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"versionConstraint": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VersionConstraint is the resolved version constraint.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Version is the resolved version.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"resolveRequest": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ResolveRequest is the value of the resolve-chart-version annotation at the time of the resolution.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"resolutionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ResolutionTime is the time of the resolution.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"helmChartRepoUrl", "helmChartName", "versionConstraint", "version", "resolutionTime"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_apis_deployer_helm_v1alpha1_ResourceRef(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -14449,6 +18049,12 @@ func schema_landscaper_apis_deployer_manifest_Configuration(ref common.Reference
 							},
 						},
 					},
+					"targetResolvers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetResolvers enables built-in target resolvers that are disabled by default.",
+							Ref:         ref("github.com/gardener/landscaper/apis/config/v1alpha1.TargetResolversConfiguration"),
+						},
+					},
 					"export": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Export defines the export configuration.",
@@ -14473,7 +18079,7 @@ func schema_landscaper_apis_deployer_manifest_Configuration(ref common.Reference
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector", "github.com/gardener/landscaper/apis/deployer/manifest.Controller", "github.com/gardener/landscaper/apis/deployer/manifest.ExportConfiguration", "github.com/gardener/landscaper/apis/deployer/manifest.HPAConfiguration"},
+			"github.com/gardener/landscaper/apis/config/v1alpha1.TargetResolversConfiguration", "github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector", "github.com/gardener/landscaper/apis/deployer/manifest.Controller", "github.com/gardener/landscaper/apis/deployer/manifest.ExportConfiguration", "github.com/gardener/landscaper/apis/deployer/manifest.HPAConfiguration"},
 	}
 }
 
@@ -14754,6 +18360,12 @@ func schema_apis_deployer_manifest_v1alpha1_Configuration(ref common.ReferenceCa
 							},
 						},
 					},
+					"targetResolvers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetResolvers enables built-in target resolvers that are disabled by default.",
+							Ref:         ref("github.com/gardener/landscaper/apis/config/v1alpha1.TargetResolversConfiguration"),
+						},
+					},
 					"export": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Export defines the export configuration.",
@@ -14778,7 +18390,7 @@ func schema_apis_deployer_manifest_v1alpha1_Configuration(ref common.ReferenceCa
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector", "github.com/gardener/landscaper/apis/deployer/manifest/v1alpha1.Controller", "github.com/gardener/landscaper/apis/deployer/manifest/v1alpha1.ExportConfiguration", "github.com/gardener/landscaper/apis/deployer/manifest/v1alpha1.HPAConfiguration"},
+			"github.com/gardener/landscaper/apis/config/v1alpha1.TargetResolversConfiguration", "github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector", "github.com/gardener/landscaper/apis/deployer/manifest/v1alpha1.Controller", "github.com/gardener/landscaper/apis/deployer/manifest/v1alpha1.ExportConfiguration", "github.com/gardener/landscaper/apis/deployer/manifest/v1alpha1.HPAConfiguration"},
 	}
 }
 
@@ -15019,6 +18631,12 @@ func schema_apis_deployer_manifest_v1alpha2_Configuration(ref common.ReferenceCa
 							},
 						},
 					},
+					"targetResolvers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetResolvers enables built-in target resolvers that are disabled by default.",
+							Ref:         ref("github.com/gardener/landscaper/apis/config/v1alpha1.TargetResolversConfiguration"),
+						},
+					},
 					"export": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Export defines the export configuration.",
@@ -15043,7 +18661,7 @@ func schema_apis_deployer_manifest_v1alpha2_Configuration(ref common.ReferenceCa
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector", "github.com/gardener/landscaper/apis/deployer/manifest/v1alpha2.Controller", "github.com/gardener/landscaper/apis/deployer/manifest/v1alpha2.ExportConfiguration", "github.com/gardener/landscaper/apis/deployer/manifest/v1alpha2.HPAConfiguration"},
+			"github.com/gardener/landscaper/apis/config/v1alpha1.TargetResolversConfiguration", "github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector", "github.com/gardener/landscaper/apis/deployer/manifest/v1alpha2.Controller", "github.com/gardener/landscaper/apis/deployer/manifest/v1alpha2.ExportConfiguration", "github.com/gardener/landscaper/apis/deployer/manifest/v1alpha2.HPAConfiguration"},
 	}
 }
 
@@ -15291,11 +18909,17 @@ func schema_landscaper_apis_deployer_mock_Configuration(ref common.ReferenceCall
 							},
 						},
 					},
+					"targetResolvers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetResolvers enables built-in target resolvers that are disabled by default.",
+							Ref:         ref("github.com/gardener/landscaper/apis/config/v1alpha1.TargetResolversConfiguration"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector"},
+			"github.com/gardener/landscaper/apis/config/v1alpha1.TargetResolversConfiguration", "github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector"},
 	}
 }
 
@@ -15403,11 +19027,17 @@ func schema_apis_deployer_mock_v1alpha1_Configuration(ref common.ReferenceCallba
 							},
 						},
 					},
+					"targetResolvers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetResolvers enables built-in target resolvers that are disabled by default.",
+							Ref:         ref("github.com/gardener/landscaper/apis/config/v1alpha1.TargetResolversConfiguration"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector"},
+			"github.com/gardener/landscaper/apis/config/v1alpha1.TargetResolversConfiguration", "github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector"},
 	}
 }
 
@@ -15599,12 +19229,24 @@ func schema_apis_deployer_utils_managedresource_Export(ref common.ReferenceCallb
 							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.TypedObjectReference"),
 						},
 					},
+					"fromResources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FromResources selects multiple resources from which the values are read. The values of all selected resources are exported as list ordered by the namespace and name of the resources. FromResource and FromResources are mutually exclusive.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.ResourceSelector"),
+						},
+					},
 					"fromObjectRef": {
 						SchemaProps: spec.SchemaProps{
 							Description: "FromObjectReference describes that the jsonpath points to a object reference where the actual value is read from. This is helpful if for example a deployed resource referenced a secret and that exported value is in that secret.",
 							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.FromObjectReference"),
 						},
 					},
+					"waitFor": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WaitFor defines a condition that the exported value has to fulfill. The export is retried until the condition is fulfilled or the timeout of the deploy item is exceeded. If the values are read from multiple resources, the value of every selected resource has to fulfill the condition.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.ExportCondition"),
+						},
+					},
 					"targetName": {
 						SchemaProps: spec.SchemaProps{
 							Description: "TargetName specifies the target from which the objects for the export are read. The value typically comes from a target import parameter, for example: {{.imports.myCluster.metadata.name}}. TargetName is optional; the default is the target specified in the deployitem.",
@@ -15617,7 +19259,42 @@ func schema_apis_deployer_utils_managedresource_Export(ref common.ReferenceCallb
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.TypedObjectReference", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.FromObjectReference"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.TypedObjectReference", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.ExportCondition", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.FromObjectReference", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.ResourceSelector"},
+	}
+}
+
+func schema_apis_deployer_utils_managedresource_ExportCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ExportCondition describes a condition that an exported value has to fulfill. All defined checks have to be fulfilled.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"notEmpty": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NotEmpty requires the value to be set and not to be an empty string, list or map.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"matches": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Matches is a regular expression that the value has to match. Values that are not strings are matched in their json encoded form.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"equals": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Equals is the value that the exported value has to be equal to.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"},
 	}
 }
 
@@ -15835,6 +19512,58 @@ func schema_apis_deployer_utils_managedresource_PredefinedResourceGroup(ref comm
 	}
 }
 
+func schema_apis_deployer_utils_managedresource_ResourceSelector(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ResourceSelector selects multiple resources of the same kind.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion is the group and version of the selected resources.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is the kind of the selected resources.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace is the namespace of the selected resources. Resources of all namespaces are selected if the namespace is empty.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"labelSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LabelSelector selects the resources by their labels. All resources of the kind are selected if no label selector is defined.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+					"minCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MinCount is the minimal number of resources that have to be selected. The export is retried until enough resources exist. Defaults to 1.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"apiVersion", "kind"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+	}
+}
+
 func schema_apis_deployer_utils_managedresource_ResourceType(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -95,6 +95,10 @@ oci:
 targetSelector:
 {{ toYaml . }}
 {{- end }}
+{{- with .Values.deployer.targetResolvers }}
+targetResolvers:
+{{ toYaml . | indent 2 }}
+{{- end }}
 {{- if .Values.hpa }}
 hpa:
 {{ .Values.hpa | toYaml | indent 2 }}
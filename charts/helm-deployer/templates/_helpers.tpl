@@ -87,6 +87,10 @@ oci:
 targetSelector:
 {{ toYaml . }}
 {{- end }}
+{{- with .Values.deployer.targetResolvers }}
+targetResolvers:
+{{ toYaml . | indent 2 }}
+{{- end }}
 {{- if .Values.hpa }}
 hpa:
 {{ .Values.hpa | toYaml | indent 2 }}
@@ -95,6 +99,10 @@ hpa:
 controller:
 {{ .Values.deployer.controller | toYaml | indent 2 }}
 {{- end }}
+{{- if .Values.deployer.helmChartRepoIndexCache }}
+helmChartRepoIndexCache:
+{{ .Values.deployer.helmChartRepoIndexCache | toYaml | indent 2 }}
+{{- end }}
 {{- end }}
 
 {{- define "deployer-image" -}}
@@ -152,6 +152,10 @@ crdManagement:
     forceUpdate: {{ .Values.landscaper.crdManagement.forceUpdate }}
     {{- end }}
 {{- end }}
+{{- if .Values.landscaper.jsonSchema }}
+jsonSchema:
+{{ toYaml .Values.landscaper.jsonSchema | indent 2 }}
+{{- end }}
 
 {{- if .Values.landscaper.deployerManagement }}
 {{- if .Values.landscaper.deployerManagement.agent.name }}
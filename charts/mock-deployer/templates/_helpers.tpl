@@ -75,6 +75,10 @@ identity: {{ .Values.deployer.identity }}
 targetSelector:
 {{ toYaml . }}
 {{- end }}
+{{- with .Values.deployer.targetResolvers }}
+targetResolvers:
+{{ toYaml . | indent 2 }}
+{{- end }}
 {{- end }}
 
 {{- define "deployer-image" -}}
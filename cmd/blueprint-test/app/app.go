@@ -0,0 +1,106 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/gardener/landscaper/pkg/utils/landscaper/blueprinttest"
+	"github.com/gardener/landscaper/pkg/version"
+)
+
+func NewBlueprintTestCommand(ctx context.Context) *cobra.Command {
+	options := NewOptions()
+
+	cmd := &cobra.Command{
+		Use:   "blueprint-test [blueprint-dir...]",
+		Short: "Runs the declarative test cases of blueprints without a cluster",
+		Long: `Runs the declarative test cases that are shipped alongside blueprints.
+Every test case defines the imports of the blueprint and the expected rendered deploy items, exports or errors.
+The results can be written as JUnit XML and JSON report.`,
+		Version: version.Get().GitVersion,
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := options.Complete(args); err != nil {
+				fmt.Print(err)
+				os.Exit(1)
+			}
+			if err := options.run(ctx, cmd.OutOrStdout()); err != nil {
+				fmt.Println(err)
+				os.Exit(1)
+			}
+		},
+	}
+
+	options.AddFlags(cmd.Flags())
+
+	return cmd
+}
+
+func (o *options) run(ctx context.Context, out io.Writer) error {
+	suites := make([]*blueprinttest.SuiteResult, 0, len(o.blueprintDirs))
+	for _, blueprintDir := range o.blueprintDirs {
+		runner := blueprinttest.NewRunner(blueprintDir)
+		if len(o.testsDir) != 0 {
+			runner.WithTestsDir(o.testsDir)
+		}
+		suite, err := runner.Run(ctx)
+		if err != nil {
+			return err
+		}
+		suites = append(suites, suite)
+		printSuite(out, suite)
+	}
+
+	if len(o.junitReport) != 0 {
+		if err := writeReport(o.junitReport, func(w io.Writer) error {
+			return blueprinttest.WriteJUnitReport(w, suites...)
+		}); err != nil {
+			return err
+		}
+	}
+	if len(o.jsonReport) != 0 {
+		if err := writeReport(o.jsonReport, func(w io.Writer) error {
+			return blueprinttest.WriteJSONReport(w, suites...)
+		}); err != nil {
+			return err
+		}
+	}
+
+	for _, suite := range suites {
+		if !suite.Passed() {
+			return fmt.Errorf("blueprint tests failed")
+		}
+	}
+	return nil
+}
+
+func printSuite(out io.Writer, suite *blueprinttest.SuiteResult) {
+	_, _ = fmt.Fprintf(out, "%s\n", suite.Name)
+	for _, result := range suite.Results {
+		switch {
+		case len(result.Error) != 0:
+			_, _ = fmt.Fprintf(out, "  ERROR %s: %s\n", result.Name, result.Error)
+		case !result.Passed:
+			_, _ = fmt.Fprintf(out, "  FAIL  %s\n%s", result.Name, blueprinttest.FormatFailures(result.Failures))
+		default:
+			_, _ = fmt.Fprintf(out, "  PASS  %s\n", result.Name)
+		}
+	}
+	_, _ = fmt.Fprintf(out, "%d tests, %d failed, %d errors\n", suite.Tests, suite.Failed, suite.Errors)
+}
+
+func writeReport(path string, write func(w io.Writer) error) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("unable to create report %q: %w", path, err)
+	}
+	defer file.Close()
+	return write(file)
+}
@@ -0,0 +1,49 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"errors"
+
+	flag "github.com/spf13/pflag"
+)
+
+type options struct {
+	// blueprintDirs are the directories of the blueprints that should be tested.
+	blueprintDirs []string
+	// testsDir is an optional directory containing the test cases.
+	// Defaults to the "tests" directory inside of the blueprint directory.
+	testsDir string
+	// junitReport is the optional path of the JUnit XML report.
+	junitReport string
+	// jsonReport is the optional path of the JSON report.
+	jsonReport string
+}
+
+func NewOptions() *options {
+	return &options{}
+}
+
+func (o *options) AddFlags(fs *flag.FlagSet) {
+	fs.StringVar(&o.testsDir, "tests-dir", "", "directory containing the test cases; defaults to the \"tests\" directory of each blueprint")
+	fs.StringVar(&o.junitReport, "junit-report", "", "path of the JUnit XML report")
+	fs.StringVar(&o.jsonReport, "json-report", "", "path of the JSON report")
+}
+
+// Complete parses all options and flags and initializes the basic functions
+func (o *options) Complete(args []string) error {
+	o.blueprintDirs = args
+	return o.validate()
+}
+
+func (o *options) validate() error {
+	if len(o.blueprintDirs) == 0 {
+		return errors.New("at least one blueprint directory has to be provided")
+	}
+	if len(o.testsDir) != 0 && len(o.blueprintDirs) > 1 {
+		return errors.New("a tests directory can only be defined for a single blueprint")
+	}
+	return nil
+}
@@ -10,8 +10,10 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
 	containerctlr "github.com/gardener/landscaper/pkg/deployer/container"
+	"github.com/gardener/landscaper/pkg/utils/tracing"
 	"github.com/gardener/landscaper/pkg/version"
 )
 
@@ -36,6 +38,11 @@ func NewContainerDeployerControllerCommand(ctx context.Context) *cobra.Command {
 
 func (o *options) run(ctx context.Context) error {
 	o.DeployerOptions.Log.Info("Starting Container Deployer", lc.KeyVersion, version.Get().GitVersion)
+	stopTracing, err := tracing.Setup(logging.NewContext(ctx, o.DeployerOptions.Log), "container-deployer")
+	if err != nil {
+		return err
+	}
+	defer stopTracing()
 
 	gc, err := containerctlr.AddControllerToManager(
 		o.DeployerOptions.LsUncachedClient, o.DeployerOptions.LsCachedClient, o.DeployerOptions.HostUncachedClient, o.DeployerOptions.HostCachedClient,
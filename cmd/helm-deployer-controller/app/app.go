@@ -19,6 +19,7 @@ import (
 	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
 	helmctrl "github.com/gardener/landscaper/pkg/deployer/helm"
 	"github.com/gardener/landscaper/pkg/utils"
+	"github.com/gardener/landscaper/pkg/utils/tracing"
 	"github.com/gardener/landscaper/pkg/version"
 )
 
@@ -43,6 +44,12 @@ func NewHelmDeployerControllerCommand(ctx context.Context) *cobra.Command {
 
 func (o *options) run(ctx context.Context) error {
 	o.DeployerOptions.Log.Info("Starting helm deployer", lc.KeyVersion, version.Get().GitVersion)
+	stopTracing, err := tracing.Setup(logging.NewContext(ctx, o.DeployerOptions.Log), "helm-deployer")
+	if err != nil {
+		return err
+	}
+	defer stopTracing()
+
 	if err := helmctrl.AddDeployerToManager(
 		o.DeployerOptions.LsUncachedClient, o.DeployerOptions.LsCachedClient, o.DeployerOptions.HostUncachedClient, o.DeployerOptions.HostCachedClient,
 		o.DeployerOptions.FinishedObjectCache,
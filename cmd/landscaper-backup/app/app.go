@@ -0,0 +1,225 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"context"
+	goflag "flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/landscaper/pkg/api"
+	"github.com/gardener/landscaper/pkg/landscaper/backup"
+	"github.com/gardener/landscaper/pkg/version"
+)
+
+func NewLandscaperBackupCommand(ctx context.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "landscaper-backup",
+		Short: "Backs up and restores the landscaper objects of a landscaper resource cluster",
+		Long: `Backs up and restores the installations, executions, deploy items, data objects, targets, contexts,
+secrets and config maps of a set of namespaces, together with the state secrets of the helm and container deployer.
+The restore preserves the job ids of the objects, so that nothing is re-run unnecessarily.
+The cluster is defined by the --kubeconfig flag or the KUBECONFIG environment variable.`,
+		Version: version.Get().GitVersion,
+	}
+
+	cmd.PersistentFlags().AddGoFlagSet(goflag.CommandLine)
+
+	cmd.AddCommand(newCreateCommand(ctx))
+	cmd.AddCommand(newRestoreCommand(ctx))
+	cmd.AddCommand(newValidateCommand(ctx))
+
+	return cmd
+}
+
+func newCreateCommand(ctx context.Context) *cobra.Command {
+	options := &createOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Creates a backup of the landscaper objects of a set of namespaces",
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := options.validate(); err != nil {
+				fmt.Println(err)
+				os.Exit(1)
+			}
+			if err := options.run(ctx, cmd.OutOrStdout()); err != nil {
+				fmt.Println(err)
+				os.Exit(1)
+			}
+		},
+	}
+
+	options.AddFlags(cmd.Flags())
+
+	return cmd
+}
+
+func (o *createOptions) run(ctx context.Context, out io.Writer) error {
+	c, err := newClient()
+	if err != nil {
+		return err
+	}
+
+	b, err := backup.Create(ctx, c, backup.BackupOptions{
+		Namespaces:              o.namespaces,
+		DeployerStateNamespaces: o.deployerStateNamespaces,
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(o.output) == 0 {
+		return b.Write(out)
+	}
+
+	file, err := os.Create(o.output)
+	if err != nil {
+		return fmt.Errorf("unable to create backup file %q: %w", o.output, err)
+	}
+	defer file.Close()
+	if err := b.Write(file); err != nil {
+		return err
+	}
+	_, _ = fmt.Fprintf(os.Stderr, "backed up %d objects of the namespaces %v to %s\n", len(b.Objects), b.Namespaces, o.output)
+	return nil
+}
+
+func newRestoreCommand(ctx context.Context) *cobra.Command {
+	options := &restoreOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "restore",
+		Short: "Restores the landscaper objects of a backup",
+		Long: `Restores the landscaper objects of a backup and validates the restored objects.
+The installations, executions and deploy items are restored with the ignore annotation.
+If the validation succeeds, the annotation is removed afterwards, unless --keep-ignored is set.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := options.validate(); err != nil {
+				fmt.Println(err)
+				os.Exit(1)
+			}
+			if err := options.run(ctx, cmd.OutOrStdout()); err != nil {
+				fmt.Println(err)
+				os.Exit(1)
+			}
+		},
+	}
+
+	options.AddFlags(cmd.Flags())
+
+	return cmd
+}
+
+func (o *restoreOptions) run(ctx context.Context, out io.Writer) error {
+	b, err := readBackup(o.input)
+	if err != nil {
+		return err
+	}
+	c, err := newClient()
+	if err != nil {
+		return err
+	}
+
+	result, err := backup.Restore(ctx, c, b)
+	if err != nil {
+		return err
+	}
+	for _, key := range result.Skipped {
+		_, _ = fmt.Fprintf(out, "skipped %s, it already exists\n", key)
+	}
+	_, _ = fmt.Fprintf(out, "restored %d objects, skipped %d objects\n", len(result.Created), len(result.Skipped))
+
+	if err := validate(ctx, out, c, b); err != nil {
+		return err
+	}
+
+	if o.keepIgnored {
+		_, _ = fmt.Fprintln(out, "the restored objects keep the ignore annotation")
+		return nil
+	}
+	if err := backup.Release(ctx, c, b); err != nil {
+		return err
+	}
+	_, _ = fmt.Fprintln(out, "removed the ignore annotation from the restored objects")
+	return nil
+}
+
+func newValidateCommand(ctx context.Context) *cobra.Command {
+	options := &validateOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "validate",
+		Short: "Validates the consistency of the restored objects of a backup",
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := options.validate(); err != nil {
+				fmt.Println(err)
+				os.Exit(1)
+			}
+			if err := options.run(ctx, cmd.OutOrStdout()); err != nil {
+				fmt.Println(err)
+				os.Exit(1)
+			}
+		},
+	}
+
+	options.AddFlags(cmd.Flags())
+
+	return cmd
+}
+
+func (o *validateOptions) run(ctx context.Context, out io.Writer) error {
+	b, err := readBackup(o.input)
+	if err != nil {
+		return err
+	}
+	c, err := newClient()
+	if err != nil {
+		return err
+	}
+	return validate(ctx, out, c, b)
+}
+
+func validate(ctx context.Context, out io.Writer, c client.Client, b *backup.Backup) error {
+	problems, err := backup.Validate(ctx, c, b)
+	if err != nil {
+		return err
+	}
+	for _, problem := range problems {
+		_, _ = fmt.Fprintf(out, "  %s\n", problem)
+	}
+	if len(problems) != 0 {
+		return fmt.Errorf("validation of the restored objects failed with %d problems", len(problems))
+	}
+	_, _ = fmt.Fprintln(out, "the restored objects are consistent")
+	return nil
+}
+
+func newClient() (client.Client, error) {
+	restConfig, err := ctrl.GetConfig()
+	if err != nil {
+		return nil, fmt.Errorf("unable to get kubeconfig: %w", err)
+	}
+	c, err := client.New(restConfig, client.Options{Scheme: api.LandscaperScheme})
+	if err != nil {
+		return nil, fmt.Errorf("unable to create client: %w", err)
+	}
+	return c, nil
+}
+
+func readBackup(path string) (*backup.Backup, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open backup file %q: %w", path, err)
+	}
+	defer file.Close()
+	return backup.Read(file)
+}
@@ -0,0 +1,70 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"errors"
+
+	flag "github.com/spf13/pflag"
+)
+
+type createOptions struct {
+	// namespaces are the namespaces whose landscaper objects are backed up.
+	namespaces []string
+	// deployerStateNamespaces are the namespaces from which the state secrets of the deployers are backed up.
+	deployerStateNamespaces []string
+	// output is the path of the backup file.
+	output string
+}
+
+func (o *createOptions) AddFlags(fs *flag.FlagSet) {
+	fs.StringSliceVar(&o.namespaces, "namespaces", nil, "namespaces whose landscaper objects are backed up")
+	fs.StringSliceVar(&o.deployerStateNamespaces, "deployer-state-namespaces", nil,
+		"namespaces from which the helm release secrets and the container deployer state secrets are backed up")
+	fs.StringVarP(&o.output, "output", "o", "", "path of the backup file; defaults to stdout")
+}
+
+func (o *createOptions) validate() error {
+	if len(o.namespaces) == 0 {
+		return errors.New("at least one namespace has to be provided")
+	}
+	return nil
+}
+
+type restoreOptions struct {
+	// input is the path of the backup file.
+	input string
+	// keepIgnored defines whether the restored objects keep the ignore annotation after the restore.
+	keepIgnored bool
+}
+
+func (o *restoreOptions) AddFlags(fs *flag.FlagSet) {
+	fs.StringVarP(&o.input, "input", "i", "", "path of the backup file")
+	fs.BoolVar(&o.keepIgnored, "keep-ignored", false,
+		"keep the ignore annotation at the restored installations, executions and deploy items")
+}
+
+func (o *restoreOptions) validate() error {
+	if len(o.input) == 0 {
+		return errors.New("the backup file has to be provided")
+	}
+	return nil
+}
+
+type validateOptions struct {
+	// input is the path of the backup file.
+	input string
+}
+
+func (o *validateOptions) AddFlags(fs *flag.FlagSet) {
+	fs.StringVarP(&o.input, "input", "i", "", "path of the backup file")
+}
+
+func (o *validateOptions) validate() error {
+	if len(o.input) == 0 {
+		return errors.New("the backup file has to be provided")
+	}
+	return nil
+}
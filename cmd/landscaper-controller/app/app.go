@@ -12,6 +12,7 @@ import (
 	"os"
 	"time"
 
+	"github.com/mandelsoft/vfs/pkg/osfs"
 	"github.com/spf13/cobra"
 	"golang.org/x/sync/errgroup"
 	"k8s.io/client-go/tools/clientcmd"
@@ -25,19 +26,26 @@ import (
 	"sigs.k8s.io/yaml"
 
 	"github.com/gardener/landscaper/apis/core/install"
+	"github.com/gardener/landscaper/apis/core/v1alpha1/targettypes"
+	builtinresolver "github.com/gardener/landscaper/controller-utils/pkg/landscaper/targetresolver/builtin"
+	genericresolver "github.com/gardener/landscaper/controller-utils/pkg/landscaper/targetresolver/generic"
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	"github.com/gardener/landscaper/pkg/landscaper/blueprints"
 	contextctrl "github.com/gardener/landscaper/pkg/landscaper/controllers/context"
 	deployitemctrl "github.com/gardener/landscaper/pkg/landscaper/controllers/deployitem"
 	executionactrl "github.com/gardener/landscaper/pkg/landscaper/controllers/execution"
 	"github.com/gardener/landscaper/pkg/landscaper/controllers/healthcheck"
 	installationsctrl "github.com/gardener/landscaper/pkg/landscaper/controllers/installations"
+	"github.com/gardener/landscaper/pkg/landscaper/controllers/targethealth"
 	"github.com/gardener/landscaper/pkg/landscaper/controllers/targetsync"
 	"github.com/gardener/landscaper/pkg/landscaper/crdmanager"
+	"github.com/gardener/landscaper/pkg/landscaper/jsonschema"
 	"github.com/gardener/landscaper/pkg/metrics"
 	lsutils "github.com/gardener/landscaper/pkg/utils"
 	"github.com/gardener/landscaper/pkg/utils/lock"
 	"github.com/gardener/landscaper/pkg/utils/monitoring"
+	"github.com/gardener/landscaper/pkg/utils/tracing"
 	"github.com/gardener/landscaper/pkg/version"
 )
 
@@ -76,6 +84,12 @@ func (o *Options) run(ctx context.Context) error {
 	}
 	_, _ = fmt.Fprintln(os.Stderr, string(configBytes))
 
+	stopTracing, err := tracing.Setup(logging.NewContext(ctx, setupLogger), "landscaper-controller")
+	if err != nil {
+		return err
+	}
+	defer stopTracing()
+
 	hostAndResourceClusterDifferent := len(o.landscaperKubeconfigPath) > 0
 
 	burst, qps := lsutils.GetHostClientRequestRestrictions(setupLogger, hostAndResourceClusterDifferent)
@@ -135,6 +149,9 @@ func (o *Options) run(ctx context.Context) error {
 		}
 	}
 	install.Install(lsMgr.GetScheme())
+	// targets with a resolverRef to the serviceaccount-token resolver are resolved against the landscaper resource cluster
+	genericresolver.DefaultRegistry.Register(targettypes.ServiceAccountTokenResolverName,
+		builtinresolver.NewServiceAccountTokenResolver(lsMgr.GetConfig()))
 
 	lsUncachedClient, lsCachedClient, hostUncachedClient, hostCachedClient, err := lsutils.ClientsFromManagers(lsMgr, hostMgr)
 	if err != nil {
@@ -154,6 +171,19 @@ func (o *Options) startMainController(ctx context.Context,
 	lsUncachedClient, lsCachedClient, hostUncachedClient, hostCachedClient client.Client,
 	lsMgr, hostMgr manager.Manager, ctrlLogger, setupLogger logging.Logger) error {
 
+	blueprints.RegisterStoreMetrics(controllerruntimeMetrics.Registry)
+	store, err := blueprints.NewStore(ctrlLogger, osfs.New(), o.Config.BlueprintStore)
+	if err != nil {
+		return fmt.Errorf("unable to setup blueprint store: %w", err)
+	}
+	defer store.Close()
+	blueprints.SetStore(store)
+	setupLogger.Info("Blueprint store initialized", "path", o.Config.BlueprintStore.Path, "indexMethod", store.IndexMethod())
+
+	if o.Config.JSONSchema != nil {
+		jsonschema.SetAssertAllFormats(o.Config.JSONSchema.AssertAllFormats)
+	}
+
 	if err := installationsctrl.AddControllerToManager(lsUncachedClient, lsCachedClient, hostUncachedClient, hostCachedClient,
 		ctrlLogger, lsMgr, o.Config, "installations"); err != nil {
 		return fmt.Errorf("unable to setup installation controller: %w", err)
@@ -223,6 +253,10 @@ func (o *Options) startCentralLandscaper(ctx context.Context,
 		return fmt.Errorf("unable to register target sync controller: %w", err)
 	}
 
+	if err := targethealth.AddControllerToManager(lsUncachedClient, lsCachedClient, ctrlLogger, lsMgr, o.Config.Controllers.Targets); err != nil {
+		return fmt.Errorf("unable to register target health controller: %w", err)
+	}
+
 	eg, ctx := errgroup.WithContext(ctx)
 
 	if os.Getenv("ENABLE_PROFILER") == "true" {
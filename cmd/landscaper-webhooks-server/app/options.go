@@ -68,11 +68,23 @@ var defaultWebhooks = webhooklib.NewWebhookRegistry().
 		Operations:    webhooklib.Operations(webhooklib.CREATE, webhooklib.UPDATE),
 		LabelSelector: landscaperSkipValidationSelector,
 		Process:       webhook.TargetWebhookLogic,
+	}).
+	Register(&webhooklib.Webhook{
+		Name:          "contexts",
+		Type:          webhooklib.ValidatingWebhook,
+		APIGroup:      core.GroupName,
+		APIVersions:   []string{"v1alpha1"},
+		ResourceName:  "contexts",
+		Operations:    webhooklib.Operations(webhooklib.CREATE, webhooklib.UPDATE),
+		LabelSelector: landscaperSkipValidationSelector,
+		Process:       webhook.ContextWebhookLogic,
 	})
 
 type options struct {
-	log           logging.Logger
-	webhookConfig *webhooklib.WebhookFlags
+	log                       logging.Logger
+	webhookConfig             *webhooklib.WebhookFlags
+	approverGroups            []string
+	allowedExecTargetCommands []string
 }
 
 func NewOptions() *options {
@@ -83,6 +95,8 @@ func NewOptions() *options {
 
 func (o *options) AddFlags(fs *flag.FlagSet) {
 	o.webhookConfig.AddFlags(fs)
+	fs.StringSliceVar(&o.approverGroups, "approver-groups", nil, "Specify the groups whose members are allowed to approve deploy item jobs")
+	fs.StringSliceVar(&o.allowedExecTargetCommands, "allowed-exec-target-commands", nil, "Specify the commands that targets may use with the exec target resolver")
 	logging.InitFlags(fs)
 	flag.CommandLine.AddGoFlagSet(goflag.CommandLine)
 }
@@ -95,6 +109,9 @@ func (o *options) Complete() error {
 	}
 	o.log = log
 
+	defaultWebhooks["deployitems"].Process = webhook.NewDeployItemWebhookLogic(o.approverGroups)
+	defaultWebhooks["targets"].Process = webhook.NewTargetWebhookLogic(o.allowedExecTargetCommands)
+
 	err = o.webhookConfig.Complete(defaultWebhooks)
 	if err != nil {
 		return err
@@ -0,0 +1,68 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package builtin
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/apis/core/v1alpha1/targettypes"
+)
+
+// defaultExecAPIVersion is the default api version of the ExecCredential that is expected from exec credential plugins.
+const defaultExecAPIVersion = "client.authentication.k8s.io/v1"
+
+// ExecResolver resolves targets to a kubeconfig that uses an exec credential plugin.
+// The plugin is executed by the client that uses the resolved kubeconfig, i.e. in the deployer.
+type ExecResolver struct {
+	// AllowedCommands are the commands that targets may use as exec credential plugin.
+	AllowedCommands sets.Set[string]
+}
+
+// NewExecResolver creates a new exec resolver that only resolves targets with one of the given commands.
+func NewExecResolver(allowedCommands []string) *ExecResolver {
+	return &ExecResolver{
+		AllowedCommands: sets.New[string](allowedCommands...),
+	}
+}
+
+func (r *ExecResolver) Resolve(_ context.Context, _ client.Client, target *lsv1alpha1.Target) (*lsv1alpha1.ResolvedTarget, error) {
+	config := &targettypes.ExecResolverConfig{}
+	if err := parseResolverConfig(target, config); err != nil {
+		return nil, err
+	}
+	if len(config.Server) == 0 {
+		return nil, fmt.Errorf("no server defined")
+	}
+	if len(config.Command) == 0 {
+		return nil, fmt.Errorf("no command defined")
+	}
+	if !r.AllowedCommands.Has(config.Command) {
+		return nil, fmt.Errorf("command %q is not allowed", config.Command)
+	}
+
+	execConfig := &clientcmdapi.ExecConfig{
+		APIVersion:      config.APIVersion,
+		Command:         config.Command,
+		Args:            config.Args,
+		InteractiveMode: clientcmdapi.NeverExecInteractiveMode,
+	}
+	if len(execConfig.APIVersion) == 0 {
+		execConfig.APIVersion = defaultExecAPIVersion
+	}
+	for _, env := range config.Env {
+		execConfig.Env = append(execConfig.Env, clientcmdapi.ExecEnvVar{Name: env.Name, Value: env.Value})
+	}
+
+	kubeconfig := newKubeconfig(config.Server, config.CAData, false, &clientcmdapi.AuthInfo{
+		Exec: execConfig,
+	})
+	return newResolvedKubernetesClusterTarget(target, kubeconfig)
+}
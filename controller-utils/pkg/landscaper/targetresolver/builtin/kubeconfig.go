@@ -0,0 +1,79 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package builtin
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/yaml"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/apis/core/v1alpha1/targettypes"
+)
+
+const kubeconfigContextName = "default"
+
+// parseResolverConfig decodes the configuration of the resolverRef of a target.
+func parseResolverConfig(target *lsv1alpha1.Target, config interface{}) error {
+	if target.Spec.ResolverRef == nil || target.Spec.ResolverRef.Config == nil {
+		return fmt.Errorf("target contains no resolver configuration")
+	}
+	if err := yaml.Unmarshal(target.Spec.ResolverRef.Config.RawMessage, config); err != nil {
+		return fmt.Errorf("unable to parse resolver configuration: %w", err)
+	}
+	return nil
+}
+
+// newKubeconfig creates a kubeconfig with a single cluster, user and context.
+func newKubeconfig(server string, caData []byte, insecure bool, authInfo *clientcmdapi.AuthInfo) *clientcmdapi.Config {
+	cfg := clientcmdapi.NewConfig()
+	cfg.Clusters[kubeconfigContextName] = &clientcmdapi.Cluster{
+		Server:                   server,
+		CertificateAuthorityData: caData,
+		InsecureSkipTLSVerify:    insecure,
+	}
+	cfg.AuthInfos[kubeconfigContextName] = authInfo
+	cfg.Contexts[kubeconfigContextName] = &clientcmdapi.Context{
+		Cluster:  kubeconfigContextName,
+		AuthInfo: kubeconfigContextName,
+	}
+	cfg.CurrentContext = kubeconfigContextName
+	return cfg
+}
+
+// newKubernetesClusterContent returns the content of a resolved kubernetes-cluster target with the given kubeconfig.
+func newKubernetesClusterContent(kubeconfig []byte) (string, error) {
+	targetConfig := &targettypes.KubernetesClusterTargetConfig{
+		Kubeconfig: targettypes.ValueRef{StrVal: ptr.To(string(kubeconfig))},
+	}
+	data, err := json.Marshal(targetConfig)
+	if err != nil {
+		return "", fmt.Errorf("unable to marshal target configuration: %w", err)
+	}
+	return string(data), nil
+}
+
+// newResolvedKubernetesClusterTarget returns a resolved target that contains the given kubeconfig.
+func newResolvedKubernetesClusterTarget(target *lsv1alpha1.Target, kubeconfig *clientcmdapi.Config) (*lsv1alpha1.ResolvedTarget, error) {
+	data, err := clientcmd.Write(*kubeconfig)
+	if err != nil {
+		return nil, fmt.Errorf("unable to write kubeconfig: %w", err)
+	}
+	return newResolvedKubernetesClusterTargetFromBytes(target, data)
+}
+
+func newResolvedKubernetesClusterTargetFromBytes(target *lsv1alpha1.Target, kubeconfig []byte) (*lsv1alpha1.ResolvedTarget, error) {
+	content, err := newKubernetesClusterContent(kubeconfig)
+	if err != nil {
+		return nil, err
+	}
+	rt := lsv1alpha1.NewResolvedTarget(target)
+	rt.Content = content
+	return rt, nil
+}
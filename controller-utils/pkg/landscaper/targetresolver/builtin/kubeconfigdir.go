@@ -0,0 +1,52 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package builtin
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/apis/core/v1alpha1/targettypes"
+)
+
+// DefaultKubeconfigDirectory is the default directory from which the kubeconfig-directory resolver reads kubeconfigs.
+const DefaultKubeconfigDirectory = "/etc/landscaper/kubeconfigs"
+
+// KubeconfigDirectoryResolver resolves targets to a kubeconfig that is read from a directory,
+// e.g. a directory into which a secret is mounted.
+type KubeconfigDirectoryResolver struct {
+	// Directory is the directory that contains the kubeconfig files.
+	Directory string
+}
+
+// NewKubeconfigDirectoryResolver creates a new kubeconfig-directory resolver that reads kubeconfigs from the given directory.
+func NewKubeconfigDirectoryResolver(directory string) *KubeconfigDirectoryResolver {
+	return &KubeconfigDirectoryResolver{
+		Directory: directory,
+	}
+}
+
+func (r *KubeconfigDirectoryResolver) Resolve(_ context.Context, _ client.Client, target *lsv1alpha1.Target) (*lsv1alpha1.ResolvedTarget, error) {
+	config := &targettypes.KubeconfigDirectoryResolverConfig{}
+	if err := parseResolverConfig(target, config); err != nil {
+		return nil, err
+	}
+	// only plain file names are allowed so that targets cannot read arbitrary files of the deployer
+	if len(config.Name) == 0 || config.Name != filepath.Base(config.Name) || strings.HasPrefix(config.Name, ".") {
+		return nil, fmt.Errorf("invalid kubeconfig name %q: the name must be the name of a file in the kubeconfig directory", config.Name)
+	}
+
+	kubeconfig, err := os.ReadFile(filepath.Join(r.Directory, config.Name))
+	if err != nil {
+		return nil, fmt.Errorf("unable to read kubeconfig %q: %w", config.Name, err)
+	}
+	return newResolvedKubernetesClusterTargetFromBytes(target, kubeconfig)
+}
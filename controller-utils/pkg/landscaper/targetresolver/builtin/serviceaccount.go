@@ -0,0 +1,93 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package builtin
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/apis/core/v1alpha1/targettypes"
+)
+
+// defaultExpirationSeconds is the default expiration duration of the requested service account tokens.
+const defaultExpirationSeconds = 86400 // = 1 day
+
+// ServiceAccountTokenResolver resolves targets to a kubeconfig for the landscaper resource cluster.
+// The kubeconfig contains a token that is requested for a service account in the namespace of the target.
+type ServiceAccountTokenResolver struct {
+	// RestConfig is the rest config of the landscaper resource cluster.
+	// It defines the server and the certificate authority of the resolved kubeconfig.
+	RestConfig *rest.Config
+}
+
+// NewServiceAccountTokenResolver creates a new serviceaccount-token resolver for the landscaper resource cluster
+// with the given rest config.
+func NewServiceAccountTokenResolver(restConfig *rest.Config) *ServiceAccountTokenResolver {
+	return &ServiceAccountTokenResolver{
+		RestConfig: restConfig,
+	}
+}
+
+func (r *ServiceAccountTokenResolver) Resolve(ctx context.Context, c client.Client, target *lsv1alpha1.Target) (*lsv1alpha1.ResolvedTarget, error) {
+	if r.RestConfig == nil {
+		return nil, fmt.Errorf("the landscaper resource cluster is not known to the %s resolver", targettypes.ServiceAccountTokenResolverName)
+	}
+	if c == nil {
+		return nil, fmt.Errorf("the %s resolver requires a client for the landscaper resource cluster", targettypes.ServiceAccountTokenResolverName)
+	}
+
+	config := &targettypes.ServiceAccountTokenResolverConfig{}
+	if err := parseResolverConfig(target, config); err != nil {
+		return nil, err
+	}
+	if len(config.ServiceAccount.Name) == 0 {
+		return nil, fmt.Errorf("no service account defined")
+	}
+
+	expirationSeconds := config.ExpirationSeconds
+	if expirationSeconds == nil {
+		expirationSeconds = ptr.To[int64](defaultExpirationSeconds)
+	}
+
+	serviceAccount := &corev1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: target.Namespace,
+			Name:      config.ServiceAccount.Name,
+		},
+	}
+	tokenRequest := &authenticationv1.TokenRequest{
+		Spec: authenticationv1.TokenRequestSpec{
+			Audiences:         config.Audience,
+			ExpirationSeconds: expirationSeconds,
+		},
+	}
+	if err := c.SubResource("token").Create(ctx, serviceAccount, tokenRequest); err != nil {
+		return nil, fmt.Errorf("unable to create token for service account %s/%s: %w", target.Namespace, config.ServiceAccount.Name, err)
+	}
+
+	caData := r.RestConfig.CAData
+	if len(caData) == 0 && len(r.RestConfig.CAFile) != 0 {
+		var err error
+		caData, err = os.ReadFile(r.RestConfig.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read certificate authority of the landscaper resource cluster: %w", err)
+		}
+	}
+
+	kubeconfig := newKubeconfig(r.RestConfig.Host, caData, r.RestConfig.Insecure, &clientcmdapi.AuthInfo{
+		Token: tokenRequest.Status.Token,
+	})
+	return newResolvedKubernetesClusterTarget(target, kubeconfig)
+}
@@ -17,6 +17,9 @@ import (
 // GenericResolver is a generic targetresolver that checks which actual resolver is required and then uses it to resolve the Target.
 type GenericResolver struct {
 	Client client.Client
+	// Registry contains the resolvers for targets with resolverRef and for specific target types.
+	// The DefaultRegistry is used if no registry is set.
+	Registry *Registry
 }
 
 // New creates a new GenericResolver.
@@ -32,7 +35,25 @@ func New(c client.Client) *GenericResolver {
 func (gr GenericResolver) Resolve(ctx context.Context, target *lsv1alpha1.Target) (*lsv1alpha1.ResolvedTarget, error) {
 	var rt *lsv1alpha1.ResolvedTarget
 	var err error
-	if target.Spec.SecretRef != nil {
+	registry := gr.Registry
+	if registry == nil {
+		registry = DefaultRegistry
+	}
+	if target.Spec.ResolverRef != nil {
+		resolver, ok := registry.GetByName(target.Spec.ResolverRef.Name)
+		if !ok {
+			return nil, fmt.Errorf("target resolver %q of Target '%s/%s' is not registered", target.Spec.ResolverRef.Name, target.Namespace, target.Name)
+		}
+		rt, err = resolver.Resolve(ctx, gr.Client, target)
+		if err != nil {
+			return nil, fmt.Errorf("error resolving Target '%s/%s' with target resolver %q: %w", target.Namespace, target.Name, target.Spec.ResolverRef.Name, err)
+		}
+	} else if resolver, ok := registry.GetByTargetType(target.Spec.Type); ok {
+		rt, err = resolver.Resolve(ctx, gr.Client, target)
+		if err != nil {
+			return nil, fmt.Errorf("error resolving Target '%s/%s' with target resolver for type %q: %w", target.Namespace, target.Name, target.Spec.Type, err)
+		}
+	} else if target.Spec.SecretRef != nil {
 		if gr.Client == nil {
 			return nil, fmt.Errorf("target contains a secret reference, but secretresolver cannot be constructed because given client is nil")
 		}
@@ -0,0 +1,177 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package generic_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/apis/core/v1alpha1/targettypes"
+	"github.com/gardener/landscaper/controller-utils/pkg/landscaper/targetresolver/builtin"
+	"github.com/gardener/landscaper/controller-utils/pkg/landscaper/targetresolver/generic"
+)
+
+func TestConfig(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Generic Target Resolver Test Suite")
+}
+
+func newTarget(targetType lsv1alpha1.TargetType, resolverName, resolverConfig string) *lsv1alpha1.Target {
+	target := &lsv1alpha1.Target{}
+	target.Name = "my-target"
+	target.Namespace = "default"
+	target.Spec.Type = targetType
+	if len(resolverName) != 0 {
+		target.Spec.ResolverRef = &lsv1alpha1.TargetResolverReference{
+			Name:   resolverName,
+			Config: lsv1alpha1.NewAnyJSONPointer([]byte(resolverConfig)),
+		}
+	}
+	return target
+}
+
+func getKubeconfig(rt *lsv1alpha1.ResolvedTarget) []byte {
+	targetConfig := &targettypes.KubernetesClusterTargetConfig{}
+	Expect(yaml.Unmarshal([]byte(rt.Content), targetConfig)).To(Succeed())
+	Expect(targetConfig.Kubeconfig.StrVal).ToNot(BeNil())
+	return []byte(*targetConfig.Kubeconfig.StrVal)
+}
+
+var _ = Describe("GenericResolver", func() {
+
+	var (
+		ctx      context.Context
+		registry *generic.Registry
+		resolver *generic.GenericResolver
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		registry = generic.NewRegistry()
+		resolver = &generic.GenericResolver{Registry: registry}
+	})
+
+	It("should resolve a target with inline configuration without registered resolvers", func() {
+		target := newTarget(targettypes.KubernetesClusterTargetType, "", "")
+		target.Spec.Configuration = lsv1alpha1.NewAnyJSONPointer([]byte(`{"kubeconfig":"abc"}`))
+		rt, err := resolver.Resolve(ctx, target)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(rt.Content).To(Equal(`{"kubeconfig":"abc"}`))
+	})
+
+	It("should resolve a target with the resolver that is referenced by name", func() {
+		registry.Register("custom", generic.ResolverFunc(func(_ context.Context, _ client.Client, target *lsv1alpha1.Target) (*lsv1alpha1.ResolvedTarget, error) {
+			rt := lsv1alpha1.NewResolvedTarget(target)
+			rt.Content = string(target.Spec.ResolverRef.Config.RawMessage)
+			return rt, nil
+		}))
+		rt, err := resolver.Resolve(ctx, newTarget("example.com/vault", "custom", `{"path":"secret/a"}`))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(rt.Content).To(Equal(`{"path":"secret/a"}`))
+	})
+
+	It("should resolve a target with the resolver of its target type", func() {
+		registry.RegisterForTargetType("example.com/vault", generic.ResolverFunc(func(_ context.Context, _ client.Client, target *lsv1alpha1.Target) (*lsv1alpha1.ResolvedTarget, error) {
+			rt := lsv1alpha1.NewResolvedTarget(target)
+			rt.Content = "vault"
+			return rt, nil
+		}))
+		rt, err := resolver.Resolve(ctx, newTarget("example.com/vault", "", ""))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(rt.Content).To(Equal("vault"))
+	})
+
+	It("should fail if the referenced resolver is not registered", func() {
+		_, err := resolver.Resolve(ctx, newTarget(targettypes.KubernetesClusterTargetType, "unknown", `{}`))
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("not registered"))
+	})
+
+	It("should only contain the serviceaccount-token resolver in the default registry", func() {
+		_, ok := generic.DefaultRegistry.GetByName(targettypes.ServiceAccountTokenResolverName)
+		Expect(ok).To(BeTrue())
+		for _, name := range []string{targettypes.ExecResolverName, targettypes.KubeconfigDirectoryResolverName} {
+			_, ok := generic.DefaultRegistry.GetByName(name)
+			Expect(ok).To(BeFalse(), name)
+		}
+	})
+
+	It("should resolve a target to a kubeconfig with an exec credential plugin", func() {
+		registry.Register(targettypes.ExecResolverName, builtin.NewExecResolver([]string{"my-plugin"}))
+		rt, err := resolver.Resolve(ctx, newTarget(targettypes.KubernetesClusterTargetType, targettypes.ExecResolverName,
+			`{"server":"https://api.example.com","command":"my-plugin","args":["token"],"env":[{"name":"A","value":"b"}]}`))
+		Expect(err).ToNot(HaveOccurred())
+
+		kubeconfig, err := clientcmd.Load(getKubeconfig(rt))
+		Expect(err).ToNot(HaveOccurred())
+		kubeContext := kubeconfig.Contexts[kubeconfig.CurrentContext]
+		Expect(kubeconfig.Clusters[kubeContext.Cluster].Server).To(Equal("https://api.example.com"))
+		exec := kubeconfig.AuthInfos[kubeContext.AuthInfo].Exec
+		Expect(exec).ToNot(BeNil())
+		Expect(exec.Command).To(Equal("my-plugin"))
+		Expect(exec.Args).To(ConsistOf("token"))
+		Expect(exec.APIVersion).To(Equal("client.authentication.k8s.io/v1"))
+		Expect(exec.Env).To(HaveLen(1))
+	})
+
+	It("should fail to resolve a target with the exec resolver with a command that is not allowed", func() {
+		registry.Register(targettypes.ExecResolverName, builtin.NewExecResolver([]string{"my-plugin"}))
+		_, err := resolver.Resolve(ctx, newTarget(targettypes.KubernetesClusterTargetType, targettypes.ExecResolverName,
+			`{"server":"https://api.example.com","command":"/bin/sh","args":["-c","cat /var/run/secrets/kubernetes.io/serviceaccount/token"]}`))
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("not allowed"))
+	})
+
+	It("should fail to resolve a target with the exec resolver without command", func() {
+		registry.Register(targettypes.ExecResolverName, builtin.NewExecResolver([]string{"my-plugin"}))
+		_, err := resolver.Resolve(ctx, newTarget(targettypes.KubernetesClusterTargetType, targettypes.ExecResolverName,
+			`{"server":"https://api.example.com"}`))
+		Expect(err).To(HaveOccurred())
+	})
+
+	Context("kubeconfig-directory", func() {
+
+		var dir string
+
+		BeforeEach(func() {
+			var err error
+			dir, err = os.MkdirTemp("", "kubeconfigs-")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(os.WriteFile(filepath.Join(dir, "cluster-a"), []byte("kubeconfig-a"), 0600)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(filepath.Dir(dir), filepath.Base(dir)+"-secret"), []byte("secret"), 0600)).To(Succeed())
+			registry.Register(targettypes.KubeconfigDirectoryResolverName, builtin.NewKubeconfigDirectoryResolver(dir))
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(dir)).To(Succeed())
+			Expect(os.Remove(dir + "-secret")).To(Succeed())
+		})
+
+		It("should resolve a target to a kubeconfig of the directory", func() {
+			rt, err := resolver.Resolve(ctx, newTarget(targettypes.KubernetesClusterTargetType, targettypes.KubeconfigDirectoryResolverName,
+				`{"name":"cluster-a"}`))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(getKubeconfig(rt))).To(Equal("kubeconfig-a"))
+		})
+
+		It("should not resolve files outside of the directory", func() {
+			_, err := resolver.Resolve(ctx, newTarget(targettypes.KubernetesClusterTargetType, targettypes.KubeconfigDirectoryResolverName,
+				`{"name":"../`+filepath.Base(dir)+`-secret"}`))
+			Expect(err).To(HaveOccurred())
+			_, err = resolver.Resolve(ctx, newTarget(targettypes.KubernetesClusterTargetType, targettypes.KubeconfigDirectoryResolverName,
+				`{"name":"cluster-b"}`))
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
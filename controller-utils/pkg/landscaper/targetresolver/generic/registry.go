@@ -0,0 +1,90 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package generic
+
+import (
+	"context"
+	"sync"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/apis/core/v1alpha1/targettypes"
+	"github.com/gardener/landscaper/controller-utils/pkg/landscaper/targetresolver/builtin"
+)
+
+// Resolver resolves the content of a target.
+// Resolvers are registered once in a Registry, so that they get the client for the landscaper resource cluster with every call.
+type Resolver interface {
+	Resolve(ctx context.Context, c client.Client, target *lsv1alpha1.Target) (*lsv1alpha1.ResolvedTarget, error)
+}
+
+// ResolverFunc is a function that implements the Resolver interface.
+type ResolverFunc func(ctx context.Context, c client.Client, target *lsv1alpha1.Target) (*lsv1alpha1.ResolvedTarget, error)
+
+// Resolve implements the Resolver interface.
+func (f ResolverFunc) Resolve(ctx context.Context, c client.Client, target *lsv1alpha1.Target) (*lsv1alpha1.ResolvedTarget, error) {
+	return f(ctx, c, target)
+}
+
+// Registry contains target resolvers that are either referenced by name in the resolverRef of a target
+// or that are responsible for all targets of a target type.
+type Registry struct {
+	mux    sync.RWMutex
+	byName map[string]Resolver
+	byType map[lsv1alpha1.TargetType]Resolver
+}
+
+// NewRegistry creates a new empty registry.
+func NewRegistry() *Registry {
+	return &Registry{
+		byName: map[string]Resolver{},
+		byType: map[lsv1alpha1.TargetType]Resolver{},
+	}
+}
+
+// DefaultRegistry is the registry that is used by GenericResolvers without explicit registry.
+// It contains the built-in serviceaccount-token resolver.
+// The exec and kubeconfig-directory resolvers execute commands or read files of the process that resolves a target,
+// so they are not contained and have to be registered explicitly.
+var DefaultRegistry = newDefaultRegistry()
+
+func newDefaultRegistry() *Registry {
+	r := NewRegistry()
+	r.Register(targettypes.ServiceAccountTokenResolverName, builtin.NewServiceAccountTokenResolver(nil))
+	return r
+}
+
+// Register registers a resolver that is used for targets that reference it by name.
+// An already registered resolver with the same name is replaced.
+func (r *Registry) Register(name string, resolver Resolver) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	r.byName[name] = resolver
+}
+
+// RegisterForTargetType registers a resolver that is used for all targets of the given type without resolverRef.
+// An already registered resolver for the same type is replaced.
+func (r *Registry) RegisterForTargetType(targetType lsv1alpha1.TargetType, resolver Resolver) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	r.byType[targetType] = resolver
+}
+
+// GetByName returns the resolver with the given name.
+func (r *Registry) GetByName(name string) (Resolver, bool) {
+	r.mux.RLock()
+	defer r.mux.RUnlock()
+	resolver, ok := r.byName[name]
+	return resolver, ok
+}
+
+// GetByTargetType returns the resolver that is registered for the given target type.
+func (r *Registry) GetByTargetType(targetType lsv1alpha1.TargetType) (Resolver, bool) {
+	r.mux.RLock()
+	defer r.mux.RUnlock()
+	resolver, ok := r.byType[targetType]
+	return resolver, ok
+}
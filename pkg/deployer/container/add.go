@@ -56,13 +56,14 @@ func AddControllerToManager(lsUncachedClient, lsCachedClient, hostUncachedClient
 	err = deployerlib.Add(lsUncachedClient, lsCachedClient, hostUncachedClient, hostCachedClient,
 		finishedObjectCache,
 		log, lsMgr, hostMgr, deployerlib.DeployerArgs{
-			Name:            Name,
-			Version:         version.Get().String(),
-			Identity:        config.Identity,
-			Type:            Type,
-			Deployer:        containerDeployer,
-			TargetSelectors: config.TargetSelector,
-			Options:         options,
+			Name:                   Name,
+			Version:                version.Get().String(),
+			Identity:               config.Identity,
+			Type:                   Type,
+			Deployer:               containerDeployer,
+			TargetSelectors:        config.TargetSelector,
+			Options:                options,
+			BuiltinTargetResolvers: config.TargetResolvers,
 		}, config.Controller.Workers, lockingEnabled, callerName)
 	if err != nil {
 		return nil, err
@@ -9,6 +9,7 @@ import (
 
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	"github.com/gardener/landscaper/apis/deployer/container"
+	containerv1alpha1 "github.com/gardener/landscaper/apis/deployer/container/v1alpha1"
 	lserrors "github.com/gardener/landscaper/apis/errors"
 	"github.com/gardener/landscaper/pkg/deployer/lib/timeout"
 )
@@ -19,10 +20,13 @@ func (c *Container) Delete(ctx context.Context) error {
 		return err
 	}
 
-	// skip the deletion container when the force cleanup annotation is set
-	if _, ok := c.DeployItem.Annotations[container.ContainerDeployerOperationForceCleanupAnnotation]; !ok {
+	// skip the deletion container when the force cleanup annotation is set or the delete run is skipped
+	_, forceCleanup := c.DeployItem.Annotations[container.ContainerDeployerOperationForceCleanupAnnotation]
+	skipDeleteRun := c.ProviderConfiguration.OnDelete != nil && c.ProviderConfiguration.OnDelete.SkipDeleteRun
+	if !forceCleanup && !skipDeleteRun {
 		if c.ProviderStatus.LastOperation != string(container.OperationDelete) || c.DeployItem.Status.Phase != lsv1alpha1.DeployItemPhases.Succeeded {
 			// do default reconcile until the pod has finished
+			c.ProviderConfiguration = DeleteProviderConfiguration(c.ProviderConfiguration)
 			return c.Reconcile(ctx, container.OperationDelete)
 		}
 	}
@@ -37,3 +41,31 @@ func (c *Container) Delete(ctx context.Context) error {
 	}
 	return nil
 }
+
+// DeleteProviderConfiguration returns the provider configuration of the container that is executed on deletion.
+// The image, command, args and import values of the delete configuration replace the ones of the provider configuration.
+// The command and args of the provider configuration are not used if the delete configuration defines its own image.
+func DeleteProviderConfiguration(providerConfig *containerv1alpha1.ProviderConfiguration) *containerv1alpha1.ProviderConfiguration {
+	onDelete := providerConfig.OnDelete
+	if onDelete == nil {
+		return providerConfig
+	}
+
+	deleteConfig := providerConfig.DeepCopy()
+	if len(onDelete.Image) != 0 {
+		deleteConfig.Image = onDelete.Image
+		deleteConfig.Command = onDelete.Command
+		deleteConfig.Args = onDelete.Args
+	} else {
+		if onDelete.Command != nil {
+			deleteConfig.Command = onDelete.Command
+		}
+		if onDelete.Args != nil {
+			deleteConfig.Args = onDelete.Args
+		}
+	}
+	if onDelete.ImportValues != nil {
+		deleteConfig.ImportValues = onDelete.ImportValues
+	}
+	return deleteConfig
+}
@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package container_test
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	containerv1alpha1 "github.com/gardener/landscaper/apis/deployer/container/v1alpha1"
+	containerctlr "github.com/gardener/landscaper/pkg/deployer/container"
+)
+
+var _ = Describe("Delete Provider Configuration", func() {
+
+	var providerConfig *containerv1alpha1.ProviderConfiguration
+
+	BeforeEach(func() {
+		providerConfig = &containerv1alpha1.ProviderConfiguration{
+			Image:        "example.com/provision:1.0.0",
+			Command:      []string{"provision"},
+			Args:         []string{"--verbose"},
+			ImportValues: json.RawMessage(`{"key": "val"}`),
+		}
+	})
+
+	It("should use the provider configuration if no delete configuration is defined", func() {
+		Expect(containerctlr.DeleteProviderConfiguration(providerConfig)).To(Equal(providerConfig))
+	})
+
+	It("should use the image and import values of the delete configuration", func() {
+		providerConfig.OnDelete = &containerv1alpha1.DeleteConfiguration{
+			Image:        "example.com/teardown:1.0.0",
+			Args:         []string{"--all"},
+			ImportValues: json.RawMessage(`{"key": "other"}`),
+		}
+
+		deleteConfig := containerctlr.DeleteProviderConfiguration(providerConfig)
+		Expect(deleteConfig.Image).To(Equal("example.com/teardown:1.0.0"))
+		Expect(deleteConfig.Command).To(BeNil())
+		Expect(deleteConfig.Args).To(Equal([]string{"--all"}))
+		Expect(deleteConfig.ImportValues).To(MatchJSON(`{"key": "other"}`))
+
+		// the provider configuration is not modified
+		Expect(providerConfig.Image).To(Equal("example.com/provision:1.0.0"))
+	})
+
+	It("should keep the image, command and args of the provider configuration if not overwritten", func() {
+		providerConfig.OnDelete = &containerv1alpha1.DeleteConfiguration{
+			Command: []string{"teardown"},
+		}
+
+		deleteConfig := containerctlr.DeleteProviderConfiguration(providerConfig)
+		Expect(deleteConfig.Image).To(Equal("example.com/provision:1.0.0"))
+		Expect(deleteConfig.Command).To(Equal([]string{"teardown"}))
+		Expect(deleteConfig.Args).To(Equal([]string{"--verbose"}))
+		Expect(deleteConfig.ImportValues).To(MatchJSON(`{"key": "val"}`))
+	})
+})
@@ -35,6 +35,7 @@ import (
 	containerv1alpha1 "github.com/gardener/landscaper/apis/deployer/container/v1alpha1"
 	lserrors "github.com/gardener/landscaper/apis/errors"
 	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/controller-utils/pkg/landscaper/targetresolver"
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
 	"github.com/gardener/landscaper/pkg/api"
@@ -144,7 +145,9 @@ func (c *Container) Reconcile(ctx context.Context, operation container.Operation
 			BluePrintPullSecret:           blueprintSecret,
 			ComponentDescriptorPullSecret: componentDescriptorSecret,
 
-			OCMConfigConfigMapName: OCMConfigConfigMapName(c.DeployItem.Namespace, c.DeployItem.Name),
+			OCMConfigConfigMapName:        OCMConfigConfigMapName(c.DeployItem.Namespace, c.DeployItem.Name),
+			BlueprintStore:                c.Configuration.BlueprintStore,
+			BlueprintStoreVolumeClaimName: c.Configuration.BlueprintStoreVolumeClaimName,
 
 			Name:                 c.DeployItem.Name,
 			Namespace:            c.Configuration.Namespace,
@@ -298,6 +301,9 @@ func setStatusFromPod(pod *corev1.Pod, providerStatus *containerv1alpha1.Provide
 	if waitContainerStatus, err := kutil.GetStatusForContainer(pod.Status.ContainerStatuses, container.WaitContainerName); err == nil {
 		podStatus.WaitContainerStatus = convertCoreContainerStatusToV1alpha1Container(waitContainerStatus)
 	}
+	if progress := GetProgressFromPod(pod); progress != nil {
+		podStatus.Progress = progress
+	}
 
 	providerStatus.PodStatus = podStatus
 	return nil
@@ -694,7 +700,13 @@ func (c *Container) SyncOCMConfiguration(ctx context.Context, defaultLabels map[
 }
 
 // SyncTarget syncs the deployitem's target content as secret to the host cluster.
+// The additional targets of the provider configuration are added to the same secret.
 func (c *Container) SyncTarget(ctx context.Context, defaultLabels map[string]string) error {
+	additionalTargets, err := c.resolveAdditionalTargets(ctx)
+	if err != nil {
+		return err
+	}
+
 	secret := &corev1.Secret{}
 	secret.Name = TargetSecretName(c.DeployItem.Namespace, c.DeployItem.Name)
 	secret.Namespace = c.Configuration.Namespace
@@ -708,6 +720,13 @@ func (c *Container) SyncTarget(ctx context.Context, defaultLabels map[string]str
 		secret.Data = map[string][]byte{
 			container.TargetFileName: data,
 		}
+		for name, rt := range additionalTargets {
+			data, err := json.Marshal(rt)
+			if err != nil {
+				return fmt.Errorf("error marshalling resolved target %q into json: %w", name, err)
+			}
+			secret.Data[TargetFileNameForName(name)] = data
+		}
 		return nil
 	}); err != nil {
 		return fmt.Errorf("unable to sync target content to host cluster: %w", err)
@@ -715,6 +734,26 @@ func (c *Container) SyncTarget(ctx context.Context, defaultLabels map[string]str
 	return nil
 }
 
+// resolveAdditionalTargets resolves the additional targets of the provider configuration.
+// The returned map contains the resolved targets by their names.
+// The targets are always read from the namespace of the deploy item.
+func (c *Container) resolveAdditionalTargets(ctx context.Context) (map[string]*lsv1alpha1.ResolvedTarget, error) {
+	resolvedTargets := make(map[string]*lsv1alpha1.ResolvedTarget, len(c.ProviderConfiguration.Targets))
+	for _, ref := range c.ProviderConfiguration.Targets {
+		target := &lsv1alpha1.Target{}
+		if err := read_write_layer.GetTarget(ctx, c.lsUncachedClient, kutil.ObjectKey(ref.Target.Name, c.DeployItem.Namespace), target,
+			read_write_layer.R000118); err != nil {
+			return nil, fmt.Errorf("unable to get target %q for %q: %w", ref.Target.Name, ref.Name, err)
+		}
+		rt, err := targetresolver.Resolve(ctx, target, c.lsUncachedClient)
+		if err != nil {
+			return nil, fmt.Errorf("unable to resolve target %q for %q: %w", ref.Target.Name, ref.Name, err)
+		}
+		resolvedTargets[ref.Name] = rt
+	}
+	return resolvedTargets, nil
+}
+
 // SyncExport syncs the export secret from the wait container to the deploy item export.
 func (c *Container) SyncExport(ctx context.Context) error {
 	log, ctx := logging.FromContextOrNew(ctx, nil)
@@ -105,4 +105,41 @@ var _ = Describe("Init e2e", func() {
 		Expect(resData).To(Equal(testData))
 	})
 
+	It("should copy the additional targets to the targets directory", func() {
+		ctx := logging.NewContextWithDiscard(context.Background())
+		defer ctx.Done()
+		resFs := memoryfs.New()
+		utils.ExpectNoError(os.Setenv(container.DeployItemName, "testname"))
+		utils.ExpectNoError(os.Setenv(container.DeployItemNamespaceName, "testns"))
+		utils.ExpectNoError(os.Setenv(container.PodNamespaceName, testState.Namespace))
+
+		Expect(resFs.MkdirAll(container.TargetInitDir, os.ModePerm)).To(Succeed())
+		for name, content := range map[string]string{
+			container.TargetFileName: `{"name": "default"}`,
+			"cluster-a.json":         `{"name": "a"}`,
+			"cluster-b.json":         `{"name": "b"}`,
+		} {
+			Expect(vfs.WriteFile(resFs, filepath.Join(container.TargetInitDir, name), []byte(content), os.ModePerm)).To(Succeed())
+		}
+
+		file, err := os.ReadFile("./testdata/06-di-targets.yaml")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resFs.MkdirAll(filepath.Dir(container.ConfigurationPath), os.ModePerm)).To(Succeed())
+		Expect(vfs.WriteFile(resFs, container.ConfigurationPath, file, os.ModePerm)).To(Succeed())
+
+		opts := &options{}
+		opts.Complete()
+		Expect(run(ctx, opts, testenv.Client, resFs)).To(Succeed())
+
+		data, err := vfs.ReadFile(resFs, container.TargetPath)
+		utils.ExpectNoError(err)
+		Expect(string(data)).To(Equal(`{"name": "default"}`))
+		data, err = vfs.ReadFile(resFs, filepath.Join(container.TargetsDir, "cluster-a.json"))
+		utils.ExpectNoError(err)
+		Expect(string(data)).To(Equal(`{"name": "a"}`))
+		data, err = vfs.ReadFile(resFs, filepath.Join(container.TargetsDir, "cluster-b.json"))
+		utils.ExpectNoError(err)
+		Expect(string(data)).To(Equal(`{"name": "b"}`))
+	})
+
 })
@@ -23,10 +23,12 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/gardener/landscaper/apis/config"
+	lsconfigv1alpha1 "github.com/gardener/landscaper/apis/config/v1alpha1"
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	containercore "github.com/gardener/landscaper/apis/deployer/container"
 	containerv1alpha1 "github.com/gardener/landscaper/apis/deployer/container/v1alpha1"
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
 	"github.com/gardener/landscaper/pkg/api"
 	"github.com/gardener/landscaper/pkg/components/model"
 	"github.com/gardener/landscaper/pkg/components/registries"
@@ -97,6 +99,14 @@ func run(ctx context.Context, opts *options, kubeClient client.Client, fs vfs.Fi
 	if err := fs.MkdirAll(path.Dir(opts.TargetFilePath), os.ModePerm); err != nil {
 		return err
 	}
+	if err := fs.MkdirAll(opts.TargetsDirPath, os.ModePerm); err != nil {
+		return err
+	}
+	if len(opts.ProgressFilePath) != 0 {
+		if err := fs.MkdirAll(path.Dir(opts.ProgressFilePath), os.ModePerm); err != nil {
+			return err
+		}
+	}
 	if err := fs.MkdirAll(opts.ContentDirPath, os.ModePerm); err != nil {
 		return err
 	}
@@ -105,6 +115,16 @@ func run(ctx context.Context, opts *options, kubeClient client.Client, fs vfs.Fi
 	}
 	log.Info("All directories have been successfully created")
 
+	store, err := newBlueprintStore(log, opts, fs)
+	if err != nil {
+		return err
+	}
+	if store != nil {
+		defer store.Close()
+		blueprints.SetStore(store)
+		defer blueprints.SetStore(nil)
+	}
+
 	var (
 		cdReference    *lsv1alpha1.ComponentDescriptorReference
 		registryAccess model.RegistryAccess
@@ -168,7 +188,12 @@ func run(ctx context.Context, opts *options, kubeClient client.Client, fs vfs.Fi
 			return err
 		}
 
-		if err := fetchComponentDescriptor(ctx, registryAccess, opts, fs, cdReference); err != nil {
+		cdStore := store
+		if providerConfig.ComponentDescriptor.Inline != nil {
+			// inline component descriptors are not immutable and therefore must not be stored
+			cdStore = nil
+		}
+		if err := fetchComponentDescriptor(ctx, registryAccess, cdStore, opts, fs, cdReference); err != nil {
 			return fmt.Errorf("unable to fetch component descriptor: %w", err)
 		}
 	}
@@ -205,6 +230,18 @@ func run(ctx context.Context, opts *options, kubeClient client.Client, fs vfs.Fi
 	if err := vfs.WriteFile(fs, opts.TargetFilePath, targetContent, os.ModePerm); err != nil {
 		return fmt.Errorf("error writing target content to '%s': %w", opts.TargetFilePath, err)
 	}
+	for _, ref := range providerConfig.Targets {
+		fileName := container.TargetFileNameForName(ref.Name)
+		source := filepath.Join(containercore.TargetInitDir, fileName)
+		content, err := vfs.ReadFile(fs, source)
+		if err != nil {
+			return fmt.Errorf("error reading content of target %q from '%s': %w", ref.Name, source, err)
+		}
+		destination := filepath.Join(opts.TargetsDirPath, fileName)
+		if err := vfs.WriteFile(fs, destination, content, os.ModePerm); err != nil {
+			return fmt.Errorf("error writing content of target %q to '%s': %w", ref.Name, destination, err)
+		}
+	}
 	log.Info("Copied target content to shared volume.")
 
 	log.Info("Restoring state")
@@ -219,6 +256,7 @@ func run(ctx context.Context, opts *options, kubeClient client.Client, fs vfs.Fi
 func fetchComponentDescriptor(
 	ctx context.Context,
 	registryAccess model.RegistryAccess,
+	store *blueprints.Store,
 	opts *options,
 	fs vfs.FileSystem,
 	cdRef *lsv1alpha1.ComponentDescriptorReference) error {
@@ -234,6 +272,16 @@ func fetchComponentDescriptor(
 		return fmt.Errorf("unable to resolve component descriptor for ref %v %s:%s: %w", string(cdRef.RepositoryContext.Raw), cdRef.ComponentName, cdRef.Version, err)
 	}
 
+	// the stored component descriptors are only used after the component version has been read with the
+	// credentials of the deploy item
+	if cdListJSONBytes, ok := store.GetComponentDescriptors(cdRef); ok {
+		log.Info("Using component descriptors from blueprint store")
+		if err := vfs.WriteFile(fs, opts.ComponentDescriptorFilePath, cdListJSONBytes, os.ModePerm); err != nil {
+			return errors.Wrapf(err, "unable to write mapped component descriptor to file %s", opts.ComponentDescriptorFilePath)
+		}
+		return nil
+	}
+
 	resolvedComponentVersions, err := model.GetTransitiveComponentReferences(ctx,
 		componentVersion,
 		cdRef.RepositoryContext,
@@ -254,5 +302,30 @@ func fetchComponentDescriptor(
 	if err := vfs.WriteFile(fs, opts.ComponentDescriptorFilePath, cdListJSONBytes, os.ModePerm); err != nil {
 		return errors.Wrapf(err, "unable to write mapped component descriptor to file %s", opts.ComponentDescriptorFilePath)
 	}
+	if err := store.PutComponentDescriptors(cdRef, cdListJSONBytes); err != nil {
+		log.Info("Unable to store component descriptors in blueprint store", lc.KeyError, err.Error())
+	}
 	return nil
 }
+
+// newBlueprintStore creates the blueprint store of the init container.
+// It returns nil if no blueprint store is configured.
+func newBlueprintStore(log logging.Logger, opts *options, fs vfs.FileSystem) (*blueprints.Store, error) {
+	if len(opts.BlueprintStoreConfiguration) == 0 {
+		return nil, nil
+	}
+	storeConfigV1alpha1 := &lsconfigv1alpha1.BlueprintStore{}
+	if err := json.Unmarshal([]byte(opts.BlueprintStoreConfiguration), storeConfigV1alpha1); err != nil {
+		return nil, fmt.Errorf("unable to decode blueprint store configuration: %w", err)
+	}
+	lsconfigv1alpha1.SetDefaults_BlueprintStore(storeConfigV1alpha1)
+	storeConfig := config.BlueprintStore{}
+	if err := lsconfigv1alpha1.Convert_v1alpha1_BlueprintStore_To_config_BlueprintStore(storeConfigV1alpha1, &storeConfig, nil); err != nil {
+		return nil, fmt.Errorf("unable to convert blueprint store configuration: %w", err)
+	}
+	store, err := blueprints.NewStore(log, fs, storeConfig)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create blueprint store: %w", err)
+	}
+	return store, nil
+}
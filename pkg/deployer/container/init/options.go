@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"math"
 	"os"
+	"path/filepath"
 	"time"
 
 	"github.com/hashicorp/go-multierror"
@@ -27,10 +28,13 @@ type options struct {
 	ExportsFilePath             string
 	ComponentDescriptorFilePath string
 	TargetFilePath              string
+	TargetsDirPath              string
 	ContentDirPath              string
 	StateDirPath                string
+	ProgressFilePath            string
 	RegistrySecretBasePath      string
 	OCMConfigFilePath           string
+	BlueprintStoreConfiguration string
 
 	podNamespace string
 
@@ -47,10 +51,16 @@ func (o *options) Complete() {
 	o.ExportsFilePath = os.Getenv(container.ExportsPathName)
 	o.ComponentDescriptorFilePath = os.Getenv(container.ComponentDescriptorPathName)
 	o.TargetFilePath = os.Getenv(container.TargetPathName)
+	o.TargetsDirPath = os.Getenv(container.TargetsDirName)
+	if len(o.TargetsDirPath) == 0 {
+		o.TargetsDirPath = filepath.Dir(o.TargetFilePath)
+	}
 	o.ContentDirPath = os.Getenv(container.ContentPathName)
 	o.StateDirPath = os.Getenv(container.StatePathName)
+	o.ProgressFilePath = os.Getenv(container.ProgressPathName)
 	o.RegistrySecretBasePath = os.Getenv(container.RegistrySecretBasePathName)
 	o.OCMConfigFilePath = os.Getenv(container.OCMConfigPathName)
+	o.BlueprintStoreConfiguration = os.Getenv(container.BlueprintStoreConfigurationName)
 
 	o.podNamespace = os.Getenv(container.PodNamespaceName)
 	o.deployItemName = os.Getenv(container.DeployItemName)
@@ -24,6 +24,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
+	lsconfigv1alpha1 "github.com/gardener/landscaper/apis/config/v1alpha1"
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	"github.com/gardener/landscaper/apis/deployer/container"
 	containerv1alpha1 "github.com/gardener/landscaper/apis/deployer/container/v1alpha1"
@@ -66,6 +67,11 @@ func TargetSecretName(deployItemNamespace, deployItemName string) string {
 	return fmt.Sprintf("%s-%s-target", deployItemNamespace, deployItemName)
 }
 
+// TargetFileNameForName returns the name of the file of an additional target in the targets directory.
+func TargetFileNameForName(name string) string {
+	return name + ".json"
+}
+
 // OCMConfigConfigMapName generates the secret name for the imported secret.
 // todo: use container identity
 func OCMConfigConfigMapName(deployItemNamespace, deployItemName string) string {
@@ -129,6 +135,11 @@ type PodOptions struct {
 
 	OCMConfigConfigMapName string
 
+	// BlueprintStore is the optional configuration of the blueprint store of the init container.
+	BlueprintStore *lsconfigv1alpha1.BlueprintStore
+	// BlueprintStoreVolumeClaimName is the optional persistent volume claim that contains the blueprint store.
+	BlueprintStoreVolumeClaimName string
+
 	Name                 string
 	Namespace            string
 	DeployItemName       string
@@ -314,6 +325,38 @@ func generatePod(opts PodOptions) (*corev1.Pod, error) {
 		})
 	}
 
+	if opts.BlueprintStore != nil && len(opts.BlueprintStore.Path) != 0 {
+		storeConfig, err := json.Marshal(opts.BlueprintStore)
+		if err != nil {
+			return nil, fmt.Errorf("unable to encode blueprint store configuration: %w", err)
+		}
+		storeVolume := corev1.Volume{
+			Name: "blueprint-store",
+			VolumeSource: corev1.VolumeSource{
+				EmptyDir: &corev1.EmptyDirVolumeSource{},
+			},
+		}
+		storeMount := corev1.VolumeMount{
+			Name:      "blueprint-store",
+			MountPath: opts.BlueprintStore.Path,
+		}
+		if len(opts.BlueprintStoreVolumeClaimName) != 0 {
+			// the pods of different namespaces must not share stored blueprints and component descriptors
+			storeVolume.VolumeSource = corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+					ClaimName: opts.BlueprintStoreVolumeClaimName,
+				},
+			}
+			storeMount.SubPath = opts.DeployItemNamespace
+		}
+		volumes = append(volumes, storeVolume)
+		initMounts = append(initMounts, storeMount)
+		additionalInitEnvVars = append(additionalInitEnvVars, corev1.EnvVar{
+			Name:  container.BlueprintStoreConfigurationName,
+			Value: string(storeConfig),
+		})
+	}
+
 	initContainer := corev1.Container{
 		Name:                     container.InitContainerName,
 		Image:                    opts.InitContainer.Image,
@@ -529,10 +572,11 @@ func EnsureServiceAccounts(ctx context.Context, hostClient client.Client, deploy
 				Resources: []string{"secrets"},
 				Verbs:     []string{"create", "update", "get", "list"},
 			},
+			// the wait container reports the progress of the main container as annotation of its pod.
 			{
 				APIGroups: []string{corev1.SchemeGroupVersion.Group},
 				Resources: []string{"pods"},
-				Verbs:     []string{"get"},
+				Verbs:     []string{"get", "patch"},
 			},
 		}
 		return nil
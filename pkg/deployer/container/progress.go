@@ -0,0 +1,61 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package container
+
+import (
+	"encoding/json"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/gardener/landscaper/apis/deployer/container"
+	containerv1alpha1 "github.com/gardener/landscaper/apis/deployer/container/v1alpha1"
+)
+
+const (
+	// maxProgressStepLength is the maximal length of the step of a progress.
+	maxProgressStepLength = 256
+	// maxProgressMessageLength is the maximal length of the message of a progress.
+	maxProgressMessageLength = 1024
+)
+
+// ParseProgress parses the content of the progress file of the main container.
+// The file contains a json object with the optional fields "percentage", "step" and "message".
+// Too long steps and messages are truncated.
+func ParseProgress(data []byte, now metav1.Time) (*containerv1alpha1.Progress, error) {
+	progress := &containerv1alpha1.Progress{}
+	if err := json.Unmarshal(data, progress); err != nil {
+		return nil, fmt.Errorf("unable to parse progress: %w", err)
+	}
+	if progress.Percentage != nil && (*progress.Percentage < 0 || *progress.Percentage > 100) {
+		return nil, fmt.Errorf("the percentage of the progress must be between 0 and 100, but is %d", *progress.Percentage)
+	}
+	progress.Step = truncate(progress.Step, maxProgressStepLength)
+	progress.Message = truncate(progress.Message, maxProgressMessageLength)
+	progress.LastUpdateTime = &now
+	return progress, nil
+}
+
+// GetProgressFromPod returns the progress that has been reported by the wait container at the given pod.
+// It returns nil if the pod has no valid progress.
+func GetProgressFromPod(pod *corev1.Pod) *containerv1alpha1.Progress {
+	data, ok := pod.GetAnnotations()[container.ContainerDeployerProgressAnnotation]
+	if !ok {
+		return nil
+	}
+	progress := &containerv1alpha1.Progress{}
+	if err := json.Unmarshal([]byte(data), progress); err != nil {
+		return nil
+	}
+	return progress
+}
+
+func truncate(s string, maxLength int) string {
+	if len(s) <= maxLength {
+		return s
+	}
+	return s[:maxLength]
+}
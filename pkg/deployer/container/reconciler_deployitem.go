@@ -38,7 +38,7 @@ func NewDeployer(lsUncachedClient, lsCachedClient, hostUncachedClient, hostCache
 		config:             config,
 		hooks:              extension.ReconcileExtensionHooks{},
 	}
-	dep.hooks.RegisterHookSetup(cr.ContinuousReconcileExtensionSetup(dep.NextReconcile))
+	dep.hooks.RegisterHookSetup(cr.ContinuousReconcileExtensionSetup(dep.NextReconcile, lsUncachedClient))
 	return dep, nil
 }
 
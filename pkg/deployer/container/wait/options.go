@@ -20,8 +20,11 @@ import (
 type options struct {
 	DefaultBackoff wait.Backoff
 
-	ExportFilePath string
-	StatePath      string
+	ExportFilePath   string
+	StatePath        string
+	ProgressFilePath string
+	// ProgressInterval is the interval in which the progress file is checked for changes.
+	ProgressInterval time.Duration
 
 	podName      string
 	podNamespace string
@@ -36,6 +39,8 @@ type options struct {
 func (o *options) Setup() {
 	o.ExportFilePath = os.Getenv(container.ExportsPathName)
 	o.StatePath = os.Getenv(container.StatePathName)
+	o.ProgressFilePath = os.Getenv(container.ProgressPathName)
+	o.ProgressInterval = 10 * time.Second
 
 	o.podName = os.Getenv(container.PodName)
 	o.podNamespace = os.Getenv(container.PodNamespaceName)
@@ -0,0 +1,113 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package wait
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/apis/deployer/container"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	containeractuator "github.com/gardener/landscaper/pkg/deployer/container"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+// ProgressReporter publishes the progress file of the main container as annotation of the pod,
+// from where the container deployer copies it into the status of the deploy item.
+type ProgressReporter struct {
+	kubeClient       client.Client
+	podKey           lsv1alpha1.ObjectReference
+	progressFilePath string
+	clock            clock.PassiveClock
+
+	// lastData is the content of the progress file that has been published last.
+	lastData []byte
+}
+
+// NewProgressReporter creates a new progress reporter.
+func NewProgressReporter(kubeClient client.Client, podKey lsv1alpha1.ObjectReference, progressFilePath string) *ProgressReporter {
+	return &ProgressReporter{
+		kubeClient:       kubeClient,
+		podKey:           podKey,
+		progressFilePath: progressFilePath,
+		clock:            clock.RealClock{},
+	}
+}
+
+// WithClock sets the clock that is used for the update time of the progress.
+func (r *ProgressReporter) WithClock(clk clock.PassiveClock) *ProgressReporter {
+	r.clock = clk
+	return r
+}
+
+// Run publishes the progress in the given interval until the context is cancelled.
+// Errors are only logged, as the progress must not influence the result of the main container.
+func (r *ProgressReporter) Run(ctx context.Context, interval time.Duration) {
+	log, ctx := logging.FromContextOrNew(ctx, nil)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := r.Report(ctx); err != nil {
+				log.Error(err, "Unable to report progress")
+			}
+		}
+	}
+}
+
+// Report publishes the content of the progress file if it has changed since the last report.
+func (r *ProgressReporter) Report(ctx context.Context) error {
+	if len(r.progressFilePath) == 0 {
+		return nil
+	}
+	data, err := os.ReadFile(r.progressFilePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("unable to read progress file %s: %w", r.progressFilePath, err)
+	}
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 || bytes.Equal(data, r.lastData) {
+		return nil
+	}
+
+	progress, err := containeractuator.ParseProgress(data, metav1.NewTime(r.clock.Now()))
+	if err != nil {
+		return err
+	}
+	encProgress, err := json.Marshal(progress)
+	if err != nil {
+		return fmt.Errorf("unable to encode progress: %w", err)
+	}
+
+	pod := &corev1.Pod{}
+	if err := read_write_layer.GetPod(ctx, r.kubeClient, r.podKey.NamespacedName(), pod, read_write_layer.R000119); err != nil {
+		return err
+	}
+	patch := client.MergeFrom(pod.DeepCopy())
+	metav1.SetMetaDataAnnotation(&pod.ObjectMeta, container.ContainerDeployerProgressAnnotation, string(encProgress))
+	if err := r.kubeClient.Patch(ctx, pod, patch); err != nil {
+		return fmt.Errorf("unable to set progress at pod %s: %w", r.podKey.NamespacedName().String(), err)
+	}
+
+	logging.FromContextOrDiscard(ctx).Debug("Reported progress", lc.KeyResource, r.podKey.NamespacedName().String())
+	r.lastData = data
+	return nil
+}
@@ -0,0 +1,97 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package wait_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/apis/deployer/container"
+	"github.com/gardener/landscaper/pkg/api"
+	containeractuator "github.com/gardener/landscaper/pkg/deployer/container"
+	"github.com/gardener/landscaper/pkg/deployer/container/wait"
+)
+
+var _ = Describe("Progress", func() {
+
+	var (
+		ctx          context.Context
+		kubeClient   client.Client
+		clk          *testing.FakePassiveClock
+		progressFile string
+		reporter     *wait.ProgressReporter
+		podKey       = lsv1alpha1.ObjectReference{Name: "my-pod", Namespace: "default"}
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: podKey.Name, Namespace: podKey.Namespace}}
+		kubeClient = fake.NewClientBuilder().WithScheme(api.LandscaperScheme).WithObjects(pod).Build()
+		clk = testing.NewFakePassiveClock(time.Date(2024, time.May, 1, 8, 0, 0, 0, time.UTC))
+		progressFile = filepath.Join(GinkgoT().TempDir(), "progress.json")
+		reporter = wait.NewProgressReporter(kubeClient, podKey, progressFile).WithClock(clk)
+	})
+
+	getPod := func() *corev1.Pod {
+		pod := &corev1.Pod{}
+		Expect(kubeClient.Get(ctx, podKey.NamespacedName(), pod)).To(Succeed())
+		return pod
+	}
+
+	It("should not report anything if there is no progress file", func() {
+		Expect(reporter.Report(ctx)).To(Succeed())
+		Expect(getPod().Annotations).ToNot(HaveKey(container.ContainerDeployerProgressAnnotation))
+	})
+
+	It("should publish the progress at the pod", func() {
+		Expect(os.WriteFile(progressFile, []byte(`{"percentage": 40, "step": "apply", "message": "creating resources"}`), os.ModePerm)).To(Succeed())
+		Expect(reporter.Report(ctx)).To(Succeed())
+
+		progress := containeractuator.GetProgressFromPod(getPod())
+		Expect(progress).ToNot(BeNil())
+		Expect(progress.Percentage).To(Equal(ptr.To[int32](40)))
+		Expect(progress.Step).To(Equal("apply"))
+		Expect(progress.Message).To(Equal("creating resources"))
+		Expect(progress.LastUpdateTime.Time.Equal(clk.Now())).To(BeTrue())
+	})
+
+	It("should only publish changed progress", func() {
+		Expect(os.WriteFile(progressFile, []byte(`{"percentage": 40}`), os.ModePerm)).To(Succeed())
+		Expect(reporter.Report(ctx)).To(Succeed())
+		t1 := clk.Now()
+
+		// unchanged progress keeps the update time
+		clk.SetTime(t1.Add(time.Minute))
+		Expect(reporter.Report(ctx)).To(Succeed())
+		Expect(containeractuator.GetProgressFromPod(getPod()).LastUpdateTime.Time.Equal(t1)).To(BeTrue())
+
+		Expect(os.WriteFile(progressFile, []byte(`{"percentage": 80}`), os.ModePerm)).To(Succeed())
+		Expect(reporter.Report(ctx)).To(Succeed())
+		progress := containeractuator.GetProgressFromPod(getPod())
+		Expect(progress.Percentage).To(Equal(ptr.To[int32](80)))
+		Expect(progress.LastUpdateTime.Time.Equal(clk.Now())).To(BeTrue())
+	})
+
+	It("should reject an invalid progress", func() {
+		Expect(os.WriteFile(progressFile, []byte(`{"percentage": 140}`), os.ModePerm)).To(Succeed())
+		Expect(reporter.Report(ctx)).ToNot(Succeed())
+		Expect(getPod().Annotations).ToNot(HaveKey(container.ContainerDeployerProgressAnnotation))
+
+		Expect(os.WriteFile(progressFile, []byte(`40%`), os.ModePerm)).To(Succeed())
+		Expect(reporter.Report(ctx)).ToNot(Succeed())
+	})
+})
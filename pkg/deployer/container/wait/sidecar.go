@@ -48,12 +48,24 @@ func Run(ctx context.Context) error {
 		return err
 	}
 
+	// report the progress of the main container while it is running.
+	progressReporter := NewProgressReporter(kubeClient, opts.PodKey, opts.ProgressFilePath)
+	progressCtx, cancelProgress := context.WithCancel(ctx)
+	go progressReporter.Run(progressCtx, opts.ProgressInterval)
+
 	// wait for the main container to finish.
 	// event if the exitcode != 0, the state is still backed up.
-	if err := WaitUntilMainContainerFinished(ctx, kubeClient, opts.PodKey.NamespacedName()); err != nil {
+	err = WaitUntilMainContainerFinished(ctx, kubeClient, opts.PodKey.NamespacedName())
+	cancelProgress()
+	if err != nil {
 		return withTerminationLog(log, err)
 	}
 
+	// report the final progress of the main container
+	if err := progressReporter.Report(ctx); err != nil {
+		log.Error(err, "Unable to report progress")
+	}
+
 	// backup state
 	if err := state.New(kubeClient, opts.podNamespace, opts.DeployItemKey, opts.StatePath).Backup(ctx); err != nil {
 		return withTerminationLog(log, err)
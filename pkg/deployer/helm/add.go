@@ -54,12 +54,13 @@ func AddDeployerToManager(lsUncachedClient, lsCachedClient, hostUncachedClient,
 	return deployerlib.Add(lsUncachedClient, lsCachedClient, hostUncachedClient, hostCachedClient,
 		finishedObjectCache,
 		log, lsMgr, hostMgr, deployerlib.DeployerArgs{
-			Name:            Name,
-			Version:         version.Get().String(),
-			Identity:        config.Identity,
-			Type:            Type,
-			Deployer:        d,
-			TargetSelectors: config.TargetSelector,
-			Options:         options,
+			Name:                   Name,
+			Version:                version.Get().String(),
+			Identity:               config.Identity,
+			Type:                   Type,
+			Deployer:               d,
+			TargetSelectors:        config.TargetSelector,
+			Options:                options,
+			BuiltinTargetResolvers: config.TargetResolvers,
 		}, config.Controller.Workers, lockingEnabled, callerName)
 }
@@ -0,0 +1,145 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package chartresolver
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	helmv1alpha1 "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1"
+	lserrors "github.com/gardener/landscaper/apis/errors"
+	"github.com/gardener/landscaper/pkg/components/common"
+)
+
+// IsExactChartVersion returns true if the version of a chart from a helm chart repo is an exact version
+// and not a version constraint.
+func IsExactChartVersion(version string) bool {
+	_, err := semver.StrictNewVersion(strings.TrimPrefix(version, "v"))
+	return err == nil
+}
+
+// ChartVersionResolver resolves the version constraints of charts from helm chart repos.
+type ChartVersionResolver struct {
+	lsClient   client.Client
+	contextObj *lsv1alpha1.Context
+	indexCache *HelmRepoIndexCache
+	now        func() time.Time
+}
+
+// NewChartVersionResolver creates a new resolver, which uses the credentials of the given landscaper context.
+func NewChartVersionResolver(lsClient client.Client, contextObj *lsv1alpha1.Context, indexCache *HelmRepoIndexCache) *ChartVersionResolver {
+	return &ChartVersionResolver{
+		lsClient:   lsClient,
+		contextObj: contextObj,
+		indexCache: indexCache,
+		now:        time.Now,
+	}
+}
+
+// WithClock sets the function which returns the current time.
+func (r *ChartVersionResolver) WithClock(now func() time.Time) *ChartVersionResolver {
+	r.now = now
+	return r
+}
+
+// Resolve resolves the version constraint of a chart from a helm chart repo to the highest matching version
+// in the index of the repo.
+// A previous resolution is kept as long as the chart reference is unchanged and the resolve request,
+// i.e. the value of the resolve-chart-version annotation, is the same. This keeps the chart version stable until
+// the next explicit upgrade.
+// Nil is returned for exact versions, which need no resolution.
+func (r *ChartVersionResolver) Resolve(ctx context.Context, repo *helmv1alpha1.HelmChartRepo,
+	previous *helmv1alpha1.ResolvedChartVersion, resolveRequest string) (*helmv1alpha1.ResolvedChartVersion, error) {
+
+	op := "ResolveChartVersion"
+
+	if IsExactChartVersion(repo.HelmChartVersion) {
+		return nil, nil
+	}
+
+	if previous != nil &&
+		previous.HelmChartRepoUrl == repo.HelmChartRepoUrl &&
+		previous.HelmChartName == repo.HelmChartName &&
+		previous.VersionConstraint == repo.HelmChartVersion &&
+		previous.ResolveRequest == resolveRequest {
+		return previous, nil
+	}
+
+	if _, err := semver.NewConstraint(repo.HelmChartVersion); err != nil {
+		return nil, lserrors.NewWrappedError(err, op, "ParseVersionConstraint",
+			fmt.Sprintf("invalid version constraint %q of chart %q: %s", repo.HelmChartVersion, repo.HelmChartName, err.Error()),
+			lsv1alpha1.ErrorConfigurationProblem)
+	}
+
+	repoURL := common.NormalizeUrl(repo.HelmChartRepoUrl)
+	access, err := r.getRepoAccess(ctx, repoURL)
+	if err != nil {
+		return nil, err
+	}
+
+	index, err := r.indexCache.GetIndex(ctx, repoURL, access)
+	if err != nil {
+		return nil, err
+	}
+
+	chartVersion, err := index.Get(repo.HelmChartName, repo.HelmChartVersion)
+	if err != nil {
+		return nil, lserrors.NewWrappedError(err, op, "ResolveVersionConstraint",
+			fmt.Sprintf("no version of chart %q in helm chart repo %q matches the constraint %q",
+				repo.HelmChartName, repo.HelmChartRepoUrl, repo.HelmChartVersion))
+	}
+
+	return &helmv1alpha1.ResolvedChartVersion{
+		HelmChartRepoUrl:  repo.HelmChartRepoUrl,
+		HelmChartName:     repo.HelmChartName,
+		VersionConstraint: repo.HelmChartVersion,
+		Version:           chartVersion.Version,
+		ResolveRequest:    resolveRequest,
+		ResolutionTime:    metav1.NewTime(r.now()),
+	}, nil
+}
+
+// getRepoAccess returns the credentials of the landscaper context for a helm chart repo.
+func (r *ChartVersionResolver) getRepoAccess(ctx context.Context, repoURL string) (*RepoAccess, error) {
+	if r.contextObj == nil || r.contextObj.Configurations == nil {
+		return nil, nil
+	}
+
+	rawAuths, ok := r.contextObj.Configurations[helmv1alpha1.HelmChartRepoCredentialsKey]
+	if !ok {
+		return nil, nil
+	}
+
+	repoCredentials := helmv1alpha1.HelmChartRepoCredentials{}
+	if err := yaml.Unmarshal(rawAuths.RawMessage, &repoCredentials); err != nil {
+		return nil, lserrors.NewWrappedError(err, "GetRepoAccess", "ParsingAuths", err.Error(), lsv1alpha1.ErrorConfigurationProblem)
+	}
+
+	for i := range repoCredentials.Auths {
+		auth := &repoCredentials.Auths[i]
+		if common.NormalizeUrl(auth.URL) != repoURL {
+			continue
+		}
+
+		authHeader, err := common.GetAuthHeader(ctx, auth, r.lsClient, r.contextObj.Namespace)
+		if err != nil {
+			return nil, err
+		}
+		return &RepoAccess{
+			AuthHeader:   authHeader,
+			CustomCAData: auth.CustomCAData,
+		}, nil
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,240 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package chartresolver
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	helmv1alpha1 "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+)
+
+const testRepoIndex = `
+apiVersion: v1
+entries:
+  mychart:
+  - name: mychart
+    version: 1.3.2
+    urls: ["mychart-1.3.2.tgz"]
+  - name: mychart
+    version: 1.4.0
+    urls: ["mychart-1.4.0.tgz"]
+  - name: mychart
+    version: 1.4.3
+    urls: ["mychart-1.4.3.tgz"]
+  - name: mychart
+    version: 1.5.0-rc.1
+    urls: ["mychart-1.5.0-rc.1.tgz"]
+  - name: mychart
+    version: 2.0.0
+    urls: ["mychart-2.0.0.tgz"]
+`
+
+var _ = Describe("ChartVersionResolver", func() {
+
+	var (
+		ctx        context.Context
+		server     *httptest.Server
+		index      string
+		etag       string
+		downloads  atomic.Int32
+		requests   atomic.Int32
+		authHeader atomic.Value
+		now        time.Time
+		cache      *HelmRepoIndexCache
+	)
+
+	BeforeEach(func() {
+		ctx = logging.NewContext(context.Background(), logging.Discard())
+		index = testRepoIndex
+		etag = `"v1"`
+		downloads.Store(0)
+		requests.Store(0)
+		authHeader.Store("")
+		now = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests.Add(1)
+			authHeader.Store(r.Header.Get("Authorization"))
+			if r.URL.Path != "/index.yaml" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			if r.Header.Get("If-None-Match") == etag {
+				w.WriteHeader(http.StatusNotModified)
+				return
+			}
+			downloads.Add(1)
+			w.Header().Set("ETag", etag)
+			_, _ = w.Write([]byte(index))
+		}))
+
+		cache = NewHelmRepoIndexCache(&helmv1alpha1.HelmChartRepoIndexCacheConfiguration{
+			Path:   GinkgoT().TempDir(),
+			MaxAge: &lsv1alpha1.Duration{Duration: time.Minute},
+		}).WithClock(func() time.Time { return now })
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	repo := func(version string) *helmv1alpha1.HelmChartRepo {
+		return &helmv1alpha1.HelmChartRepo{
+			HelmChartRepoUrl: server.URL,
+			HelmChartName:    "mychart",
+			HelmChartVersion: version,
+		}
+	}
+
+	It("should not resolve exact versions", func() {
+		resolver := NewChartVersionResolver(nil, nil, cache)
+		resolved, err := resolver.Resolve(ctx, repo("1.4.0"), nil, "")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resolved).To(BeNil())
+		Expect(requests.Load()).To(BeEquivalentTo(0))
+	})
+
+	It("should resolve a version constraint to the highest matching version", func() {
+		resolver := NewChartVersionResolver(nil, nil, cache).WithClock(func() time.Time { return now })
+
+		resolved, err := resolver.Resolve(ctx, repo("~1.4"), nil, "")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resolved.Version).To(Equal("1.4.3"))
+		Expect(resolved.VersionConstraint).To(Equal("~1.4"))
+		Expect(resolved.ResolutionTime.Time).To(Equal(now))
+
+		resolved, err = resolver.Resolve(ctx, repo(">=1.0.0"), nil, "")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resolved.Version).To(Equal("2.0.0"))
+	})
+
+	It("should fail if no version matches the constraint", func() {
+		resolver := NewChartVersionResolver(nil, nil, cache)
+		_, err := resolver.Resolve(ctx, repo("^3.0"), nil, "")
+		Expect(err).To(HaveOccurred())
+
+		_, err = resolver.Resolve(ctx, repo("~1.4 ||| x"), nil, "")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should keep a previous resolution until a new resolution is requested", func() {
+		resolver := NewChartVersionResolver(nil, nil, cache)
+		previous, err := resolver.Resolve(ctx, repo("~1.4"), nil, "")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(previous.Version).To(Equal("1.4.3"))
+
+		index = testRepoIndex + `
+  - name: mychart
+    version: 1.4.4
+    urls: ["mychart-1.4.4.tgz"]
+`
+		etag = `"v2"`
+		now = now.Add(time.Hour)
+
+		resolved, err := resolver.Resolve(ctx, repo("~1.4"), previous, "")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resolved).To(Equal(previous))
+		Expect(requests.Load()).To(BeEquivalentTo(1))
+
+		resolved, err = resolver.Resolve(ctx, repo("~1.4"), previous, "upgrade-1")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resolved.Version).To(Equal("1.4.4"))
+		Expect(resolved.ResolveRequest).To(Equal("upgrade-1"))
+
+		resolved, err = resolver.Resolve(ctx, repo("~1.3"), resolved, "upgrade-1")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resolved.Version).To(Equal("1.3.2"))
+	})
+
+	It("should use the credentials of the context", func() {
+		contextObj := &lsv1alpha1.Context{}
+		contextObj.Configurations = map[string]lsv1alpha1.AnyJSON{
+			helmv1alpha1.HelmChartRepoCredentialsKey: lsv1alpha1.NewAnyJSON([]byte(
+				`{"auths": [{"url": "` + server.URL + `/", "authHeader": "Basic dGVzdDp0ZXN0"}]}`)),
+		}
+
+		resolver := NewChartVersionResolver(nil, contextObj, cache)
+		_, err := resolver.Resolve(ctx, repo("~1.4"), nil, "")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(authHeader.Load()).To(Equal("Basic dGVzdDp0ZXN0"))
+	})
+
+	Context("HelmRepoIndexCache", func() {
+
+		It("should use the cached index within the max age and revalidate it afterwards", func() {
+			_, err := cache.GetIndex(ctx, server.URL, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(requests.Load()).To(BeEquivalentTo(1))
+			Expect(downloads.Load()).To(BeEquivalentTo(1))
+
+			now = now.Add(30 * time.Second)
+			_, err = cache.GetIndex(ctx, server.URL, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(requests.Load()).To(BeEquivalentTo(1))
+
+			now = now.Add(time.Minute)
+			index, err := cache.GetIndex(ctx, server.URL, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(requests.Load()).To(BeEquivalentTo(2))
+			Expect(downloads.Load()).To(BeEquivalentTo(1))
+			Expect(index.Entries["mychart"]).To(HaveLen(5))
+
+			etag = `"v2"`
+			now = now.Add(2 * time.Minute)
+			_, err = cache.GetIndex(ctx, server.URL, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(requests.Load()).To(BeEquivalentTo(3))
+			Expect(downloads.Load()).To(BeEquivalentTo(2))
+		})
+
+		It("should share the cached index files on disk", func() {
+			_, err := cache.GetIndex(ctx, server.URL, nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			other := NewHelmRepoIndexCache(&helmv1alpha1.HelmChartRepoIndexCacheConfiguration{
+				Path:   cache.path,
+				MaxAge: &lsv1alpha1.Duration{Duration: time.Minute},
+			}).WithClock(func() time.Time { return now })
+			index, err := other.GetIndex(ctx, server.URL, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(index.Entries).To(HaveKey("mychart"))
+			Expect(requests.Load()).To(BeEquivalentTo(1))
+		})
+
+		It("should not share cached index files between different credentials", func() {
+			_, err := cache.GetIndex(ctx, server.URL, &RepoAccess{AuthHeader: "Basic dGVzdDp0ZXN0"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(requests.Load()).To(BeEquivalentTo(1))
+
+			_, err = cache.GetIndex(ctx, server.URL, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(requests.Load()).To(BeEquivalentTo(2))
+			Expect(authHeader.Load()).To(Equal(""))
+
+			_, err = cache.GetIndex(ctx, server.URL, &RepoAccess{AuthHeader: "Basic b3RoZXI6b3RoZXI="})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(requests.Load()).To(BeEquivalentTo(3))
+			Expect(authHeader.Load()).To(Equal("Basic b3RoZXI6b3RoZXI="))
+
+			_, err = cache.GetIndex(ctx, server.URL, &RepoAccess{AuthHeader: "Basic dGVzdDp0ZXN0"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(requests.Load()).To(BeEquivalentTo(3))
+		})
+
+		It("should fail for a missing index", func() {
+			_, err := cache.GetIndex(ctx, server.URL+"/missing", nil)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,309 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package chartresolver
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"helm.sh/helm/v3/pkg/repo"
+	"sigs.k8s.io/yaml"
+
+	helmv1alpha1 "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+)
+
+const (
+	// RepoIndexMaxAgeDefault is the default duration for which a cached index file is used without revalidation.
+	RepoIndexMaxAgeDefault = 10 * time.Minute
+
+	repoIndexFileName     = "index.yaml"
+	repoIndexMetaFileName = "meta.json"
+)
+
+// RepoIndexCachePathDefault is the default directory of the index cache.
+var RepoIndexCachePathDefault = filepath.Join(os.TempDir(), "landscaper-helm-repo-index-cache")
+
+// HelmRepoIndexCache caches the index files of helm chart repositories on disk.
+// An index file is used without any request to the repository for the max age. Afterwards, it is revalidated
+// with a conditional request based on its ETag, so that an unchanged index file is not downloaded again.
+// The cache directory can be shared by several deployer instances, as all files are replaced atomically.
+// The entries are keyed by the repository URL and the access data, so that an index file that was fetched with
+// credentials is only returned for requests with the same credentials.
+type HelmRepoIndexCache struct {
+	path   string
+	maxAge time.Duration
+	now    func() time.Time
+
+	// locks serializes the accesses to the cache entry of a repository within this process
+	locks sync.Map
+}
+
+// repoIndexMeta contains the metadata of a cached index file.
+type repoIndexMeta struct {
+	URL          string    `json:"url"`
+	ETag         string    `json:"etag,omitempty"`
+	LastModified string    `json:"lastModified,omitempty"`
+	FetchTime    time.Time `json:"fetchTime"`
+}
+
+// RepoAccess contains the data to access a helm chart repository.
+type RepoAccess struct {
+	// AuthHeader is the value of the authorization header.
+	AuthHeader string
+	// CustomCAData is a base64 encoded custom CA in PEM format.
+	CustomCAData string
+}
+
+var repoIndexCache *HelmRepoIndexCache
+var repoIndexCacheLock sync.Mutex
+
+// GetHelmRepoIndexCache returns the index cache. The configuration is only used when the cache is created.
+func GetHelmRepoIndexCache(config *helmv1alpha1.HelmChartRepoIndexCacheConfiguration) *HelmRepoIndexCache {
+	repoIndexCacheLock.Lock()
+	defer repoIndexCacheLock.Unlock()
+
+	if repoIndexCache == nil {
+		repoIndexCache = NewHelmRepoIndexCache(config)
+	}
+	return repoIndexCache
+}
+
+// NewHelmRepoIndexCache creates a new index cache.
+func NewHelmRepoIndexCache(config *helmv1alpha1.HelmChartRepoIndexCacheConfiguration) *HelmRepoIndexCache {
+	c := &HelmRepoIndexCache{
+		path:   RepoIndexCachePathDefault,
+		maxAge: RepoIndexMaxAgeDefault,
+		now:    time.Now,
+	}
+	if config != nil {
+		if len(config.Path) != 0 {
+			c.path = config.Path
+		}
+		if config.MaxAge != nil {
+			c.maxAge = config.MaxAge.Duration
+		}
+	}
+	return c
+}
+
+// WithClock sets the function which returns the current time.
+func (c *HelmRepoIndexCache) WithClock(now func() time.Time) *HelmRepoIndexCache {
+	c.now = now
+	return c
+}
+
+// GetIndex returns the index file of a helm chart repository.
+func (c *HelmRepoIndexCache) GetIndex(ctx context.Context, repoURL string, access *RepoAccess) (*repo.IndexFile, error) {
+	logger, ctx := logging.FromContextOrNew(ctx, nil)
+
+	dir := c.entryDir(repoURL, access)
+	lock, _ := c.locks.LoadOrStore(dir, &sync.Mutex{})
+	lock.(*sync.Mutex).Lock()
+	defer lock.(*sync.Mutex).Unlock()
+
+	meta, data, err := c.readEntry(dir, repoURL)
+	if err != nil {
+		logger.Info("ignoring unreadable cache entry of helm chart repo index", "url", repoURL, "error", err.Error())
+		meta, data = nil, nil
+	}
+
+	if meta != nil && c.now().Before(meta.FetchTime.Add(c.maxAge)) {
+		return loadIndex(data, repoURL)
+	}
+
+	newMeta, newData, err := c.fetchIndex(ctx, repoURL, access, meta)
+	if err != nil {
+		return nil, err
+	}
+	if newData == nil {
+		// not modified
+		logger.Debug("helm chart repo index not modified", "url", repoURL)
+		newData = data
+	}
+
+	if err := c.writeEntry(dir, newMeta, newData); err != nil {
+		logger.Info("unable to cache helm chart repo index", "url", repoURL, "error", err.Error())
+	}
+
+	return loadIndex(newData, repoURL)
+}
+
+// fetchIndex downloads the index file. If the cached index file is still valid, no data is returned.
+func (c *HelmRepoIndexCache) fetchIndex(ctx context.Context, repoURL string, access *RepoAccess,
+	cached *repoIndexMeta) (*repoIndexMeta, []byte, error) {
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, repoURL+"/"+repoIndexFileName, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to create request for index of helm chart repo %q: %w", repoURL, err)
+	}
+
+	httpClient := http.DefaultClient
+	if access != nil {
+		if len(access.AuthHeader) != 0 {
+			req.Header.Set("Authorization", access.AuthHeader)
+		}
+		if len(access.CustomCAData) != 0 {
+			httpClient, err = newHTTPClientWithCustomCA(access.CustomCAData)
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+
+	if cached != nil {
+		if len(cached.ETag) != 0 {
+			req.Header.Set("If-None-Match", cached.ETag)
+		}
+		if len(cached.LastModified) != 0 {
+			req.Header.Set("If-Modified-Since", cached.LastModified)
+		}
+	}
+
+	res, err := httpClient.Do(req)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to fetch index of helm chart repo %q: %w", repoURL, err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusNotModified && cached != nil {
+		meta := *cached
+		meta.FetchTime = c.now()
+		return &meta, nil, nil
+	}
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return nil, nil, fmt.Errorf("unable to fetch index of helm chart repo %q: %s", repoURL, res.Status)
+	}
+
+	data, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to read index of helm chart repo %q: %w", repoURL, err)
+	}
+
+	meta := &repoIndexMeta{
+		URL:          repoURL,
+		ETag:         res.Header.Get("ETag"),
+		LastModified: res.Header.Get("Last-Modified"),
+		FetchTime:    c.now(),
+	}
+	return meta, data, nil
+}
+
+// entryDir returns the directory of the cache entry for a repository and its access data.
+func (c *HelmRepoIndexCache) entryDir(repoURL string, access *RepoAccess) string {
+	h := sha256.New()
+	h.Write([]byte(repoURL))
+	if access != nil {
+		h.Write([]byte{0})
+		h.Write([]byte(access.AuthHeader))
+		h.Write([]byte{0})
+		h.Write([]byte(access.CustomCAData))
+	}
+	return filepath.Join(c.path, hex.EncodeToString(h.Sum(nil)))
+}
+
+// readEntry reads a cache entry. It returns no metadata if the entry does not exist.
+func (c *HelmRepoIndexCache) readEntry(dir, repoURL string) (*repoIndexMeta, []byte, error) {
+	metaData, err := os.ReadFile(filepath.Join(dir, repoIndexMetaFileName))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil, nil
+		}
+		return nil, nil, err
+	}
+
+	meta := &repoIndexMeta{}
+	if err := json.Unmarshal(metaData, meta); err != nil {
+		return nil, nil, err
+	}
+	if meta.URL != repoURL {
+		return nil, nil, fmt.Errorf("cache entry belongs to url %q", meta.URL)
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, repoIndexFileName))
+	if err != nil {
+		return nil, nil, err
+	}
+	return meta, data, nil
+}
+
+// writeEntry writes a cache entry. The index file is written before the metadata, so that readers never
+// combine new metadata with an old index file.
+func (c *HelmRepoIndexCache) writeEntry(dir string, meta *repoIndexMeta, data []byte) error {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return err
+	}
+
+	metaData, err := json.Marshal(meta)
+	if err != nil {
+		return err
+	}
+
+	if err := writeFileAtomically(filepath.Join(dir, repoIndexFileName), data); err != nil {
+		return err
+	}
+	return writeFileAtomically(filepath.Join(dir, repoIndexMetaFileName), metaData)
+}
+
+func writeFileAtomically(path string, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+func loadIndex(data []byte, repoURL string) (*repo.IndexFile, error) {
+	index := &repo.IndexFile{}
+	if err := yaml.Unmarshal(data, index); err != nil {
+		return nil, fmt.Errorf("unable to parse index of helm chart repo %q: %w", repoURL, err)
+	}
+	if index.APIVersion == "" {
+		return nil, fmt.Errorf("invalid index of helm chart repo %q: no api version", repoURL)
+	}
+	index.SortEntries()
+	return index, nil
+}
+
+func newHTTPClientWithCustomCA(customCAData string) (*http.Client, error) {
+	caData, err := base64.StdEncoding.DecodeString(customCAData)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode custom ca data: %w", err)
+	}
+
+	pool, err := x509.SystemCertPool()
+	if err != nil {
+		pool = x509.NewCertPool()
+	}
+	if !pool.AppendCertsFromPEM(caData) {
+		return nil, errors.New("unable to add custom ca data to the cert pool")
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = &tls.Config{RootCAs: pool}
+	return &http.Client{Transport: transport}, nil
+}
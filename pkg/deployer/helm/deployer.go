@@ -51,7 +51,7 @@ func NewDeployer(
 		config:             config,
 		hooks:              extension.ReconcileExtensionHooks{},
 	}
-	dep.hooks.RegisterHookSetup(cr.ContinuousReconcileExtensionSetup(dep.NextReconcile))
+	dep.hooks.RegisterHookSetup(cr.ContinuousReconcileExtensionSetup(dep.NextReconcile, lsUncachedClient))
 	return dep, nil
 }
 
@@ -5,17 +5,19 @@
 package helm
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 
 	"helm.sh/helm/v3/pkg/chart"
 	corev1 "k8s.io/api/core/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/yaml"
 
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	helmv1alpha1 "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1"
@@ -25,6 +27,7 @@ import (
 	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	"github.com/gardener/landscaper/pkg/deployer/helm/postrenderer"
 	"github.com/gardener/landscaper/pkg/deployer/helm/realhelmdeployer"
 	deployerlib "github.com/gardener/landscaper/pkg/deployer/lib"
 	"github.com/gardener/landscaper/pkg/deployer/lib/interruption"
@@ -47,25 +50,25 @@ func (h *Helm) ApplyFiles(ctx context.Context, filesForManifestDeployer, crdsFor
 		return lserrors.NewWrappedError(err, currOp, "ensureTargetAccess", err.Error())
 	}
 
-	if h.ProviderStatus == nil {
-		h.ProviderStatus = &helmv1alpha1.ProviderStatus{
-			TypeMeta: metav1.TypeMeta{
-				APIVersion: helmv1alpha1.SchemeGroupVersion.String(),
-				Kind:       "ProviderStatus",
-			},
-			ManagedResources: make(managedresource.ManagedResourceStatusList, 0),
-		}
+	h.initProviderStatus()
+
+	shouldUseRealHelmDeployer := ptr.Deref[bool](h.ProviderConfiguration.HelmDeployment, true)
+	deploymentMode := helmv1alpha1.DeploymentModeManifest
+	if shouldUseRealHelmDeployer {
+		deploymentMode = helmv1alpha1.DeploymentModeHelm
 	}
 
-	var deployErr error
+	realHelmDeployer := realhelmdeployer.NewRealHelmDeployer(ch, h.ProviderConfiguration, h.targetAccess, h.DeployItem)
 
-	shouldUseRealHelmDeployer := ptr.Deref[bool](h.ProviderConfiguration.HelmDeployment, true)
+	migration, deployErr := h.prepareReleaseMigration(ctx, realHelmDeployer, deploymentMode)
 
-	if shouldUseRealHelmDeployer {
+	if deployErr == nil && shouldUseRealHelmDeployer {
 		// Apply helm install/upgrade. Afterwards get the list of deployed resources by helm get release.
 		// The list is filtered, i.e. it contains only the resources that are needed for the default readiness check.
-		realHelmDeployer := realhelmdeployer.NewRealHelmDeployer(ch, h.ProviderConfiguration, h.targetAccess, h.DeployItem)
 		deployErr = realHelmDeployer.Deploy(ctx)
+		if deployErr == nil {
+			deployErr = h.completeReleaseMigration(ctx, realHelmDeployer, migration)
+		}
 		if deployErr == nil {
 			managedResourceStatusList, err := realHelmDeployer.GetManagedResourcesStatus(ctx)
 			if err != nil {
@@ -74,16 +77,19 @@ func (h *Helm) ApplyFiles(ctx context.Context, filesForManifestDeployer, crdsFor
 			h.ProviderStatus.ManagedResources = managedResourceStatusList
 		}
 
-	} else {
+	} else if deployErr == nil {
 		manifests, err := h.createManifests(ctx, currOp, filesForManifestDeployer, crdsForManifestDeployer)
 		if err != nil {
 			return err
 		}
 
 		deployErr = h.applyManifests(ctx, manifests)
+		if deployErr == nil {
+			deployErr = h.completeReleaseMigration(ctx, realHelmDeployer, migration)
+		}
 	}
 
-	// common error handling for deploy errors (h.applyManifests / realHelmDeployer.Deploy)
+	// common error handling for deploy errors (h.applyManifests / realHelmDeployer.Deploy / release migration)
 	if deployErr != nil {
 		var err error
 		h.DeployItem.Status.ProviderStatus, err = kutil.ConvertToRawExtension(h.ProviderStatus, HelmScheme)
@@ -93,6 +99,8 @@ func (h *Helm) ApplyFiles(ctx context.Context, filesForManifestDeployer, crdsFor
 		return deployErr
 	}
 
+	h.ProviderStatus.DeploymentMode = deploymentMode
+
 	var err error
 	h.DeployItem.Status.ProviderStatus, err = kutil.ConvertToRawExtension(h.ProviderStatus, HelmScheme)
 	if err != nil {
@@ -173,6 +181,11 @@ func (h *Helm) createManifests(ctx context.Context, currOp string, files, crds m
 		return nil, lserrors.NewWrappedError(err, currOp, "ExpandManifests", err.Error())
 	}
 
+	objects, err = h.postRenderObjects(logger, objects)
+	if err != nil {
+		return nil, lserrors.NewWrappedError(err, currOp, "PostRender", err.Error(), lsv1alpha1.ErrorConfigurationProblem)
+	}
+
 	crdObjects, err := kutil.ParseFilesToRawExtension(logger, crds)
 	if err != nil {
 		return nil, lserrors.NewWrappedError(err,
@@ -214,6 +227,31 @@ func (h *Helm) createManifests(ctx context.Context, currOp string, files, crds m
 	return manifests, nil
 }
 
+// postRenderObjects applies the post renderer to the templated objects, as helm does for a release.
+// Like in helm, crds are not post rendered.
+func (h *Helm) postRenderObjects(logger logging.Logger, objects []*runtime.RawExtension) ([]*runtime.RawExtension, error) {
+	postRenderer, err := postrenderer.New(h.ProviderConfiguration.PostRenderer)
+	if err != nil || postRenderer == nil {
+		return objects, err
+	}
+
+	manifests := &bytes.Buffer{}
+	for _, obj := range objects {
+		data, err := yaml.JSONToYAML(obj.Raw)
+		if err != nil {
+			return nil, fmt.Errorf("unable to convert templated object to yaml: %w", err)
+		}
+		manifests.WriteString("---\n")
+		manifests.Write(data)
+	}
+
+	postRendered, err := postRenderer.Run(manifests)
+	if err != nil {
+		return nil, err
+	}
+	return kutil.DecodeObjectsToRawExtension(logger, "post-rendered manifests", postRendered.Bytes())
+}
+
 // checkResourcesReady checks if the managed resources are Ready/Healthy.
 func (h *Helm) checkResourcesReady(ctx context.Context, client client.Client, failOnMissingObject bool) error {
 
@@ -298,8 +336,14 @@ func (h *Helm) readExportValues(ctx context.Context, currOp string, targetClient
 }
 
 // DeleteFiles deletes the managed resources from the target cluster.
+// The deletion follows the mode of the last deployment, as the deployment mode might have been changed since then.
 func (h *Helm) DeleteFiles(ctx context.Context) error {
-	if h.ProviderConfiguration.HelmDeployment != nil && !(*h.ProviderConfiguration.HelmDeployment) {
+	deployedWithManifests := h.ProviderConfiguration.HelmDeployment != nil && !(*h.ProviderConfiguration.HelmDeployment)
+	if h.ProviderStatus != nil && len(h.ProviderStatus.DeploymentMode) != 0 {
+		deployedWithManifests = h.ProviderStatus.DeploymentMode == helmv1alpha1.DeploymentModeManifest
+	}
+
+	if deployedWithManifests {
 		return h.deleteManifestsInGroups(ctx)
 	} else {
 		return h.deleteManifestsWithRealHelmDeployer(ctx, h.DeployItem)
@@ -376,7 +420,7 @@ func (h *Helm) constructExportsFromValues(values map[string]interface{}) (map[st
 		exportDefs = append(exportDefs, h.ProviderConfiguration.Exports.Exports...)
 	}
 	for _, export := range exportDefs {
-		if export.FromResource != nil {
+		if export.IsResourceExport() {
 			continue
 		}
 
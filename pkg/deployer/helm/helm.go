@@ -11,6 +11,7 @@ import (
 	"helm.sh/helm/v3/pkg/chart"
 	"helm.sh/helm/v3/pkg/chartutil"
 	"helm.sh/helm/v3/pkg/engine"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/yaml"
 	"k8s.io/client-go/rest"
@@ -22,6 +23,7 @@ import (
 	helminstall "github.com/gardener/landscaper/apis/deployer/helm/install"
 	helmv1alpha1 "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1"
 	helmv1alpha1validation "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1/validation"
+	"github.com/gardener/landscaper/apis/deployer/utils/managedresource"
 	lserrors "github.com/gardener/landscaper/apis/errors"
 	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
 	"github.com/gardener/landscaper/pkg/api"
@@ -139,9 +141,14 @@ func (h *Helm) Template(ctx context.Context) (map[string]string, map[string]stri
 		return nil, nil, nil, nil, lserrors.NewWrappedError(err, currOp, "ResolveSecrets", err.Error())
 	}
 
+	chartConfig, err := h.resolveChartVersion(ctx)
+	if err != nil {
+		return nil, nil, nil, nil, lserrors.NewWrappedError(err, currOp, "ResolveChartVersion", err.Error())
+	}
+
 	useChartCache := helper.HasCacheHelmChartsAnnotation(&h.DeployItem.ObjectMeta)
 
-	ch, err := chartresolver.GetChart(ctx, &h.ProviderConfiguration.Chart, h.lsUncachedClient, h.Context,
+	ch, err := chartresolver.GetChart(ctx, chartConfig, h.lsUncachedClient, h.Context,
 		registryPullSecrets, h.Configuration.OCI, useChartCache)
 	if err != nil {
 		if h.isDownloadInfoError(err) {
@@ -188,6 +195,55 @@ func (h *Helm) Template(ctx context.Context) (map[string]string, map[string]stri
 	return filesForManifestDeployer, crdsForManifestDeployer, values, ch, nil
 }
 
+// resolveChartVersion resolves the version constraint of a chart from a helm chart repo and records the resolved
+// version in the provider status. It returns the chart configuration with the resolved version.
+func (h *Helm) resolveChartVersion(ctx context.Context) (*helmv1alpha1.Chart, error) {
+	chartConfig := &h.ProviderConfiguration.Chart
+	if chartConfig.HelmChartRepo == nil {
+		return chartConfig, nil
+	}
+
+	var previous *helmv1alpha1.ResolvedChartVersion
+	if h.ProviderStatus != nil {
+		previous = h.ProviderStatus.ResolvedChartVersion
+	}
+
+	resolver := chartresolver.NewChartVersionResolver(h.lsUncachedClient, h.Context,
+		chartresolver.GetHelmRepoIndexCache(h.Configuration.HelmChartRepoIndexCache))
+	resolved, err := resolver.Resolve(ctx, chartConfig.HelmChartRepo, previous,
+		h.DeployItem.GetAnnotations()[helmv1alpha1.ResolveChartVersionAnnotation])
+	if err != nil {
+		return nil, err
+	}
+
+	if resolved == nil {
+		if h.ProviderStatus != nil {
+			h.ProviderStatus.ResolvedChartVersion = nil
+		}
+		return chartConfig, nil
+	}
+
+	h.initProviderStatus()
+	h.ProviderStatus.ResolvedChartVersion = resolved
+
+	resolvedChartConfig := chartConfig.DeepCopy()
+	resolvedChartConfig.HelmChartRepo.HelmChartVersion = resolved.Version
+	return resolvedChartConfig, nil
+}
+
+// initProviderStatus creates the provider status if it does not yet exist.
+func (h *Helm) initProviderStatus() {
+	if h.ProviderStatus == nil {
+		h.ProviderStatus = &helmv1alpha1.ProviderStatus{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: helmv1alpha1.SchemeGroupVersion.String(),
+				Kind:       "ProviderStatus",
+			},
+			ManagedResources: make(managedresource.ManagedResourceStatusList, 0),
+		}
+	}
+}
+
 func (h *Helm) isDownloadInfoError(err error) bool {
 	msg := err.Error()
 	return strings.Contains(msg, "no chart name found") ||
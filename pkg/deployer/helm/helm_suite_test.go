@@ -375,6 +375,125 @@ var _ = Describe("Template", func() {
 
 		})
 
+		It("should migrate a deploy item between the helm deployment and the manifest-only deployment", func() {
+			const namespace = "migration-namespace"
+			ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: namespace}}
+			Expect(state.Client.Create(ctx, ns)).To(Succeed())
+			defer func() {
+				Expect(state.Client.Delete(ctx, ns)).To(Succeed())
+			}()
+
+			Expect(utils.CreateExampleDefaultContext(ctx, testenv.Client, state.Namespace)).To(Succeed())
+			target, err := utils.CreateKubernetesTarget(state.Namespace, "my-target", testenv.Env.Config)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(state.Create(ctx, target)).To(Succeed())
+
+			chartBytes, closer := utils.ReadChartFrom("./testdata/testchart2")
+			defer closer()
+
+			helmConfig := &helmv1alpha1.ProviderConfiguration{
+				Name:      "test",
+				Namespace: namespace,
+				Chart: helmv1alpha1.Chart{
+					Archive: &helmv1alpha1.ArchiveAccess{
+						Raw: base64.StdEncoding.EncodeToString(chartBytes),
+					},
+				},
+				HelmDeployment: ptr.To(true),
+			}
+			item, err := helm.NewDeployItemBuilder().
+				Key(state.Namespace, "myitem").
+				ProviderConfig(helmConfig).
+				Target(target.Namespace, target.Name).
+				GenerateJobID().
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(state.Create(ctx, item, envtest.UpdateStatus(true))).To(Succeed())
+
+			reconcile := func(config *helmv1alpha1.ProviderConfiguration) *helmv1alpha1.ProviderStatus {
+				if config != nil {
+					Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(item), item)).To(Succeed())
+					providerConfig, err := helper.ProviderConfigurationToRawExtension(config)
+					Expect(err).ToNot(HaveOccurred())
+					item.Spec.Configuration = providerConfig
+					Expect(testenv.Client.Update(ctx, item)).To(Succeed())
+					Expect(utils.UpdateJobIdForDeployItem(ctx, testenv, item, metav1.Now())).To(Succeed())
+				}
+				Eventually(func(g Gomega) {
+					g.Expect(isFinished(item)).To(BeTrue())
+				}, 20*time.Second, 1*time.Second).Should(Succeed(), "deploy item should be finished")
+
+				status := &helmv1alpha1.ProviderStatus{}
+				Expect(json.Unmarshal(item.Status.ProviderStatus.Raw, status)).To(Succeed())
+				return status
+			}
+
+			releaseSecrets := func() []corev1.Secret {
+				secrets := &corev1.SecretList{}
+				Expect(testenv.Client.List(ctx, secrets, client.InNamespace(namespace),
+					client.MatchingLabels{"owner": "helm", "name": "test"})).To(Succeed())
+				return secrets.Items
+			}
+
+			getConfigMap := func() *corev1.ConfigMap {
+				cm := &corev1.ConfigMap{}
+				Expect(testenv.Client.Get(ctx, kutil.ObjectKey("test-cm-1", namespace), cm)).To(Succeed())
+				return cm
+			}
+
+			By("Deploy the chart as helm release")
+			status := reconcile(nil)
+			Expect(item.Status.Phase).To(Equal(lsv1alpha1.DeployItemPhases.Succeeded))
+			Expect(status.DeploymentMode).To(Equal(helmv1alpha1.DeploymentModeHelm))
+			Expect(releaseSecrets()).ToNot(BeEmpty())
+			uid := getConfigMap().UID
+
+			By("Plan the migration to the manifest-only deployment")
+			helmConfig.HelmDeployment = ptr.To(false)
+			helmConfig.ReleaseMigration = &helmv1alpha1.ReleaseMigrationConfiguration{DryRun: true}
+			status = reconcile(helmConfig)
+			Expect(item.Status.Phase).To(Equal(lsv1alpha1.DeployItemPhases.Failed))
+			Expect(status.DeploymentMode).To(Equal(helmv1alpha1.DeploymentModeHelm))
+			Expect(status.ReleaseMigration).ToNot(BeNil())
+			Expect(status.ReleaseMigration.DryRun).To(BeTrue())
+			Expect(status.ReleaseMigration.From).To(Equal(helmv1alpha1.DeploymentModeHelm))
+			Expect(status.ReleaseMigration.To).To(Equal(helmv1alpha1.DeploymentModeManifest))
+			Expect(status.ReleaseMigration.Steps).ToNot(BeEmpty())
+			Expect(releaseSecrets()).ToNot(BeEmpty())
+
+			By("Migrate to the manifest-only deployment")
+			helmConfig.ReleaseMigration.DryRun = false
+			status = reconcile(helmConfig)
+			Expect(item.Status.Phase).To(Equal(lsv1alpha1.DeployItemPhases.Succeeded))
+			Expect(status.DeploymentMode).To(Equal(helmv1alpha1.DeploymentModeManifest))
+			Expect(status.ManagedResources).To(HaveLen(3))
+			Expect(releaseSecrets()).To(BeEmpty())
+			cm := getConfigMap()
+			Expect(cm.UID).To(Equal(uid))
+			Expect(cm.Labels).To(HaveKeyWithValue(helmv1alpha1.ManagedDeployItemLabel, item.Name))
+			Expect(cm.Annotations).ToNot(HaveKey("meta.helm.sh/release-name"))
+
+			By("Migrate back to the helm deployment")
+			helmConfig.HelmDeployment = ptr.To(true)
+			status = reconcile(helmConfig)
+			Expect(item.Status.Phase).To(Equal(lsv1alpha1.DeployItemPhases.Succeeded))
+			Expect(status.DeploymentMode).To(Equal(helmv1alpha1.DeploymentModeHelm))
+			Expect(releaseSecrets()).ToNot(BeEmpty())
+			cm = getConfigMap()
+			Expect(cm.UID).To(Equal(uid))
+			Expect(cm.Labels).ToNot(HaveKey(helmv1alpha1.ManagedDeployItemLabel))
+			Expect(cm.Annotations).To(HaveKeyWithValue("meta.helm.sh/release-name", "test"))
+
+			By("Delete the deploy item")
+			Expect(testenv.Client.Delete(ctx, item)).To(Succeed())
+			Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(item), item)).To(Succeed())
+			Expect(utils.UpdateJobIdForDeployItem(ctx, testenv, item, metav1.Now())).To(Succeed())
+			Eventually(func() bool {
+				return apierrors.IsNotFound(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(item), item))
+			}, 20*time.Second, 1*time.Second).Should(BeTrue(), "deploy item should be deleted")
+			Expect(releaseSecrets()).To(BeEmpty())
+		})
+
 		It("should deploy a chart with subchart", func() {
 			Expect(utils.CreateExampleDefaultContext(ctx, testenv.Client, state.Namespace)).To(Succeed())
 			target, err := utils.CreateKubernetesTarget(state.Namespace, "my-target", testenv.Env.Config)
@@ -0,0 +1,331 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package helm
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	helmv1alpha1 "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1"
+	"github.com/gardener/landscaper/apis/deployer/utils/managedresource"
+	lserrors "github.com/gardener/landscaper/apis/errors"
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	"github.com/gardener/landscaper/pkg/deployer/helm/realhelmdeployer"
+	"github.com/gardener/landscaper/pkg/deployer/lib/interruption"
+	"github.com/gardener/landscaper/pkg/deployer/lib/resourcemanager"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+const (
+	// helmReleaseNameAnnotation, helmReleaseNamespaceAnnotation and helmManagedByLabel are the metadata with which
+	// helm marks the objects of a release. Helm only adopts existing objects with matching metadata.
+	helmReleaseNameAnnotation      = "meta.helm.sh/release-name"
+	helmReleaseNamespaceAnnotation = "meta.helm.sh/release-namespace"
+	helmManagedByLabel             = "app.kubernetes.io/managed-by"
+	helmManagedByLabelValue        = "Helm"
+)
+
+// releaseMigration describes a migration between the deployment modes or the adoption of an existing helm release.
+type releaseMigration struct {
+	from helmv1alpha1.DeploymentMode
+	to   helmv1alpha1.DeploymentMode
+
+	// releaseObjects are the objects of the helm release, which are taken over by the manifest-only deployment.
+	releaseObjects managedresource.ManagedResourceStatusList
+	// managedObjects are the objects of the manifest-only deployment, which are taken over by the helm release.
+	managedObjects managedresource.ManagedResourceStatusList
+
+	steps []string
+}
+
+// planReleaseMigration determines whether the deploy item migrates from its previous deployment mode to the given
+// mode or adopts an existing helm release. It returns nil if there is nothing to migrate.
+// Deploy items without recorded deployment mode have been deployed before the mode was recorded: their mode is
+// derived from the existence of the helm release.
+func (h *Helm) planReleaseMigration(ctx context.Context, helmDeployer *realhelmdeployer.RealHelmDeployer,
+	to helmv1alpha1.DeploymentMode) (*releaseMigration, error) {
+
+	from := h.ProviderStatus.DeploymentMode
+	if from == to {
+		return nil, nil
+	}
+
+	var releaseObjects managedresource.ManagedResourceStatusList
+	if from == helmv1alpha1.DeploymentModeHelm || len(from) == 0 {
+		objects, err := helmDeployer.GetReleaseObjects(ctx)
+		if err != nil {
+			return nil, err
+		}
+		releaseObjects = objects
+	}
+
+	if len(from) == 0 {
+		if len(h.ProviderStatus.ManagedResources) != 0 {
+			from = helmv1alpha1.DeploymentModeManifest
+			if releaseObjects != nil {
+				from = helmv1alpha1.DeploymentModeHelm
+			}
+		} else if releaseObjects == nil {
+			// first deployment
+			return nil, nil
+		}
+	}
+
+	return newReleaseMigration(from, to, h.ProviderConfiguration, h.DeployItem.Name,
+		releaseObjects, h.ProviderStatus.ManagedResources)
+}
+
+// newReleaseMigration computes the steps of a migration. An empty source mode denotes the adoption of an existing
+// helm release. The release objects are nil if the helm release does not exist.
+func newReleaseMigration(from, to helmv1alpha1.DeploymentMode, config *helmv1alpha1.ProviderConfiguration,
+	deployItemName string, releaseObjects, managedResources managedresource.ManagedResourceStatusList) (*releaseMigration, error) {
+
+	if from == to {
+		return nil, nil
+	}
+
+	adoptRelease := config.ReleaseMigration != nil && config.ReleaseMigration.AdoptRelease
+	releaseKey := fmt.Sprintf("%s/%s", config.Namespace, config.Name)
+
+	m := &releaseMigration{
+		from:  from,
+		to:    to,
+		steps: []string{},
+	}
+
+	switch {
+	case len(from) == 0 && to == helmv1alpha1.DeploymentModeHelm:
+		if !adoptRelease {
+			// an existing release is upgraded as before
+			return nil, nil
+		}
+		m.steps = append(m.steps, fmt.Sprintf("upgrade the existing helm release %s with %d objects", releaseKey, len(releaseObjects)))
+
+	case len(from) == 0 && to == helmv1alpha1.DeploymentModeManifest:
+		if !adoptRelease {
+			return nil, lserrors.NewError("PlanReleaseMigration", "AdoptRelease",
+				fmt.Sprintf("a helm release %s already exists; set releaseMigration.adoptRelease to take it over", releaseKey),
+				lsv1alpha1.ErrorConfigurationProblem)
+		}
+		fallthrough
+
+	case to == helmv1alpha1.DeploymentModeManifest:
+		for i := range releaseObjects {
+			releaseObjects[i].Policy = managedresource.ManagePolicy
+			m.steps = append(m.steps, fmt.Sprintf("take over %s from the helm release: remove the helm release annotations and add the label %s=%s",
+				objectDescription(&releaseObjects[i]), helmv1alpha1.ManagedDeployItemLabel, deployItemName))
+		}
+		m.releaseObjects = releaseObjects
+		m.steps = append(m.steps, "apply the rendered manifests and delete the taken over objects which are no longer rendered")
+		if releaseObjects != nil {
+			m.steps = append(m.steps, fmt.Sprintf("remove the revisions of the helm release %s without deleting its objects", releaseKey))
+		}
+
+	case to == helmv1alpha1.DeploymentModeHelm:
+		for i := range managedResources {
+			mr := &managedResources[i]
+			if !isOwnedManagedResource(mr) {
+				continue
+			}
+			m.managedObjects = append(m.managedObjects, *mr)
+			m.steps = append(m.steps, fmt.Sprintf("hand over %s to the helm release: add the helm release annotations and remove the label %s",
+				objectDescription(mr), helmv1alpha1.ManagedDeployItemLabel))
+		}
+		m.steps = append(m.steps,
+			fmt.Sprintf("install or upgrade the helm release %s", releaseKey),
+			"delete the handed over objects which are not part of the helm release")
+	}
+
+	return m, nil
+}
+
+// isOwnedManagedResource returns whether a managed resource of a manifest-only deployment is owned by the deploy item,
+// so that it is handed over to a helm release.
+func isOwnedManagedResource(mr *managedresource.ManagedResourceStatus) bool {
+	return len(mr.Policy) == 0 || mr.Policy == managedresource.ManagePolicy
+}
+
+func objectDescription(mr *managedresource.ManagedResourceStatus) string {
+	if len(mr.Resource.Namespace) == 0 {
+		return fmt.Sprintf("%s %s", mr.Resource.Kind, mr.Resource.Name)
+	}
+	return fmt.Sprintf("%s %s/%s", mr.Resource.Kind, mr.Resource.Namespace, mr.Resource.Name)
+}
+
+// prepareReleaseMigration plans a migration and performs the steps which are required before the deployment.
+// In dry run mode, the planned steps are only reported in the provider status and an error is returned,
+// so that the deployment is not executed.
+func (h *Helm) prepareReleaseMigration(ctx context.Context, helmDeployer *realhelmdeployer.RealHelmDeployer,
+	to helmv1alpha1.DeploymentMode) (*releaseMigration, error) {
+
+	currOp := "PrepareReleaseMigration"
+	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyMethod, currOp})
+
+	m, err := h.planReleaseMigration(ctx, helmDeployer, to)
+	if err != nil || m == nil {
+		return nil, err
+	}
+
+	dryRun := h.ProviderConfiguration.ReleaseMigration != nil && h.ProviderConfiguration.ReleaseMigration.DryRun
+	h.ProviderStatus.ReleaseMigration = &helmv1alpha1.ReleaseMigrationStatus{
+		From:   m.from,
+		To:     m.to,
+		DryRun: dryRun,
+		Steps:  m.steps,
+		Time:   metav1.Now(),
+	}
+
+	if dryRun {
+		return nil, lserrors.NewError(currOp, "DryRun",
+			fmt.Sprintf("the migration to the deployment mode %q has been planned in dry run mode: see the steps in the provider status "+
+				"and set releaseMigration.dryRun to false to perform them", m.to))
+	}
+
+	logger.Info("migrating deployment mode", "from", string(m.from), "to", string(m.to))
+
+	targetClient := h.targetAccess.TargetClient()
+	switch m.to {
+	case helmv1alpha1.DeploymentModeManifest:
+		for i := range m.releaseObjects {
+			if err := patchObjectMetadata(ctx, targetClient, &m.releaseObjects[i], func(obj *unstructured.Unstructured) {
+				removeAnnotations(obj, helmReleaseNameAnnotation, helmReleaseNamespaceAnnotation)
+				kutil.SetMetaDataLabel(obj, helmv1alpha1.ManagedDeployItemLabel, h.DeployItem.Name)
+			}); err != nil {
+				return nil, lserrors.NewWrappedError(err, currOp, "TakeOverReleaseObject", err.Error())
+			}
+		}
+		if m.releaseObjects != nil {
+			// the manifest applier deletes the taken over objects which are no longer rendered
+			h.ProviderStatus.ManagedResources = m.releaseObjects
+		}
+
+	case helmv1alpha1.DeploymentModeHelm:
+		for i := range m.managedObjects {
+			if err := patchObjectMetadata(ctx, targetClient, &m.managedObjects[i], func(obj *unstructured.Unstructured) {
+				annotations := obj.GetAnnotations()
+				if annotations == nil {
+					annotations = map[string]string{}
+				}
+				annotations[helmReleaseNameAnnotation] = h.ProviderConfiguration.Name
+				annotations[helmReleaseNamespaceAnnotation] = h.ProviderConfiguration.Namespace
+				obj.SetAnnotations(annotations)
+				kutil.SetMetaDataLabel(obj, helmManagedByLabel, helmManagedByLabelValue)
+				removeLabels(obj, helmv1alpha1.ManagedDeployItemLabel)
+			}); err != nil {
+				return nil, lserrors.NewWrappedError(err, currOp, "HandOverManagedObject", err.Error())
+			}
+		}
+	}
+
+	return m, nil
+}
+
+// completeReleaseMigration performs the steps of a migration which are required after a successful deployment.
+func (h *Helm) completeReleaseMigration(ctx context.Context, helmDeployer *realhelmdeployer.RealHelmDeployer, m *releaseMigration) error {
+	if m == nil {
+		return nil
+	}
+
+	currOp := "CompleteReleaseMigration"
+	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyMethod, currOp})
+
+	switch m.to {
+	case helmv1alpha1.DeploymentModeManifest:
+		if m.releaseObjects != nil {
+			if err := helmDeployer.DeleteReleaseHistory(ctx); err != nil {
+				return err
+			}
+		}
+
+	case helmv1alpha1.DeploymentModeHelm:
+		releaseObjects, err := helmDeployer.GetReleaseObjects(ctx)
+		if err != nil {
+			return err
+		}
+
+		orphaned := managedresource.ManagedResourceStatusList{}
+		for _, mr := range m.managedObjects {
+			if !containsObject(releaseObjects, &mr) {
+				orphaned = append(orphaned, mr)
+			}
+		}
+
+		if len(orphaned) != 0 {
+			logger.Info("deleting objects which are not part of the helm release", "count", len(orphaned))
+			err := resourcemanager.DeleteManagedResources(
+				ctx,
+				h.lsUncachedClient,
+				orphaned,
+				h.ProviderConfiguration.DeletionGroupsDuringUpdate,
+				h.targetAccess.TargetClient(),
+				h.DeployItem,
+				interruption.NewStandardInterruptionChecker(h.DeployItem, h.lsUncachedClient),
+				h.lsRestConfig)
+			if err != nil {
+				return lserrors.NewWrappedError(err, currOp, "DeleteOrphanedObjects", err.Error())
+			}
+		}
+	}
+
+	logger.Info("migration of deployment mode completed", "from", string(m.from), "to", string(m.to))
+	return nil
+}
+
+// patchObjectMetadata modifies the metadata of an object in the target cluster. Missing objects are skipped.
+func patchObjectMetadata(ctx context.Context, targetClient client.Client, mr *managedresource.ManagedResourceStatus,
+	modify func(obj *unstructured.Unstructured)) error {
+
+	ref := mr.Resource
+	obj := kutil.ObjectFromCoreObjectReference(&ref)
+	key := kutil.ObjectKey(ref.Name, ref.Namespace)
+	if err := read_write_layer.GetUnstructured(ctx, targetClient, key, obj, read_write_layer.R000120); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("unable to get %s: %w", objectDescription(mr), err)
+	}
+
+	orig := obj.DeepCopy()
+	modify(obj)
+	if err := targetClient.Patch(ctx, obj, client.MergeFrom(orig)); err != nil {
+		return fmt.Errorf("unable to patch %s: %w", objectDescription(mr), err)
+	}
+	return nil
+}
+
+func removeAnnotations(obj *unstructured.Unstructured, keys ...string) {
+	annotations := obj.GetAnnotations()
+	for _, key := range keys {
+		delete(annotations, key)
+	}
+	obj.SetAnnotations(annotations)
+}
+
+func removeLabels(obj *unstructured.Unstructured, keys ...string) {
+	labels := obj.GetLabels()
+	for _, key := range keys {
+		delete(labels, key)
+	}
+	obj.SetLabels(labels)
+}
+
+func containsObject(list managedresource.ManagedResourceStatusList, mr *managedresource.ManagedResourceStatus) bool {
+	for i := range list {
+		ref := &list[i].Resource
+		if ref.APIVersion == mr.Resource.APIVersion && ref.Kind == mr.Resource.Kind &&
+			ref.Namespace == mr.Resource.Namespace && ref.Name == mr.Resource.Name {
+			return true
+		}
+	}
+	return false
+}
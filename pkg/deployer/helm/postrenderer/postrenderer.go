@@ -0,0 +1,171 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package postrenderer implements the post renderer of the helm deployer, which modifies the rendered manifests
+// of a chart with kustomize before they are deployed.
+package postrenderer
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+
+	"helm.sh/helm/v3/pkg/postrender"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/kustomize/api/krusty"
+	"sigs.k8s.io/kustomize/api/types"
+	"sigs.k8s.io/kustomize/kyaml/filesys"
+	"sigs.k8s.io/yaml"
+
+	helmv1alpha1 "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1"
+)
+
+const (
+	kustomizationDir   = "/postrenderer"
+	manifestsFileName  = "manifests.yaml"
+	kustomizationFile  = "kustomization.yaml"
+	kustomizationPatch = "patches"
+)
+
+// allowedKustomizationFields are the fields of a kustomization that can be used in the post renderer.
+// They only configure patches and built-in transformers. All other fields are rejected,
+// as they could load further resources or execute plugins.
+var allowedKustomizationFields = sets.New[string](
+	"apiVersion",
+	"kind",
+	kustomizationPatch,
+	"images",
+	"labels",
+	"commonLabels",
+	"commonAnnotations",
+	"namespace",
+	"namePrefix",
+	"nameSuffix",
+	"replicas",
+)
+
+// PostRenderer modifies rendered manifests according to a post renderer configuration.
+// It implements the post renderer interface of helm, so that it can be used for helm install and upgrade operations
+// as well as for manifest-only deployments.
+type PostRenderer struct {
+	kustomization map[string]interface{}
+}
+
+var _ postrender.PostRenderer = &PostRenderer{}
+
+// New creates a post renderer. Nil is returned if the configuration contains no modifications.
+func New(config *helmv1alpha1.PostRendererConfiguration) (*PostRenderer, error) {
+	if config == nil || (len(config.Patches) == 0 && len(config.Kustomization) == 0) {
+		return nil, nil
+	}
+
+	kustomization := map[string]interface{}{}
+	if len(config.Kustomization) != 0 {
+		if err := json.Unmarshal(config.Kustomization, &kustomization); err != nil {
+			return nil, fmt.Errorf("unable to parse kustomization of post renderer: %w", err)
+		}
+		for field := range kustomization {
+			if !allowedKustomizationFields.Has(field) {
+				return nil, fmt.Errorf("field %q of the kustomization of the post renderer is not supported", field)
+			}
+		}
+	}
+
+	patches, _ := kustomization[kustomizationPatch].([]interface{})
+	for i, patch := range config.Patches {
+		kustomizePatch, err := newKustomizePatch(patch)
+		if err != nil {
+			return nil, fmt.Errorf("invalid patch %d of post renderer: %w", i, err)
+		}
+		patches = append(patches, kustomizePatch)
+	}
+	if len(patches) != 0 {
+		kustomization[kustomizationPatch] = patches
+	}
+
+	kustomization["apiVersion"] = "kustomize.config.k8s.io/v1beta1"
+	kustomization["kind"] = "Kustomization"
+	kustomization["resources"] = []interface{}{manifestsFileName}
+
+	return &PostRenderer{kustomization: kustomization}, nil
+}
+
+// newKustomizePatch converts a patch into the patch format of a kustomization.
+// Kustomize detects the type of the patch from its content.
+func newKustomizePatch(patch helmv1alpha1.PostRendererPatch) (map[string]interface{}, error) {
+	var content []byte
+	switch {
+	case len(patch.StrategicMerge) != 0 && len(patch.JSON) != 0:
+		return nil, fmt.Errorf("only one of strategicMerge and json must be set")
+	case len(patch.StrategicMerge) != 0:
+		data, err := yaml.JSONToYAML(patch.StrategicMerge)
+		if err != nil {
+			return nil, fmt.Errorf("unable to convert strategic merge patch: %w", err)
+		}
+		content = data
+	case len(patch.JSON) != 0:
+		if patch.Target == nil {
+			return nil, fmt.Errorf("a json patch requires a target")
+		}
+		content = patch.JSON
+	default:
+		return nil, fmt.Errorf("one of strategicMerge and json must be set")
+	}
+
+	kustomizePatch := map[string]interface{}{
+		"patch": string(content),
+	}
+	if t := patch.Target; t != nil {
+		target := map[string]interface{}{}
+		for key, value := range map[string]string{
+			"group":              t.Group,
+			"version":            t.Version,
+			"kind":               t.Kind,
+			"name":               t.Name,
+			"namespace":          t.Namespace,
+			"labelSelector":      t.LabelSelector,
+			"annotationSelector": t.AnnotationSelector,
+		} {
+			if len(value) != 0 {
+				target[key] = value
+			}
+		}
+		kustomizePatch["target"] = target
+	}
+	return kustomizePatch, nil
+}
+
+// Run applies the modifications to the rendered manifests, which are given as multi document yaml.
+func (p *PostRenderer) Run(renderedManifests *bytes.Buffer) (*bytes.Buffer, error) {
+	fs := filesys.MakeFsInMemory()
+
+	kustomizationData, err := yaml.Marshal(p.kustomization)
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal kustomization of post renderer: %w", err)
+	}
+	if err := fs.MkdirAll(kustomizationDir); err != nil {
+		return nil, err
+	}
+	if err := fs.WriteFile(filepath.Join(kustomizationDir, kustomizationFile), kustomizationData); err != nil {
+		return nil, err
+	}
+	if err := fs.WriteFile(filepath.Join(kustomizationDir, manifestsFileName), renderedManifests.Bytes()); err != nil {
+		return nil, err
+	}
+
+	opts := krusty.MakeDefaultOptions()
+	opts.LoadRestrictions = types.LoadRestrictionsRootOnly
+	opts.PluginConfig = types.DisabledPluginConfig()
+	resources, err := krusty.MakeKustomizer(opts).Run(fs, kustomizationDir)
+	if err != nil {
+		return nil, fmt.Errorf("post renderer failed: %w", err)
+	}
+
+	data, err := resources.AsYaml()
+	if err != nil {
+		return nil, fmt.Errorf("unable to serialize post rendered manifests: %w", err)
+	}
+	return bytes.NewBuffer(data), nil
+}
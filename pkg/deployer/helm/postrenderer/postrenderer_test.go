@@ -0,0 +1,166 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package postrenderer_test
+
+import (
+	"bytes"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/yaml"
+
+	helmv1alpha1 "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1"
+	"github.com/gardener/landscaper/pkg/deployer/helm/postrenderer"
+)
+
+const testManifests = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: app
+  namespace: default
+  labels:
+    app: test
+spec:
+  replicas: 1
+  template:
+    spec:
+      containers:
+      - name: app
+        image: example.com/app:1.0.0
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: config
+  namespace: default
+data:
+  key: value
+`
+
+var _ = Describe("PostRenderer", func() {
+
+	run := func(config *helmv1alpha1.PostRendererConfiguration) (*appsv1.Deployment, *corev1.ConfigMap) {
+		renderer, err := postrenderer.New(config)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(renderer).ToNot(BeNil())
+
+		result, err := renderer.Run(bytes.NewBufferString(testManifests))
+		Expect(err).ToNot(HaveOccurred())
+
+		docs := bytes.Split(result.Bytes(), []byte("\n---\n"))
+		Expect(docs).To(HaveLen(2))
+		deployment := &appsv1.Deployment{}
+		Expect(yaml.Unmarshal(docs[0], deployment)).To(Succeed())
+		cm := &corev1.ConfigMap{}
+		Expect(yaml.Unmarshal(docs[1], cm)).To(Succeed())
+		return deployment, cm
+	}
+
+	It("should return no post renderer without modifications", func() {
+		renderer, err := postrenderer.New(nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(renderer).To(BeNil())
+
+		renderer, err = postrenderer.New(&helmv1alpha1.PostRendererConfiguration{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(renderer).To(BeNil())
+	})
+
+	It("should apply a strategic merge patch", func() {
+		deployment, cm := run(&helmv1alpha1.PostRendererConfiguration{
+			Patches: []helmv1alpha1.PostRendererPatch{
+				{
+					StrategicMerge: []byte(`{"apiVersion": "apps/v1", "kind": "Deployment", "metadata": {"name": "app", "namespace": "default"},
+"spec": {"template": {"spec": {"containers": [{"name": "app", "resources": {"limits": {"memory": "1Gi"}}}]}}}}`),
+				},
+			},
+		})
+		Expect(deployment.Spec.Template.Spec.Containers).To(HaveLen(1))
+		Expect(deployment.Spec.Template.Spec.Containers[0].Image).To(Equal("example.com/app:1.0.0"))
+		Expect(deployment.Spec.Template.Spec.Containers[0].Resources.Limits.Memory().String()).To(Equal("1Gi"))
+		Expect(cm.Data).To(HaveKeyWithValue("key", "value"))
+	})
+
+	It("should apply a json patch to the target objects", func() {
+		deployment, cm := run(&helmv1alpha1.PostRendererConfiguration{
+			Patches: []helmv1alpha1.PostRendererPatch{
+				{
+					Target: &helmv1alpha1.PostRendererPatchTarget{Kind: "ConfigMap", Name: "config"},
+					JSON:   []byte(`[{"op": "add", "path": "/data/other", "value": "patched"}]`),
+				},
+				{
+					Target: &helmv1alpha1.PostRendererPatchTarget{LabelSelector: "app=test"},
+					JSON:   []byte(`[{"op": "replace", "path": "/spec/replicas", "value": 3}]`),
+				},
+			},
+		})
+		Expect(cm.Data).To(HaveKeyWithValue("key", "value"))
+		Expect(cm.Data).To(HaveKeyWithValue("other", "patched"))
+		Expect(*deployment.Spec.Replicas).To(BeEquivalentTo(3))
+	})
+
+	It("should apply a kustomization", func() {
+		deployment, cm := run(&helmv1alpha1.PostRendererConfiguration{
+			Kustomization: []byte(`{"commonLabels": {"team": "a"}, "images": [{"name": "example.com/app", "newTag": "1.1.0"}]}`),
+			Patches: []helmv1alpha1.PostRendererPatch{
+				{
+					Target: &helmv1alpha1.PostRendererPatchTarget{Kind: "ConfigMap"},
+					JSON:   []byte(`[{"op": "remove", "path": "/data/key"}]`),
+				},
+			},
+		})
+		Expect(deployment.Labels).To(HaveKeyWithValue("team", "a"))
+		Expect(deployment.Spec.Template.Spec.Containers[0].Image).To(Equal("example.com/app:1.1.0"))
+		Expect(cm.Labels).To(HaveKeyWithValue("team", "a"))
+		Expect(cm.Data).ToNot(HaveKey("key"))
+	})
+
+	It("should reject invalid patches", func() {
+		_, err := postrenderer.New(&helmv1alpha1.PostRendererConfiguration{
+			Patches: []helmv1alpha1.PostRendererPatch{
+				{JSON: []byte(`[{"op": "remove", "path": "/data/key"}]`)},
+			},
+		})
+		Expect(err).To(HaveOccurred())
+
+		_, err = postrenderer.New(&helmv1alpha1.PostRendererConfiguration{
+			Patches: []helmv1alpha1.PostRendererPatch{{}},
+		})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should reject unsupported fields of the kustomization", func() {
+		for _, kustomization := range []string{
+			`{"resources": ["https://example.com/manifests.yaml"]}`,
+			`{"generators": ["generator.yaml"]}`,
+			`{"transformers": ["transformer.yaml"]}`,
+			`{"helmCharts": [{"name": "chart"}]}`,
+			`{"configMapGenerator": [{"name": "config", "files": ["/etc/passwd"]}]}`,
+		} {
+			_, err := postrenderer.New(&helmv1alpha1.PostRendererConfiguration{
+				Kustomization: []byte(kustomization),
+			})
+			Expect(err).To(HaveOccurred(), kustomization)
+			Expect(err.Error()).To(ContainSubstring("is not supported"))
+		}
+	})
+
+	It("should fail if a patch cannot be applied", func() {
+		renderer, err := postrenderer.New(&helmv1alpha1.PostRendererConfiguration{
+			Patches: []helmv1alpha1.PostRendererPatch{
+				{
+					Target: &helmv1alpha1.PostRendererPatchTarget{Kind: "ConfigMap"},
+					JSON:   []byte(`[{"op": "remove", "path": "/data/missing"}]`),
+				},
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		_, err = renderer.Run(bytes.NewBufferString(testManifests))
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -7,6 +7,7 @@ package realhelmdeployer
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"os"
@@ -39,9 +40,11 @@ import (
 	lserrors "github.com/gardener/landscaper/apis/errors"
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	"github.com/gardener/landscaper/pkg/deployer/helm/postrenderer"
 	"github.com/gardener/landscaper/pkg/deployer/lib/readinesscheck"
 	"github.com/gardener/landscaper/pkg/deployer/lib/resourcemanager"
 	"github.com/gardener/landscaper/pkg/deployer/lib/timeout"
+	"github.com/gardener/landscaper/pkg/utils/tracing"
 )
 
 const (
@@ -57,6 +60,7 @@ type RealHelmDeployer struct {
 	defaultNamespace   string
 	rawValues          json.RawMessage
 	helmConfig         *helmv1alpha1.HelmDeploymentConfiguration
+	postRenderer       *helmv1alpha1.PostRendererConfiguration
 	createNamespace    bool
 	targetRestConfig   *rest.Config
 	apiResourceHandler *resourcemanager.ApiResourceHandler
@@ -76,6 +80,7 @@ func NewRealHelmDeployer(ch *chart.Chart, providerConfig *helmv1alpha1.ProviderC
 		defaultNamespace:   providerConfig.Namespace,
 		rawValues:          providerConfig.Values,
 		helmConfig:         providerConfig.HelmDeploymentConfig,
+		postRenderer:       providerConfig.PostRenderer,
 		createNamespace:    providerConfig.CreateNamespace,
 		targetRestConfig:   targetAccess.TargetRestConfig(),
 		apiResourceHandler: resourcemanager.CreateApiResourceHandler(targetAccess.TargetClientSet()),
@@ -95,7 +100,9 @@ func (c *RealHelmDeployer) Deploy(ctx context.Context) error {
 
 	_, err := c.getRelease(ctx)
 	if err != nil && c.isReleaseNotFoundErr(err) {
-		_, err = c.installRelease(ctx, values)
+		spanCtx, span := tracing.StartSpan(ctx, "InstallHelmRelease", tracing.KeyName.String(c.releaseName))
+		_, err = c.installRelease(spanCtx, values)
+		tracing.EndSpan(ctx, span, err)
 
 		if err != nil {
 			helmMsg := c.getMessages()
@@ -106,7 +113,9 @@ func (c *RealHelmDeployer) Deploy(ctx context.Context) error {
 	} else if err != nil {
 		return err
 	} else {
-		_, err = c.upgradeRelease(ctx, values)
+		spanCtx, span := tracing.StartSpan(ctx, "UpgradeHelmRelease", tracing.KeyName.String(c.releaseName))
+		_, err = c.upgradeRelease(spanCtx, values)
+		tracing.EndSpan(ctx, span, err)
 		if err != nil {
 			helmMsg := c.getMessages()
 			helmMsg = helmMsg + "\n" + err.Error()
@@ -169,6 +178,14 @@ func (c *RealHelmDeployer) installRelease(ctx context.Context, values map[string
 	install.Atomic = installConfig.Atomic
 	install.Force = installConfig.Force
 
+	postRenderer, err := c.newPostRenderer()
+	if err != nil {
+		return nil, lserror.NewWrappedError(err, currOp, "CreatePostRenderer", err.Error(), lsv1alpha1.ErrorConfigurationProblem)
+	}
+	if postRenderer != nil {
+		install.PostRenderer = postRenderer
+	}
+
 	timeout, err := timeout.TimeoutExceeded(ctx, c.di, TimeoutCheckpointHelmBeforeInstallingRelease)
 	if err != nil {
 		return nil, err
@@ -195,6 +212,12 @@ func (c *RealHelmDeployer) installRelease(ctx context.Context, values map[string
 	return rel, nil
 }
 
+// newPostRenderer creates the post renderer for the rendered manifests of the release.
+// Nil is returned if no post renderer is configured.
+func (c *RealHelmDeployer) newPostRenderer() (*postrenderer.PostRenderer, error) {
+	return postrenderer.New(c.postRenderer)
+}
+
 func (c *RealHelmDeployer) isHelmInstallMessage(message string) bool {
 	return strings.Contains(message, "rendered manifests contain a resource that already exists. Unable to continue with install")
 }
@@ -222,6 +245,14 @@ func (c *RealHelmDeployer) upgradeRelease(ctx context.Context, values map[string
 	upgrade.Atomic = upgradeConfig.Atomic
 	upgrade.Force = upgradeConfig.Force
 
+	postRenderer, err := c.newPostRenderer()
+	if err != nil {
+		return nil, lserror.NewWrappedError(err, currOp, "CreatePostRenderer", err.Error(), lsv1alpha1.ErrorConfigurationProblem)
+	}
+	if postRenderer != nil {
+		upgrade.PostRenderer = postRenderer
+	}
+
 	timeout, err := timeout.TimeoutExceeded(ctx, c.di, TimeoutCheckpointHelmBeforeUpgradingRelease)
 	if err != nil {
 		return nil, err
@@ -407,12 +438,32 @@ func (o *ManifestObject) toManagedResourceStatus() *managedresource.ManagedResou
 	}
 }
 
+// GetManagedResourcesStatus returns the objects of the release, which are relevant for the default readiness check.
 func (c *RealHelmDeployer) GetManagedResourcesStatus(ctx context.Context) ([]managedresource.ManagedResourceStatus, error) {
 	release, err := c.getRelease(ctx)
 	if err != nil {
 		return nil, err
 	}
 
+	return c.parseReleaseManifest(release, true)
+}
+
+// GetReleaseObjects returns all objects of the release. Nil is returned if the release does not exist.
+func (c *RealHelmDeployer) GetReleaseObjects(ctx context.Context) ([]managedresource.ManagedResourceStatus, error) {
+	release, err := c.getRelease(ctx)
+	if err != nil {
+		if c.isReleaseNotFoundErr(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return c.parseReleaseManifest(release, false)
+}
+
+// parseReleaseManifest returns the objects of a release. If onlyReadinessRelevant is true, the result only contains
+// the objects which are relevant for the default readiness check.
+func (c *RealHelmDeployer) parseReleaseManifest(release *release.Release, onlyReadinessRelevant bool) ([]managedresource.ManagedResourceStatus, error) {
 	result := make([]managedresource.ManagedResourceStatus, 0)
 	reader := strings.NewReader(release.Manifest)
 	decoder := apimachineryyaml.NewYAMLOrJSONDecoder(reader, 1024)
@@ -430,7 +481,7 @@ func (c *RealHelmDeployer) GetManagedResourcesStatus(ctx context.Context) ([]man
 			continue
 		}
 
-		if !readinesscheck.IsRelevantForDefaultReadinessCheck(obj.groupVersionKind().GroupKind()) {
+		if onlyReadinessRelevant && !readinesscheck.IsRelevantForDefaultReadinessCheck(obj.groupVersionKind().GroupKind()) {
 			continue
 		}
 
@@ -451,6 +502,38 @@ func (c *RealHelmDeployer) GetManagedResourcesStatus(ctx context.Context) ([]man
 	return result, nil
 }
 
+// DeleteReleaseHistory removes all revisions of the release from the helm storage, e.g. the release secrets.
+// In contrast to an uninstall, the objects of the release are not deleted.
+func (c *RealHelmDeployer) DeleteReleaseHistory(ctx context.Context) error {
+	currOp := "DeleteHelmReleaseHistory"
+	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyMethod, currOp})
+
+	actionConfig, err := c.initActionConfig(ctx)
+	if err != nil {
+		return err
+	}
+
+	history, err := actionConfig.Releases.History(c.releaseName)
+	if err != nil {
+		if errors.Is(err, driver.ErrReleaseNotFound) {
+			return nil
+		}
+		return lserror.NewWrappedError(err, currOp, "GetHistory", err.Error())
+	}
+
+	for _, rel := range history {
+		if rel.Namespace != c.defaultNamespace {
+			continue
+		}
+		logger.Info(fmt.Sprintf("removing revision %d of release %s", rel.Version, c.releaseName))
+		if _, err := actionConfig.Releases.Delete(rel.Name, rel.Version); err != nil {
+			return lserror.NewWrappedError(err, currOp, "DeleteRevision", err.Error())
+		}
+	}
+
+	return nil
+}
+
 func (c *RealHelmDeployer) isReleaseNotFoundErr(err error) bool {
 	return strings.Contains(strings.ToLower(err.Error()), "release: not found")
 }
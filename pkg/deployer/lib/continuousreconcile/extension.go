@@ -10,11 +10,15 @@ import (
 	"time"
 
 	"github.com/robfig/cron/v3"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
 	cr "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile"
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	"github.com/gardener/landscaper/pkg/deployer/lib/extension"
+	"github.com/gardener/landscaper/pkg/utils/maintenancewindow"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
 )
 
 // ContinuousReconcileActiveAnnotation can be used to deactivate continuous reconciliation on a deploy item without changing its spec.
@@ -28,8 +32,12 @@ const ContinuousReconcileActiveAnnotation = "continuousreconcile.extensions.land
 //
 //	It should return nil if continuous reconciliation is not configured for the deploy item.
 //
+// If a landscaper cluster client is given, due reconciliations are postponed until the next maintenance window
+// of the context of the deploy item.
+//
 // The returned function will panic if the provided deploy item is nil.
-func ContinuousReconcileExtension(nextReconcile func(context.Context, time.Time, *lsv1alpha1.DeployItem) (*time.Time, error)) extension.ReconcileExtensionHook {
+func ContinuousReconcileExtension(nextReconcile func(context.Context, time.Time, *lsv1alpha1.DeployItem) (*time.Time, error),
+	lsClient client.Reader) extension.ReconcileExtensionHook {
 	return func(ctx context.Context, di *lsv1alpha1.DeployItem, target *lsv1alpha1.Target, hype extension.HookType) (*extension.HookResult, error) {
 		logger, ctx := logging.FromContextOrNew(ctx, nil)
 		logger = logger.WithName("continuousReconcileExtension")
@@ -43,6 +51,14 @@ func ContinuousReconcileExtension(nextReconcile func(context.Context, time.Time,
 			logger.Info("Continuous reconciliation disabled by annotation", "annotation", ContinuousReconcileActiveAnnotation)
 			return nil, nil
 		}
+		if lsv1alpha1helper.HasSuspendedAnnotation(di.ObjectMeta) {
+			logger.Info("Continuous reconciliation paused because the deploy item is suspended")
+			return nil, nil
+		}
+		if lsv1alpha1helper.HasIgnoreAnnotation(di.ObjectMeta) {
+			logger.Info("Continuous reconciliation paused because the deploy item is ignored")
+			return nil, nil
+		}
 
 		nextRaw, err := nextReconcile(ctx, di.Status.LastReconcileTime.Time, di)
 		if err != nil {
@@ -62,6 +78,18 @@ func ContinuousReconcileExtension(nextReconcile func(context.Context, time.Time,
 			res.AbortReconcile = true
 		} else {
 			// reconcile is (over-)due
+			delay, err := durationUntilMaintenanceWindow(ctx, lsClient, di, now)
+			if err != nil {
+				return nil, fmt.Errorf("unable to check maintenance windows: %w", err)
+			}
+			if delay > 0 {
+				// the reconcile remains due and is therefore executed at the begin of the maintenance window
+				res.AbortReconcile = true
+				res.ReconcileResult.RequeueAfter = delay
+				logger.Info("Postpone continuous reconcile until next maintenance window", "nextReconcileAfter", delay.String())
+				return res, nil
+			}
+
 			logger.Info("Reconcile deploy item")
 
 			// compute next reconciliation time based on reconciliation which will happen now
@@ -86,13 +114,34 @@ func ContinuousReconcileExtension(nextReconcile func(context.Context, time.Time,
 
 // ContinuousReconcileExtensionSetup is a wrapper for ContinuousReconcileExtension.
 // The return value also contains the ShouldReconcile hook type.
-func ContinuousReconcileExtensionSetup(nextReconcile func(context.Context, time.Time, *lsv1alpha1.DeployItem) (*time.Time, error)) extension.ReconcileExtensionHookSetup {
+func ContinuousReconcileExtensionSetup(nextReconcile func(context.Context, time.Time, *lsv1alpha1.DeployItem) (*time.Time, error),
+	lsClient client.Reader) extension.ReconcileExtensionHookSetup {
 	return extension.ReconcileExtensionHookSetup{
-		Hook:      ContinuousReconcileExtension(nextReconcile),
+		Hook:      ContinuousReconcileExtension(nextReconcile, lsClient),
 		HookTypes: []extension.HookType{extension.ShouldReconcileHook},
 	}
 }
 
+// durationUntilMaintenanceWindow returns the duration until the next maintenance window of the context of the deploy item.
+// It returns zero if no client is given, if the given time lies within a maintenance window, or if the context
+// defines no maintenance windows.
+func durationUntilMaintenanceWindow(ctx context.Context, lsClient client.Reader, di *lsv1alpha1.DeployItem, now time.Time) (time.Duration, error) {
+	if lsClient == nil {
+		return 0, nil
+	}
+
+	contextName := di.Spec.Context
+	if len(contextName) == 0 {
+		contextName = lsv1alpha1.DefaultContextName
+	}
+
+	windows, err := maintenancewindow.GetMaintenanceWindows(ctx, lsClient, di.Namespace, contextName, read_write_layer.R000117)
+	if err != nil {
+		return 0, err
+	}
+	return maintenancewindow.DurationUntilAllowed(windows, now)
+}
+
 // Schedule returns a cron schedule based on the specification.
 // If both Cron and Every are specified (which should be prevented by validation), Cron takes precedence.
 // If neither is specified, an error is returned (this should also be prevented by validation).
@@ -14,6 +14,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/open-component-model/ocm/pkg/contexts/datacontext"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm"
+	"go.opentelemetry.io/otel/trace"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -29,16 +30,22 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	lsconfigv1alpha1 "github.com/gardener/landscaper/apis/config/v1alpha1"
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+	"github.com/gardener/landscaper/apis/core/v1alpha1/targettypes"
 	lserrors "github.com/gardener/landscaper/apis/errors"
 	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	builtinresolver "github.com/gardener/landscaper/controller-utils/pkg/landscaper/targetresolver/builtin"
+	genericresolver "github.com/gardener/landscaper/controller-utils/pkg/landscaper/targetresolver/generic"
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
 	"github.com/gardener/landscaper/pkg/deployer/lib/extension"
 	lsutil "github.com/gardener/landscaper/pkg/utils"
 	"github.com/gardener/landscaper/pkg/utils/lock"
 	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+	"github.com/gardener/landscaper/pkg/utils/redact"
+	"github.com/gardener/landscaper/pkg/utils/tracing"
 	"github.com/gardener/landscaper/pkg/version"
 )
 
@@ -63,6 +70,13 @@ type DeployerArgs struct {
 	Deployer        Deployer
 	TargetSelectors []lsv1alpha1.TargetSelector
 	Options         ctrl.Options
+	// TargetResolvers are additional target resolvers that are referenced by name in the resolverRef of targets.
+	TargetResolvers map[string]genericresolver.Resolver
+	// TargetTypeResolvers are additional target resolvers that resolve all targets of a target type,
+	// e.g. targets for environments other than kubernetes clusters.
+	TargetTypeResolvers map[lsv1alpha1.TargetType]genericresolver.Resolver
+	// BuiltinTargetResolvers enables the built-in target resolvers that are disabled by default.
+	BuiltinTargetResolvers *lsconfigv1alpha1.TargetResolversConfiguration
 }
 
 // Default defaults deployer arguments
@@ -99,6 +113,8 @@ func Add(lsUncachedClient, lsCachedClient, hostUncachedClient, hostCachedClient
 	if err := args.Validate(); err != nil {
 		return err
 	}
+	RegisterTargetResolvers(lsMgr.GetConfig(), args)
+
 	con := NewController(lsMgr.GetConfig(),
 		lsUncachedClient, lsCachedClient, hostUncachedClient, hostCachedClient,
 		finishedObjectCache,
@@ -119,6 +135,33 @@ func Add(lsUncachedClient, lsCachedClient, hostUncachedClient, hostCachedClient
 		Complete(con)
 }
 
+// RegisterTargetResolvers registers the built-in serviceaccount-token resolver for the landscaper resource cluster,
+// the enabled built-in resolvers and the additional target resolvers of the deployer in the default target resolver registry.
+func RegisterTargetResolvers(lsRestConfig *rest.Config, args DeployerArgs) {
+	genericresolver.DefaultRegistry.Register(targettypes.ServiceAccountTokenResolverName,
+		builtinresolver.NewServiceAccountTokenResolver(lsRestConfig))
+	if builtin := args.BuiltinTargetResolvers; builtin != nil {
+		if builtin.Exec != nil {
+			genericresolver.DefaultRegistry.Register(targettypes.ExecResolverName,
+				builtinresolver.NewExecResolver(builtin.Exec.AllowedCommands))
+		}
+		if builtin.KubeconfigDirectory != nil {
+			directory := builtin.KubeconfigDirectory.Directory
+			if len(directory) == 0 {
+				directory = builtinresolver.DefaultKubeconfigDirectory
+			}
+			genericresolver.DefaultRegistry.Register(targettypes.KubeconfigDirectoryResolverName,
+				builtinresolver.NewKubeconfigDirectoryResolver(directory))
+		}
+	}
+	for name, resolver := range args.TargetResolvers {
+		genericresolver.DefaultRegistry.Register(name, resolver)
+	}
+	for targetType, resolver := range args.TargetTypeResolvers {
+		genericresolver.DefaultRegistry.RegisterForTargetType(targetType, resolver)
+	}
+}
+
 // controller reconciles deployitems and delegates the business logic to the configured Deployer.
 type controller struct {
 	lsRestConfig       *rest.Config
@@ -231,8 +274,20 @@ func (c *controller) innerReconcile(ctx context.Context, req reconcile.Request)
 		return lsutil.LogHelper{}.LogStandardErrorAndGetReconcileResult(ctx, err)
 	}
 
+	if lsv1alpha1helper.HasSuspendedAnnotation(metadata.ObjectMeta) {
+		logger.Debug("deploy item is suspended")
+		return reconcile.Result{}, nil
+	}
+
+	if lsv1alpha1helper.HasIgnoreAnnotation(metadata.ObjectMeta) {
+		logger.Debug("deploy item is ignored")
+		return reconcile.Result{}, nil
+	}
+
 	// this check is only for compatibility reasons
+	resolveStart := time.Now()
 	rt, responsible, targetNotFound, err := CheckResponsibility(ctx, c.lsUncachedClient, metadata, c.deployerType, c.targetSelectors)
+	resolveEnd := time.Now()
 	if err != nil {
 		return lsutil.LogHelper{}.LogErrorAndGetReconcileResult(ctx, err)
 	}
@@ -256,11 +311,11 @@ func (c *controller) innerReconcile(ctx context.Context, req reconcile.Request)
 		}()
 	}
 
-	return c.reconcilePrivate(ctx, metadata, rt, targetNotFound)
+	return c.reconcilePrivate(ctx, metadata, rt, targetNotFound, resolveStart, resolveEnd)
 }
 
 func (c *controller) reconcilePrivate(ctx context.Context, metadata *metav1.PartialObjectMetadata,
-	rt *lsv1alpha1.ResolvedTarget, targetNotFound bool) (_ reconcile.Result, err error) {
+	rt *lsv1alpha1.ResolvedTarget, targetNotFound bool, resolveStart, resolveEnd time.Time) (_ reconcile.Result, err error) {
 
 	op := "reconcilePrivate"
 
@@ -274,6 +329,12 @@ func (c *controller) reconcilePrivate(ctx context.Context, metadata *metav1.Part
 		return lsutil.LogHelper{}.LogStandardErrorAndGetReconcileResult(ctx, err)
 	}
 
+	// the sensitive values of the installation must not be part of the status and the logs of the deploy item
+	ctx, err = redact.NewContextForObject(ctx, c.lsUncachedClient, di)
+	if err != nil {
+		return lsutil.LogHelper{}.LogStandardErrorAndGetReconcileResult(ctx, err)
+	}
+
 	// do we really need to check if metadata and di have the same guid?
 	if metadata.UID != di.UID {
 		err := lserrors.NewError("Reconcile", "differentUIDs", "different UIDs")
@@ -291,6 +352,11 @@ func (c *controller) reconcilePrivate(ctx context.Context, metadata *metav1.Part
 		return reconcile.Result{}, nil
 	}
 
+	if lsv1alpha1helper.IsWaitingForApproval(di) {
+		logger.Debug("deploy item not reconciled because the job has not been approved")
+		return reconcile.Result{}, nil
+	}
+
 	if hasTestReconcileAnnotation {
 		if err := c.removeTestReconcileAnnotation(ctx, di); err != nil {
 			return lsutil.LogHelper{}.LogStandardErrorAndGetReconcileResult(ctx, err)
@@ -301,16 +367,27 @@ func (c *controller) reconcilePrivate(ctx context.Context, metadata *metav1.Part
 		di.Status.TransitionTimes = lsutil.NewTransitionTimes()
 	}
 
+	// the reconcile span starts with the resolution of the target which happened before the deploy item was read
+	obj, _ := tracing.DeployItemObject(di)
+	ctx, span := tracing.StartReconcile(ctx, obj, di.Status.GetJobID(), trace.WithTimestamp(resolveStart))
+	var spanErr error
+	defer func() { tracing.EndSpan(ctx, span, spanErr) }()
+	if rt != nil && rt.Target != nil {
+		tracing.RecordSpan(ctx, "ResolveTarget", resolveStart, resolveEnd, nil,
+			tracing.KeyNamespace.String(rt.Target.Namespace), tracing.KeyName.String(rt.Target.Name))
+	}
+
 	if targetNotFound {
 		lsError := lserrors.NewError(op, "NoTargetFound", "setting deploy item to failed due to missing target")
+		spanErr = lsError
 		logger.Info(lsError.Error())
 		lsv1alpha1helper.SetDeployItemToFailed(di)
 		_ = c.handleReconcileResult(ctx, lsError, old, di)
 		return c.buildResult(ctx, di.Status.Phase, nil)
 	}
 
-	if di.Status.Phase.IsFinal() || di.Status.Phase.IsEmpty() {
-		// The deployitem has a new jobID, but the phase is still finished from before
+	if di.Status.Phase.IsFinal() || di.Status.Phase.IsEmpty() || di.Status.Phase == lsv1alpha1.DeployItemPhases.WaitingForApproval {
+		// The deployitem has a new jobID, but the phase is still finished from before or the job has just been approved
 		if di.DeletionTimestamp.IsZero() {
 			if di.Spec.UpdateOnChangeOnly &&
 				di.GetGeneration() == di.Status.ObservedGeneration &&
@@ -349,11 +426,13 @@ func (c *controller) reconcilePrivate(ctx context.Context, metadata *metav1.Part
 
 	if di.DeletionTimestamp.IsZero() {
 		lsError := c.reconcile(ctx, di, rt)
+		spanErr = lsError
 		_ = c.handleReconcileResult(ctx, lsError, old, di)
 		return c.buildResult(ctx, di.Status.Phase, lsError)
 
 	} else {
 		lsError := c.delete(ctx, di, rt)
+		spanErr = lsError
 		_ = c.handleReconcileResult(ctx, lsError, old, di)
 		return c.buildResult(ctx, di.Status.Phase, lsError)
 	}
@@ -421,10 +500,37 @@ func (c *controller) reconcile(ctx context.Context, deployItem *lsv1alpha1.Deplo
 		return lserrors.NewError(operation, "ProviderConfigurationMissing", "provider configuration missing",
 			lsv1alpha1.ErrorConfigurationProblem)
 	}
+	if lsErr := checkTargetReachable(rt, operation, time.Now()); lsErr != nil {
+		return lsErr
+	}
 	err := c.deployer.Reconcile(ctx, lsCtx, deployItem, rt)
 	return lserrors.BuildLsErrorOrNil(err, operation, "Reconcile")
 }
 
+// targetProbeMaxAge is the maximal age of a failed probe of a target that lets the reconciliation of deploy items fail.
+// It is the default probe interval of the target health controller.
+const targetProbeMaxAge = 5 * time.Minute
+
+// checkTargetReachable returns an error if the status of the target reports that the target is not reachable.
+// The status is only considered if it has been probed for the current generation of the target within the
+// last probe interval, as a repaired secret of the target does not change its generation.
+func checkTargetReachable(rt *lsv1alpha1.ResolvedTarget, operation string, now time.Time) lserrors.LsError {
+	if rt == nil || rt.Target == nil {
+		return nil
+	}
+	target := rt.Target
+	if target.Status == nil || target.Status.Reachable == nil || *target.Status.Reachable ||
+		target.Status.ObservedGeneration != target.Generation {
+		return nil
+	}
+	if target.Status.LastProbeTime == nil || now.Sub(target.Status.LastProbeTime.Time) > targetProbeMaxAge {
+		return nil
+	}
+	return lserrors.NewError(operation, "TargetUnreachable",
+		fmt.Sprintf("target %s/%s is not reachable: %s", target.Namespace, target.Name, target.Status.Message),
+		lsv1alpha1.ErrorTargetUnreachable)
+}
+
 func (c *controller) delete(ctx context.Context, deployItem *lsv1alpha1.DeployItem,
 	rt *lsv1alpha1.ResolvedTarget) lserrors.LsError {
 
@@ -0,0 +1,84 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lib
+
+import (
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"strings"
+	"time"
+
+	"k8s.io/client-go/rest"
+)
+
+// GetCredentialExpirationTime returns the time when the credentials of a rest config expire.
+// The expiration time is read from the "exp" claim of a bearer token, or from the client certificate.
+// Kubeconfigs created with the gardener "shoots/adminkubeconfig" subresource, e.g. by the template function
+// getShootAdminKubeconfigWithExpirationTimestamp, contain a client certificate that expires together with the kubeconfig.
+// Nil is returned if the credentials do not expire or their expiration time is unknown.
+func GetCredentialExpirationTime(restConfig *rest.Config) (*time.Time, error) {
+	if restConfig == nil {
+		return nil, nil
+	}
+	if len(restConfig.BearerToken) != 0 {
+		return getTokenExpirationTime(restConfig.BearerToken)
+	}
+	if len(restConfig.CertData) != 0 {
+		return getCertificateExpirationTime(restConfig.CertData)
+	}
+	return nil, nil
+}
+
+// getTokenExpirationTime returns the expiration time of a jwt token.
+// Nil is returned for opaque tokens and tokens without "exp" claim.
+func getTokenExpirationTime(token string) (*time.Time, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		// not a jwt token
+		return nil, nil
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode payload of token: %w", err)
+	}
+	claims := struct {
+		Exp *int64 `json:"exp"`
+	}{}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return nil, fmt.Errorf("unable to parse claims of token: %w", err)
+	}
+	if claims.Exp == nil {
+		return nil, nil
+	}
+	exp := time.Unix(*claims.Exp, 0)
+	return &exp, nil
+}
+
+// getCertificateExpirationTime returns the earliest expiration time of the pem encoded certificates.
+func getCertificateExpirationTime(certData []byte) (*time.Time, error) {
+	var expirationTime *time.Time
+	for rest := certData; ; {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse client certificate: %w", err)
+		}
+		if expirationTime == nil || cert.NotAfter.Before(*expirationTime) {
+			notAfter := cert.NotAfter
+			expirationTime = &notAfter
+		}
+	}
+	return expirationTime, nil
+}
@@ -0,0 +1,135 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lib
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+	"k8s.io/utils/ptr"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lserrors "github.com/gardener/landscaper/apis/errors"
+)
+
+func createTestCertificate(notAfter time.Time) []byte {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).ToNot(HaveOccurred())
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "test"},
+		NotBefore:    notAfter.Add(-time.Hour),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	Expect(err).ToNot(HaveOccurred())
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
+
+func createTestToken(claims string) string {
+	encode := base64.RawURLEncoding.EncodeToString
+	return fmt.Sprintf("%s.%s.%s", encode([]byte(`{"alg":"RS256"}`)), encode([]byte(claims)), encode([]byte("signature")))
+}
+
+var _ = Describe("Credential Expiration", func() {
+
+	It("should return the expiration time of a jwt token", func() {
+		exp, err := GetCredentialExpirationTime(&rest.Config{BearerToken: createTestToken(`{"sub":"test","exp":1700000000}`)})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(exp).ToNot(BeNil())
+		Expect(exp.Unix()).To(Equal(int64(1700000000)))
+	})
+
+	It("should return nil for tokens without expiration", func() {
+		exp, err := GetCredentialExpirationTime(&rest.Config{BearerToken: createTestToken(`{"sub":"test"}`)})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(exp).To(BeNil())
+
+		exp, err = GetCredentialExpirationTime(&rest.Config{BearerToken: "opaque-token"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(exp).To(BeNil())
+	})
+
+	It("should return the earliest expiration time of the client certificates", func() {
+		notAfter := time.Now().Add(2 * time.Hour).Truncate(time.Second)
+		certData := append(createTestCertificate(notAfter.Add(time.Hour)), createTestCertificate(notAfter)...)
+		exp, err := GetCredentialExpirationTime(&rest.Config{TLSClientConfig: rest.TLSClientConfig{CertData: certData}})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(exp).ToNot(BeNil())
+		Expect(exp.Equal(notAfter)).To(BeTrue())
+	})
+
+	It("should prefer the token over the client certificate", func() {
+		exp, err := GetCredentialExpirationTime(&rest.Config{
+			BearerToken:     createTestToken(`{"exp":1700000000}`),
+			TLSClientConfig: rest.TLSClientConfig{CertData: createTestCertificate(time.Now())},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(exp.Unix()).To(Equal(int64(1700000000)))
+	})
+
+	It("should return nil if the credentials do not expire", func() {
+		exp, err := GetCredentialExpirationTime(&rest.Config{Username: "user", Password: "pass"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(exp).To(BeNil())
+	})
+})
+
+var _ = Describe("Target Reachability", func() {
+
+	now := time.Now()
+
+	newResolvedTarget := func(generation, observedGeneration int64, reachable *bool) *lsv1alpha1.ResolvedTarget {
+		target := &lsv1alpha1.Target{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-target", Namespace: "default", Generation: generation},
+		}
+		target.Status = &lsv1alpha1.TargetStatus{
+			ObservedGeneration: observedGeneration,
+			Reachable:          reachable,
+			LastProbeTime:      &metav1.Time{Time: now.Add(-time.Minute)},
+			Message:            "connection refused",
+		}
+		return &lsv1alpha1.ResolvedTarget{Target: target}
+	}
+
+	It("should fail with the target unreachable error code if the target is not reachable", func() {
+		lsErr := checkTargetReachable(newResolvedTarget(1, 1, ptr.To(false)), "reconcile", now)
+		Expect(lsErr).To(HaveOccurred())
+		Expect(lserrors.ContainsErrorCode(lsErr, lsv1alpha1.ErrorTargetUnreachable)).To(BeTrue())
+		Expect(lsErr.Error()).To(ContainSubstring("connection refused"))
+	})
+
+	It("should not fail if the target is reachable or has not been probed", func() {
+		Expect(checkTargetReachable(newResolvedTarget(1, 1, ptr.To(true)), "reconcile", now)).To(BeNil())
+		Expect(checkTargetReachable(newResolvedTarget(1, 0, nil), "reconcile", now)).To(BeNil())
+		Expect(checkTargetReachable(nil, "reconcile", now)).To(BeNil())
+		Expect(checkTargetReachable(&lsv1alpha1.ResolvedTarget{Target: &lsv1alpha1.Target{}}, "reconcile", now)).To(BeNil())
+	})
+
+	It("should ignore the status of an outdated generation", func() {
+		Expect(checkTargetReachable(newResolvedTarget(2, 1, ptr.To(false)), "reconcile", now)).To(BeNil())
+	})
+
+	It("should ignore a stale probe", func() {
+		rt := newResolvedTarget(1, 1, ptr.To(false))
+		rt.Target.Status.LastProbeTime = &metav1.Time{Time: now.Add(-10 * time.Minute)}
+		Expect(checkTargetReachable(rt, "reconcile", now)).To(BeNil())
+
+		rt.Target.Status.LastProbeTime = nil
+		Expect(checkTargetReachable(rt, "reconcile", now)).To(BeNil())
+	})
+})
@@ -0,0 +1,106 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lib
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"unicode/utf8"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+)
+
+const (
+	// MaxDeployItemHistoryEntries is the maximal number of finished jobs that are kept in the history of a deploy item.
+	MaxDeployItemHistoryEntries = 10
+
+	// maxHistoryErrorMessageLength is the maximal length of an error message in the history of a deploy item.
+	maxHistoryErrorMessageLength = 1024
+)
+
+// AddDeployItemHistoryEntry adds the result of the current finished job to the history of a deploy item.
+// An existing entry for the same job is replaced. If the history exceeds its maximal length, the oldest entries are removed.
+func AddDeployItemHistoryEntry(di *lsv1alpha1.DeployItem) {
+	entry := newDeployItemHistoryEntry(di)
+
+	history := di.Status.History
+	if n := len(history); n > 0 && history[n-1].JobID == entry.JobID {
+		history = history[:n-1]
+	}
+	history = append(history, entry)
+
+	if len(history) > MaxDeployItemHistoryEntries {
+		history = history[len(history)-MaxDeployItemHistoryEntries:]
+	}
+	di.Status.History = history
+}
+
+func newDeployItemHistoryEntry(di *lsv1alpha1.DeployItem) lsv1alpha1.DeployItemHistoryEntry {
+	entry := lsv1alpha1.DeployItemHistoryEntry{
+		JobID:             di.Status.GetJobID(),
+		Generation:        di.GetGeneration(),
+		Phase:             di.Status.Phase,
+		ConfigurationHash: configurationHash(di),
+		ManagedResources:  countManagedResources(di),
+	}
+
+	if times := di.Status.TransitionTimes; times != nil {
+		entry.StartTime = times.InitTime
+		if entry.StartTime == nil {
+			entry.StartTime = times.TriggerTime
+		}
+		entry.EndTime = times.FinishedTime
+	}
+
+	if di.Status.Phase.IsFailed() && di.Status.LastError != nil {
+		entry.Error = di.Status.LastError.DeepCopy()
+		entry.Error.Message = truncateErrorMessage(entry.Error.Message)
+	}
+
+	return entry
+}
+
+// truncateErrorMessage shortens a message to at most maxHistoryErrorMessageLength bytes.
+// The message is cut at the start of a rune, so that no multi-byte character is split.
+func truncateErrorMessage(message string) string {
+	if len(message) <= maxHistoryErrorMessageLength {
+		return message
+	}
+
+	end := maxHistoryErrorMessageLength
+	for end > 0 && !utf8.RuneStart(message[end]) {
+		end--
+	}
+	return message[:end] + "..."
+}
+
+// configurationHash returns the sha256 hash of the provider configuration of a deploy item.
+func configurationHash(di *lsv1alpha1.DeployItem) string {
+	if di.Spec.Configuration == nil || len(di.Spec.Configuration.Raw) == 0 {
+		return ""
+	}
+	sum := sha256.Sum256(di.Spec.Configuration.Raw)
+	return hex.EncodeToString(sum[:])
+}
+
+// countManagedResources returns the number of managed resources in the provider status of a deploy item.
+// Deployers that manage kubernetes resources, like the helm and manifest deployer, list them in the field
+// "managedResources" of their provider status. Nil is returned if the provider status has no such list.
+func countManagedResources(di *lsv1alpha1.DeployItem) *int {
+	if di.Status.ProviderStatus == nil || len(di.Status.ProviderStatus.Raw) == 0 {
+		return nil
+	}
+
+	providerStatus := struct {
+		ManagedResources []json.RawMessage `json:"managedResources"`
+	}{}
+	if err := json.Unmarshal(di.Status.ProviderStatus.Raw, &providerStatus); err != nil || providerStatus.ManagedResources == nil {
+		return nil
+	}
+
+	count := len(providerStatus.ManagedResources)
+	return &count
+}
@@ -0,0 +1,133 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lib
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+)
+
+var _ = Describe("Deploy item history", func() {
+
+	start := metav1.NewTime(time.Date(2024, time.May, 1, 8, 0, 0, 0, time.UTC))
+	end := metav1.NewTime(start.Add(time.Minute))
+
+	buildDeployItem := func(jobID string, phase lsv1alpha1.DeployItemPhase) *lsv1alpha1.DeployItem {
+		di := &lsv1alpha1.DeployItem{
+			ObjectMeta: metav1.ObjectMeta{Name: "di", Namespace: "test", Generation: 3},
+			Spec: lsv1alpha1.DeployItemSpec{
+				Configuration: &runtime.RawExtension{Raw: []byte(`{"values":{"replicas":2}}`)},
+			},
+			Status: lsv1alpha1.DeployItemStatus{
+				Phase: phase,
+				TransitionTimes: &lsv1alpha1.TransitionTimes{
+					TriggerTime:  &start,
+					InitTime:     &start,
+					FinishedTime: &end,
+				},
+				ProviderStatus: &runtime.RawExtension{Raw: []byte(`{"managedResources":[{"name":"a"},{"name":"b"}]}`)},
+			},
+		}
+		di.Status.SetJobID(jobID)
+		return di
+	}
+
+	It("should add the result of a finished job", func() {
+		di := buildDeployItem("job-1", lsv1alpha1.DeployItemPhases.Succeeded)
+
+		AddDeployItemHistoryEntry(di)
+		Expect(di.Status.History).To(HaveLen(1))
+		entry := di.Status.History[0]
+		Expect(entry.JobID).To(Equal("job-1"))
+		Expect(entry.Generation).To(Equal(int64(3)))
+		Expect(entry.Phase).To(Equal(lsv1alpha1.DeployItemPhases.Succeeded))
+		Expect(entry.StartTime).To(Equal(&start))
+		Expect(entry.EndTime).To(Equal(&end))
+		Expect(entry.Error).To(BeNil())
+		Expect(entry.ConfigurationHash).To(HaveLen(64))
+		Expect(entry.ManagedResources).To(HaveValue(Equal(2)))
+	})
+
+	It("should add the truncated error of a failed job", func() {
+		di := buildDeployItem("job-1", lsv1alpha1.DeployItemPhases.Failed)
+		di.Status.ProviderStatus = nil
+		di.Status.LastError = &lsv1alpha1.Error{
+			Operation: "Reconcile",
+			Reason:    "ApplyFailed",
+			Message:   strings.Repeat("x", 2*maxHistoryErrorMessageLength),
+		}
+
+		AddDeployItemHistoryEntry(di)
+		entry := di.Status.History[0]
+		Expect(entry.Error).NotTo(BeNil())
+		Expect(entry.Error.Reason).To(Equal("ApplyFailed"))
+		Expect(entry.Error.Message).To(HaveLen(maxHistoryErrorMessageLength + 3))
+		Expect(di.Status.LastError.Message).To(HaveLen(2 * maxHistoryErrorMessageLength))
+		Expect(entry.ManagedResources).To(BeNil())
+	})
+
+	It("should truncate a non-ASCII error message at a rune boundary", func() {
+		di := buildDeployItem("job-1", lsv1alpha1.DeployItemPhases.Failed)
+		di.Status.LastError = &lsv1alpha1.Error{
+			Operation: "Reconcile",
+			Reason:    "ApplyFailed",
+			// "ä" is encoded with two bytes, so that the byte limit falls into the middle of a character
+			Message: "x" + strings.Repeat("ä", maxHistoryErrorMessageLength),
+		}
+
+		AddDeployItemHistoryEntry(di)
+		message := di.Status.History[0].Error.Message
+		Expect(utf8.ValidString(message)).To(BeTrue())
+		Expect(message).To(Equal("x" + strings.Repeat("ä", (maxHistoryErrorMessageLength-1)/2) + "..."))
+	})
+
+	It("should detect configuration changes by the hash", func() {
+		di := buildDeployItem("job-1", lsv1alpha1.DeployItemPhases.Succeeded)
+		AddDeployItemHistoryEntry(di)
+
+		di.Status.SetJobID("job-2")
+		AddDeployItemHistoryEntry(di)
+
+		di.Status.SetJobID("job-3")
+		di.Spec.Configuration = &runtime.RawExtension{Raw: []byte(`{"values":{"replicas":3}}`)}
+		AddDeployItemHistoryEntry(di)
+
+		Expect(di.Status.History).To(HaveLen(3))
+		Expect(di.Status.History[1].ConfigurationHash).To(Equal(di.Status.History[0].ConfigurationHash))
+		Expect(di.Status.History[2].ConfigurationHash).NotTo(Equal(di.Status.History[1].ConfigurationHash))
+	})
+
+	It("should replace the entry of the same job", func() {
+		di := buildDeployItem("job-1", lsv1alpha1.DeployItemPhases.Failed)
+		AddDeployItemHistoryEntry(di)
+
+		di.Status.Phase = lsv1alpha1.DeployItemPhases.Succeeded
+		AddDeployItemHistoryEntry(di)
+
+		Expect(di.Status.History).To(HaveLen(1))
+		Expect(di.Status.History[0].Phase).To(Equal(lsv1alpha1.DeployItemPhases.Succeeded))
+	})
+
+	It("should keep only the most recent jobs", func() {
+		di := buildDeployItem("", lsv1alpha1.DeployItemPhases.Succeeded)
+		for i := 0; i < MaxDeployItemHistoryEntries+5; i++ {
+			di.Status.SetJobID(fmt.Sprintf("job-%d", i))
+			AddDeployItemHistoryEntry(di)
+		}
+
+		Expect(di.Status.History).To(HaveLen(MaxDeployItemHistoryEntries))
+		Expect(di.Status.History[0].JobID).To(Equal("job-5"))
+		Expect(di.Status.History[MaxDeployItemHistoryEntries-1].JobID).To(Equal(fmt.Sprintf("job-%d", MaxDeployItemHistoryEntries+4)))
+	})
+})
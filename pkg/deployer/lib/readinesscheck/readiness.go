@@ -20,6 +20,7 @@ import (
 	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
 	"github.com/gardener/landscaper/pkg/deployer/lib/interruption"
 	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+	"github.com/gardener/landscaper/pkg/utils/tracing"
 )
 
 const (
@@ -39,6 +40,14 @@ type ObjectsToWatchFunc func() ([]*unstructured.Unstructured, error)
 // WaitForObjectsReady waits for objects to be heatlhy and
 // returns an error if all the objects are not ready after the timeout.
 func WaitForObjectsReady(ctx context.Context, timeout time.Duration, kubeClient client.Client,
+	getObjects ObjectsToWatchFunc, fn checkObjectFunc, interruptionChecker interruption.InterruptionChecker, operation string) error {
+	ctx, span := tracing.StartSpan(ctx, "WaitForObjectsReady", tracing.KeyOperation.String(operation))
+	err := waitForObjectsReady(ctx, timeout, kubeClient, getObjects, fn, interruptionChecker, operation)
+	tracing.EndSpan(ctx, span, err)
+	return err
+}
+
+func waitForObjectsReady(ctx context.Context, timeout time.Duration, kubeClient client.Client,
 	getObjects ObjectsToWatchFunc, fn checkObjectFunc, interruptionChecker interruption.InterruptionChecker, operation string) error {
 	var (
 		try     int32 = 1
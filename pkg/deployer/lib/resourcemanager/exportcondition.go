@@ -0,0 +1,102 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package resourcemanager
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+
+	"github.com/gardener/landscaper/apis/deployer/utils/managedresource"
+)
+
+// checkExportCondition returns an error if the value does not fulfill the condition.
+// A nil condition is always fulfilled.
+func checkExportCondition(condition *managedresource.ExportCondition, value interface{}) error {
+	if condition == nil {
+		return nil
+	}
+
+	if condition.NotEmpty && isEmptyValue(value) {
+		return fmt.Errorf("condition not fulfilled: value is empty")
+	}
+
+	if len(condition.Matches) != 0 {
+		re, err := regexp.Compile(condition.Matches)
+		if err != nil {
+			return fmt.Errorf("invalid regular expression %q: %w", condition.Matches, err)
+		}
+		str, err := valueAsString(value)
+		if err != nil {
+			return err
+		}
+		if !re.MatchString(str) {
+			return fmt.Errorf("condition not fulfilled: value %q does not match %q", str, condition.Matches)
+		}
+	}
+
+	if condition.Equals != nil {
+		var expected interface{}
+		if err := json.Unmarshal(condition.Equals.RawMessage, &expected); err != nil {
+			return fmt.Errorf("unable to decode expected value: %w", err)
+		}
+		// normalize the value so that e.g. numbers are compared with the same type
+		actual, err := normalizeValue(value)
+		if err != nil {
+			return err
+		}
+		if !reflect.DeepEqual(expected, actual) {
+			return fmt.Errorf("condition not fulfilled: value %s is not equal to %s", mustMarshal(actual), string(condition.Equals.RawMessage))
+		}
+	}
+
+	return nil
+}
+
+// isEmptyValue returns true for nil, empty strings, empty lists and empty maps.
+func isEmptyValue(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return len(v) == 0
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	default:
+		return false
+	}
+}
+
+// valueAsString returns strings as they are and all other values json encoded.
+func valueAsString(value interface{}) (string, error) {
+	if str, ok := value.(string); ok {
+		return str, nil
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return "", fmt.Errorf("unable to encode value: %w", err)
+	}
+	return string(data), nil
+}
+
+func normalizeValue(value interface{}) (interface{}, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("unable to encode value: %w", err)
+	}
+	var normalized interface{}
+	if err := json.Unmarshal(data, &normalized); err != nil {
+		return nil, fmt.Errorf("unable to decode value: %w", err)
+	}
+	return normalized, nil
+}
+
+func mustMarshal(value interface{}) string {
+	data, _ := json.Marshal(value)
+	return string(data)
+}
@@ -7,8 +7,11 @@ package resourcemanager
 import (
 	"context"
 	"fmt"
+	"sort"
 	"time"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/rest"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -69,7 +72,7 @@ func (e *Exporter) Export(ctx context.Context, exports *managedresource.Exports)
 	var result map[string]interface{}
 
 	for _, export := range exports.Exports {
-		if export.FromResource == nil {
+		if !export.IsResourceExport() {
 			// ignore exports without from resource
 			// this currently only used for helm values where no resource is needed.
 			continue
@@ -83,6 +86,7 @@ func (e *Exporter) Export(ctx context.Context, exports *managedresource.Exports)
 
 		log2 := log.WithValues(lc.KeyExportKey, export.Key)
 
+		var lastErr error
 		err := wait.PollUntilContextTimeout(ctx, 5*time.Second, timeout, true, func(ctx context.Context) (done bool, err error) {
 			if err := e.interruptionChecker.Check(ctx); err != nil {
 				return false, err
@@ -90,6 +94,7 @@ func (e *Exporter) Export(ctx context.Context, exports *managedresource.Exports)
 
 			value, err := e.doExport(ctx, export)
 			if err != nil {
+				lastErr = err
 				log2.Info("error while creating export", lc.KeyError, err.Error())
 				return false, nil
 			}
@@ -99,6 +104,10 @@ func (e *Exporter) Export(ctx context.Context, exports *managedresource.Exports)
 
 		if wait.Interrupted(err) {
 			msg := fmt.Sprintf("timeout at: %q", checkpoint)
+			if export.WaitFor != nil && lastErr != nil {
+				// the reason why the condition was not fulfilled helps more than the plain timeout
+				msg = fmt.Sprintf("%s: %s", msg, lastErr.Error())
+			}
 			return nil, errors.NewWrappedError(err, "Export", lsv1alpha1.ProgressingTimeoutReason, msg, lsv1alpha1.ErrorTimeout)
 		}
 
@@ -116,13 +125,98 @@ func (e *Exporter) doExport(ctx context.Context, export managedresource.Export)
 		return nil, err
 	}
 
+	var val interface{}
+	if export.FromResources != nil {
+		val, err = e.exportFromSelectedResources(ctx, targetClient, export)
+	} else {
+		val, err = e.exportFromResource(ctx, targetClient, export)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	newValue, err := jsonpath.Construct(export.Key, val)
+	if err != nil {
+		return nil, err
+	}
+	return newValue, nil
+}
+
+// exportFromResource reads the value of an export from a single resource.
+func (e *Exporter) exportFromResource(ctx context.Context, cl client.Client, export managedresource.Export) (interface{}, error) {
 	// get resource from client
 	obj := kutil.ObjectFromTypedObjectReference(export.FromResource)
-	if err := read_write_layer.GetUnstructured(ctx, targetClient, kutil.ObjectKeyFromObject(obj), obj,
+	if err := read_write_layer.GetUnstructured(ctx, cl, kutil.ObjectKeyFromObject(obj), obj,
 		read_write_layer.R000046); err != nil {
 		return nil, err
 	}
 
+	val, err := e.readValue(ctx, cl, export, obj)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkExportCondition(export.WaitFor, val); err != nil {
+		return nil, err
+	}
+	return val, nil
+}
+
+// exportFromSelectedResources reads the values of an export from all selected resources.
+// The values are returned as list ordered by the namespace and name of the resources.
+func (e *Exporter) exportFromSelectedResources(ctx context.Context, cl client.Client, export managedresource.Export) (interface{}, error) {
+	selector := export.FromResources
+
+	list := &unstructured.UnstructuredList{}
+	list.SetAPIVersion(selector.APIVersion)
+	list.SetKind(selector.Kind + "List")
+	opts := make([]client.ListOption, 0)
+	if len(selector.Namespace) != 0 {
+		opts = append(opts, client.InNamespace(selector.Namespace))
+	}
+	if selector.LabelSelector != nil {
+		labelSelector, err := metav1.LabelSelectorAsSelector(selector.LabelSelector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid label selector: %w", err)
+		}
+		opts = append(opts, client.MatchingLabelsSelector{Selector: labelSelector})
+	}
+	if err := read_write_layer.ListUnstructured(ctx, cl, list, read_write_layer.R000111, opts...); err != nil {
+		return nil, err
+	}
+
+	minCount := 1
+	if selector.MinCount != nil {
+		minCount = int(*selector.MinCount)
+	}
+	if len(list.Items) < minCount {
+		return nil, fmt.Errorf("%d resources of kind %s selected but at least %d are expected", len(list.Items), selector.Kind, minCount)
+	}
+
+	sort.Slice(list.Items, func(i, j int) bool {
+		if list.Items[i].GetNamespace() != list.Items[j].GetNamespace() {
+			return list.Items[i].GetNamespace() < list.Items[j].GetNamespace()
+		}
+		return list.Items[i].GetName() < list.Items[j].GetName()
+	})
+
+	values := make([]interface{}, 0, len(list.Items))
+	for i := range list.Items {
+		obj := &list.Items[i]
+		val, err := e.readValue(ctx, cl, export, obj)
+		if err != nil {
+			return nil, fmt.Errorf("%s %s: %w", selector.Kind, kutil.ObjectKeyFromObject(obj).String(), err)
+		}
+		if err := checkExportCondition(export.WaitFor, val); err != nil {
+			return nil, fmt.Errorf("%s %s: %w", selector.Kind, kutil.ObjectKeyFromObject(obj).String(), err)
+		}
+		values = append(values, val)
+	}
+	return values, nil
+}
+
+// readValue reads the value of the export's jsonpath from the resource.
+// If the export reads from a referenced object, the value is read from the referenced object.
+func (e *Exporter) readValue(ctx context.Context, cl client.Client, export managedresource.Export, obj *unstructured.Unstructured) (interface{}, error) {
 	var val interface{}
 	if err := jsonpath.GetValue(export.JSONPath, obj.Object, &val); err != nil {
 		return nil, err
@@ -130,20 +224,15 @@ func (e *Exporter) doExport(ctx context.Context, export managedresource.Export)
 
 	if export.FromObjectReference != nil {
 		var err error
-		val, err = e.exportFromReferencedResource(ctx, targetClient, export, val)
+		val, err = e.exportFromReferencedResource(ctx, cl, export, obj.GetNamespace(), val)
 		if err != nil {
 			return nil, err
 		}
 	}
-
-	newValue, err := jsonpath.Construct(export.Key, val)
-	if err != nil {
-		return nil, err
-	}
-	return newValue, nil
+	return val, nil
 }
 
-func (e *Exporter) exportFromReferencedResource(ctx context.Context, cl client.Client, export managedresource.Export, ref interface{}) (interface{}, error) {
+func (e *Exporter) exportFromReferencedResource(ctx context.Context, cl client.Client, export managedresource.Export, defaultNamespace string, ref interface{}) (interface{}, error) {
 	// check if the ref is of the right type
 	refMap, ok := ref.(map[string]interface{})
 	if !ok {
@@ -158,7 +247,7 @@ func (e *Exporter) exportFromReferencedResource(ctx context.Context, cl client.C
 		return nil, fmt.Errorf("expected name %#v to be a string", refName)
 	}
 
-	namespace := export.FromResource.Namespace // default to same namespace as resource
+	namespace := defaultNamespace // default to same namespace as resource
 	refNamespace, ok := refMap["namespace"]
 	if ok {
 		namespace, ok = refNamespace.(string)
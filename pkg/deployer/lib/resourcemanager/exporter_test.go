@@ -12,6 +12,8 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	"github.com/gardener/landscaper/apis/deployer/utils/managedresource"
@@ -300,4 +302,101 @@ var _ = Describe("Exporter", func() {
 		})
 	})
 
+	Context("wait for condition", func() {
+		It("should wait until the exported value fulfills the condition", func() {
+			ctx := context.Background()
+			cm := &corev1.ConfigMap{}
+			cm.Name = "my-data"
+			cm.Namespace = state.Namespace
+			cm.Data = map[string]string{
+				"somekey": "",
+			}
+			Expect(state.Create(ctx, cm)).To(Succeed())
+
+			go func() {
+				defer GinkgoRecover()
+				select {
+				case <-ctx.Done():
+					return
+				case <-time.After(10 * time.Second):
+					cm.Data["somekey"] = "ready"
+					Expect(testenv.Client.Update(ctx, cm)).To(Succeed())
+				}
+			}()
+
+			exports := &managedresource.Exports{
+				Exports: []managedresource.Export{
+					{
+						Key:      "exportkey",
+						JSONPath: "data.somekey",
+						FromResource: &lsv1alpha1.TypedObjectReference{
+							APIVersion: "v1",
+							Kind:       "ConfigMap",
+							ObjectReference: lsv1alpha1.ObjectReference{
+								Name:      cm.Name,
+								Namespace: cm.Namespace,
+							},
+						},
+						WaitFor: &managedresource.ExportCondition{
+							NotEmpty: true,
+							Matches:  "^re",
+							Equals:   &lsv1alpha1.AnyJSON{RawMessage: []byte(`"ready"`)},
+						},
+					},
+				},
+			}
+			res, err := resourcemanager.NewExporter(resourcemanager.ExporterOptions{
+				KubeClient: testenv.Client,
+			}).Export(ctx, exports)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res).To(Equal(map[string]interface{}{
+				"exportkey": "ready",
+			}))
+		})
+
+		It("should export the values of all selected resources", func() {
+			ctx := context.Background()
+			for _, name := range []string{"data-b", "data-a", "other"} {
+				cm := &corev1.ConfigMap{}
+				cm.Name = name
+				cm.Namespace = state.Namespace
+				if name != "other" {
+					cm.Labels = map[string]string{"export": "true"}
+				}
+				cm.Data = map[string]string{
+					"somekey": name,
+				}
+				Expect(state.Create(ctx, cm)).To(Succeed())
+			}
+
+			exports := &managedresource.Exports{
+				Exports: []managedresource.Export{
+					{
+						Key:      "exportkey",
+						JSONPath: "data.somekey",
+						FromResources: &managedresource.ResourceSelector{
+							APIVersion: "v1",
+							Kind:       "ConfigMap",
+							Namespace:  state.Namespace,
+							LabelSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"export": "true"},
+							},
+							MinCount: ptr.To[int32](2),
+						},
+						WaitFor: &managedresource.ExportCondition{
+							NotEmpty: true,
+						},
+					},
+				},
+			}
+			res, err := resourcemanager.NewExporter(resourcemanager.ExporterOptions{
+				KubeClient: testenv.Client,
+			}).Export(ctx, exports)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res).To(Equal(map[string]interface{}{
+				"exportkey": []interface{}{"data-a", "data-b"},
+			}))
+		})
+	})
+
 })
@@ -36,6 +36,7 @@ import (
 	"github.com/gardener/landscaper/pkg/deployer/lib/interruption"
 	"github.com/gardener/landscaper/pkg/deployer/lib/timeout"
 	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+	"github.com/gardener/landscaper/pkg/utils/tracing"
 )
 
 const (
@@ -154,6 +155,13 @@ func (a *ManifestApplier) GetManagedResourcesStatus() managedresource.ManagedRes
 
 // Apply creates or updates all configured manifests.
 func (a *ManifestApplier) Apply(ctx context.Context) ([]*PatchInfo, error) {
+	ctx, span := tracing.StartSpan(ctx, "ApplyManifests")
+	patchInfos, err := a.apply(ctx)
+	tracing.EndSpan(ctx, span, err)
+	return patchInfos, err
+}
+
+func (a *ManifestApplier) apply(ctx context.Context) ([]*PatchInfo, error) {
 	if err := a.prepareManifests(ctx); err != nil {
 		return nil, err
 	}
@@ -28,6 +28,8 @@ import (
 	"github.com/gardener/landscaper/pkg/deployer/lib/targetselector"
 	lsutil "github.com/gardener/landscaper/pkg/utils"
 	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+	"github.com/gardener/landscaper/pkg/utils/redact"
+	"github.com/gardener/landscaper/pkg/utils/tracing"
 )
 
 // CreateOrUpdateExport creates or updates the export of a deploy item.
@@ -93,7 +95,7 @@ func HandleReconcileResult(ctx context.Context, err lserrors.LsError, oldDeployI
 	lsClient client.Client, lsEventRecorder record.EventRecorder, finishedObjectCache *lsutil.FinishedObjectCache) error {
 
 	logger, ctx := logging.FromContextOrNew(ctx, nil)
-	lsutil.SetLastError(&deployItem.Status, lserrors.TryUpdateLsError(deployItem.Status.GetLastError(), err))
+	lsutil.SetLastError(&deployItem.Status, lserrors.TryUpdateLsError(deployItem.Status.GetLastError(), redact.FromContext(ctx).RedactLsError(err)))
 
 	if deployItem.Status.GetLastError() != nil {
 		if lserrors.ContainsAnyErrorCode(deployItem.Status.GetLastError().Codes, lsv1alpha1.UnrecoverableErrorCodes) {
@@ -108,6 +110,7 @@ func HandleReconcileResult(ctx context.Context, err lserrors.LsError, oldDeployI
 	if deployItem.Status.Phase.IsFinal() {
 		deployItem.Status.JobIDFinished = deployItem.Status.GetJobID()
 		deployItem.Status.TransitionTimes = lsutil.SetFinishedTransitionTime(deployItem.Status.TransitionTimes)
+		AddDeployItemHistoryEntry(deployItem)
 	}
 
 	if !reflect.DeepEqual(&oldDeployItem.Status, &deployItem.Status) {
@@ -130,8 +133,13 @@ func HandleReconcileResult(ctx context.Context, err lserrors.LsError, oldDeployI
 			if err == nil {
 				return err2
 			}
-		} else if finishedObjectCache != nil && IsDeployItemFinished(deployItem) {
-			finishedObjectCache.AddSynchonized(&deployItem.ObjectMeta)
+		} else {
+			if deployItem.Status.Phase.IsFinal() {
+				tracing.RecordDeployItemJob(ctx, deployItem)
+			}
+			if finishedObjectCache != nil && IsDeployItemFinished(deployItem) {
+				finishedObjectCache.AddSynchonized(&deployItem.ObjectMeta)
+			}
 		}
 	}
 
@@ -45,7 +45,7 @@ func NewDeployer(lsRestConfig *rest.Config,
 		config:             config,
 		hooks:              extension.ReconcileExtensionHooks{},
 	}
-	dep.hooks.RegisterHookSetup(cr.ContinuousReconcileExtensionSetup(dep.NextReconcile))
+	dep.hooks.RegisterHookSetup(cr.ContinuousReconcileExtensionSetup(dep.NextReconcile, lsUncachedClient))
 	return dep, nil
 }
 
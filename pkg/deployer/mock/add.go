@@ -48,12 +48,13 @@ func AddDeployerToManager(lsUncachedClient, lsCachedClient, hostUncachedClient,
 	return deployerlib.Add(lsUncachedClient, lsCachedClient, hostUncachedClient, hostCachedClient,
 		finishedObjectCache,
 		log, lsMgr, hostMgr, deployerlib.DeployerArgs{
-			Name:            Name,
-			Version:         version.Get().String(),
-			Identity:        config.Identity,
-			Type:            Type,
-			Deployer:        d,
-			TargetSelectors: config.TargetSelector,
+			Name:                   Name,
+			Version:                version.Get().String(),
+			Identity:               config.Identity,
+			Type:                   Type,
+			Deployer:               d,
+			TargetSelectors:        config.TargetSelector,
+			BuiltinTargetResolvers: config.TargetResolvers,
 		}, 5, false, callerName)
 }
 
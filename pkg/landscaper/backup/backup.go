@@ -0,0 +1,199 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package backup implements the backup and restore of the landscaper objects of a set of namespaces.
+// A restore recreates the objects in an order that does not trigger any uninstallation and
+// preserves the job ids of the installations, executions and deploy items, so that nothing is re-run unnecessarily.
+package backup
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/apis/deployer/container"
+)
+
+// Version is the version of the backup format.
+const Version = "v1"
+
+// Backup contains the landscaper objects of a set of namespaces.
+type Backup struct {
+	// Version is the version of the backup format.
+	Version string `json:"version"`
+	// CreationTime is the time when the backup has been created.
+	CreationTime metav1.Time `json:"creationTime"`
+	// Namespaces are the namespaces of the backup.
+	Namespaces []string `json:"namespaces"`
+	// Objects are the objects of the backup in the order in which they are restored.
+	Objects []*unstructured.Unstructured `json:"objects"`
+}
+
+// BackupOptions describe which objects are part of a backup.
+type BackupOptions struct {
+	// Namespaces are the namespaces whose landscaper objects are backed up.
+	Namespaces []string
+	// DeployerStateNamespaces are additional namespaces from which only the state secrets of the deployers are backed up,
+	// i.e. the helm release secrets and the state secrets of the container deployer.
+	DeployerStateNamespaces []string
+}
+
+// resourceKind describes a kind of objects that is part of a backup.
+type resourceKind struct {
+	gvk schema.GroupVersionKind
+	// hasStatus defines whether the status of the objects is a subresource and must be restored separately.
+	hasStatus bool
+	// ignore defines whether the ignore annotation is set during the restore to prevent reconciles.
+	ignore bool
+	// filter excludes objects from the backup.
+	filter func(obj *unstructured.Unstructured) bool
+}
+
+// resourceKinds are the kinds of a backup in the order in which they are restored.
+// Installations, executions and deploy items are restored last so that all objects they refer to already exist.
+var resourceKinds = []resourceKind{
+	{gvk: lsv1alpha1.SchemeGroupVersion.WithKind("Context")},
+	{gvk: lsv1alpha1.SchemeGroupVersion.WithKind("ComponentVersionOverwrites")},
+	{gvk: corev1.SchemeGroupVersion.WithKind("Secret"), filter: isBackupSecret},
+	{gvk: corev1.SchemeGroupVersion.WithKind("ConfigMap"), filter: isBackupConfigMap},
+	{gvk: lsv1alpha1.SchemeGroupVersion.WithKind("Target"), hasStatus: true},
+	{gvk: lsv1alpha1.SchemeGroupVersion.WithKind("TargetSync"), hasStatus: true},
+	{gvk: lsv1alpha1.SchemeGroupVersion.WithKind("DataObject")},
+	{gvk: lsv1alpha1.SchemeGroupVersion.WithKind("Installation"), hasStatus: true, ignore: true},
+	{gvk: lsv1alpha1.SchemeGroupVersion.WithKind("Execution"), hasStatus: true, ignore: true},
+	{gvk: lsv1alpha1.SchemeGroupVersion.WithKind("DeployItem"), hasStatus: true, ignore: true},
+}
+
+// getResourceKind returns the resource kind of an object.
+func getResourceKind(obj *unstructured.Unstructured) (resourceKind, bool) {
+	for _, kind := range resourceKinds {
+		if kind.gvk == obj.GroupVersionKind() {
+			return kind, true
+		}
+	}
+	return resourceKind{}, false
+}
+
+// isBackupSecret excludes the service account tokens, which are recreated by kubernetes.
+func isBackupSecret(obj *unstructured.Unstructured) bool {
+	secretType, _, _ := unstructured.NestedString(obj.Object, "type")
+	return secretType != string(corev1.SecretTypeServiceAccountToken)
+}
+
+// isBackupConfigMap excludes the root ca config map, which is recreated by kubernetes.
+func isBackupConfigMap(obj *unstructured.Unstructured) bool {
+	return obj.GetName() != "kube-root-ca.crt"
+}
+
+// isDeployerStateSecret returns true for the helm release secrets and the state secrets of the container deployer.
+func isDeployerStateSecret(obj *unstructured.Unstructured) bool {
+	labels := obj.GetLabels()
+	return labels["owner"] == "helm" || labels[container.ContainerDeployerTypeLabel] == "state"
+}
+
+// Create creates a backup of the landscaper objects of the given namespaces.
+func Create(ctx context.Context, c client.Client, opts BackupOptions) (*Backup, error) {
+	b := &Backup{
+		Version:      Version,
+		CreationTime: metav1.Now(),
+		Namespaces:   opts.Namespaces,
+		Objects:      []*unstructured.Unstructured{},
+	}
+
+	for _, kind := range resourceKinds {
+		for _, namespace := range opts.Namespaces {
+			objects, err := listObjects(ctx, c, kind.gvk, namespace)
+			if err != nil {
+				return nil, err
+			}
+			for _, obj := range objects {
+				if kind.filter == nil || kind.filter(obj) {
+					b.Objects = append(b.Objects, obj)
+				}
+			}
+		}
+
+		if kind.gvk.Kind != "Secret" {
+			continue
+		}
+		for _, namespace := range opts.DeployerStateNamespaces {
+			objects, err := listObjects(ctx, c, kind.gvk, namespace)
+			if err != nil {
+				return nil, err
+			}
+			for _, obj := range objects {
+				if isDeployerStateSecret(obj) {
+					b.Objects = append(b.Objects, obj)
+					b.addNamespace(namespace)
+				}
+			}
+		}
+	}
+
+	return b, nil
+}
+
+func listObjects(ctx context.Context, c client.Client, gvk schema.GroupVersionKind, namespace string) ([]*unstructured.Unstructured, error) {
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+	if err := c.List(ctx, list, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("unable to list %s objects in namespace %q: %w", gvk.Kind, namespace, err)
+	}
+
+	objects := make([]*unstructured.Unstructured, 0, len(list.Items))
+	for i := range list.Items {
+		obj := &list.Items[i]
+		obj.SetGroupVersionKind(gvk)
+		objects = append(objects, obj)
+	}
+	return objects, nil
+}
+
+func (b *Backup) addNamespace(namespace string) {
+	for _, ns := range b.Namespaces {
+		if ns == namespace {
+			return
+		}
+	}
+	b.Namespaces = append(b.Namespaces, namespace)
+}
+
+// Write writes the backup as yaml.
+func (b *Backup) Write(w io.Writer) error {
+	data, err := yaml.Marshal(b)
+	if err != nil {
+		return fmt.Errorf("unable to marshal backup: %w", err)
+	}
+	_, err = w.Write(data)
+	return err
+}
+
+// Read reads a backup from yaml.
+func Read(r io.Reader) (*Backup, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read backup: %w", err)
+	}
+	b := &Backup{}
+	if err := yaml.Unmarshal(data, b); err != nil {
+		return nil, fmt.Errorf("unable to unmarshal backup: %w", err)
+	}
+	if b.Version != Version {
+		return nil, fmt.Errorf("unsupported backup version %q, expected %q", b.Version, Version)
+	}
+	for i, obj := range b.Objects {
+		if _, ok := getResourceKind(obj); !ok {
+			return nil, fmt.Errorf("object %d of the backup has the unsupported kind %q", i, obj.GroupVersionKind().String())
+		}
+	}
+	return b, nil
+}
@@ -0,0 +1,301 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backup_test
+
+import (
+	"bytes"
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/uuid"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/apis/deployer/container"
+	"github.com/gardener/landscaper/pkg/api"
+	"github.com/gardener/landscaper/pkg/landscaper/backup"
+)
+
+// newClient returns a fake client which assigns uids and generations to the created objects like the api server.
+func newClient(objects ...client.Object) client.Client {
+	return fake.NewClientBuilder().WithScheme(api.LandscaperScheme).
+		WithObjects(objects...).
+		WithStatusSubresource(&lsv1alpha1.Installation{}, &lsv1alpha1.Execution{}, &lsv1alpha1.DeployItem{},
+			&lsv1alpha1.Target{}, &lsv1alpha1.TargetSync{}).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+				obj.SetUID(uuid.NewUUID())
+				obj.SetGeneration(1)
+				return c.Create(ctx, obj, opts...)
+			},
+		}).
+		Build()
+}
+
+func ownerReference(kind, name string, uid types.UID) metav1.OwnerReference {
+	return metav1.OwnerReference{
+		APIVersion: lsv1alpha1.SchemeGroupVersion.String(),
+		Kind:       kind,
+		Name:       name,
+		UID:        uid,
+	}
+}
+
+var _ = Describe("Backup", func() {
+
+	var (
+		ctx    context.Context
+		source client.Client
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		lsContext := &lsv1alpha1.Context{ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: "test", UID: "ctx-uid"}}
+		secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "creds", Namespace: "test", UID: "secret-uid"}}
+		tokenSecret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "token", Namespace: "test", UID: "token-uid"},
+			Type:       corev1.SecretTypeServiceAccountToken,
+		}
+		rootCA := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "kube-root-ca.crt", Namespace: "test", UID: "ca-uid"}}
+		target := &lsv1alpha1.Target{ObjectMeta: metav1.ObjectMeta{Name: "my-target", Namespace: "test", UID: "target-uid"}}
+		do := &lsv1alpha1.DataObject{ObjectMeta: metav1.ObjectMeta{Name: "my-do", Namespace: "test", UID: "do-uid"}}
+
+		inst := &lsv1alpha1.Installation{
+			ObjectMeta: metav1.ObjectMeta{Name: "root", Namespace: "test", UID: "inst-uid"},
+			Status: lsv1alpha1.InstallationStatus{
+				JobID:              "job-1",
+				JobIDFinished:      "job-1",
+				ExecutionReference: &lsv1alpha1.ObjectReference{Name: "root", Namespace: "test"},
+			},
+		}
+		exec := &lsv1alpha1.Execution{
+			ObjectMeta: metav1.ObjectMeta{Name: "root", Namespace: "test", UID: "exec-uid",
+				OwnerReferences: []metav1.OwnerReference{ownerReference("Installation", "root", "inst-uid")}},
+			Status: lsv1alpha1.ExecutionStatus{
+				JobID:         "job-1",
+				JobIDFinished: "job-1",
+				DeployItemCache: &lsv1alpha1.DeployItemCache{
+					ActiveDIs: []lsv1alpha1.DiNamePair{{SpecName: "main", ObjectName: "root-main"}},
+				},
+			},
+		}
+		di := &lsv1alpha1.DeployItem{
+			ObjectMeta: metav1.ObjectMeta{Name: "root-main", Namespace: "test", UID: "di-uid",
+				OwnerReferences: []metav1.OwnerReference{ownerReference("Execution", "root", "exec-uid")}},
+			Spec: lsv1alpha1.DeployItemSpec{
+				Type:   "landscaper.gardener.cloud/helm",
+				Target: &lsv1alpha1.ObjectReference{Name: "my-target", Namespace: "test"},
+			},
+			Status: lsv1alpha1.DeployItemStatus{
+				Phase:         lsv1alpha1.DeployItemPhases.Succeeded,
+				JobID:         "job-1",
+				JobIDFinished: "job-1",
+			},
+		}
+
+		helmSecret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "sh.helm.release.v1.app.v1", Namespace: "deployer",
+			UID: "helm-uid", Labels: map[string]string{"owner": "helm"}}}
+		stateSecret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "state", Namespace: "deployer",
+			UID: "state-uid", Labels: map[string]string{container.ContainerDeployerTypeLabel: "state"}}}
+		otherSecret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "deployer", UID: "other-uid"}}
+
+		source = fake.NewClientBuilder().WithScheme(api.LandscaperScheme).
+			WithObjects(lsContext, secret, tokenSecret, rootCA, target, do, inst, exec, di, helmSecret, stateSecret, otherSecret).
+			Build()
+	})
+
+	createBackup := func() *backup.Backup {
+		b, err := backup.Create(ctx, source, backup.BackupOptions{
+			Namespaces:              []string{"test"},
+			DeployerStateNamespaces: []string{"deployer"},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		return b
+	}
+
+	objectNames := func(b *backup.Backup) []string {
+		names := []string{}
+		for _, obj := range b.Objects {
+			names = append(names, obj.GetKind()+"/"+obj.GetNamespace()+"/"+obj.GetName())
+		}
+		return names
+	}
+
+	It("should back up the landscaper objects in the restore order", func() {
+		b := createBackup()
+		Expect(b.Version).To(Equal(backup.Version))
+		Expect(b.Namespaces).To(ConsistOf("test", "deployer"))
+		Expect(objectNames(b)).To(Equal([]string{
+			"Context/test/default",
+			"Secret/test/creds",
+			"Secret/deployer/sh.helm.release.v1.app.v1",
+			"Secret/deployer/state",
+			"Target/test/my-target",
+			"DataObject/test/my-do",
+			"Installation/test/root",
+			"Execution/test/root",
+			"DeployItem/test/root-main",
+		}))
+	})
+
+	It("should write and read a backup", func() {
+		b := createBackup()
+		buf := &bytes.Buffer{}
+		Expect(b.Write(buf)).To(Succeed())
+
+		read, err := backup.Read(buf)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(objectNames(read)).To(Equal(objectNames(b)))
+	})
+
+	It("should reject a backup with an unknown version", func() {
+		_, err := backup.Read(bytes.NewBufferString("version: v0\n"))
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should restore the objects with their job ids and owner references", func() {
+		b := createBackup()
+		target := newClient()
+
+		result, err := backup.Restore(ctx, target, b)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.Created).To(HaveLen(len(b.Objects)))
+		Expect(result.Skipped).To(BeEmpty())
+
+		ns := &corev1.Namespace{}
+		Expect(target.Get(ctx, client.ObjectKey{Name: "deployer"}, ns)).To(Succeed())
+
+		inst := &lsv1alpha1.Installation{}
+		Expect(target.Get(ctx, client.ObjectKey{Name: "root", Namespace: "test"}, inst)).To(Succeed())
+		Expect(inst.Status.JobID).To(Equal("job-1"))
+		Expect(inst.Status.JobIDFinished).To(Equal("job-1"))
+		Expect(inst.Annotations).To(HaveKeyWithValue(lsv1alpha1.IgnoreAnnotation, "true"))
+
+		exec := &lsv1alpha1.Execution{}
+		Expect(target.Get(ctx, client.ObjectKey{Name: "root", Namespace: "test"}, exec)).To(Succeed())
+		Expect(exec.OwnerReferences).To(HaveLen(1))
+		Expect(exec.OwnerReferences[0].UID).To(Equal(inst.UID))
+		Expect(exec.OwnerReferences[0].UID).ToNot(Equal(types.UID("inst-uid")))
+
+		di := &lsv1alpha1.DeployItem{}
+		Expect(target.Get(ctx, client.ObjectKey{Name: "root-main", Namespace: "test"}, di)).To(Succeed())
+		Expect(di.Status.JobIDFinished).To(Equal("job-1"))
+		Expect(di.Status.Phase).To(Equal(lsv1alpha1.DeployItemPhases.Succeeded))
+		Expect(di.OwnerReferences[0].UID).To(Equal(exec.UID))
+
+		problems, err := backup.Validate(ctx, target, b)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(problems).To(BeEmpty())
+
+		Expect(backup.Release(ctx, target, b)).To(Succeed())
+		Expect(target.Get(ctx, client.ObjectKey{Name: "root", Namespace: "test"}, inst)).To(Succeed())
+		Expect(inst.Annotations).ToNot(HaveKey(lsv1alpha1.IgnoreAnnotation))
+		Expect(target.Get(ctx, client.ObjectKey{Name: "root-main", Namespace: "test"}, di)).To(Succeed())
+		Expect(di.Annotations).ToNot(HaveKey(lsv1alpha1.IgnoreAnnotation))
+	})
+
+	It("should restore the observed generation, so that no reconcile is triggered by the restore", func() {
+		inst := &lsv1alpha1.Installation{}
+		Expect(source.Get(ctx, client.ObjectKey{Name: "root", Namespace: "test"}, inst)).To(Succeed())
+		metav1.SetMetaDataAnnotation(&inst.ObjectMeta, lsv1alpha1.ReconcileIfChangedAnnotation, "true")
+		inst.Generation = 5
+		inst.Status.ObservedGeneration = 5
+		Expect(source.Update(ctx, inst)).To(Succeed())
+
+		di := &lsv1alpha1.DeployItem{}
+		Expect(source.Get(ctx, client.ObjectKey{Name: "root-main", Namespace: "test"}, di)).To(Succeed())
+		di.Spec.UpdateOnChangeOnly = true
+		di.Generation = 3
+		di.Status.ObservedGeneration = 3
+		Expect(source.Update(ctx, di)).To(Succeed())
+
+		b := createBackup()
+		target := newClient()
+		_, err := backup.Restore(ctx, target, b)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(backup.Release(ctx, target, b)).To(Succeed())
+
+		// an installation with the reconcile-if-changed annotation is reconciled if its spec has not been observed
+		Expect(target.Get(ctx, client.ObjectKey{Name: "root", Namespace: "test"}, inst)).To(Succeed())
+		Expect(inst.Generation).To(Equal(int64(1)))
+		Expect(inst.Status.ObservedGeneration).To(Equal(inst.Generation))
+		Expect(inst.Status.JobIDFinished).To(Equal(inst.Status.JobID))
+
+		// a deploy item with updateOnChangeOnly is reconciled if its spec has not been observed
+		Expect(target.Get(ctx, client.ObjectKey{Name: "root-main", Namespace: "test"}, di)).To(Succeed())
+		Expect(di.Status.ObservedGeneration).To(Equal(di.Generation))
+	})
+
+	It("should keep an unobserved spec change unobserved", func() {
+		di := &lsv1alpha1.DeployItem{}
+		Expect(source.Get(ctx, client.ObjectKey{Name: "root-main", Namespace: "test"}, di)).To(Succeed())
+		di.Generation = 4
+		di.Status.ObservedGeneration = 3
+		Expect(source.Update(ctx, di)).To(Succeed())
+
+		b := createBackup()
+		target := newClient()
+		_, err := backup.Restore(ctx, target, b)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(target.Get(ctx, client.ObjectKey{Name: "root-main", Namespace: "test"}, di)).To(Succeed())
+		Expect(di.Status.ObservedGeneration).To(Equal(di.Generation - 1))
+	})
+
+	It("should keep the ignore annotation of objects that were ignored before the backup", func() {
+		inst := &lsv1alpha1.Installation{}
+		Expect(source.Get(ctx, client.ObjectKey{Name: "root", Namespace: "test"}, inst)).To(Succeed())
+		metav1.SetMetaDataAnnotation(&inst.ObjectMeta, lsv1alpha1.IgnoreAnnotation, "true")
+		Expect(source.Update(ctx, inst)).To(Succeed())
+
+		b := createBackup()
+		target := newClient()
+		_, err := backup.Restore(ctx, target, b)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(backup.Release(ctx, target, b)).To(Succeed())
+
+		Expect(target.Get(ctx, client.ObjectKey{Name: "root", Namespace: "test"}, inst)).To(Succeed())
+		Expect(inst.Annotations).To(HaveKeyWithValue(lsv1alpha1.IgnoreAnnotation, "true"))
+	})
+
+	It("should skip existing objects", func() {
+		b := createBackup()
+		existing := &lsv1alpha1.DataObject{ObjectMeta: metav1.ObjectMeta{Name: "my-do", Namespace: "test", UID: "existing-uid"}}
+		target := newClient(existing)
+
+		result, err := backup.Restore(ctx, target, b)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.Skipped).To(ConsistOf("DataObject test/my-do"))
+		Expect(result.Created).To(HaveLen(len(b.Objects) - 1))
+	})
+
+	It("should report inconsistencies of the restored objects", func() {
+		b := createBackup()
+		target := newClient()
+		_, err := backup.Restore(ctx, target, b)
+		Expect(err).ToNot(HaveOccurred())
+
+		di := &lsv1alpha1.DeployItem{}
+		Expect(target.Get(ctx, client.ObjectKey{Name: "root-main", Namespace: "test"}, di)).To(Succeed())
+		di.Status.JobID = "job-2"
+		Expect(target.Status().Update(ctx, di)).To(Succeed())
+		Expect(target.Delete(ctx, &lsv1alpha1.Target{ObjectMeta: metav1.ObjectMeta{Name: "my-target", Namespace: "test"}})).To(Succeed())
+
+		problems, err := backup.Validate(ctx, target, b)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(problems).To(ConsistOf(
+			"Target test/my-target does not exist",
+			`DeployItem test/root-main has the jobID "job-2" instead of "job-1"`,
+			"DeployItem test/root-main refers to Target test/my-target, which is not part of the restored objects",
+		))
+	})
+})
@@ -0,0 +1,235 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backup
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+)
+
+// RestoreResult summarizes a restore.
+type RestoreResult struct {
+	// Created are the keys of the created objects.
+	Created []string
+	// Skipped are the keys of the objects which have not been restored, because they already existed.
+	Skipped []string
+}
+
+// restoredObject is an object of a backup together with the parts that are restored in separate steps.
+type restoredObject struct {
+	kind            resourceKind
+	obj             *unstructured.Unstructured
+	status          interface{}
+	generation      int64
+	ownerReferences []metav1.OwnerReference
+	created         bool
+}
+
+// Restore creates the objects of a backup.
+// Installations, executions and deploy items are created with the ignore annotation, so that the controllers
+// do not process them before the restore is complete; the annotation is removed by Release.
+// The restore is done in the following steps:
+//   - create the missing namespaces
+//   - create the objects in the order of the backup without their status and owner references
+//   - restore the status of the objects, which contains the job ids, with the observed generation adapted
+//     to the generation of the created objects
+//   - restore the owner references with the uids of the restored owners
+//
+// Objects that already exist are not modified.
+func Restore(ctx context.Context, c client.Client, b *Backup) (*RestoreResult, error) {
+	logger, ctx := logging.FromContextOrNew(ctx, nil)
+	result := &RestoreResult{}
+
+	for _, namespace := range b.Namespaces {
+		if err := ensureNamespace(ctx, c, namespace); err != nil {
+			return result, err
+		}
+	}
+
+	uids := map[types.UID]types.UID{}
+	objects := make([]*restoredObject, 0, len(b.Objects))
+	for _, backupObj := range b.Objects {
+		kind, ok := getResourceKind(backupObj)
+		if !ok {
+			return result, fmt.Errorf("unsupported kind %q", backupObj.GroupVersionKind().String())
+		}
+
+		r := newRestoredObject(kind, backupObj)
+		key := objectKeyString(r.obj)
+		if err := c.Create(ctx, r.obj); err != nil {
+			if !apierrors.IsAlreadyExists(err) {
+				return result, fmt.Errorf("unable to create %s: %w", key, err)
+			}
+			logger.Info("object already exists, skipping it", "object", key)
+			result.Skipped = append(result.Skipped, key)
+			existing := &unstructured.Unstructured{}
+			existing.SetGroupVersionKind(kind.gvk)
+			if err := c.Get(ctx, client.ObjectKeyFromObject(r.obj), existing); err != nil {
+				return result, fmt.Errorf("unable to get %s: %w", key, err)
+			}
+			uids[backupObj.GetUID()] = existing.GetUID()
+			continue
+		}
+
+		logger.Debug("object created", "object", key)
+		r.created = true
+		result.Created = append(result.Created, key)
+		uids[backupObj.GetUID()] = r.obj.GetUID()
+		objects = append(objects, r)
+	}
+
+	for _, r := range objects {
+		if !r.kind.hasStatus || r.status == nil {
+			continue
+		}
+		if err := restoreObservedGeneration(r); err != nil {
+			return result, fmt.Errorf("unable to restore observed generation of %s: %w", objectKeyString(r.obj), err)
+		}
+		if err := unstructured.SetNestedField(r.obj.Object, r.status, "status"); err != nil {
+			return result, err
+		}
+		if err := c.Status().Update(ctx, r.obj); err != nil {
+			return result, fmt.Errorf("unable to restore status of %s: %w", objectKeyString(r.obj), err)
+		}
+	}
+
+	for _, r := range objects {
+		if len(r.ownerReferences) == 0 {
+			continue
+		}
+		refs := make([]metav1.OwnerReference, 0, len(r.ownerReferences))
+		for _, ref := range r.ownerReferences {
+			uid, ok := uids[ref.UID]
+			if !ok {
+				logger.Info("owner is not part of the backup, dropping the owner reference",
+					"object", objectKeyString(r.obj), "owner", ref.Name)
+				continue
+			}
+			ref.UID = uid
+			refs = append(refs, ref)
+		}
+		if len(refs) == 0 {
+			continue
+		}
+		r.obj.SetOwnerReferences(refs)
+		if err := c.Update(ctx, r.obj); err != nil {
+			return result, fmt.Errorf("unable to restore owner references of %s: %w", objectKeyString(r.obj), err)
+		}
+	}
+
+	return result, nil
+}
+
+// newRestoredObject prepares an object of a backup for its creation.
+// The server-side metadata is removed; the status and the owner references are kept for the later steps.
+func newRestoredObject(kind resourceKind, backupObj *unstructured.Unstructured) *restoredObject {
+	obj := backupObj.DeepCopy()
+	r := &restoredObject{
+		kind:            kind,
+		obj:             obj,
+		generation:      obj.GetGeneration(),
+		ownerReferences: obj.GetOwnerReferences(),
+	}
+
+	if kind.hasStatus {
+		r.status = obj.Object["status"]
+		delete(obj.Object, "status")
+	}
+
+	obj.SetResourceVersion("")
+	obj.SetUID("")
+	obj.SetCreationTimestamp(metav1.Time{})
+	obj.SetManagedFields(nil)
+	obj.SetGeneration(0)
+	obj.SetSelfLink("")
+	obj.SetDeletionTimestamp(nil)
+	obj.SetDeletionGracePeriodSeconds(nil)
+	obj.SetOwnerReferences(nil)
+
+	if kind.ignore {
+		annotations := obj.GetAnnotations()
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[lsv1alpha1.IgnoreAnnotation] = "true"
+		obj.SetAnnotations(annotations)
+	}
+
+	return r
+}
+
+// restoreObservedGeneration adapts the observed generation of the status of a restored object to the generation
+// which the api server has assigned to the created object. Otherwise, the controllers would consider the spec
+// as changed and reconcile the object again, e.g. an installation with the reconcile-if-changed annotation or
+// a deploy item with updateOnChangeOnly. A spec change that was not yet observed when the backup was created
+// remains unobserved.
+func restoreObservedGeneration(r *restoredObject) error {
+	status, ok := r.status.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	observedGeneration, found, err := unstructured.NestedInt64(status, "observedGeneration")
+	if err != nil || !found {
+		return err
+	}
+
+	observedGeneration = max(r.obj.GetGeneration()-(r.generation-observedGeneration), 0)
+	return unstructured.SetNestedField(status, observedGeneration, "observedGeneration")
+}
+
+func ensureNamespace(ctx context.Context, c client.Client, namespace string) error {
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: namespace}}
+	if err := c.Create(ctx, ns); err != nil && !apierrors.IsAlreadyExists(err) {
+		return fmt.Errorf("unable to create namespace %q: %w", namespace, err)
+	}
+	return nil
+}
+
+// Release removes the ignore annotation from the restored installations, executions and deploy items,
+// so that they are processed again by the controllers. Deploy items are released first, installations last.
+// Objects that already had the ignore annotation when the backup was created keep it.
+func Release(ctx context.Context, c client.Client, b *Backup) error {
+	for i := len(b.Objects) - 1; i >= 0; i-- {
+		backupObj := b.Objects[i]
+		kind, ok := getResourceKind(backupObj)
+		if !ok || !kind.ignore {
+			continue
+		}
+		if _, ignored := backupObj.GetAnnotations()[lsv1alpha1.IgnoreAnnotation]; ignored {
+			continue
+		}
+
+		obj := &unstructured.Unstructured{}
+		obj.SetGroupVersionKind(kind.gvk)
+		if err := c.Get(ctx, client.ObjectKeyFromObject(backupObj), obj); err != nil {
+			return fmt.Errorf("unable to get %s: %w", objectKeyString(backupObj), err)
+		}
+		annotations := obj.GetAnnotations()
+		if _, ignored := annotations[lsv1alpha1.IgnoreAnnotation]; !ignored {
+			continue
+		}
+		delete(annotations, lsv1alpha1.IgnoreAnnotation)
+		obj.SetAnnotations(annotations)
+		if err := c.Update(ctx, obj); err != nil {
+			return fmt.Errorf("unable to release %s: %w", objectKeyString(obj), err)
+		}
+	}
+	return nil
+}
+
+func objectKeyString(obj *unstructured.Unstructured) string {
+	return fmt.Sprintf("%s %s/%s", obj.GetKind(), obj.GetNamespace(), obj.GetName())
+}
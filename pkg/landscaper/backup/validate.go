@@ -0,0 +1,123 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backup
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+)
+
+// Validate checks the consistency of the restored objects of a backup and returns the found problems:
+//   - all objects of the backup exist
+//   - the job ids of the installations, executions and deploy items are the same as in the backup
+//   - the owner references refer to the existing owners
+//   - the executions of the installations, the deploy items of the executions and the targets of the deploy items exist
+func Validate(ctx context.Context, c client.Client, b *Backup) ([]string, error) {
+	problems := []string{}
+	objects := map[string]*unstructured.Unstructured{}
+	uids := map[types.UID]bool{}
+
+	for _, backupObj := range b.Objects {
+		kind, ok := getResourceKind(backupObj)
+		if !ok {
+			continue
+		}
+		key := objectKeyString(backupObj)
+		obj := &unstructured.Unstructured{}
+		obj.SetGroupVersionKind(kind.gvk)
+		if err := c.Get(ctx, client.ObjectKeyFromObject(backupObj), obj); err != nil {
+			if apierrors.IsNotFound(err) {
+				problems = append(problems, fmt.Sprintf("%s does not exist", key))
+				continue
+			}
+			return nil, fmt.Errorf("unable to get %s: %w", key, err)
+		}
+		objects[key] = obj
+		uids[obj.GetUID()] = true
+
+		if kind.ignore {
+			for _, field := range []string{"jobID", "jobIDFinished"} {
+				expected, _, _ := unstructured.NestedString(backupObj.Object, "status", field)
+				actual, _, _ := unstructured.NestedString(obj.Object, "status", field)
+				if expected != actual {
+					problems = append(problems, fmt.Sprintf("%s has the %s %q instead of %q", key, field, actual, expected))
+				}
+			}
+		}
+	}
+
+	for _, backupObj := range b.Objects {
+		obj, ok := objects[objectKeyString(backupObj)]
+		if !ok {
+			continue
+		}
+		problems = append(problems, validateOwnerReferences(obj, uids)...)
+
+		switch obj.GetKind() {
+		case "Installation":
+			inst := &lsv1alpha1.Installation{}
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, inst); err != nil {
+				return nil, err
+			}
+			if ref := inst.Status.ExecutionReference; ref != nil {
+				problems = appendMissingReference(problems, objects, obj, "Execution", ref.Namespace, ref.Name)
+			}
+		case "Execution":
+			exec := &lsv1alpha1.Execution{}
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, exec); err != nil {
+				return nil, err
+			}
+			if exec.Status.DeployItemCache != nil {
+				for _, ref := range exec.Status.DeployItemCache.ActiveDIs {
+					problems = appendMissingReference(problems, objects, obj, "DeployItem", exec.Namespace, ref.ObjectName)
+				}
+			}
+		case "DeployItem":
+			di := &lsv1alpha1.DeployItem{}
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, di); err != nil {
+				return nil, err
+			}
+			if ref := di.Spec.Target; ref != nil {
+				namespace := ref.Namespace
+				if len(namespace) == 0 {
+					namespace = di.Namespace
+				}
+				problems = appendMissingReference(problems, objects, obj, "Target", namespace, ref.Name)
+			}
+		}
+	}
+
+	return problems, nil
+}
+
+// validateOwnerReferences checks that the owner references of an object refer to existing objects of the backup.
+func validateOwnerReferences(obj *unstructured.Unstructured, uids map[types.UID]bool) []string {
+	problems := []string{}
+	for _, ref := range obj.GetOwnerReferences() {
+		if !uids[ref.UID] {
+			problems = append(problems, fmt.Sprintf("%s has an owner reference to the unknown %s %q",
+				objectKeyString(obj), ref.Kind, ref.Name))
+		}
+	}
+	return problems
+}
+
+func appendMissingReference(problems []string, objects map[string]*unstructured.Unstructured,
+	obj *unstructured.Unstructured, kind, namespace, name string) []string {
+
+	key := fmt.Sprintf("%s %s/%s", kind, namespace, name)
+	if _, ok := objects[key]; !ok {
+		problems = append(problems, fmt.Sprintf("%s refers to %s, which is not part of the restored objects", objectKeyString(obj), key))
+	}
+	return problems
+}
@@ -20,6 +20,7 @@ import (
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	"github.com/gardener/landscaper/apis/mediatype"
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
 	"github.com/gardener/landscaper/pkg/api"
 	"github.com/gardener/landscaper/pkg/components/model"
 	"github.com/gardener/landscaper/pkg/components/model/types"
@@ -141,6 +142,7 @@ func Resolve(
 	if cdRef == nil {
 		return nil, fmt.Errorf("no component descriptor reference defined")
 	}
+
 	if registryAccess == nil {
 		return nil, fmt.Errorf("did not get a working component descriptor resolver")
 	}
@@ -153,6 +155,14 @@ func Resolve(
 		return nil, fmt.Errorf("unable to resolve component descriptor for ref %#v: %w", cdRef, err)
 	}
 
+	// stored blueprints are only used after the component version has been read with the given registry access,
+	// so that the store does not grant access to blueprints of component versions that are not accessible.
+	store := GetStore()
+	if storedBlueprint, ok := store.GetByIdentity(ctx, cdRef, bpDef.Reference.ResourceName); ok {
+		cache.GetOCMContextCache().AddBlueprint(ctx, storedBlueprint, bpCacheID)
+		return storedBlueprint, nil
+	}
+
 	pm1 := utils.StartPerformanceMeasurement(&logger, "ResolveBlueprint-GetResource")
 	resource, err := componentVersion.GetResource(bpDef.Reference.ResourceName, nil)
 	pm1.StopDebug()
@@ -160,6 +170,11 @@ func Resolve(
 		return nil, err
 	}
 
+	if storedBlueprint, ok := store.GetByDigest(ctx, resource); ok {
+		cache.GetOCMContextCache().AddBlueprint(ctx, storedBlueprint, bpCacheID)
+		return storedBlueprint, nil
+	}
+
 	pm2 := utils.StartPerformanceMeasurement(&logger, "ResolveBlueprint-GetTypedContent")
 	content, err := resource.GetTypedContent(ctx)
 	pm2.StopDebug()
@@ -171,6 +186,9 @@ func Resolve(
 		return nil, fmt.Errorf("received resource of type %T but expected type *Blueprint", blueprint)
 	}
 
+	if err := store.Put(ctx, cdRef, resource, blueprint); err != nil {
+		logger.Info("unable to store blueprint in blueprint store", lc.KeyError, err.Error())
+	}
 	cache.GetOCMContextCache().AddBlueprint(ctx, blueprint, bpCacheID)
 
 	return blueprint, nil
@@ -0,0 +1,281 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package blueprints
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/mandelsoft/vfs/pkg/memoryfs"
+	"github.com/mandelsoft/vfs/pkg/projectionfs"
+	"github.com/mandelsoft/vfs/pkg/readonlyfs"
+	"github.com/mandelsoft/vfs/pkg/vfs"
+
+	"github.com/gardener/landscaper/apis/config"
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	"github.com/gardener/landscaper/pkg/components/model"
+	"github.com/gardener/landscaper/pkg/components/model/tar"
+	"github.com/gardener/landscaper/pkg/utils/blueprints"
+)
+
+const (
+	// blueprintFilePrefix is the prefix of the files that contain a blueprint as tar archive.
+	blueprintFilePrefix = "bp-"
+	// componentDescriptorFilePrefix is the prefix of the files that contain component descriptors.
+	componentDescriptorFilePrefix = "cd-"
+)
+
+var (
+	storeInstance *Store
+	storeMux      sync.RWMutex
+)
+
+// SetStore sets the blueprint store that is used to resolve blueprints.
+// The store is shared by all callers of Resolve; a nil store disables the store.
+func SetStore(store *Store) {
+	storeMux.Lock()
+	defer storeMux.Unlock()
+	storeInstance = store
+}
+
+// GetStore returns the blueprint store that is used to resolve blueprints.
+// It returns nil if no store has been configured.
+func GetStore() *Store {
+	storeMux.RLock()
+	defer storeMux.RUnlock()
+	return storeInstance
+}
+
+// Store is a persistent store for blueprints and component descriptors on the filesystem.
+// Every item is stored as a single file whose name is the sha256 hash of its index key
+// so that the store survives restarts of the controllers and can be shared by multiple processes.
+// The size of the store is limited by the garbage collection configuration.
+// If the cache is disabled, items are still written to the store but never read from it.
+type Store struct {
+	fs           *storeFs
+	indexMethod  config.IndexMethod
+	disableCache bool
+}
+
+// NewStore creates a new blueprint store at the path of the configuration.
+// If no path is configured, the store is created in a temporary directory.
+func NewStore(log logging.Logger, baseFs vfs.FileSystem, storeConfig config.BlueprintStore) (*Store, error) {
+	path := storeConfig.Path
+	if len(path) == 0 {
+		var err error
+		path, err = vfs.TempDir(baseFs, baseFs.FSTempDir(), "blueprint-store")
+		if err != nil {
+			return nil, fmt.Errorf("unable to create temporary directory for the blueprint store: %w", err)
+		}
+	}
+	if err := baseFs.MkdirAll(path, os.ModePerm); err != nil {
+		return nil, fmt.Errorf("unable to create blueprint store directory %q: %w", path, err)
+	}
+	storeFs, err := projectionfs.New(baseFs, path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create projection filesystem for the blueprint store at %q: %w", path, err)
+	}
+
+	sfs, err := newStoreFs(log.WithName("blueprintStore"), storeFs, storeConfig.GarbageCollectionConfiguration)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create blueprint store: %w", err)
+	}
+
+	indexMethod := storeConfig.IndexMethod
+	if len(indexMethod) == 0 {
+		indexMethod = config.BlueprintDigestIndex
+	}
+
+	return &Store{
+		fs:           sfs,
+		indexMethod:  indexMethod,
+		disableCache: storeConfig.DisableCache,
+	}, nil
+}
+
+// Close stops the background routines of the store.
+func (s *Store) Close() error {
+	if s == nil {
+		return nil
+	}
+	return s.fs.Close()
+}
+
+// IndexMethod returns the method that is used to index the stored items.
+func (s *Store) IndexMethod() config.IndexMethod {
+	return s.indexMethod
+}
+
+// GetByIdentity returns the stored blueprint of the given component version and resource name.
+// Blueprints are only looked up by identity if the store uses the ComponentDescriptorIdentityMethod.
+func (s *Store) GetByIdentity(ctx context.Context, cdRef *lsv1alpha1.ComponentDescriptorReference, resourceName string) (*blueprints.Blueprint, bool) {
+	if s == nil || s.disableCache || s.indexMethod != config.ComponentDescriptorIdentityMethod {
+		return nil, false
+	}
+	key, err := identityKey(cdRef, resourceName)
+	if err != nil {
+		return nil, false
+	}
+	return s.getBlueprint(ctx, key)
+}
+
+// GetByDigest returns the stored blueprint with the content of the given resource.
+// Blueprints are only looked up by digest if the store uses the BlueprintDigestIndex.
+func (s *Store) GetByDigest(ctx context.Context, resource model.Resource) (*blueprints.Blueprint, bool) {
+	if s == nil || s.disableCache || s.indexMethod != config.BlueprintDigestIndex {
+		return nil, false
+	}
+	key, err := digestKey(resource)
+	if err != nil {
+		return nil, false
+	}
+	return s.getBlueprint(ctx, key)
+}
+
+// Put stores a blueprint that has been resolved from the given component version and resource.
+// The blueprint is indexed according to the index method of the store.
+func (s *Store) Put(ctx context.Context, cdRef *lsv1alpha1.ComponentDescriptorReference, resource model.Resource, blueprint *blueprints.Blueprint) error {
+	if s == nil {
+		return nil
+	}
+	var (
+		key string
+		err error
+	)
+	if s.indexMethod == config.ComponentDescriptorIdentityMethod {
+		key, err = identityKey(cdRef, resource.GetName())
+	} else {
+		key, err = digestKey(resource)
+	}
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	if err := tar.BuildTar(blueprint.Fs, "/", &buf); err != nil {
+		return fmt.Errorf("unable to build tar of blueprint: %w", err)
+	}
+	if err := s.fs.WriteFile(blueprintFilePrefix+key, buf.Bytes()); err != nil {
+		return fmt.Errorf("unable to store blueprint: %w", err)
+	}
+
+	log, _ := logging.FromContextOrNew(ctx, nil)
+	log.Debug("stored blueprint in blueprint store", "key", key)
+	return nil
+}
+
+// GetComponentDescriptors returns the stored component descriptors of the given component version.
+// Component descriptors are only stored if the store uses the ComponentDescriptorIdentityMethod
+// as component descriptors are then treated as immutable.
+func (s *Store) GetComponentDescriptors(cdRef *lsv1alpha1.ComponentDescriptorReference) ([]byte, bool) {
+	if s == nil || s.disableCache || s.indexMethod != config.ComponentDescriptorIdentityMethod {
+		return nil, false
+	}
+	key, err := identityKey(cdRef, "")
+	if err != nil {
+		return nil, false
+	}
+	data, err := s.fs.ReadFile(componentDescriptorFilePrefix + key)
+	if err != nil {
+		return nil, false
+	}
+	return data, true
+}
+
+// PutComponentDescriptors stores the component descriptors of the given component version.
+// Nothing is stored unless the store uses the ComponentDescriptorIdentityMethod.
+func (s *Store) PutComponentDescriptors(cdRef *lsv1alpha1.ComponentDescriptorReference, data []byte) error {
+	if s == nil || s.indexMethod != config.ComponentDescriptorIdentityMethod {
+		return nil
+	}
+	key, err := identityKey(cdRef, "")
+	if err != nil {
+		return err
+	}
+	if err := s.fs.WriteFile(componentDescriptorFilePrefix+key, data); err != nil {
+		return fmt.Errorf("unable to store component descriptors: %w", err)
+	}
+	return nil
+}
+
+func (s *Store) getBlueprint(ctx context.Context, key string) (*blueprints.Blueprint, bool) {
+	log, ctx := logging.FromContextOrNew(ctx, nil)
+	name := blueprintFilePrefix + key
+	data, err := s.fs.ReadFile(name)
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			log.Info("unable to read blueprint from blueprint store", "key", key, lc.KeyError, err.Error())
+		}
+		return nil, false
+	}
+
+	fs := memoryfs.New()
+	if err := tar.ExtractTar(ctx, bytes.NewReader(data), fs); err != nil {
+		log.Info("unable to extract stored blueprint, removing it from the blueprint store", "key", key, lc.KeyError, err.Error())
+		_ = s.fs.Remove(name)
+		return nil, false
+	}
+	blueprint, err := blueprints.NewFromFs(readonlyfs.New(fs))
+	if err != nil {
+		log.Info("unable to read stored blueprint, removing it from the blueprint store", "key", key, lc.KeyError, err.Error())
+		_ = s.fs.Remove(name)
+		return nil, false
+	}
+	log.Debug("get blueprint from blueprint store", "key", key)
+	return blueprint, true
+}
+
+// identityKey returns the key of an item that is identified by its component version and resource name.
+func identityKey(cdRef *lsv1alpha1.ComponentDescriptorReference, resourceName string) (string, error) {
+	if cdRef == nil || cdRef.RepositoryContext == nil {
+		return "", fmt.Errorf("a component descriptor reference with repository context is required")
+	}
+	var repoCtx interface{}
+	if err := json.Unmarshal(cdRef.RepositoryContext.Raw, &repoCtx); err != nil {
+		return "", fmt.Errorf("unable to decode repository context: %w", err)
+	}
+	return hash(map[string]interface{}{
+		"repositoryContext": repoCtx,
+		"componentName":     cdRef.ComponentName,
+		"version":           cdRef.Version,
+		"resourceName":      resourceName,
+	})
+}
+
+// digestKey returns the key of a blueprint that is identified by the digest of its content.
+// Resources without digest are identified by their access as the access of immutable blobs contains their digest.
+func digestKey(resource model.Resource) (string, error) {
+	res, err := resource.GetResource()
+	if err != nil {
+		return "", err
+	}
+	if res.Digest != nil && len(res.Digest.Value) != 0 {
+		return hash(res.Digest)
+	}
+	if res.Access == nil {
+		return "", fmt.Errorf("resource %q has neither a digest nor an access", res.GetName())
+	}
+	return hash(res.Access)
+}
+
+// hash returns the hex encoded sha256 hash of the json representation of the object.
+// Maps are encoded with sorted keys so that the hash is stable.
+func hash(obj interface{}) (string, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,214 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package blueprints_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	cdv2 "github.com/gardener/component-spec/bindings-go/apis/v2"
+	"github.com/mandelsoft/vfs/pkg/memoryfs"
+	"github.com/mandelsoft/vfs/pkg/vfs"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/landscaper/apis/config"
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/components/model"
+	"github.com/gardener/landscaper/pkg/components/model/types"
+	"github.com/gardener/landscaper/pkg/landscaper/blueprints"
+	bputils "github.com/gardener/landscaper/pkg/utils/blueprints"
+)
+
+// testResource is a blueprint resource of a component version with a static digest.
+type testResource struct {
+	name   string
+	digest string
+}
+
+var _ model.Resource = &testResource{}
+
+func (r *testResource) GetTypedContent(_ context.Context) (*model.TypedResourceContent, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+func (r *testResource) GetName() string       { return r.name }
+func (r *testResource) GetVersion() string    { return "v0.1.0" }
+func (r *testResource) GetType() string       { return "blueprint" }
+func (r *testResource) GetAccessType() string { return "localBlob" }
+func (r *testResource) GetResource() (*types.Resource, error) {
+	res := &types.Resource{}
+	res.Name = r.name
+	res.Digest = &cdv2.DigestSpec{
+		HashAlgorithm:          "sha256",
+		NormalisationAlgorithm: "genericBlobDigest/v1",
+		Value:                  r.digest,
+	}
+	return res, nil
+}
+
+func newTestBlueprint(name string) *bputils.Blueprint {
+	fs := memoryfs.New()
+	Expect(vfs.WriteFile(fs, lsv1alpha1.BlueprintFileName, []byte(fmt.Sprintf(`apiVersion: landscaper.gardener.cloud/v1alpha1
+kind: Blueprint
+jsonSchema: "https://json-schema.org/draft/2019-09/schema"
+imports:
+- name: %s
+  type: data
+  schema:
+    type: string
+`, name)), os.ModePerm)).To(Succeed())
+	Expect(vfs.WriteFile(fs, "data.txt", []byte(strings.Repeat(name, 10)), os.ModePerm)).To(Succeed())
+	bp, err := bputils.NewFromFs(fs)
+	Expect(err).ToNot(HaveOccurred())
+	return bp
+}
+
+func newTestCdRef(version string) *lsv1alpha1.ComponentDescriptorReference {
+	return &lsv1alpha1.ComponentDescriptorReference{
+		RepositoryContext: &types.UnstructuredTypedObject{
+			ObjectType: cdv2.ObjectType{Type: "OCIRegistry"},
+			Raw:        []byte(`{"type":"OCIRegistry","baseUrl":"example.com"}`),
+		},
+		ComponentName: "example.com/component",
+		Version:       version,
+	}
+}
+
+var _ = Describe("Blueprint Store", func() {
+
+	var (
+		ctx    context.Context
+		baseFs vfs.FileSystem
+		log    logging.Logger
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		baseFs = memoryfs.New()
+		log = logging.Discard()
+	})
+
+	newStore := func(storeConfig config.BlueprintStore) *blueprints.Store {
+		storeConfig.Path = "/store"
+		store, err := blueprints.NewStore(log, baseFs, storeConfig)
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(store.Close)
+		return store
+	}
+
+	Context("ComponentDescriptorIdentityMethod", func() {
+		It("should return a stored blueprint by the identity of the component version", func() {
+			store := newStore(config.BlueprintStore{IndexMethod: config.ComponentDescriptorIdentityMethod})
+			res := &testResource{name: "my-bp", digest: "abc"}
+			Expect(store.Put(ctx, newTestCdRef("v1"), res, newTestBlueprint("a"))).To(Succeed())
+
+			bp, ok := store.GetByIdentity(ctx, newTestCdRef("v1"), "my-bp")
+			Expect(ok).To(BeTrue())
+			Expect(bp.Info.Imports).To(HaveLen(1))
+			Expect(bp.Info.Imports[0].Name).To(Equal("a"))
+			data, err := vfs.ReadFile(bp.Fs, "data.txt")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(data)).To(Equal(strings.Repeat("a", 10)))
+
+			_, ok = store.GetByIdentity(ctx, newTestCdRef("v2"), "my-bp")
+			Expect(ok).To(BeFalse())
+			_, ok = store.GetByIdentity(ctx, newTestCdRef("v1"), "other-bp")
+			Expect(ok).To(BeFalse())
+			_, ok = store.GetByDigest(ctx, res)
+			Expect(ok).To(BeFalse(), "blueprints should not be looked up by digest")
+		})
+
+		It("should keep stored blueprints and component descriptors after a restart", func() {
+			store := newStore(config.BlueprintStore{IndexMethod: config.ComponentDescriptorIdentityMethod})
+			Expect(store.Put(ctx, newTestCdRef("v1"), &testResource{name: "my-bp"}, newTestBlueprint("a"))).To(Succeed())
+			Expect(store.PutComponentDescriptors(newTestCdRef("v1"), []byte(`[]`))).To(Succeed())
+			Expect(store.Close()).To(Succeed())
+
+			store = newStore(config.BlueprintStore{IndexMethod: config.ComponentDescriptorIdentityMethod})
+			_, ok := store.GetByIdentity(ctx, newTestCdRef("v1"), "my-bp")
+			Expect(ok).To(BeTrue())
+			data, ok := store.GetComponentDescriptors(newTestCdRef("v1"))
+			Expect(ok).To(BeTrue())
+			Expect(string(data)).To(Equal(`[]`))
+		})
+	})
+
+	Context("BlueprintDigestIndex", func() {
+		It("should return a stored blueprint by the digest of the resource", func() {
+			store := newStore(config.BlueprintStore{IndexMethod: config.BlueprintDigestIndex})
+			Expect(store.Put(ctx, newTestCdRef("v1"), &testResource{name: "my-bp", digest: "abc"}, newTestBlueprint("a"))).To(Succeed())
+
+			bp, ok := store.GetByDigest(ctx, &testResource{name: "other-bp", digest: "abc"})
+			Expect(ok).To(BeTrue())
+			Expect(bp.Info.Imports[0].Name).To(Equal("a"))
+
+			_, ok = store.GetByDigest(ctx, &testResource{name: "my-bp", digest: "def"})
+			Expect(ok).To(BeFalse())
+			_, ok = store.GetByIdentity(ctx, newTestCdRef("v1"), "my-bp")
+			Expect(ok).To(BeFalse(), "blueprints should not be looked up by identity")
+		})
+
+		It("should not store component descriptors", func() {
+			store := newStore(config.BlueprintStore{IndexMethod: config.BlueprintDigestIndex})
+			Expect(store.PutComponentDescriptors(newTestCdRef("v1"), []byte(`[]`))).To(Succeed())
+			_, ok := store.GetComponentDescriptors(newTestCdRef("v1"))
+			Expect(ok).To(BeFalse())
+		})
+	})
+
+	It("should store but not return blueprints if the cache is disabled", func() {
+		store := newStore(config.BlueprintStore{IndexMethod: config.BlueprintDigestIndex, DisableCache: true})
+		Expect(store.Put(ctx, newTestCdRef("v1"), &testResource{name: "my-bp", digest: "abc"}, newTestBlueprint("a"))).To(Succeed())
+
+		_, ok := store.GetByDigest(ctx, &testResource{name: "my-bp", digest: "abc"})
+		Expect(ok).To(BeFalse())
+		files, err := vfs.ReadDir(baseFs, "/store")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(files).To(HaveLen(1))
+	})
+
+	It("should garbage collect blueprints if the size exceeds the high threshold", func() {
+		store := newStore(config.BlueprintStore{
+			IndexMethod: config.BlueprintDigestIndex,
+			GarbageCollectionConfiguration: config.GarbageCollectionConfiguration{
+				Size:            "10Ki",
+				GCHighThreshold: 0.5,
+				GCLowThreshold:  0.3,
+			},
+		})
+		bp := newTestBlueprint("a")
+		// every tar archive has a size of a few KiB
+		for i := 0; i < 4; i++ {
+			Expect(store.Put(ctx, newTestCdRef("v1"), &testResource{name: "my-bp", digest: fmt.Sprint(i)}, bp)).To(Succeed())
+		}
+
+		files, err := vfs.ReadDir(baseFs, "/store")
+		Expect(err).ToNot(HaveOccurred())
+		var size int64
+		for _, f := range files {
+			size += f.Size()
+		}
+		Expect(len(files)).To(BeNumerically("<", 4))
+		Expect(size).To(BeNumerically("<=", int64(0.3*10*1024)))
+		// the newest blueprint is kept
+		_, ok := store.GetByDigest(ctx, &testResource{name: "my-bp", digest: "3"})
+		Expect(ok).To(BeTrue())
+	})
+
+	It("should fail if the low threshold is greater than the high threshold", func() {
+		_, err := blueprints.NewStore(log, baseFs, config.BlueprintStore{
+			Path: "/store",
+			GarbageCollectionConfiguration: config.GarbageCollectionConfiguration{
+				Size:            "1Mi",
+				GCHighThreshold: 0.5,
+				GCLowThreshold:  0.8,
+			},
+		})
+		Expect(err).To(HaveOccurred())
+	})
+})
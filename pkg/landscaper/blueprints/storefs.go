@@ -0,0 +1,275 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package blueprints
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gardener/component-cli/ociclient/cache"
+	"github.com/mandelsoft/vfs/pkg/vfs"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/gardener/landscaper/apis/config"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+)
+
+// storeFs is a flat filesystem with a maximal size.
+// If the usage of the filesystem exceeds the high threshold,
+// files are deleted until the usage is below the low threshold.
+// Files with the least hits are deleted first, older files are deleted before newer ones.
+type storeFs struct {
+	log logging.Logger
+	fs  vfs.FileSystem
+
+	// size is the size of the filesystem in bytes.
+	// If the value is 0 there is no limit and no garbage collection will happen.
+	size                    int64
+	gcHighThreshold         float64
+	gcLowThreshold          float64
+	preservedHitsProportion float64
+
+	mux         sync.Mutex
+	index       map[string]*cache.IndexEntry
+	currentSize int64
+	stopCh      chan struct{}
+}
+
+// newStoreFs creates a new store filesystem and indexes all files that already exist in the filesystem.
+func newStoreFs(log logging.Logger, fs vfs.FileSystem, gcConfig config.GarbageCollectionConfiguration) (*storeFs, error) {
+	sfs := &storeFs{
+		log:                     log,
+		fs:                      fs,
+		gcHighThreshold:         gcConfig.GCHighThreshold,
+		gcLowThreshold:          gcConfig.GCLowThreshold,
+		preservedHitsProportion: gcConfig.PreservedHitsProportion,
+		index:                   map[string]*cache.IndexEntry{},
+	}
+	if len(gcConfig.Size) != 0 && gcConfig.Size != "0" {
+		quantity, err := resource.ParseQuantity(gcConfig.Size)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse size %q: %w", gcConfig.Size, err)
+		}
+		sfs.size, _ = quantity.AsInt64()
+	}
+	if sfs.size != 0 && sfs.gcLowThreshold > sfs.gcHighThreshold {
+		return nil, fmt.Errorf("the gc low threshold %v must not be greater than the gc high threshold %v",
+			sfs.gcLowThreshold, sfs.gcHighThreshold)
+	}
+
+	files, err := vfs.ReadDir(fs, "/")
+	if err != nil {
+		return nil, fmt.Errorf("unable to read stored files: %w", err)
+	}
+	for _, file := range files {
+		if file.IsDir() {
+			continue
+		}
+		if strings.HasPrefix(file.Name(), ".") {
+			// temporary files are either currently written by another process or leftovers of crashed processes
+			if time.Since(file.ModTime()) > time.Hour {
+				_ = fs.Remove(file.Name())
+			}
+			continue
+		}
+		sfs.index[file.Name()] = &cache.IndexEntry{
+			Name:      file.Name(),
+			Size:      file.Size(),
+			CreatedAt: file.ModTime(),
+		}
+		sfs.currentSize += file.Size()
+	}
+	sfs.updateMetrics()
+
+	if sfs.size != 0 && gcConfig.ResetInterval.Duration != 0 {
+		sfs.stopCh = make(chan struct{})
+		go sfs.resetHitsPeriodically(gcConfig.ResetInterval.Duration, sfs.stopCh)
+	}
+	// the store might have been filled by a previous process with a different configuration
+	sfs.runGarbageCollection()
+	return sfs, nil
+}
+
+// Close stops the periodic reset of the hits.
+func (sfs *storeFs) Close() error {
+	if sfs.stopCh != nil {
+		close(sfs.stopCh)
+		sfs.stopCh = nil
+	}
+	return nil
+}
+
+// ReadFile reads a file and counts the hit of the file.
+func (sfs *storeFs) ReadFile(name string) ([]byte, error) {
+	file, err := sfs.fs.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	data, err := io.ReadAll(file)
+	if err != nil {
+		return nil, err
+	}
+
+	sfs.mux.Lock()
+	defer sfs.mux.Unlock()
+	entry, ok := sfs.index[name]
+	if !ok {
+		// the file has been written by another process that shares the store
+		entry = &cache.IndexEntry{
+			Name:      name,
+			Size:      int64(len(data)),
+			CreatedAt: time.Now(),
+		}
+		sfs.index[name] = entry
+		sfs.currentSize += entry.Size
+		sfs.updateMetrics()
+	}
+	entry.Hits++
+	entry.HitsSinceLastReset++
+	return data, nil
+}
+
+// WriteFile writes a file if it does not exist yet and runs the garbage collection if necessary.
+func (sfs *storeFs) WriteFile(name string, data []byte) error {
+	sfs.mux.Lock()
+	_, exists := sfs.index[name]
+	sfs.mux.Unlock()
+	if exists {
+		return nil
+	}
+
+	// write to a temporary file first so that other processes sharing the store never read partial files
+	tmpName := fmt.Sprintf(".%s-%d", name, time.Now().UnixNano())
+	if err := vfs.WriteFile(sfs.fs, tmpName, data, os.ModePerm); err != nil {
+		_ = sfs.fs.Remove(tmpName)
+		return err
+	}
+	if err := sfs.fs.Rename(tmpName, name); err != nil {
+		_ = sfs.fs.Remove(tmpName)
+		return err
+	}
+
+	sfs.mux.Lock()
+	sfs.index[name] = &cache.IndexEntry{
+		Name:      name,
+		Size:      int64(len(data)),
+		CreatedAt: time.Now(),
+	}
+	sfs.currentSize += int64(len(data))
+	sfs.updateMetrics()
+	sfs.mux.Unlock()
+
+	sfs.runGarbageCollection()
+	return nil
+}
+
+// Remove removes a file from the filesystem and the index.
+func (sfs *storeFs) Remove(name string) error {
+	sfs.mux.Lock()
+	defer sfs.mux.Unlock()
+	return sfs.remove(name)
+}
+
+func (sfs *storeFs) remove(name string) error {
+	if err := sfs.fs.Remove(name); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if entry, ok := sfs.index[name]; ok {
+		sfs.currentSize -= entry.Size
+		delete(sfs.index, name)
+	}
+	sfs.updateMetrics()
+	return nil
+}
+
+// usage returns the current usage of the filesystem.
+func (sfs *storeFs) usage() float64 {
+	return float64(sfs.currentSize) / float64(sfs.size)
+}
+
+// runGarbageCollection deletes files if the usage exceeds the high threshold
+// until the usage is below the low threshold.
+func (sfs *storeFs) runGarbageCollection() {
+	if sfs.size == 0 {
+		return
+	}
+	sfs.mux.Lock()
+	defer sfs.mux.Unlock()
+	if sfs.usage() < sfs.gcHighThreshold {
+		return
+	}
+
+	for _, entry := range sfs.priorityList() {
+		if sfs.usage() <= sfs.gcLowThreshold {
+			return
+		}
+		if err := sfs.remove(entry.Name); err != nil {
+			sfs.log.Info("unable to delete file from blueprint store", "file", entry.Name, lc.KeyError, err.Error())
+		}
+	}
+}
+
+// priorityList returns all entries of the index ordered by their gc priority.
+// The entry that should be deleted first is the first item.
+func (sfs *storeFs) priorityList() []cache.IndexEntry {
+	var (
+		minHits, maxHits int64
+		oldest, newest   time.Time
+	)
+	entries := make([]cache.IndexEntry, 0, len(sfs.index))
+	for _, entry := range sfs.index {
+		if entry.Hits > maxHits {
+			maxHits = entry.Hits
+		}
+		if len(entries) == 0 || entry.Hits < minHits {
+			minHits = entry.Hits
+		}
+		if newest.Before(entry.CreatedAt) {
+			newest = entry.CreatedAt
+		}
+		if oldest.IsZero() || entry.CreatedAt.Before(oldest) {
+			oldest = entry.CreatedAt
+		}
+		entries = append(entries, *entry)
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		return cache.CalculatePriority(entries[i], minHits, maxHits, oldest, newest) <
+			cache.CalculatePriority(entries[j], minHits, maxHits, oldest, newest)
+	})
+	return entries
+}
+
+// resetHitsPeriodically reduces the hits of all files to the preserved proportion of the hits
+// so that files that have been used frequently in the past but are not used anymore can be garbage collected.
+func (sfs *storeFs) resetHitsPeriodically(interval time.Duration, stopCh <-chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			sfs.mux.Lock()
+			for _, entry := range sfs.index {
+				oldHits := entry.Hits - entry.HitsSinceLastReset
+				entry.Hits = int64(float64(oldHits)*sfs.preservedHitsProportion) + entry.HitsSinceLastReset
+				entry.HitsSinceLastReset = 0
+			}
+			sfs.mux.Unlock()
+		case <-stopCh:
+			return
+		}
+	}
+}
+
+func (sfs *storeFs) updateMetrics() {
+	DiskUsage.Set(float64(sfs.currentSize))
+	StoredItems.Set(float64(len(sfs.index)))
+}
@@ -0,0 +1,38 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package deployitem
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+// recordApproval records the approval of the current job of a deploy item in its approval request.
+// The pickup timeout is restarted with the approval, because the deploy item could not be picked up before.
+func (con *controller) recordApproval(ctx context.Context, di *lsv1alpha1.DeployItem) error {
+	if di.Status.Phase != lsv1alpha1.DeployItemPhases.WaitingForApproval {
+		return nil
+	}
+
+	request := di.Status.ApprovalRequest
+	if request == nil || request.JobID != di.Status.GetJobID() || request.ApprovalTime != nil {
+		return nil
+	}
+
+	logger, ctx := logging.FromContextOrNew(ctx, nil)
+	approvedBy := di.GetAnnotations()[lsv1alpha1.ApprovedByAnnotation]
+	logger.Info("deploy item job has been approved", "approvedBy", approvedBy)
+
+	now := metav1.Now()
+	request.ApprovedBy = approvedBy
+	request.ApprovalTime = &now
+	di.Status.JobIDGenerationTime = &now
+	return con.Writer().UpdateDeployItemStatus(ctx, read_write_layer.W000158, di)
+}
@@ -20,6 +20,8 @@ import (
 	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
 	lsutil "github.com/gardener/landscaper/pkg/utils"
 	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+	"github.com/gardener/landscaper/pkg/utils/redact"
+	"github.com/gardener/landscaper/pkg/utils/tracing"
 )
 
 func (con *controller) Reconcile(ctx context.Context, req reconcile.Request) (result reconcile.Result, err error) {
@@ -49,11 +51,36 @@ func (con *controller) reconcile(ctx context.Context, req reconcile.Request) (re
 		return reconcile.Result{}, err
 	}
 
+	// the sensitive values of the installation must not be part of the status and the logs of the deploy item
+	ctx, err := redact.NewContextForObject(ctx, con.lsUncachedClient, di)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
 	if di.Status.GetJobID() == di.Status.JobIDFinished {
 		logger.Debug("deploy item is finished, nothing to do")
 		return reconcile.Result{}, nil
 	}
 
+	if lsv1alpha1helper.HasSuspendedAnnotation(di.ObjectMeta) {
+		logger.Debug("deploy item is suspended, pickup timeout is not checked")
+		return reconcile.Result{}, nil
+	}
+
+	if lsv1alpha1helper.HasIgnoreAnnotation(di.ObjectMeta) {
+		logger.Debug("deploy item is ignored, pickup timeout is not checked")
+		return reconcile.Result{}, nil
+	}
+
+	if lsv1alpha1helper.IsWaitingForApproval(di) {
+		logger.Debug("deploy item is waiting for approval, pickup timeout is not checked")
+		return reconcile.Result{}, nil
+	}
+
+	if err := con.recordApproval(ctx, di); err != nil {
+		return reconcile.Result{}, err
+	}
+
 	if HasBeenPickedUp(di) || con.pickupTimeout == 0 {
 		// deploy item has been picked up, or the pickup check is deactivated
 		return reconcile.Result{}, nil
@@ -130,6 +157,7 @@ func (con *controller) writePickupTimeoutExceeded(ctx context.Context, di *lsv1a
 		logger.Error(err, "unable to set deployitem status")
 		return err
 	}
+	tracing.RecordDeployItemJob(ctx, di)
 
 	return nil
 }
@@ -7,6 +7,7 @@ package execution
 import (
 	"context"
 	"fmt"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -27,6 +28,9 @@ import (
 	lsutil "github.com/gardener/landscaper/pkg/utils"
 	"github.com/gardener/landscaper/pkg/utils/lock"
 	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+	"github.com/gardener/landscaper/pkg/utils/redact"
+	"github.com/gardener/landscaper/pkg/utils/rollout"
+	"github.com/gardener/landscaper/pkg/utils/tracing"
 )
 
 // NewController creates a new execution controller that reconcile Execution resources.
@@ -185,6 +189,20 @@ func (c *controller) reconcile(ctx context.Context, req reconcile.Request) (reco
 		return lsutil.LogHelper{}.LogStandardErrorAndGetReconcileResult(ctx, err)
 	}
 
+	if lsv1alpha1helper.HasIgnoreAnnotation(exec.ObjectMeta) {
+		logger.Info("execution is ignored")
+		return reconcile.Result{}, nil
+	}
+
+	if exec.Spec.DeployItemsSecretRef != nil {
+		// the sensitive values of the installation must not be part of the status and the logs of the execution
+		redactCtx, err := redact.NewContextFromSecret(ctx, c.lsUncachedClient, exec.Spec.DeployItemsSecretRef.NamespacedName())
+		if err != nil {
+			return lsutil.LogHelper{}.LogStandardErrorAndGetReconcileResult(ctx, err)
+		}
+		ctx = redactCtx
+	}
+
 	if needsFinalizer(exec) {
 		controllerutil.AddFinalizer(exec, lsv1alpha1.LandscaperFinalizer)
 		if err := c.Writer().UpdateExecution(ctx, read_write_layer.W000086, exec); err != nil {
@@ -199,11 +217,24 @@ func (c *controller) reconcile(ctx context.Context, req reconcile.Request) (reco
 		return reconcile.Result{}, nil
 	}
 
+	if lsv1alpha1helper.HasSuspendedAnnotation(exec.ObjectMeta) {
+		logger.Info("execution is suspended")
+		return reconcile.Result{}, nil
+	}
+
 	if isDifferentJobIDs(exec) {
 		// Execution is unfinished
 
+		obj, _ := tracing.ExecutionObject(exec)
+		ctx, span := tracing.StartReconcile(ctx, obj, exec.Status.JobID)
 		err := c.handleReconcilePhase(ctx, exec)
-		return lsutil.LogHelper{}.LogErrorAndGetReconcileResult(ctx, err)
+		tracing.EndSpan(ctx, span, err)
+		result, resultErr := lsutil.LogHelper{}.LogErrorAndGetReconcileResult(ctx, err)
+		if delay := durationUntilNextRolloutBatch(exec, time.Now()); delay > 0 {
+			// no deploy item changes during the pause between two batches of a rollout
+			result.RequeueAfter = delay
+		}
+		return result, resultErr
 	} else {
 		// Execution is finished; nothing to do
 		return reconcile.Result{}, nil
@@ -226,6 +257,7 @@ func (c *controller) handleReconcilePhase(ctx context.Context, exec *lsv1alpha1.
 		}
 
 		exec.Status.DeployItemCache = nil
+		exec.Status.Rollouts = nil
 
 		if exec.DeletionTimestamp.IsZero() {
 			exec.Status.ExecutionPhase = lsv1alpha1.ExecutionPhases.Init
@@ -265,10 +297,12 @@ func (c *controller) handleReconcilePhase(ctx context.Context, exec *lsv1alpha1.
 			return c.setExecutionPhaseAndUpdate(ctx, exec, exec.Status.ExecutionPhase, err, read_write_layer.W000133)
 		}
 
-		if !deployItemClassification.HasRunningItems() && deployItemClassification.HasFailedItems() {
+		if !deployItemClassification.HasRunningItems() && deployItemClassification.HasFailedItems() &&
+			!deployItemClassification.ContinuesDespiteFailures() {
 			err = lserrors.NewError(op, "handlePhaseProgressing", "has failed or missing deploy items", lsv1alpha1.ErrorForInfoOnly)
 			return c.setExecutionPhaseAndUpdate(ctx, exec, lsv1alpha1.ExecutionPhases.Failed, err, read_write_layer.W000134)
-		} else if !deployItemClassification.HasRunningItems() && !deployItemClassification.HasRunnableItems() && deployItemClassification.HasPendingItems() {
+		} else if !deployItemClassification.HasRunningItems() && !deployItemClassification.HasRunnableItems() &&
+			!deployItemClassification.HasWaitingItems() && deployItemClassification.HasPendingItems() {
 			err = lserrors.NewError(op, "handlePhaseProgressing", "items could not be started", lsv1alpha1.ErrorForInfoOnly)
 			return c.setExecutionPhaseAndUpdate(ctx, exec, lsv1alpha1.ExecutionPhases.Failed, err, read_write_layer.W000135)
 		} else if !deployItemClassification.AllSucceeded() {
@@ -405,6 +439,16 @@ func (c *controller) handlePhaseDeleting(ctx context.Context, exec *lsv1alpha1.E
 	return o.TriggerDeployItemsForDelete(ctx)
 }
 
+// durationUntilNextRolloutBatch returns the duration until the next batch of a rollout of a progressing execution
+// is started after a pause. It returns zero if no rollout is waiting for the next batch.
+func durationUntilNextRolloutBatch(exec *lsv1alpha1.Execution, now time.Time) time.Duration {
+	if exec.Status.ExecutionPhase != lsv1alpha1.ExecutionPhases.Progressing {
+		return 0
+	}
+
+	return rollout.DurationUntilNextBatch(exec.Status.Rollouts, now)
+}
+
 func (c *controller) Writer() *read_write_layer.Writer {
 	return read_write_layer.NewWriter(c.lsUncachedClient)
 }
@@ -442,6 +486,7 @@ func (c *controller) handleInterruptOperation(ctx context.Context, exec *lsv1alp
 				return lserrors.NewWrappedError(err, "UpdateDeployItemStatus",
 					fmt.Sprintf("unable to update deploy item %s / %s for interrupt", item.Namespace, item.Name), err.Error())
 			}
+			tracing.RecordDeployItemJob(ctx, item)
 		}
 	}
 
@@ -453,7 +498,7 @@ func (c *controller) setExecutionPhaseAndUpdate(ctx context.Context, exec *lsv1a
 
 	logger, ctx := logging.FromContextOrNew(ctx, nil)
 
-	exec.Status.LastError = lserrors.TryUpdateLsError(exec.Status.LastError, lsErr)
+	exec.Status.LastError = lserrors.TryUpdateLsError(exec.Status.LastError, redact.FromContext(ctx).RedactLsError(lsErr))
 
 	if phase != exec.Status.ExecutionPhase {
 		now := metav1.Now()
@@ -483,8 +528,13 @@ func (c *controller) setExecutionPhaseAndUpdate(ctx context.Context, exec *lsv1a
 		if lsErr == nil {
 			return lserrors.NewWrappedError(err, "setExecutionPhaseAndUpdate", "UpdateExecutionStatus", err.Error())
 		}
-	} else if isExecFinished(exec) {
-		c.finishedObjectCache.AddSynchonized(&exec.ObjectMeta)
+	} else {
+		if phase.IsFinal() {
+			tracing.RecordExecutionJob(ctx, exec)
+		}
+		if isExecFinished(exec) {
+			c.finishedObjectCache.AddSynchonized(&exec.ObjectMeta)
+		}
 	}
 
 	return lsErr
@@ -5,11 +5,13 @@ import (
 	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
 	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
 	"github.com/gardener/landscaper/pkg/landscaper/installations"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/generatedsecrets"
 )
 
 func isInstFinished(inst *lsv1alpha1.Installation) bool {
 	if isAutomaticReconcileOnSpecChange(inst) ||
 		isAutomaticReconcileConfigured(inst) ||
+		hasGeneratedSecretRotation(inst) ||
 		needsFinalizer(inst) ||
 		hasDependentsToTrigger(inst) ||
 		hasInterruptOperation(inst) ||
@@ -35,6 +37,12 @@ func isAutomaticReconcileConfigured(inst *lsv1alpha1.Installation) bool {
 	return retryHelper.isRetryActivatedForSucceeded(inst) || retryHelper.isRetryActivatedForFailed(inst)
 }
 
+// hasGeneratedSecretRotation checks whether the installation has generated secrets that are rotated.
+// Such an installation is reconciled again when the rotation time of a secret is reached.
+func hasGeneratedSecretRotation(inst *lsv1alpha1.Installation) bool {
+	return installations.IsRootInstallation(inst) && generatedsecrets.NextRotationTime(inst.Status.GeneratedSecrets) != nil
+}
+
 func needsFinalizer(inst *lsv1alpha1.Installation) bool {
 	return inst.DeletionTimestamp.IsZero() && !kutil.HasFinalizer(inst, lsv1alpha1.LandscaperFinalizer)
 
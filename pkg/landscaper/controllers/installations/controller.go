@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"time"
 
 	"github.com/google/uuid"
 	corev1 "k8s.io/api/core/v1"
@@ -36,6 +37,9 @@ import (
 	utilscache "github.com/gardener/landscaper/pkg/utils/cache"
 	"github.com/gardener/landscaper/pkg/utils/lock"
 	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+	"github.com/gardener/landscaper/pkg/utils/redact"
+	"github.com/gardener/landscaper/pkg/utils/rollout"
+	"github.com/gardener/landscaper/pkg/utils/tracing"
 	"github.com/gardener/landscaper/pkg/utils/verify"
 )
 
@@ -154,6 +158,8 @@ type Controller struct {
 
 func (c *Controller) Reconcile(ctx context.Context, req reconcile.Request) (result reconcile.Result, err error) {
 	_, ctx = c.log.StartReconcileAndAddToContext(ctx, req)
+	// the redactor collects the sensitive import values of the installation during the reconciliation
+	ctx = redact.NewContext(ctx, redact.New())
 
 	result = reconcile.Result{}
 	defer utils.HandlePanics(ctx, &result, c.hostUncachedClient)
@@ -223,11 +229,25 @@ func (c *Controller) reconcile(ctx context.Context, req reconcile.Request) (reco
 		return utils.LogHelper{}.LogStandardErrorAndGetReconcileResult(ctx, err)
 	}
 
+	if lsv1alpha1helper.HasIgnoreAnnotation(inst.ObjectMeta) {
+		logger.Info("installation is ignored")
+		return reconcile.Result{}, nil
+	}
+
 	// default the installation as it not done by the Controller runtime
 	if err := c.updateInstallationWithDefaults(ctx, inst); err != nil {
 		return utils.LogHelper{}.LogStandardErrorAndGetReconcileResult(ctx, err)
 	}
 
+	suspended, err := c.handleSuspension(ctx, inst)
+	if err != nil {
+		return utils.LogHelper{}.LogStandardErrorAndGetReconcileResult(ctx, err)
+	}
+	if suspended {
+		logger.Info("installation is suspended")
+		return reconcile.Result{}, nil
+	}
+
 	return c.handleAutomaticReconcile(ctx, inst)
 }
 
@@ -252,7 +272,25 @@ func (c *Controller) handleAutomaticReconcile(ctx context.Context, inst *lsv1alp
 
 	logger, _ := logging.FromContextOrNew(ctx, nil, lc.KeyMethod, "handleAutomaticReconcile")
 
+	// the rollout of a spec change is postponed until the next maintenance window
+	var maintenanceWindowDelay time.Duration
 	if isAutomaticReconcileOnSpecChange(inst) {
+		delay, err := durationUntilMaintenanceWindow(ctx, c.LsUncachedClient(), c.clock, inst)
+		if err != nil {
+			return utils.LogHelper{}.LogStandardErrorAndGetReconcileResult(ctx, err)
+		}
+
+		if delay > 0 {
+			logger.Info("postponing reconcile of changed spec until next maintenance window", "delay", delay.String())
+			maintenanceWindowDelay = delay
+		} else if err := c.addReconcileAnnotation(ctx, inst); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
+	// generated secrets are rotated by a reconcile that is triggered when the earliest rotation time is reached
+	if isGeneratedSecretRotationDue(inst, c.clock.Now()) {
+		logger.Info("triggering reconcile to rotate generated secrets")
 		if err := c.addReconcileAnnotation(ctx, inst); err != nil {
 			return reconcile.Result{}, err
 		}
@@ -271,9 +309,33 @@ func (c *Controller) handleAutomaticReconcile(ctx context.Context, inst *lsv1alp
 		logger.Error(err, "recomputeRetry failed")
 	}
 
+	if maintenanceWindowDelay > 0 && (result.RequeueAfter == 0 || maintenanceWindowDelay < result.RequeueAfter) {
+		result.RequeueAfter = maintenanceWindowDelay
+	}
+
+	if rotationDelay := durationUntilGeneratedSecretRotation(inst, c.clock.Now()); rotationDelay > 0 &&
+		(result.RequeueAfter == 0 || rotationDelay < result.RequeueAfter) {
+		result.RequeueAfter = rotationDelay
+	}
+
+	// the next batch of a rollout of subinstallations is started when the pause after the previous batch has passed
+	if rolloutDelay := durationUntilNextRolloutBatch(inst, c.clock.Now()); rolloutDelay > 0 &&
+		(result.RequeueAfter == 0 || rolloutDelay < result.RequeueAfter) {
+		result.RequeueAfter = rolloutDelay
+	}
+
 	return result, err
 }
 
+// durationUntilNextRolloutBatch returns the duration until the next batch of a rollout of the subinstallations of a
+// progressing installation is started after a pause. It returns zero if no rollout is waiting for the next batch.
+func durationUntilNextRolloutBatch(inst *lsv1alpha1.Installation, now time.Time) time.Duration {
+	if inst.Status.InstallationPhase != lsv1alpha1.InstallationPhases.Progressing {
+		return 0
+	}
+	return rollout.DurationUntilNextBatch(inst.Status.Rollouts, now)
+}
+
 func (c *Controller) reconcileInstallation(ctx context.Context, inst *lsv1alpha1.Installation) (reconcile.Result, error) {
 
 	logger, ctx := logging.FromContextOrNew(ctx, nil)
@@ -330,7 +392,10 @@ func (c *Controller) reconcileInstallation(ctx context.Context, inst *lsv1alpha1
 		octx := utilscache.GetOCMContextCache().GetOrCreateOCMContext(ctx, inst.Status.JobID)
 		ctx = octx.BindTo(ctx)
 
+		obj, _ := tracing.InstallationObject(inst)
+		ctx, span := tracing.StartReconcile(ctx, obj, inst.Status.JobID)
 		err := c.handleReconcilePhase(ctx, inst)
+		tracing.EndSpan(ctx, span, err)
 		return utils.LogHelper{}.LogErrorAndGetReconcileResult(ctx, err)
 	} else {
 		// job finished; nothing to do
@@ -378,7 +443,9 @@ func (c *Controller) initPrerequisites(ctx context.Context, inst *lsv1alpha1.Ins
 	}
 
 	blueprintCacheID := utilscache.NewBlueprintCacheID(inst)
+	_, span := tracing.StartSpan(ctx, "ResolveBlueprint")
 	intBlueprint, err := blueprints.Resolve(ctx, op.ComponentsRegistry(), lsCtx.External.ComponentDescriptorRef(), inst.Spec.Blueprint, blueprintCacheID)
+	tracing.EndSpan(ctx, span, err)
 	if err != nil {
 		return nil, lserrors.NewWrappedError(err, currOp, "ResolveBlueprint", err.Error())
 	}
@@ -463,7 +530,7 @@ func (c *Controller) setInstallationPhaseAndUpdate(ctx context.Context, inst *ls
 		[]interface{}{lc.KeyReconciledResource, client.ObjectKeyFromObject(inst).String()},
 		lc.KeyMethod, op)
 
-	inst.Status.LastError = lserrors.TryUpdateLsError(inst.Status.LastError, lsError)
+	inst.Status.LastError = lserrors.TryUpdateLsError(inst.Status.LastError, redact.FromContext(ctx).RedactLsError(lsError))
 
 	if inst.Status.LastError != nil {
 		lastErr := inst.Status.LastError
@@ -527,8 +594,13 @@ func (c *Controller) setInstallationPhaseAndUpdate(ctx context.Context, inst *ls
 		}
 
 		return lsError
-	} else if isInstFinished(inst) {
-		c.finishedObjectCache.AddSynchonized(&inst.ObjectMeta)
+	} else {
+		if phase.IsFinal() {
+			tracing.RecordInstallationJob(ctx, inst)
+		}
+		if isInstFinished(inst) {
+			c.finishedObjectCache.AddSynchonized(&inst.ObjectMeta)
+		}
 	}
 
 	return lsError
@@ -0,0 +1,42 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package installations
+
+import (
+	"time"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+	"github.com/gardener/landscaper/pkg/landscaper/installations"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/generatedsecrets"
+)
+
+// isGeneratedSecretRotationDue checks whether the rotation time of a generated secret of a succeeded root installation
+// is reached, so that a reconcile has to be triggered.
+// Only root installations are triggered, the generated secrets of subinstallations are rotated with their root.
+func isGeneratedSecretRotationDue(inst *lsv1alpha1.Installation, now time.Time) bool {
+	if !installations.IsRootInstallation(inst) ||
+		inst.Status.InstallationPhase != lsv1alpha1.InstallationPhases.Succeeded ||
+		inst.Status.JobID != inst.Status.JobIDFinished ||
+		!inst.DeletionTimestamp.IsZero() ||
+		lsv1alpha1helper.HasOperation(inst.ObjectMeta, lsv1alpha1.ReconcileOperation) {
+		return false
+	}
+	next := generatedsecrets.NextRotationTime(inst.Status.GeneratedSecrets)
+	return next != nil && !now.Before(*next)
+}
+
+// durationUntilGeneratedSecretRotation returns the duration until the earliest rotation time of the generated secrets
+// of a root installation. It returns zero if no generated secret is rotated or if the rotation time is already reached.
+func durationUntilGeneratedSecretRotation(inst *lsv1alpha1.Installation, now time.Time) time.Duration {
+	if !installations.IsRootInstallation(inst) {
+		return 0
+	}
+	next := generatedsecrets.NextRotationTime(inst.Status.GeneratedSecrets)
+	if next == nil || !now.Before(*next) {
+		return 0
+	}
+	return next.Sub(now)
+}
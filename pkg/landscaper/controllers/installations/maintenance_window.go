@@ -0,0 +1,29 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package installations
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/utils/maintenancewindow"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+// durationUntilMaintenanceWindow returns the duration until the next maintenance window of the context of the installation.
+// It returns zero if the current time lies within a maintenance window or if the context defines no maintenance windows.
+func durationUntilMaintenanceWindow(ctx context.Context, cl client.Reader, passiveClock clock.PassiveClock,
+	inst *lsv1alpha1.Installation) (time.Duration, error) {
+
+	windows, err := maintenancewindow.GetMaintenanceWindows(ctx, cl, inst.Namespace, inst.Spec.Context, read_write_layer.R000116)
+	if err != nil {
+		return 0, err
+	}
+	return maintenancewindow.DurationUntilAllowed(windows, passiveClock.Now())
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/gardener/landscaper/pkg/landscaper/installations/subinstallations"
 	lsutil "github.com/gardener/landscaper/pkg/utils"
 	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+	"github.com/gardener/landscaper/pkg/utils/tracing"
 )
 
 func (c *Controller) handleReconcilePhase(ctx context.Context, inst *lsv1alpha1.Installation) lserrors.LsError {
@@ -43,6 +44,7 @@ func (c *Controller) handleReconcilePhase(ctx context.Context, inst *lsv1alpha1.
 		}
 
 		inst.Status.SubInstCache = nil
+		inst.Status.Rollouts = nil
 
 		nextPhase := lsv1alpha1.InstallationPhases.Init
 		if !inst.DeletionTimestamp.IsZero() {
@@ -423,15 +425,8 @@ func (c *Controller) handlePhaseObjectsCreated(ctx context.Context, inst *lsv1al
 		return lserrors.NewWrappedError(err, currentOperation, "ListSubinstallations", err.Error())
 	}
 
-	// trigger subinstallations
-	for _, next := range subInsts {
-		if next.Status.JobID != inst.Status.JobID {
-			next.Status.JobID = inst.Status.JobID
-			next.Status.TransitionTimes = lsutil.NewTransitionTimes()
-			if err = c.WriterToLsUncachedClient().UpdateInstallationStatus(ctx, read_write_layer.W000083, next); err != nil {
-				return lserrors.NewWrappedError(err, currentOperation, "UpdateInstallationStatus", err.Error())
-			}
-		}
+	if _, lsErr := c.triggerSubInstallations(ctx, inst, subInsts); lsErr != nil {
+		return lsErr
 	}
 
 	if inst.Status.ExecutionReference != nil {
@@ -463,9 +458,27 @@ func (c *Controller) handlePhaseProgressing(ctx context.Context, inst *lsv1alpha
 		return false, nil, false, lserrors.NewWrappedError(err, currentOperation, "ListSubinstallations", err.Error())
 	}
 
+	// start the subinstallations of the next batches of the rollouts
+	rolloutClassification, lsErr := c.triggerSubInstallations(ctx, inst, subInsts)
+	if lsErr != nil {
+		return false, nil, false, lsErr
+	}
+
 	failedSubInstNames = []string{}
 
 	for _, next := range subInsts {
+		if next.Status.JobID != inst.Status.JobID && rolloutClassification.IsHeld(next) {
+			if rolloutClassification.IsStopped(next) {
+				// the subinstallation is not rolled out, because its rollout has been stopped
+				allSucceeded = false
+				continue
+			}
+
+			message := fmt.Sprintf("installation %s / %s is not rolled out yet", next.Namespace, next.Name)
+			return false, nil, false, lserrors.NewError(currentOperation, "RolloutProgressing", message,
+				lsv1alpha1.ErrorUnfinished, lsv1alpha1.ErrorForInfoOnly, lsv1alpha1.ErrorNoRetry)
+		}
+
 		if next.Status.JobIDFinished != next.Status.JobID {
 			// Hack: being unfinished should not be treated as an error
 			message := fmt.Sprintf("installation %s / %s is not finished yet", next.Namespace, next.Name)
@@ -505,6 +518,30 @@ func (c *Controller) handlePhaseProgressing(ctx context.Context, inst *lsv1alpha
 	return allSucceeded, failedSubInstNames, executionFailed, nil
 }
 
+// triggerSubInstallations starts the subinstallations for the current job of the installation, except the
+// subinstallations which are held by the rollouts of the subinstallations. The progress of the rollouts is stored in
+// the status of the installation.
+func (c *Controller) triggerSubInstallations(ctx context.Context, inst *lsv1alpha1.Installation,
+	subInsts []*lsv1alpha1.Installation) (*subinstallations.RolloutClassification, lserrors.LsError) {
+	currentOperation := "triggerSubInstallations"
+
+	rolloutClassification := subinstallations.ClassifyForRollouts(inst.Status.JobID, inst.Status.SubInstallationRollouts,
+		subInsts, c.clock.Now())
+	inst.Status.Rollouts = rolloutClassification.Statuses
+
+	for _, next := range subInsts {
+		if next.Status.JobID != inst.Status.JobID && !rolloutClassification.IsHeld(next) {
+			next.Status.JobID = inst.Status.JobID
+			next.Status.TransitionTimes = lsutil.NewTransitionTimes()
+			if err := c.WriterToLsUncachedClient().UpdateInstallationStatus(ctx, read_write_layer.W000083, next); err != nil {
+				return nil, lserrors.NewWrappedError(err, currentOperation, "UpdateInstallationStatus", err.Error())
+			}
+		}
+	}
+
+	return rolloutClassification, nil
+}
+
 func (c *Controller) handlePhaseCompleting(ctx context.Context, inst *lsv1alpha1.Installation) (lserrors.LsError, lserrors.LsError) {
 	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyReconciledResource, client.ObjectKeyFromObject(inst).String()})
 	currentOperation := "handlePhaseCompleting"
@@ -536,7 +573,9 @@ func (c *Controller) handlePhaseCompleting(ctx context.Context, inst *lsv1alpha1
 	if err != nil {
 		return lserrors.NewWrappedError(err, currentOperation, "ConstructImportsForExports", err.Error()), nil
 	}
-	err = con.RenderImportExecutions()
+	_, span := tracing.StartSpan(ctx, "TemplateImportExecutions")
+	err = con.RenderImportExecutions(ctx)
+	tracing.EndSpan(ctx, span, err)
 	if err != nil {
 		return lserrors.NewWrappedError(err, currentOperation, "RenderImportExecutionsForExports", err.Error()), nil
 	}
@@ -566,7 +605,10 @@ func (c *Controller) CreateImportsAndSubobjects(ctx context.Context, op *install
 	if err := constructor.Construct(ctx, imps); err != nil {
 		return lserrors.NewWrappedError(err, currOp, "ConstructImports", err.Error())
 	}
-	if err := constructor.RenderImportExecutions(); err != nil {
+	_, span := tracing.StartSpan(ctx, "TemplateImportExecutions")
+	err := constructor.RenderImportExecutions(ctx)
+	tracing.EndSpan(ctx, span, err)
+	if err != nil {
 		return lserrors.NewWrappedError(err, currOp, "RenderImportExecutions", err.Error())
 	}
 
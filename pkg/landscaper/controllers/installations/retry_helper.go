@@ -114,6 +114,10 @@ func (r *retryHelper) recomputeRetryForFailed(ctx context.Context, inst *lsv1alp
 
 	// first failure, or installation changed
 	if retryStatus == nil {
+		if delay, err := r.durationUntilMaintenanceWindow(ctx, inst); err != nil || delay > 0 {
+			return r.postponeUntilMaintenanceWindow(ctx, delay, err)
+		}
+
 		if err := r.addReconcileAnnotation(ctx, inst); err != nil {
 			return reconcile.Result{}, err
 		}
@@ -129,6 +133,10 @@ func (r *retryHelper) recomputeRetryForFailed(ctx context.Context, inst *lsv1alp
 	}
 
 	if r.isNextRetryDueForFailed(ctx, inst) {
+		if delay, err := r.durationUntilMaintenanceWindow(ctx, inst); err != nil || delay > 0 {
+			return r.postponeUntilMaintenanceWindow(ctx, delay, err)
+		}
+
 		if err := r.addReconcileAnnotation(ctx, inst); err != nil {
 			return reconcile.Result{}, err
 		}
@@ -201,6 +209,10 @@ func (r *retryHelper) recomputeRetryForNewAndSucceeded(ctx context.Context, inst
 	}
 
 	if r.isNextRetryDueForNewAndSucceeded(ctx, inst) {
+		if delay, err := r.durationUntilMaintenanceWindow(ctx, inst); err != nil || delay > 0 {
+			return r.postponeUntilMaintenanceWindow(ctx, delay, err)
+		}
+
 		if err := r.addReconcileAnnotation(ctx, inst); err != nil {
 			return reconcile.Result{}, err
 		}
@@ -220,6 +232,29 @@ func (r *retryHelper) recomputeRetryForNewAndSucceeded(ctx context.Context, inst
 	}, nil
 }
 
+// durationUntilMaintenanceWindow returns the duration until an automatic reconcile is allowed by the maintenance
+// windows of the context of the installation.
+func (r *retryHelper) durationUntilMaintenanceWindow(ctx context.Context, inst *lsv1alpha1.Installation) (time.Duration, error) {
+	return durationUntilMaintenanceWindow(ctx, r.cl, r.clock, inst)
+}
+
+// postponeUntilMaintenanceWindow returns a result that requeues a due automatic reconcile at the begin of the next
+// maintenance window. The automatic reconcile remains due and is therefore triggered then.
+func (r *retryHelper) postponeUntilMaintenanceWindow(ctx context.Context, delay time.Duration, err error) (reconcile.Result, error) {
+	logger, _ := logging.FromContextOrNew(ctx, nil)
+
+	if err != nil {
+		logger.Error(err, "failed to check maintenance windows")
+		return reconcile.Result{}, err
+	}
+
+	logger.Info("postponing automatic reconcile until next maintenance window", "delay", delay.String())
+	return reconcile.Result{
+		Requeue:      true,
+		RequeueAfter: delay,
+	}, nil
+}
+
 func (r *retryHelper) addReconcileAnnotation(ctx context.Context, inst *lsv1alpha1.Installation) error {
 	logger, ctx := logging.FromContextOrNew(ctx, nil)
 
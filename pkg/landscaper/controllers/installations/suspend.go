@@ -0,0 +1,144 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package installations
+
+import (
+	"context"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/landscaper/installations"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+// isSuspended returns true if the installation is suspended by its own spec or by a suspended parent installation.
+func isSuspended(inst *lsv1alpha1.Installation) bool {
+	return inst.Spec.Suspend || lsv1alpha1helper.HasSuspendedAnnotation(inst.ObjectMeta)
+}
+
+// handleSuspension propagates the suspension of an installation to its subinstallations, its execution
+// and the deploy items of its execution. It returns true if the installation is suspended and must not be processed.
+// When a suspended installation is resumed, the suspension is removed from the subobjects and the automatic
+// reconcile schedule is restarted, so that only pending changes and operations are processed.
+func (c *Controller) handleSuspension(ctx context.Context, inst *lsv1alpha1.Installation) (bool, error) {
+	logger, ctx := logging.FromContextOrNew(ctx, nil)
+
+	suspended := isSuspended(inst)
+	if !suspended && inst.Status.SuspendedSince == nil {
+		return false, nil
+	}
+
+	if err := c.propagateSuspension(ctx, inst, suspended); err != nil {
+		return true, err
+	}
+
+	if suspended {
+		if inst.Status.SuspendedSince == nil {
+			logger.Info("Suspending installation")
+			now := metav1.NewTime(c.clock.Now())
+			inst.Status.SuspendedSince = &now
+			if err := c.WriterToLsUncachedClient().UpdateInstallationStatus(ctx, read_write_layer.W000152, inst); err != nil {
+				return true, err
+			}
+		}
+		return true, nil
+	}
+
+	logger.Info("Resuming installation", "suspendedSince", inst.Status.SuspendedSince.Time)
+	inst.Status.SuspendedSince = nil
+	if inst.Status.AutomaticReconcileStatus != nil {
+		// automatic reconciles that were due during the suspension are not caught up
+		inst.Status.AutomaticReconcileStatus.LastReconcileTime = metav1.NewTime(c.clock.Now())
+	}
+	if err := c.WriterToLsUncachedClient().UpdateInstallationStatus(ctx, read_write_layer.W000153, inst); err != nil {
+		return true, err
+	}
+	return false, nil
+}
+
+// propagateSuspension sets or removes the suspended annotation at the subinstallations, the execution
+// and the deploy items of the execution of an installation.
+// The subinstallations propagate the suspension further to their own subobjects.
+func (c *Controller) propagateSuspension(ctx context.Context, inst *lsv1alpha1.Installation, suspended bool) error {
+	writer := c.WriterToLsUncachedClient()
+
+	subInsts, err := installations.ListSubinstallations(ctx, c.LsUncachedClient(), inst, nil, read_write_layer.R000113)
+	if err != nil {
+		return err
+	}
+	for _, subInst := range subInsts {
+		if setSuspendedAnnotation(&subInst.ObjectMeta, suspended) {
+			if err := writer.UpdateInstallation(ctx, read_write_layer.W000154, subInst); err != nil {
+				return err
+			}
+		}
+	}
+
+	if inst.Status.ExecutionReference == nil {
+		return nil
+	}
+
+	execKey := client.ObjectKey{Namespace: inst.Status.ExecutionReference.Namespace, Name: inst.Status.ExecutionReference.Name}
+	exec := &lsv1alpha1.Execution{}
+	if err := read_write_layer.GetExecution(ctx, c.LsUncachedClient(), execKey, exec, read_write_layer.R000114); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if setSuspendedAnnotation(&exec.ObjectMeta, suspended) {
+		if err := writer.UpdateExecution(ctx, read_write_layer.W000155, exec); err != nil {
+			return err
+		}
+	}
+
+	deployItems, err := read_write_layer.ListManagedDeployItems(ctx, c.LsUncachedClient(), execKey, read_write_layer.R000115)
+	if err != nil {
+		return err
+	}
+	for i := range deployItems.Items {
+		di := &deployItems.Items[i]
+		if !suspended && lsv1alpha1helper.HasSuspendedAnnotation(di.ObjectMeta) && isWaitingForPickup(di) {
+			// restart the pickup timeout, otherwise it would be exceeded immediately after the resume
+			now := metav1.NewTime(c.clock.Now())
+			di.Status.JobIDGenerationTime = &now
+			if err := writer.UpdateDeployItemStatus(ctx, read_write_layer.W000157, di); err != nil {
+				return err
+			}
+		}
+		if setSuspendedAnnotation(&di.ObjectMeta, suspended) {
+			if err := writer.UpdateDeployItem(ctx, read_write_layer.W000156, di); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// setSuspendedAnnotation sets or removes the suspended annotation and returns whether the object has been changed.
+func setSuspendedAnnotation(obj *metav1.ObjectMeta, suspended bool) bool {
+	if lsv1alpha1helper.HasSuspendedAnnotation(*obj) == suspended {
+		return false
+	}
+	if suspended {
+		metav1.SetMetaDataAnnotation(obj, lsv1alpha1.SuspendedAnnotation, "true")
+	} else {
+		delete(obj.Annotations, lsv1alpha1.SuspendedAnnotation)
+	}
+	return true
+}
+
+// isWaitingForPickup returns true if the deploy item has an unfinished job which has not yet been picked up by a deployer.
+func isWaitingForPickup(di *lsv1alpha1.DeployItem) bool {
+	return di.Status.GetJobID() != di.Status.JobIDFinished &&
+		di.Status.JobIDGenerationTime != nil &&
+		(di.Status.LastReconcileTime == nil || di.Status.LastReconcileTime.Before(di.Status.JobIDGenerationTime))
+}
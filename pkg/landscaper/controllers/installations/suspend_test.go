@@ -0,0 +1,114 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package installations_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/landscaper/apis/config"
+	"github.com/gardener/landscaper/apis/core/v1alpha1"
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/api"
+	installationsctl "github.com/gardener/landscaper/pkg/landscaper/controllers/installations"
+	lsoperation "github.com/gardener/landscaper/pkg/landscaper/operation"
+	testutils "github.com/gardener/landscaper/test/utils"
+	"github.com/gardener/landscaper/test/utils/envtest"
+)
+
+var _ = Describe("Suspend", func() {
+
+	var (
+		op    *lsoperation.Operation
+		ctrl  reconcile.Reconciler
+		clok  *testing.FakePassiveClock
+		state *envtest.State
+	)
+
+	BeforeEach(func() {
+		op = lsoperation.NewOperation(api.LandscaperScheme, record.NewFakeRecorder(1024), testenv.Client)
+		clok = &testing.FakePassiveClock{}
+
+		ctrl = installationsctl.NewTestActuator(testenv.Client, testenv.Client, testenv.Client, *op,
+			logging.Discard(), clok, &config.LandscaperConfiguration{}, "test-inst-suspend-"+testutils.GetNextCounter())
+	})
+
+	AfterEach(func() {
+		if state != nil {
+			ctx := context.Background()
+			defer ctx.Done()
+			Expect(testenv.CleanupState(ctx, state)).ToNot(HaveOccurred())
+			state = nil
+		}
+	})
+
+	It("should not start a new job while the installation is suspended", func() {
+		ctx := context.Background()
+
+		var err error
+		state, err = testenv.InitResources(ctx, "./testdata/state/test9")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(testutils.CreateExampleDefaultContext(ctx, testenv.Client, state.Namespace)).To(Succeed())
+
+		inst := state.Installations[state.Namespace+"/root"]
+		Expect(state.Client.Get(ctx, kutil.ObjectKeyFromObject(inst), inst)).To(Succeed())
+		inst.Spec.Suspend = true
+		Expect(state.Client.Update(ctx, inst)).To(Succeed())
+
+		t1 := time.Date(2020, time.May, 1, 8, 0, 0, 0, time.UTC)
+		clok.SetTime(t1)
+
+		// installation is suspended; the reconcile operation is kept until the resume
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(inst))
+		Expect(state.Client.Get(ctx, kutil.ObjectKeyFromObject(inst), inst)).To(Succeed())
+		Expect(inst.ObjectMeta.Annotations).To(HaveKeyWithValue(v1alpha1.OperationAnnotation, string(v1alpha1.ReconcileOperation)))
+		Expect(inst.Status.JobID).To(BeEmpty())
+		Expect(inst.Status.SuspendedSince).NotTo(BeNil())
+		Expect(inst.Status.SuspendedSince.Time.UnixMilli()).To(Equal(t1.UnixMilli()))
+
+		// a further reconcile keeps the time of the suspension
+		clok.SetTime(t1.Add(time.Hour))
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(inst))
+		Expect(state.Client.Get(ctx, kutil.ObjectKeyFromObject(inst), inst)).To(Succeed())
+		Expect(inst.Status.JobID).To(BeEmpty())
+		Expect(inst.Status.SuspendedSince.Time.UnixMilli()).To(Equal(t1.UnixMilli()))
+
+		// resume the installation; the pending reconcile operation is processed
+		inst.Spec.Suspend = false
+		Expect(state.Client.Update(ctx, inst)).To(Succeed())
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(inst))
+		Expect(state.Client.Get(ctx, kutil.ObjectKeyFromObject(inst), inst)).To(Succeed())
+		Expect(inst.Status.SuspendedSince).To(BeNil())
+		Expect(inst.ObjectMeta.Annotations).NotTo(HaveKeyWithValue(v1alpha1.OperationAnnotation, string(v1alpha1.ReconcileOperation)))
+		Expect(inst.Status.JobID).NotTo(BeEmpty())
+		Expect(inst.Status.JobID).NotTo(Equal(inst.Status.JobIDFinished))
+	})
+
+	It("should treat an installation with the suspended annotation as suspended", func() {
+		ctx := context.Background()
+
+		var err error
+		state, err = testenv.InitResources(ctx, "./testdata/state/test9")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(testutils.CreateExampleDefaultContext(ctx, testenv.Client, state.Namespace)).To(Succeed())
+
+		inst := state.Installations[state.Namespace+"/root"]
+		Expect(state.Client.Get(ctx, kutil.ObjectKeyFromObject(inst), inst)).To(Succeed())
+		inst.Annotations[v1alpha1.SuspendedAnnotation] = "true"
+		Expect(state.Client.Update(ctx, inst)).To(Succeed())
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(inst))
+		Expect(state.Client.Get(ctx, kutil.ObjectKeyFromObject(inst), inst)).To(Succeed())
+		Expect(inst.Status.JobID).To(BeEmpty())
+		Expect(inst.Status.SuspendedSince).NotTo(BeNil())
+	})
+})
@@ -0,0 +1,41 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package targethealth
+
+import (
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/landscaper/apis/config"
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/utils"
+)
+
+// AddControllerToManager adds the target health controller to the manager.
+// The controller periodically probes the api server of kubernetes-cluster targets and records the result in their status.
+func AddControllerToManager(lsUncachedClient, lsCachedClient client.Client,
+	logger logging.Logger, lsMgr manager.Manager, config config.TargetsController) error {
+	log := logger.Reconciles("targetHealth", "Target")
+	if config.Config.Disable {
+		log.Info("Target health controller is disabled")
+		return nil
+	}
+
+	ctrl := NewController(lsUncachedClient, lsCachedClient, log, lsMgr.GetConfig(), config.Config)
+
+	// status updates do not change the generation, so that the controller is not triggered by its own updates.
+	// Targets are probed again after the probe interval.
+	return builder.ControllerManagedBy(lsMgr).
+		Named("targethealth").
+		For(&lsv1alpha1.Target{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		WithOptions(utils.ConvertCommonControllerConfigToControllerOptions(config.CommonControllerConfig)).
+		WithLogConstructor(func(r *reconcile.Request) logr.Logger { return log.Logr() }).
+		Complete(ctrl)
+}
@@ -0,0 +1,184 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package targethealth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/client-go/rest"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/landscaper/apis/config"
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/apis/core/v1alpha1/targettypes"
+	"github.com/gardener/landscaper/controller-utils/pkg/landscaper/targetresolver"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	"github.com/gardener/landscaper/pkg/deployer/lib"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+// ProbeResult is the result of a single probe of a target.
+type ProbeResult struct {
+	// Reachable indicates whether the api server of the target could be accessed.
+	Reachable bool
+	// ServerVersion is the version of the api server.
+	ServerVersion string
+	// CredentialExpirationTime is the time when the credentials of the target expire.
+	CredentialExpirationTime *time.Time
+	// Message describes why the target is not reachable.
+	Message string
+}
+
+// ProbeFunc probes the api server of a target.
+type ProbeFunc func(ctx context.Context, target *lsv1alpha1.Target) ProbeResult
+
+// Controller is the target health controller.
+type Controller struct {
+	lsUncachedClient client.Client
+	lsCachedClient   client.Client
+	log              logging.Logger
+	lsRestConfig     *rest.Config
+	config           config.TargetControllerConfig
+	probe            ProbeFunc
+}
+
+// NewController creates a new target health controller.
+func NewController(lsUncachedClient, lsCachedClient client.Client, logger logging.Logger,
+	lsRestConfig *rest.Config, config config.TargetControllerConfig) *Controller {
+	c := &Controller{
+		lsUncachedClient: lsUncachedClient,
+		lsCachedClient:   lsCachedClient,
+		log:              logger,
+		lsRestConfig:     lsRestConfig,
+		config:           config,
+	}
+	c.probe = c.probeTarget
+	return c
+}
+
+// WithProbeFunc overwrites the function that is used to probe targets.
+func (c *Controller) WithProbeFunc(probe ProbeFunc) *Controller {
+	c.probe = probe
+	return c
+}
+
+func (c *Controller) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	logger := c.log.StartReconcile(req)
+	ctx = logging.NewContext(ctx, logger)
+
+	target := &lsv1alpha1.Target{}
+	if err := read_write_layer.GetTarget(ctx, c.lsUncachedClient, req.NamespacedName, target, read_write_layer.R000112); err != nil {
+		if apierrors.IsNotFound(err) {
+			logger.Debug(err.Error())
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	if target.Spec.Type != targettypes.KubernetesClusterTargetType || !target.DeletionTimestamp.IsZero() {
+		return reconcile.Result{}, nil
+	}
+
+	result := c.probe(ctx, target)
+	if !result.Reachable {
+		logger.Info("target is not reachable", lc.KeyReason, result.Message)
+	}
+
+	now := metav1.Now()
+	if target.Status == nil {
+		target.Status = &lsv1alpha1.TargetStatus{}
+	}
+	status := target.Status
+	if status.Reachable == nil || *status.Reachable != result.Reachable {
+		status.LastTransitionTime = &now
+	}
+	status.ObservedGeneration = target.Generation
+	status.Reachable = ptr.To(result.Reachable)
+	status.LastProbeTime = &now
+	status.Message = result.Message
+	if len(result.ServerVersion) != 0 {
+		status.ServerVersion = result.ServerVersion
+	}
+	status.CredentialExpirationTime = nil
+	if result.CredentialExpirationTime != nil {
+		status.CredentialExpirationTime = ptr.To(metav1.NewTime(*result.CredentialExpirationTime))
+	}
+
+	if err := read_write_layer.NewWriter(c.lsUncachedClient).UpdateTargetStatus(ctx, read_write_layer.W000151, target); err != nil {
+		if apierrors.IsConflict(err) || apierrors.IsNotFound(err) {
+			logger.Debug("unable to update target status", lc.KeyError, err.Error())
+			return reconcile.Result{Requeue: true}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{RequeueAfter: c.probeInterval()}, nil
+}
+
+// probeTarget resolves the target, reads the expiration time of its credentials and
+// requests the version of its api server.
+func (c *Controller) probeTarget(ctx context.Context, target *lsv1alpha1.Target) ProbeResult {
+	logger, ctx := logging.FromContextOrNew(ctx, nil)
+
+	resolvedTarget, err := targetresolver.Resolve(ctx, target, c.lsUncachedClient)
+	if err != nil {
+		return ProbeResult{Message: fmt.Sprintf("unable to resolve target: %s", err.Error())}
+	}
+
+	targetAccess, err := lib.NewTargetAccess(ctx, resolvedTarget, c.lsUncachedClient, c.lsRestConfig)
+	if err != nil {
+		return ProbeResult{Message: fmt.Sprintf("unable to access target: %s", err.Error())}
+	}
+
+	result := ProbeResult{}
+	result.CredentialExpirationTime, err = lib.GetCredentialExpirationTime(targetAccess.TargetRestConfig())
+	if err != nil {
+		logger.Info("unable to determine expiration time of target credentials", lc.KeyError, err.Error())
+	}
+	if result.CredentialExpirationTime != nil && result.CredentialExpirationTime.Before(time.Now()) {
+		result.Message = fmt.Sprintf("credentials of target expired at %s", result.CredentialExpirationTime.UTC().Format(time.RFC3339))
+		return result
+	}
+
+	probeCtx, cancel := context.WithTimeout(ctx, c.probeTimeout())
+	defer cancel()
+	data, err := targetAccess.TargetClientSet().Discovery().RESTClient().Get().AbsPath("/version").Do(probeCtx).Raw()
+	if err != nil {
+		result.Message = fmt.Sprintf("unable to get version of api server: %s", err.Error())
+		return result
+	}
+	info := &version.Info{}
+	if err := json.Unmarshal(data, info); err != nil {
+		result.Message = fmt.Sprintf("unable to decode version of api server: %s", err.Error())
+		return result
+	}
+
+	result.Reachable = true
+	result.ServerVersion = info.GitVersion
+	return result
+}
+
+func (c *Controller) probeInterval() time.Duration {
+	if c.config.ProbeInterval == nil {
+		return 5 * time.Minute
+	}
+	return c.config.ProbeInterval.Duration
+}
+
+func (c *Controller) probeTimeout() time.Duration {
+	if c.config.ProbeTimeout == nil {
+		return 10 * time.Second
+	}
+	return c.config.ProbeTimeout.Duration
+}
@@ -0,0 +1,95 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package targethealth_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/landscaper/apis/config"
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/apis/core/v1alpha1/targettypes"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/api"
+	"github.com/gardener/landscaper/pkg/landscaper/controllers/targethealth"
+)
+
+var _ = Describe("Target Health Controller", func() {
+
+	var (
+		ctx      context.Context
+		target   *lsv1alpha1.Target
+		lsClient client.Client
+		result   targethealth.ProbeResult
+		ctrl     *targethealth.Controller
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		target = &lsv1alpha1.Target{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-target", Namespace: "default", Generation: 2},
+			Spec:       lsv1alpha1.TargetSpec{Type: targettypes.KubernetesClusterTargetType},
+		}
+		lsClient = fake.NewClientBuilder().WithScheme(api.LandscaperScheme).
+			WithObjects(target).WithStatusSubresource(target).Build()
+		ctrl = targethealth.NewController(lsClient, lsClient, logging.Discard(), nil, config.TargetControllerConfig{
+			ProbeInterval: &metav1.Duration{Duration: time.Minute},
+		}).WithProbeFunc(func(_ context.Context, _ *lsv1alpha1.Target) targethealth.ProbeResult {
+			return result
+		})
+	})
+
+	reconcileTarget := func() (reconcile.Result, *lsv1alpha1.Target) {
+		res, err := ctrl.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(target)})
+		Expect(err).ToNot(HaveOccurred())
+		probed := &lsv1alpha1.Target{}
+		Expect(lsClient.Get(ctx, client.ObjectKeyFromObject(target), probed)).To(Succeed())
+		return res, probed
+	}
+
+	It("should record a reachable target and probe it again after the probe interval", func() {
+		expirationTime := time.Now().Add(time.Hour).Truncate(time.Second)
+		result = targethealth.ProbeResult{Reachable: true, ServerVersion: "v1.29.3", CredentialExpirationTime: &expirationTime}
+
+		res, probed := reconcileTarget()
+		Expect(res.RequeueAfter).To(Equal(time.Minute))
+		Expect(probed.Status.ObservedGeneration).To(Equal(int64(2)))
+		Expect(probed.Status.Reachable).To(Equal(ptr.To(true)))
+		Expect(probed.Status.ServerVersion).To(Equal("v1.29.3"))
+		Expect(probed.Status.CredentialExpirationTime.Time.Equal(expirationTime)).To(BeTrue())
+		Expect(probed.Status.LastProbeTime).ToNot(BeNil())
+		Expect(probed.Status.LastTransitionTime).ToNot(BeNil())
+		Expect(probed.Status.Message).To(BeEmpty())
+	})
+
+	It("should record an unreachable target and keep the last known server version", func() {
+		result = targethealth.ProbeResult{Reachable: true, ServerVersion: "v1.29.3"}
+		reconcileTarget()
+
+		result = targethealth.ProbeResult{Message: "connection refused"}
+		_, probed := reconcileTarget()
+		Expect(probed.Status.Reachable).To(Equal(ptr.To(false)))
+		Expect(probed.Status.Message).To(Equal("connection refused"))
+		Expect(probed.Status.ServerVersion).To(Equal("v1.29.3"))
+		Expect(probed.Status.CredentialExpirationTime).To(BeNil())
+	})
+
+	It("should not probe targets of other types", func() {
+		target.Spec.Type = "landscaper.gardener.cloud/mock"
+		Expect(lsClient.Update(ctx, target)).To(Succeed())
+
+		res, probed := reconcileTarget()
+		Expect(res.RequeueAfter).To(BeZero())
+		Expect(probed.Status).To(BeNil())
+	})
+})
@@ -0,0 +1,38 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package targetsync
+
+import (
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	clusterAPIKubeconfigSuffix = "-kubeconfig"
+	clusterAPIClusterNameLabel = "cluster.x-k8s.io/cluster-name"
+	clusterAPIKubeconfigKey    = "value"
+)
+
+// getClusterAPIClusterName returns the name of the cluster of a ClusterAPI kubeconfig secret, i.e. a secret
+// with name "<cluster>-kubeconfig" and the kubeconfig in the data key "value".
+// If the secret has the cluster name label, its name must match the label. This excludes further secrets of a cluster
+// like "<cluster>-user-kubeconfig". The second return value is false if the secret is no ClusterAPI kubeconfig secret.
+func getClusterAPIClusterName(secret *corev1.Secret) (string, bool) {
+	if _, ok := secret.Data[clusterAPIKubeconfigKey]; !ok {
+		return "", false
+	}
+
+	clusterName, ok := strings.CutSuffix(secret.Name, clusterAPIKubeconfigSuffix)
+	if !ok || clusterName == "" {
+		return "", false
+	}
+
+	if labelValue, ok := secret.Labels[clusterAPIClusterNameLabel]; ok && labelValue != clusterName {
+		return "", false
+	}
+
+	return clusterName, true
+}
@@ -0,0 +1,108 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package targetsync
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/Masterminds/sprig/v3"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+)
+
+// targetMetadata is the metadata of a synced target.
+type targetMetadata struct {
+	name        string
+	labels      map[string]string
+	annotations map[string]string
+}
+
+// newTargetMetadata computes the metadata of the target for a synced secret or shoot from the target template
+// of the targetsync object. Without template, the target gets the cluster name as name.
+func newTargetMetadata(targetSync *lsv1alpha1.TargetSync, source client.Object, clusterName string) (*targetMetadata, error) {
+	tmpl := targetSync.Spec.TargetTemplate
+	if tmpl == nil {
+		return &targetMetadata{name: clusterName}, nil
+	}
+
+	values := map[string]interface{}{
+		"name":        source.GetName(),
+		"namespace":   source.GetNamespace(),
+		"labels":      nonNilMap(source.GetLabels()),
+		"annotations": nonNilMap(source.GetAnnotations()),
+		"clusterName": clusterName,
+	}
+
+	meta := &targetMetadata{
+		name:        clusterName,
+		labels:      map[string]string{},
+		annotations: map[string]string{},
+	}
+
+	if tmpl.Name != "" {
+		name, err := executeTargetTemplate("name", tmpl.Name, values)
+		if err != nil {
+			return nil, err
+		}
+		if errs := validation.IsDNS1123Subdomain(name); len(errs) > 0 {
+			return nil, fmt.Errorf("templated target name %q is invalid: %s", name, strings.Join(errs, "; "))
+		}
+		meta.name = name
+	}
+
+	for key, valueTemplate := range tmpl.Labels {
+		if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+			return nil, fmt.Errorf("target label key %q is invalid: %s", key, strings.Join(errs, "; "))
+		}
+		value, err := executeTargetTemplate("label "+key, valueTemplate, values)
+		if err != nil {
+			return nil, err
+		}
+		if errs := validation.IsValidLabelValue(value); len(errs) > 0 {
+			return nil, fmt.Errorf("templated value %q of target label %q is invalid: %s", value, key, strings.Join(errs, "; "))
+		}
+		meta.labels[key] = value
+	}
+
+	for key, valueTemplate := range tmpl.Annotations {
+		if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+			return nil, fmt.Errorf("target annotation key %q is invalid: %s", key, strings.Join(errs, "; "))
+		}
+		value, err := executeTargetTemplate("annotation "+key, valueTemplate, values)
+		if err != nil {
+			return nil, err
+		}
+		meta.annotations[key] = value
+	}
+
+	return meta, nil
+}
+
+// executeTargetTemplate executes a template of the target template.
+// Only hermetic sprig functions are available, so that the templates cannot read the environment of the landscaper.
+func executeTargetTemplate(field, text string, values map[string]interface{}) (string, error) {
+	t, err := template.New(field).Funcs(sprig.HermeticTxtFuncMap()).Option("missingkey=zero").Parse(text)
+	if err != nil {
+		return "", fmt.Errorf("unable to parse target template for %s: %w", field, err)
+	}
+
+	buf := &bytes.Buffer{}
+	if err := t.Execute(buf, values); err != nil {
+		return "", fmt.Errorf("unable to execute target template for %s: %w", field, err)
+	}
+	return strings.TrimSpace(buf.String()), nil
+}
+
+func nonNilMap(m map[string]string) map[string]string {
+	if m == nil {
+		return map[string]string{}
+	}
+	return m
+}
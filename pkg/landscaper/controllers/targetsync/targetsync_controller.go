@@ -24,6 +24,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
@@ -248,8 +249,11 @@ func (c *TargetSyncController) handleSecretsAndShoots(ctx context.Context, targe
 	logger, ctx := logging.FromContextOrNew(ctx, nil)
 	errors := []error{}
 
-	if targetSync.Spec.SecretNameExpression != "" && targetSync.Spec.ShootNameExpression != "" {
-		msg := "a targetsync object with both, secretNameExpression and shootNameExpression, is not allowed"
+	syncSecrets := targetSync.Spec.SecretNameExpression != "" || targetSync.Spec.SecretSelector != nil || targetSync.Spec.ClusterAPISecrets
+	syncShoots := targetSync.Spec.ShootNameExpression != "" || targetSync.Spec.ShootSelector != nil
+
+	if syncSecrets && syncShoots {
+		msg := "a targetsync object which syncs both, secrets and shoots, is not allowed"
 		logger.Error(nil, msg)
 		errors = append(errors, errors2.New(msg))
 		return errors
@@ -261,17 +265,29 @@ func (c *TargetSyncController) handleSecretsAndShoots(ctx context.Context, targe
 		return errors
 	}
 
-	if targetSync.Spec.SecretNameExpression != "" {
-		secrFilter, err := newNameFilter(targetSync.Spec.SecretNameExpression)
+	syncedTargets := map[string]string{}
+
+	if syncSecrets {
+		secrFilter, err := newNameFilter(defaultNameExpression(targetSync.Spec.SecretNameExpression))
 		if err != nil {
 			logger.Error(err, "building secret name filter of targetsync object failed: "+targetSync.Spec.SecretNameExpression)
 			errors = append(errors, err)
 			return errors
 		}
 
+		listOptions := []client.ListOption{client.InNamespace(targetSync.Spec.SourceNamespace)}
+		if targetSync.Spec.SecretSelector != nil {
+			selector, err := metav1.LabelSelectorAsSelector(targetSync.Spec.SecretSelector)
+			if err != nil {
+				logger.Error(err, "building secret selector of targetsync object failed")
+				errors = append(errors, fmt.Errorf("invalid secret selector: %w", err))
+				return errors
+			}
+			listOptions = append(listOptions, client.MatchingLabelsSelector{Selector: selector})
+		}
+
 		secrets := &corev1.SecretList{}
-		if err = read_write_layer.ListSecrets(ctx, sourceClient, secrets, read_write_layer.R000064,
-			client.InNamespace(targetSync.Spec.SourceNamespace)); err != nil {
+		if err = read_write_layer.ListSecrets(ctx, sourceClient, secrets, read_write_layer.R000064, listOptions...); err != nil {
 			logger.Error(err, "fetching secret list for targetsync object failed")
 			errors = append(errors, err)
 			return errors
@@ -282,9 +298,27 @@ func (c *TargetSyncController) handleSecretsAndShoots(ctx context.Context, targe
 				secretLogger := logger.WithValues(lc.KeyResource, client.ObjectKeyFromObject(&secret).String())
 				secretCtx := logging.NewContext(ctx, secretLogger)
 
-				delete(oldTargets, secret.Name)
+				clusterName, secretKey := secret.Name, ""
+				if targetSync.Spec.ClusterAPISecrets {
+					var ok bool
+					clusterName, ok = getClusterAPIClusterName(&secret)
+					if !ok {
+						secretLogger.Debug("skipping secret, which is no ClusterAPI kubeconfig secret")
+						continue
+					}
+					secretKey = clusterAPIKubeconfigKey
+				}
+
+				targetMeta, err := c.getSyncedTargetMetadata(targetSync, &secret, clusterName, syncedTargets)
+				if err != nil {
+					secretLogger.Error(err, "computing target metadata of secret failed")
+					errors = append(errors, err)
+					continue
+				}
+
+				delete(oldTargets, targetMeta.name)
 
-				if err = c.handleSecret(secretCtx, targetSync, &secret); err != nil {
+				if err = c.handleSecret(secretCtx, targetSync, &secret, targetMeta, secretKey); err != nil {
 					msg := fmt.Sprintf("handling secret %s of targetsync object failed", client.ObjectKeyFromObject(&secret).String())
 					secretLogger.Error(err, msg)
 					errors = append(errors, err)
@@ -293,14 +327,24 @@ func (c *TargetSyncController) handleSecretsAndShoots(ctx context.Context, targe
 		}
 	}
 
-	if targetSync.Spec.ShootNameExpression != "" {
-		shootFilter, err := newNameFilter(targetSync.Spec.ShootNameExpression)
+	if syncShoots {
+		shootFilter, err := newNameFilter(defaultNameExpression(targetSync.Spec.ShootNameExpression))
 		if err != nil {
 			logger.Error(err, "building shoot name filter of targetsync object failed: "+targetSync.Spec.ShootNameExpression)
 			errors = append(errors, err)
 			return errors
 		}
 
+		shootSelector := labels.Everything()
+		if targetSync.Spec.ShootSelector != nil {
+			shootSelector, err = metav1.LabelSelectorAsSelector(targetSync.Spec.ShootSelector)
+			if err != nil {
+				logger.Error(err, "building shoot selector of targetsync object failed")
+				errors = append(errors, fmt.Errorf("invalid shoot selector: %w", err))
+				return errors
+			}
+		}
+
 		shootClient, err := c.sourceClientProvider.GetSourceShootClient(ctx, targetSync, c.lsUncachedClient)
 		if err != nil {
 			logger.Error(err, "failed to get shoot client for targetsync")
@@ -316,14 +360,20 @@ func (c *TargetSyncController) handleSecretsAndShoots(ctx context.Context, targe
 		}
 
 		for _, shoot := range shootList.Items {
-			if shootFilter.shouldBeProcessed(&shoot) {
+			if shootFilter.shouldBeProcessed(&shoot) && shootSelector.Matches(labels.Set(shoot.GetLabels())) {
 				shootLogger := logger.WithValues(lc.KeyResource, client.ObjectKeyFromObject(&shoot).String())
 				shootCtx := logging.NewContext(ctx, shootLogger)
 
-				targetName := c.deriveTargetNameFromShootName(shoot.GetName())
-				delete(oldTargets, targetName)
+				targetMeta, err := c.getSyncedTargetMetadata(targetSync, &shoot, c.deriveTargetNameFromShootName(shoot.GetName()), syncedTargets)
+				if err != nil {
+					shootLogger.Error(err, "computing target metadata of shoot failed")
+					errors = append(errors, err)
+					continue
+				}
+
+				delete(oldTargets, targetMeta.name)
 
-				if err = c.handleShoot(shootCtx, targetSync, shootClient, &shoot); err != nil {
+				if err = c.handleShoot(shootCtx, targetSync, shootClient, &shoot, targetMeta); err != nil {
 					msg := fmt.Sprintf("handling shoot %s of targetsync object failed", client.ObjectKeyFromObject(&shoot).String())
 					shootLogger.Error(err, msg)
 					errors = append(errors, err)
@@ -338,7 +388,7 @@ func (c *TargetSyncController) handleSecretsAndShoots(ctx context.Context, targe
 			targetName = targetSync.Spec.SourceNamespace
 		}
 		delete(oldTargets, targetName)
-		if err := c.createOrUpdateTarget(ctx, targetSync, targetName, targetSync.Spec.SecretRef.Name,
+		if err := c.createOrUpdateTarget(ctx, targetSync, &targetMetadata{name: targetName}, targetSync.Spec.SecretRef.Name,
 			targetSync.Spec.SecretRef.Key, false); err != nil {
 			errors = append(errors, err)
 		}
@@ -369,27 +419,52 @@ func (c *TargetSyncController) handleSecretsAndShoots(ctx context.Context, targe
 	return errors
 }
 
-func (c *TargetSyncController) handleSecret(ctx context.Context, targetSync *lsv1alpha1.TargetSync, secret *corev1.Secret) error {
-	targetName := secret.GetName()
-	err := c.createOrUpdateTarget(ctx, targetSync, targetName, "", "", false)
+// getSyncedTargetMetadata computes the metadata of the target for a synced secret or shoot and checks that
+// the target name is not already used for another synced object.
+func (c *TargetSyncController) getSyncedTargetMetadata(targetSync *lsv1alpha1.TargetSync, source client.Object,
+	clusterName string, syncedTargets map[string]string) (*targetMetadata, error) {
+
+	targetMeta, err := newTargetMetadata(targetSync, source, clusterName)
+	if err != nil {
+		return nil, fmt.Errorf("target for %s: %w", source.GetName(), err)
+	}
+
+	if other, ok := syncedTargets[targetMeta.name]; ok {
+		return nil, fmt.Errorf("target name %s for %s is already used for %s", targetMeta.name, source.GetName(), other)
+	}
+	syncedTargets[targetMeta.name] = source.GetName()
+
+	return targetMeta, nil
+}
+
+func (c *TargetSyncController) handleSecret(ctx context.Context, targetSync *lsv1alpha1.TargetSync, secret *corev1.Secret,
+	targetMeta *targetMetadata, secretKey string) error {
+
+	err := c.createOrUpdateTarget(ctx, targetSync, targetMeta, "", secretKey, false)
 	if err != nil {
 		return err
 	}
 
-	err = c.createOrUpdateSecret(ctx, targetSync, secret)
+	err = c.createOrUpdateSecret(ctx, targetSync, targetMeta.name, secret)
 	return err
 }
 
 func (c *TargetSyncController) handleShoot(ctx context.Context, targetSync *lsv1alpha1.TargetSync,
-	shootClient *clusters.ShootClient, shoot *unstructured.Unstructured) error {
+	shootClient *clusters.ShootClient, shoot *unstructured.Unstructured, targetMeta *targetMetadata) error {
 	logger, ctx := logging.FromContextOrNew(ctx, nil)
 
-	targetName := c.deriveTargetNameFromShootName(shoot.GetName())
+	targetName := targetMeta.name
 
 	due, err := c.isRenewalOfShortLivedKubeconfigDue(ctx, targetName, targetSync.Namespace)
 	if err != nil {
 		return err
 	} else if !due {
+		// keep the labels and annotations of the target up to date with the target template
+		if err := c.createOrUpdateTarget(ctx, targetSync, targetMeta, "", "", false); err != nil {
+			msg := "targetsync for shoot failed: could not update target"
+			logger.Error(err, msg)
+			return fmt.Errorf("%s; target: %s, error: %w", msg, targetName, err)
+		}
 		return nil
 	}
 
@@ -407,7 +482,7 @@ func (c *TargetSyncController) handleShoot(ctx context.Context, targetSync *lsv1
 		return fmt.Errorf("%s; target: %s, error: %w", msg, targetName, err)
 	}
 
-	err = c.createOrUpdateTarget(ctx, targetSync, targetName, "", "", true)
+	err = c.createOrUpdateTarget(ctx, targetSync, targetMeta, "", "", true)
 	if err != nil {
 		msg := "targetsync for shoot failed: could not create or update target"
 		logger.Error(err, msg)
@@ -450,15 +525,26 @@ func (c *TargetSyncController) isRenewalOfShortLivedKubeconfigDue(ctx context.Co
 }
 
 func (c *TargetSyncController) createOrUpdateTarget(ctx context.Context, targetSync *lsv1alpha1.TargetSync,
-	targetName, alternativeSecretName, alternativeKubeconfigKey string, addLastTargetSyncAnnotation bool) error {
+	targetMeta *targetMetadata, alternativeSecretName, alternativeKubeconfigKey string, addLastTargetSyncAnnotation bool) error {
 
+	targetName := targetMeta.name
 	newTarget := &lsv1alpha1.Target{
 		ObjectMeta: controllerruntime.ObjectMeta{Name: targetName, Namespace: targetSync.Namespace},
 	}
 
 	_, err := controllerruntime.CreateOrUpdate(ctx, c.lsUncachedClient, newTarget, func() error {
-		newTarget.ObjectMeta.Labels = map[string]string{
-			labelKeyTargetSync: labelValueOk,
+		if err := checkCreatedByTargetSync(newTarget, "target"); err != nil {
+			return err
+		}
+
+		newTarget.ObjectMeta.Labels = map[string]string{}
+		for key, value := range targetMeta.labels {
+			newTarget.ObjectMeta.Labels[key] = value
+		}
+		newTarget.ObjectMeta.Labels[labelKeyTargetSync] = labelValueOk
+
+		for key, value := range targetMeta.annotations {
+			metav1.SetMetaDataAnnotation(&newTarget.ObjectMeta, key, value)
 		}
 		if addLastTargetSyncAnnotation {
 			helper.SetTimestampAnnotationNow(&newTarget.ObjectMeta, annotationKeyLastTargetSync)
@@ -487,12 +573,27 @@ func (c *TargetSyncController) createOrUpdateTarget(ctx context.Context, targetS
 	return err
 }
 
-func (c *TargetSyncController) createOrUpdateSecret(ctx context.Context, targetSync *lsv1alpha1.TargetSync, secret *corev1.Secret) error {
+// checkCreatedByTargetSync returns an error if the given object already exists but has not been created by a
+// targetsync, so that a targetsync never overwrites targets and secrets of other owners.
+func checkCreatedByTargetSync(obj client.Object, kind string) error {
+	if obj.GetResourceVersion() == "" || obj.GetLabels()[labelKeyTargetSync] == labelValueOk {
+		return nil
+	}
+	return fmt.Errorf("%s %s already exists and has not been created by a targetsync", kind, obj.GetName())
+}
+
+func (c *TargetSyncController) createOrUpdateSecret(ctx context.Context, targetSync *lsv1alpha1.TargetSync,
+	secretName string, secret *corev1.Secret) error {
+
 	newSecret := &corev1.Secret{
-		ObjectMeta: controllerruntime.ObjectMeta{Name: secret.Name, Namespace: targetSync.Namespace},
+		ObjectMeta: controllerruntime.ObjectMeta{Name: secretName, Namespace: targetSync.Namespace},
 	}
 
 	_, err := controllerruntime.CreateOrUpdate(ctx, c.lsUncachedClient, newSecret, func() error {
+		if err := checkCreatedByTargetSync(newSecret, "secret"); err != nil {
+			return err
+		}
+
 		newSecret.ObjectMeta.Labels = map[string]string{
 			labelKeyTargetSync: labelValueOk,
 		}
@@ -517,6 +618,10 @@ func (c *TargetSyncController) createOrUpdateSecretForShoot(ctx context.Context,
 	}
 
 	_, err = controllerruntime.CreateOrUpdate(ctx, c.lsUncachedClient, newSecret, func() error {
+		if err := checkCreatedByTargetSync(newSecret, "secret"); err != nil {
+			return err
+		}
+
 		newSecret.ObjectMeta.Labels = map[string]string{
 			labelKeyTargetSync: labelValueOk,
 		}
@@ -751,6 +856,15 @@ func (c *TargetSyncController) deriveTargetNameFromShootName(shootName string) s
 	return shootName
 }
 
+// defaultNameExpression returns the name expression which matches all names if no expression is set,
+// which is the case if the objects to sync are only selected by labels.
+func defaultNameExpression(nameExpression string) string {
+	if nameExpression == "" {
+		return "*"
+	}
+	return nameExpression
+}
+
 func (c *TargetSyncController) isTargetSyncSecret(secretName string, targetSync *lsv1alpha1.TargetSync) bool {
 	return secretName == targetSync.Spec.SecretRef.Name
 }
@@ -12,7 +12,10 @@ import (
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
@@ -20,6 +23,7 @@ import (
 	"github.com/gardener/landscaper/apis/core/v1alpha1/targettypes"
 	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/api"
 	"github.com/gardener/landscaper/pkg/utils/clusters"
 	testutils "github.com/gardener/landscaper/test/utils"
 	"github.com/gardener/landscaper/test/utils/envtest"
@@ -214,6 +218,60 @@ var _ = Describe("TargetSync Controller", func() {
 			checkTargetAndSecretDoNotExist(ctx, secretName2)
 		})
 
+		It("should sync ClusterAPI secrets selected by labels with templated targets", func() {
+			ctx := context.Background()
+
+			var err error
+			state, err = testenv.InitResourcesWithTwoNamespaces(ctx, "./testdata/state/test3")
+			Expect(err).ToNot(HaveOccurred())
+
+			tgs := &lsv1alpha1.TargetSync{}
+			tgs.Name = "test-target-sync"
+			tgs.Namespace = state.Namespace
+			testutils.ExpectNoError(state.Client.Get(ctx, kutil.ObjectKeyFromObject(tgs), tgs))
+
+			testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(tgs))
+
+			checkTarget(ctx, "cluster-a-target", "cluster-a-target", "value")
+
+			target := &lsv1alpha1.Target{}
+			target.Name = "cluster-a-target"
+			target.Namespace = state.Namespace
+			testutils.ExpectNoError(state.Client.Get(ctx, kutil.ObjectKeyFromObject(target), target))
+			Expect(target.Labels).To(Equal(map[string]string{
+				labelKeyTargetSync: labelValueOk,
+				"env":              "prod",
+				"cluster":          "cluster-a",
+			}))
+			Expect(target.Annotations).To(HaveKeyWithValue("example.com/source", state.Namespace2+"/cluster-a-kubeconfig"))
+
+			secret := &corev1.Secret{}
+			secret.Name = "cluster-a-target"
+			secret.Namespace = state.Namespace
+			testutils.ExpectNoError(state.Client.Get(ctx, kutil.ObjectKeyFromObject(secret), secret))
+			Expect(secret.Data).To(HaveKeyWithValue("value", []byte("dummy-kubeconfig")))
+
+			targets := &lsv1alpha1.TargetList{}
+			testutils.ExpectNoError(state.Client.List(ctx, targets, client.InNamespace(state.Namespace)))
+			Expect(targets.Items).To(HaveLen(1))
+
+			testutils.ExpectNoError(state.Client.Get(ctx, kutil.ObjectKeyFromObject(tgs), tgs))
+			Expect(tgs.Status.LastErrors).To(BeEmpty())
+
+			// Change the labels of the source secret so that it is no longer selected
+
+			sourceSecret := &corev1.Secret{}
+			sourceSecret.Name = "cluster-a-kubeconfig"
+			sourceSecret.Namespace = state.Namespace2
+			testutils.ExpectNoError(state.Client.Get(ctx, kutil.ObjectKeyFromObject(sourceSecret), sourceSecret))
+			sourceSecret.Labels["env"] = "dev"
+			testutils.ExpectNoError(state.Client.Update(ctx, sourceSecret))
+
+			testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(tgs))
+
+			checkTargetAndSecretDoNotExist(ctx, "cluster-a-target")
+		})
+
 		It("should not sync if there is more than one TargetSync object", func() {
 			ctx := context.Background()
 
@@ -243,4 +301,42 @@ var _ = Describe("TargetSync Controller", func() {
 		})
 	})
 
+	Context("target names", func() {
+
+		It("should not overwrite a target that has not been created by a targetsync", func() {
+			ctx := context.Background()
+			existing := &lsv1alpha1.Target{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-target", Namespace: "test"},
+				Spec:       lsv1alpha1.TargetSpec{Type: "my-type"},
+			}
+			lsClient := fake.NewClientBuilder().WithScheme(api.LandscaperScheme).WithObjects(existing).Build()
+			ctrl := NewTargetSyncController(lsClient, lsClient, logging.Discard(), nil).(*TargetSyncController)
+
+			tgs := &lsv1alpha1.TargetSync{ObjectMeta: metav1.ObjectMeta{Name: "sync", Namespace: "test"}}
+			err := ctrl.createOrUpdateTarget(ctx, tgs, &targetMetadata{name: "my-target"}, "", "", false)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("has not been created by a targetsync"))
+
+			target := &lsv1alpha1.Target{}
+			testutils.ExpectNoError(lsClient.Get(ctx, kutil.ObjectKeyFromObject(existing), target))
+			Expect(target.Spec.Type).To(BeEquivalentTo("my-type"))
+
+			target.Labels = map[string]string{labelKeyTargetSync: labelValueOk}
+			testutils.ExpectNoError(lsClient.Update(ctx, target))
+			testutils.ExpectNoError(ctrl.createOrUpdateTarget(ctx, tgs, &targetMetadata{name: "my-target"}, "", "", false))
+			testutils.ExpectNoError(lsClient.Get(ctx, kutil.ObjectKeyFromObject(existing), target))
+			Expect(target.Spec.Type).To(Equal(targettypes.KubernetesClusterTargetType))
+		})
+
+		It("should not provide functions that read the environment to target templates", func() {
+			_, err := executeTargetTemplate("name", `{{ env "HOME" }}`, nil)
+			Expect(err).To(HaveOccurred())
+			_, err = executeTargetTemplate("name", `{{ expandenv "$HOME" }}`, nil)
+			Expect(err).To(HaveOccurred())
+
+			name, err := executeTargetTemplate("name", `{{ .name | lower | trunc 5 }}`, map[string]interface{}{"name": "MyCluster"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(name).To(Equal("myclu"))
+		})
+	})
 })
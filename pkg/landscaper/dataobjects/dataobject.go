@@ -72,6 +72,14 @@ func NewFromDataObject(do *lsv1alpha1.DataObject) (*DataObject, error) {
 	}, nil
 }
 
+// IsSensitive returns true if the data object has been read from a secret because its data is sensitive.
+func IsSensitive(objAcc metav1.Object) bool {
+	if objAcc == nil {
+		return false
+	}
+	return objAcc.GetLabels()[lsv1alpha1.DataObjectSensitiveLabel] == "true"
+}
+
 // GetMetadataFromObject read optional metadata from object's labels and annotations
 func GetMetadataFromObject(objAcc metav1.Object, data []byte) Metadata {
 	meta := Metadata{}
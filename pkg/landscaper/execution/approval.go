@@ -0,0 +1,66 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package execution
+
+import (
+	"fmt"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+)
+
+// requestApproval sets a deploy item, whose new job must be approved, to phase WaitingForApproval
+// and adds an approval request with a summary of the job to its status.
+// Deploy items that would not be changed by the job, because they are unchanged and succeeded and only updated
+// on changes, do not need an approval.
+func requestApproval(di *lsv1alpha1.DeployItem, requireApproval bool, now metav1.Time) {
+	if !requireApproval || !di.DeletionTimestamp.IsZero() || isUnchangedAndSucceeded(di) {
+		di.Status.ApprovalRequest = nil
+		return
+	}
+
+	di.Status.Phase = lsv1alpha1.DeployItemPhases.WaitingForApproval
+	di.Status.ApprovalRequest = &lsv1alpha1.ApprovalRequest{
+		JobID:       di.Status.GetJobID(),
+		Summary:     approvalSummary(di),
+		RequestTime: now,
+	}
+}
+
+func isUnchangedAndSucceeded(di *lsv1alpha1.DeployItem) bool {
+	return di.Spec.UpdateOnChangeOnly &&
+		di.GetGeneration() == di.Status.ObservedGeneration &&
+		di.Status.Phase == lsv1alpha1.DeployItemPhases.Succeeded
+}
+
+// approvalSummary returns a human-readable summary of what is deployed by the current job of a deploy item.
+func approvalSummary(di *lsv1alpha1.DeployItem) string {
+	lines := []string{}
+
+	name := di.GetLabels()[lsv1alpha1.ExecutionManagedNameLabel]
+	if len(name) == 0 {
+		name = di.GetName()
+	}
+	lines = append(lines, fmt.Sprintf("Deploy item %q of type %q", name, di.Spec.Type))
+
+	if di.Spec.Target != nil && len(di.Spec.Target.Name) != 0 {
+		lines = append(lines, fmt.Sprintf("Target: %s/%s", di.GetNamespace(), di.Spec.Target.Name))
+	}
+
+	switch {
+	case di.Status.ObservedGeneration == 0:
+		lines = append(lines, "Initial deployment")
+	case di.GetGeneration() != di.Status.ObservedGeneration:
+		lines = append(lines, fmt.Sprintf("Specification changed since the last deployment (generation %d, last deployed generation %d)",
+			di.GetGeneration(), di.Status.ObservedGeneration))
+	default:
+		lines = append(lines, fmt.Sprintf("Specification unchanged since the last deployment (generation %d, last phase %s)",
+			di.GetGeneration(), di.Status.Phase))
+	}
+
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,87 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package execution
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+)
+
+var _ = Describe("Approval", func() {
+
+	buildDeployItem := func(generation, observedGeneration int64, phase lsv1alpha1.DeployItemPhase) *lsv1alpha1.DeployItem {
+		di := &lsv1alpha1.DeployItem{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       "di-abc",
+				Namespace:  "test",
+				Generation: generation,
+				Labels:     map[string]string{lsv1alpha1.ExecutionManagedNameLabel: "app"},
+			},
+			Spec: lsv1alpha1.DeployItemSpec{
+				Type:   "landscaper.gardener.cloud/helm",
+				Target: &lsv1alpha1.ObjectReference{Name: "cluster", Namespace: "test"},
+			},
+			Status: lsv1alpha1.DeployItemStatus{
+				ObservedGeneration: observedGeneration,
+				Phase:              phase,
+			},
+		}
+		di.Status.SetJobID("job-2")
+		return di
+	}
+
+	It("should request an approval for a deploy item which requires an approval", func() {
+		di := buildDeployItem(2, 1, lsv1alpha1.DeployItemPhases.Succeeded)
+		now := metav1.Now()
+
+		requestApproval(di, true, now)
+		Expect(di.Status.Phase).To(Equal(lsv1alpha1.DeployItemPhases.WaitingForApproval))
+		Expect(di.Status.ApprovalRequest).NotTo(BeNil())
+		Expect(di.Status.ApprovalRequest.JobID).To(Equal("job-2"))
+		Expect(di.Status.ApprovalRequest.RequestTime).To(Equal(now))
+		Expect(di.Status.ApprovalRequest.Summary).To(ContainSubstring(`Deploy item "app" of type "landscaper.gardener.cloud/helm"`))
+		Expect(di.Status.ApprovalRequest.Summary).To(ContainSubstring("Target: test/cluster"))
+		Expect(di.Status.ApprovalRequest.Summary).To(ContainSubstring("generation 2, last deployed generation 1"))
+		Expect(lsv1alpha1helper.IsWaitingForApproval(di)).To(BeTrue())
+
+		metav1.SetMetaDataAnnotation(&di.ObjectMeta, lsv1alpha1.ApprovedJobAnnotation, "job-1")
+		Expect(lsv1alpha1helper.IsWaitingForApproval(di)).To(BeTrue())
+		metav1.SetMetaDataAnnotation(&di.ObjectMeta, lsv1alpha1.ApprovedJobAnnotation, "job-2")
+		Expect(lsv1alpha1helper.IsWaitingForApproval(di)).To(BeFalse())
+	})
+
+	It("should not request an approval for a deploy item which does not require an approval", func() {
+		di := buildDeployItem(2, 1, lsv1alpha1.DeployItemPhases.Succeeded)
+		di.Status.ApprovalRequest = &lsv1alpha1.ApprovalRequest{JobID: "job-1"}
+
+		requestApproval(di, false, metav1.Now())
+		Expect(di.Status.Phase).To(Equal(lsv1alpha1.DeployItemPhases.Succeeded))
+		Expect(di.Status.ApprovalRequest).To(BeNil())
+		Expect(lsv1alpha1helper.IsWaitingForApproval(di)).To(BeFalse())
+	})
+
+	It("should not request an approval for an unchanged deploy item which is only updated on changes", func() {
+		di := buildDeployItem(2, 2, lsv1alpha1.DeployItemPhases.Succeeded)
+		di.Spec.UpdateOnChangeOnly = true
+
+		requestApproval(di, true, metav1.Now())
+		Expect(di.Status.Phase).To(Equal(lsv1alpha1.DeployItemPhases.Succeeded))
+		Expect(di.Status.ApprovalRequest).To(BeNil())
+	})
+
+	It("should not request an approval for the deletion of a deploy item", func() {
+		di := buildDeployItem(2, 1, lsv1alpha1.DeployItemPhases.Succeeded)
+		now := metav1.Now()
+		di.DeletionTimestamp = &now
+
+		requestApproval(di, true, now)
+		Expect(di.Status.Phase).To(Equal(lsv1alpha1.DeployItemPhases.Succeeded))
+		Expect(di.Status.ApprovalRequest).To(BeNil())
+	})
+})
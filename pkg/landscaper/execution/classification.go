@@ -14,12 +14,17 @@ import (
 // - failed items:    they have the same jobID as the execution, are finished and not succeeded (=> failed)
 // - runnableItems:   they have an old jobID, which can be updated because there are no pending dependencies
 // - pending items:   they have an old jobID, which can not be updated because of pending dependencies
+// - waiting items:   they have an old jobID, which can not be updated before the pause between two batches of a rollout has passed
 type DeployItemClassification struct {
 	runningItems   []*executionItem
 	succeededItems []*executionItem
 	failedItems    []*executionItem
 	runnableItems  []*executionItem
 	pendingItems   []*executionItem
+	waitingItems   []*executionItem
+
+	// failuresTolerated is true if all failed items belong to rollouts which tolerate the failures
+	failuresTolerated bool
 }
 
 func (c *DeployItemClassification) HasRunningItems() bool {
@@ -42,8 +47,18 @@ func (c *DeployItemClassification) HasPendingItems() bool {
 	return len(c.pendingItems) > 0
 }
 
+func (c *DeployItemClassification) HasWaitingItems() bool {
+	return len(c.waitingItems) > 0
+}
+
+// ContinuesDespiteFailures returns true if the failed items are tolerated by their rollouts
+// and there are further items to roll out.
+func (c *DeployItemClassification) ContinuesDespiteFailures() bool {
+	return c.failuresTolerated && (c.HasRunnableItems() || c.HasWaitingItems())
+}
+
 func (c *DeployItemClassification) AllSucceeded() bool {
-	return !c.HasRunningItems() && !c.HasFailedItems() && !c.HasRunnableItems() && !c.HasPendingItems()
+	return !c.HasRunningItems() && !c.HasFailedItems() && !c.HasRunnableItems() && !c.HasPendingItems() && !c.HasWaitingItems()
 }
 
 func (c *DeployItemClassification) GetRunnableItems() []*executionItem {
@@ -7,6 +7,7 @@ package execution
 import (
 	"context"
 	"fmt"
+	"time"
 
 	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
 
@@ -108,7 +109,7 @@ func (o *Operation) TriggerDeployItems(ctx context.Context) (*DeployItemClassifi
 						return nil, err
 					}
 				} else {
-					if err := o.triggerDeployItem(ctx, item.DeployItem, read_write_layer.W000030); err != nil {
+					if err := o.triggerDeployItem(ctx, item.DeployItem, false, read_write_layer.W000030); err != nil {
 						return nil, err
 					}
 				}
@@ -124,11 +125,14 @@ func (o *Operation) TriggerDeployItems(ctx context.Context) (*DeployItemClassifi
 		return nil, lsErr
 	}
 
-	// Start the runnable items, provided there are no failed items
-	if !classification.HasFailedItems() {
+	// Restrict the runnable items to the allowed batches of the rollouts
+	o.exec.Status.Rollouts = classification.applyRollouts(o.exec.Status.JobID, o.exec.Spec.Rollouts, items, time.Now())
+
+	// Start the runnable items, provided there are no failed items or the failures are tolerated by the rollouts
+	if !classification.HasFailedItems() || classification.failuresTolerated {
 		runnableItems := classification.GetRunnableItems()
 		for _, item := range runnableItems {
-			if err := o.triggerDeployItem(ctx, item.DeployItem, read_write_layer.W000056); err != nil {
+			if err := o.triggerDeployItem(ctx, item.DeployItem, item.Info.RequireApproval, read_write_layer.W000056); err != nil {
 				return nil, err
 			}
 		}
@@ -177,7 +181,7 @@ func (o *Operation) TriggerDeployItemsForDelete(ctx context.Context) (*DeployIte
 					return nil, err
 				}
 			} else {
-				if err := o.triggerDeployItem(ctx, item.DeployItem, read_write_layer.W000090); err != nil {
+				if err := o.triggerDeployItem(ctx, item.DeployItem, false, read_write_layer.W000090); err != nil {
 					return nil, err
 				}
 			}
@@ -187,7 +191,8 @@ func (o *Operation) TriggerDeployItemsForDelete(ctx context.Context) (*DeployIte
 	return classification, nil
 }
 
-func (o *Operation) triggerDeployItem(ctx context.Context, di *lsv1alpha1.DeployItem, writeId read_write_layer.WriteID) lserrors.LsError {
+func (o *Operation) triggerDeployItem(ctx context.Context, di *lsv1alpha1.DeployItem, requireApproval bool,
+	writeId read_write_layer.WriteID) lserrors.LsError {
 	op := "TriggerDeployItem"
 
 	key := kutil.ObjectKeyFromObject(di)
@@ -200,6 +205,7 @@ func (o *Operation) triggerDeployItem(ctx context.Context, di *lsv1alpha1.Deploy
 	di.Status.TransitionTimes = utils.NewTransitionTimes()
 	now := metav1.Now()
 	di.Status.JobIDGenerationTime = &now
+	requestApproval(di, requireApproval, now)
 	if err := o.WriterToLsUncachedClient().UpdateDeployItemStatus(ctx, writeId, di); err != nil {
 		return lserrors.NewWrappedError(err, op, "UpdateDeployItemStatus", err.Error())
 	}
@@ -277,7 +283,12 @@ func (o *Operation) getDeployItems(ctx context.Context,
 		return nil, nil, lserrors.NewWrappedError(err, op, "ListManagedDeployItems", err.Error())
 	}
 
-	executionItems, orphaned := o.getExecutionItems(managedItems)
+	deployItemTemplates, err := o.getDeployItemTemplates(ctx)
+	if err != nil {
+		return nil, nil, lserrors.NewWrappedError(err, op, "GetDeployItemTemplates", err.Error())
+	}
+
+	executionItems, orphaned := o.getExecutionItems(deployItemTemplates, managedItems)
 	return executionItems, orphaned, nil
 }
 
@@ -6,8 +6,11 @@ package execution
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"strings"
 
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -98,12 +101,32 @@ func (o *Operation) ListManagedDeployItems(ctx context.Context, readID read_writ
 	}
 }
 
+// getDeployItemTemplates returns the deploy item templates of the execution.
+// The templates are read from the referenced secret if the execution does not contain them inline.
+func (o *Operation) getDeployItemTemplates(ctx context.Context) (lsv1alpha1.DeployItemTemplateList, error) {
+	if o.exec.Spec.DeployItemsSecretRef == nil {
+		return o.exec.Spec.DeployItems, nil
+	}
+
+	secret := &corev1.Secret{}
+	if err := o.LsUncachedClient().Get(ctx, o.exec.Spec.DeployItemsSecretRef.NamespacedName(), secret); err != nil {
+		return nil, fmt.Errorf("unable to get deploy items secret %s: %w", o.exec.Spec.DeployItemsSecretRef.NamespacedName().String(), err)
+	}
+
+	deployItems := lsv1alpha1.DeployItemTemplateList{}
+	if err := json.Unmarshal(secret.Data[lsv1alpha1.ExecutionDeployItemsSecretDataKey], &deployItems); err != nil {
+		return nil, fmt.Errorf("unable to decode deploy items of secret %s: %w", o.exec.Spec.DeployItemsSecretRef.NamespacedName().String(), err)
+	}
+	return deployItems, nil
+}
+
 // getExecutionItems creates an internal representation for all execution items.
 // It also returns all removed deploy items that are not defined by the execution anymore.
-func (o *Operation) getExecutionItems(items []*lsv1alpha1.DeployItem) ([]*executionItem, []*lsv1alpha1.DeployItem) {
-	execItems := make([]*executionItem, len(o.exec.Spec.DeployItems))
+func (o *Operation) getExecutionItems(deployItemTemplates lsv1alpha1.DeployItemTemplateList,
+	items []*lsv1alpha1.DeployItem) ([]*executionItem, []*lsv1alpha1.DeployItem) {
+	execItems := make([]*executionItem, len(deployItemTemplates))
 	managed := sets.NewInt()
-	for i, di := range o.exec.Spec.DeployItems {
+	for i, di := range deployItemTemplates {
 		execItem := executionItem{
 			Info: *di.DeepCopy(),
 		}
@@ -60,6 +60,12 @@ func (o *Operation) updateDeployItem(ctx context.Context, item executionItem) (*
 			metav1.SetMetaDataAnnotation(&item.DeployItem.ObjectMeta, clusterNameAnnotation, clusterName)
 		}
 
+		// the deploy item masks the sensitive values of the execution in its status and logs
+		delete(item.DeployItem.Annotations, lsv1alpha1.SensitiveValuesSecretAnnotation)
+		if o.exec.Spec.DeployItemsSecretRef != nil {
+			metav1.SetMetaDataAnnotation(&item.DeployItem.ObjectMeta, lsv1alpha1.SensitiveValuesSecretAnnotation, o.exec.Spec.DeployItemsSecretRef.Name)
+		}
+
 		lsv1alpha1helper.DeleteCacheHelmChartsAnnotation(&item.DeployItem.ObjectMeta)
 		if lsv1alpha1helper.HasCacheHelmChartsAnnotation(&o.exec.ObjectMeta) {
 			metav1.SetMetaDataAnnotation(&item.DeployItem.ObjectMeta, lsv1alpha1.CacheHelmChartsAnnotation, "true")
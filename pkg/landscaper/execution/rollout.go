@@ -0,0 +1,122 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package execution
+
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/utils/rollout"
+)
+
+// applyRollouts restricts the runnable items of the classification to the items of the allowed batches of the rollouts.
+// Items of batches that must wait for the pause after the previous batch are moved to the waiting items,
+// items of other batches that are not yet allowed to start are moved to the pending items.
+// Failed items are tolerated if they belong to rollouts which have not been stopped.
+// The returned status describes the progress of the rollouts.
+func (c *DeployItemClassification) applyRollouts(executionJobID string, rollouts []lsv1alpha1.Rollout,
+	items []*executionItem, now time.Time) []lsv1alpha1.RolloutStatus {
+
+	if len(rollouts) == 0 {
+		return nil
+	}
+
+	heldItems := sets.New[string]()
+	waitingItems := sets.New[string]()
+	toleratedFailedItems := sets.New[string]()
+	statuses := make([]lsv1alpha1.RolloutStatus, len(rollouts))
+
+	for i := range rollouts {
+		targets := make([]*rollout.Target, len(rollouts[i].Targets))
+		itemsByKey := map[string][]*executionItem{}
+		for j, t := range rollouts[i].Targets {
+			targets[j], itemsByKey[t.Key] = newRolloutTarget(executionJobID, t, items)
+		}
+
+		result := rollout.Evaluate(&rollouts[i], targets, now)
+		for key, targetItems := range itemsByKey {
+			for _, item := range targetItems {
+				if result.Held.Has(key) {
+					heldItems.Insert(item.Info.Name)
+				}
+				if result.Waiting.Has(key) {
+					waitingItems.Insert(item.Info.Name)
+				}
+				if !result.Status.Stopped {
+					toleratedFailedItems.Insert(item.Info.Name)
+				}
+			}
+		}
+
+		statuses[i] = result.Status
+	}
+
+	runnableItems := []*executionItem{}
+	for _, item := range c.runnableItems {
+		if !heldItems.Has(item.Info.Name) {
+			runnableItems = append(runnableItems, item)
+		} else if waitingItems.Has(item.Info.Name) {
+			c.waitingItems = append(c.waitingItems, item)
+		} else {
+			c.pendingItems = append(c.pendingItems, item)
+		}
+	}
+	c.runnableItems = runnableItems
+
+	pendingItems := []*executionItem{}
+	for _, item := range c.pendingItems {
+		if waitingItems.Has(item.Info.Name) {
+			c.waitingItems = append(c.waitingItems, item)
+		} else {
+			pendingItems = append(pendingItems, item)
+		}
+	}
+	c.pendingItems = pendingItems
+
+	c.failuresTolerated = true
+	for _, item := range c.failedItems {
+		if !toleratedFailedItems.Has(item.Info.Name) {
+			c.failuresTolerated = false
+			break
+		}
+	}
+
+	return statuses
+}
+
+// newRolloutTarget determines the state of a target from the deploy items of the target.
+// It returns the target together with the execution items of its deploy items.
+func newRolloutTarget(executionJobID string, t lsv1alpha1.RolloutTarget, items []*executionItem) (*rollout.Target, []*executionItem) {
+	targetItems := []*executionItem{}
+	objects := []rollout.ObjectState{}
+	for _, name := range t.DeployItems {
+		item := getItemByName(name, items)
+		if item == nil {
+			continue
+		}
+		targetItems = append(targetItems, item)
+
+		di := item.DeployItem
+		if di == nil {
+			// missing items are treated as failed, see newDeployItemClassification
+			objects = append(objects, rollout.ObjectState{Started: true, Finished: true})
+			continue
+		}
+
+		obj := rollout.ObjectState{
+			Started:   di.Status.GetJobID() == executionJobID,
+			Finished:  di.Status.JobIDFinished == executionJobID,
+			Succeeded: di.Status.Phase == lsv1alpha1.DeployItemPhases.Succeeded,
+		}
+		if di.Status.TransitionTimes != nil && di.Status.TransitionTimes.FinishedTime != nil {
+			obj.FinishedTime = di.Status.TransitionTimes.FinishedTime.Time
+		}
+		objects = append(objects, obj)
+	}
+
+	return rollout.NewTarget(t.Key, objects), targetItems
+}
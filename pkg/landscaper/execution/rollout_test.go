@@ -0,0 +1,162 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package execution
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+)
+
+var _ = Describe("Rollouts", func() {
+
+	const (
+		currJobID = "02"
+		prevJobID = "01"
+	)
+
+	now := time.Date(2024, time.May, 1, 8, 0, 0, 0, time.UTC)
+
+	buildExecutionItem := func(name string, jobID, jobIDFinished string, phase lsv1alpha1.DeployItemPhase, finished time.Time) *executionItem {
+		item := &executionItem{
+			Info: lsv1alpha1.DeployItemTemplate{
+				Name: name,
+			},
+			DeployItem: &lsv1alpha1.DeployItem{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: ""},
+				Status: lsv1alpha1.DeployItemStatus{
+					JobID:         jobID,
+					JobIDFinished: jobIDFinished,
+					Phase:         phase,
+				},
+			},
+		}
+		if !finished.IsZero() {
+			item.DeployItem.Status.TransitionTimes = &lsv1alpha1.TransitionTimes{
+				FinishedTime: &metav1.Time{Time: finished},
+			}
+		}
+		return item
+	}
+
+	notStarted := func(name string) *executionItem {
+		return buildExecutionItem(name, prevJobID, prevJobID, lsv1alpha1.DeployItemPhases.Succeeded, time.Time{})
+	}
+
+	succeeded := func(name string, finished time.Time) *executionItem {
+		return buildExecutionItem(name, currJobID, currJobID, lsv1alpha1.DeployItemPhases.Succeeded, finished)
+	}
+
+	failed := func(name string, finished time.Time) *executionItem {
+		return buildExecutionItem(name, currJobID, currJobID, lsv1alpha1.DeployItemPhases.Failed, finished)
+	}
+
+	buildRollout := func(keys ...string) lsv1alpha1.Rollout {
+		rollout := lsv1alpha1.Rollout{Name: "rollout"}
+		for _, key := range keys {
+			rollout.Targets = append(rollout.Targets, lsv1alpha1.RolloutTarget{Key: key, DeployItems: []string{key}})
+		}
+		return rollout
+	}
+
+	classify := func(rollout lsv1alpha1.Rollout, items []*executionItem) (*DeployItemClassification, []lsv1alpha1.RolloutStatus) {
+		classification, err := newDeployItemClassification(currJobID, items)
+		Expect(err).NotTo(HaveOccurred())
+		return classification, classification.applyRollouts(currJobID, []lsv1alpha1.Rollout{rollout}, items, now)
+	}
+
+	It("should start only the canary first", func() {
+		rollout := buildRollout("a", "b", "c")
+		rollout.Canary = "b"
+		items := []*executionItem{notStarted("a"), notStarted("b"), notStarted("c")}
+
+		classification, statuses := classify(rollout, items)
+		Expect(classification.runnableItems).To(ConsistOf(items[1]))
+		Expect(classification.pendingItems).To(ConsistOf(items[0], items[2]))
+		Expect(classification.HasWaitingItems()).To(BeFalse())
+		Expect(statuses).To(HaveLen(1))
+		Expect(statuses[0].Batches).To(Equal(2))
+		Expect(statuses[0].CompletedBatches).To(Equal(0))
+	})
+
+	It("should start the next batch after the previous batch has finished", func() {
+		rollout := buildRollout("a", "b", "c", "d")
+		rollout.BatchSize = ptr.To(intstr.FromString("50%"))
+		items := []*executionItem{succeeded("a", now), succeeded("b", now), notStarted("c"), notStarted("d")}
+
+		classification, statuses := classify(rollout, items)
+		Expect(classification.runnableItems).To(ConsistOf(items[2], items[3]))
+		Expect(classification.pendingItems).To(BeEmpty())
+		Expect(statuses[0].Batches).To(Equal(2))
+		Expect(statuses[0].CompletedBatches).To(Equal(1))
+	})
+
+	It("should wait for the pause between batches", func() {
+		rollout := buildRollout("a", "b")
+		rollout.BatchSize = ptr.To(intstr.FromInt32(1))
+		rollout.PauseBetweenBatches = &lsv1alpha1.Duration{Duration: 10 * time.Minute}
+		items := []*executionItem{succeeded("a", now.Add(-5*time.Minute)), notStarted("b")}
+
+		classification, statuses := classify(rollout, items)
+		Expect(classification.runnableItems).To(BeEmpty())
+		Expect(classification.waitingItems).To(ConsistOf(items[1]))
+		Expect(classification.AllSucceeded()).To(BeFalse())
+		Expect(statuses[0].NextBatchTime).NotTo(BeNil())
+		Expect(statuses[0].NextBatchTime.Time).To(Equal(now.Add(5 * time.Minute)))
+
+		classification, statuses = classify(rollout, []*executionItem{succeeded("a", now.Add(-15*time.Minute)), notStarted("b")})
+		Expect(classification.runnableItems).To(HaveLen(1))
+		Expect(classification.HasWaitingItems()).To(BeFalse())
+		Expect(statuses[0].NextBatchTime).To(BeNil())
+	})
+
+	It("should stop the rollout if the canary fails", func() {
+		rollout := buildRollout("a", "b")
+		rollout.Canary = "a"
+		rollout.MaxFailedTargets = ptr.To(1)
+		items := []*executionItem{failed("a", now), notStarted("b")}
+
+		classification, statuses := classify(rollout, items)
+		Expect(classification.runnableItems).To(BeEmpty())
+		Expect(classification.pendingItems).To(ConsistOf(items[1]))
+		Expect(classification.ContinuesDespiteFailures()).To(BeFalse())
+		Expect(statuses[0].Stopped).To(BeTrue())
+		Expect(statuses[0].FailedTargets).To(ConsistOf("a"))
+	})
+
+	It("should tolerate failed targets up to the maximum", func() {
+		rollout := buildRollout("a", "b", "c", "d")
+		rollout.BatchSize = ptr.To(intstr.FromInt32(1))
+		rollout.MaxFailedTargets = ptr.To(1)
+
+		classification, statuses := classify(rollout, []*executionItem{failed("a", now), notStarted("b"), notStarted("c"), notStarted("d")})
+		Expect(classification.runnableItems).To(HaveLen(1))
+		Expect(classification.runnableItems[0].Info.Name).To(Equal("b"))
+		Expect(classification.ContinuesDespiteFailures()).To(BeTrue())
+		Expect(statuses[0].Stopped).To(BeFalse())
+
+		classification, statuses = classify(rollout, []*executionItem{failed("a", now), failed("b", now), notStarted("c"), notStarted("d")})
+		Expect(classification.runnableItems).To(BeEmpty())
+		Expect(classification.ContinuesDespiteFailures()).To(BeFalse())
+		Expect(statuses[0].Stopped).To(BeTrue())
+		Expect(statuses[0].FailedTargets).To(ConsistOf("a", "b"))
+	})
+
+	It("should not restrict items which do not belong to a rollout", func() {
+		rollout := buildRollout("a", "b")
+		rollout.BatchSize = ptr.To(intstr.FromInt32(1))
+		items := []*executionItem{notStarted("a"), notStarted("b"), notStarted("other")}
+
+		classification, _ := classify(rollout, items)
+		Expect(classification.runnableItems).To(ConsistOf(items[0], items[2]))
+		Expect(classification.pendingItems).To(ConsistOf(items[1]))
+	})
+})
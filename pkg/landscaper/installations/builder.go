@@ -17,6 +17,7 @@ import (
 
 	"github.com/gardener/landscaper/pkg/components/model"
 	lsoperation "github.com/gardener/landscaper/pkg/landscaper/operation"
+	"github.com/gardener/landscaper/pkg/utils/tracing"
 )
 
 // OperationBuilder is a builder helper struct for building an installation operation.
@@ -144,7 +145,10 @@ func (b *OperationBuilder) Build(ctx context.Context) (*Operation, error) {
 			return instOp, nil
 		}
 
+		_, span := tracing.StartSpan(ctx, "GetComponentVersion",
+			tracing.KeyComponentName.String(cdRef.ComponentName), tracing.KeyComponentVersion.String(cdRef.Version))
 		componentVersion, err := registryAccess.GetComponentVersion(ctx, cdRef)
+		tracing.EndSpan(ctx, span, err)
 		if err != nil {
 			return nil, err
 		}
@@ -19,6 +19,7 @@ const (
 	InvalidDefaultValue    ErrorReason = "InvalidDefaultValue"
 	NotCompletedDependents ErrorReason = "NotCompletedDependents"
 	SchemaValidationFailed ErrorReason = "SchemaValidationFailed"
+	SecretGenerationFailed ErrorReason = "SecretGenerationFailed"
 )
 
 // NewErrorf creates a new import error with a formated message
@@ -18,11 +18,15 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/json"
 	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/gardener/landscaper/apis/core"
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
 	"github.com/gardener/landscaper/pkg/api"
 	"github.com/gardener/landscaper/pkg/components/registries"
 	"github.com/gardener/landscaper/pkg/landscaper/installations"
@@ -30,6 +34,7 @@ import (
 	"github.com/gardener/landscaper/pkg/landscaper/installations/imports"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/reconcilehelper"
 	lsoperation "github.com/gardener/landscaper/pkg/landscaper/operation"
+	"github.com/gardener/landscaper/pkg/utils/redact"
 	"github.com/gardener/landscaper/test/utils/envtest"
 )
 
@@ -139,4 +144,57 @@ var _ = Describe("DeployItemExecutions", func() {
 		Expect(err.Error()).To(Equal("invalid deployitem specification \"myDi\": target import \"targetListImp\" not found"))
 	})
 
+	Context("sensitive imports", func() {
+
+		setSensitiveImport := func(inst *installations.InstallationImportsAndBlueprint) {
+			imps := inst.GetImports()
+			imps["password"] = "my-secret-password"
+			inst.SetImports(imps)
+			inst.SetSensitiveImports("password")
+		}
+
+		It("should store the deploy items and the sensitive values in a secret", func() {
+			ctx, inst := Load("test2/root")
+			setSensitiveImport(inst)
+			Expect(executions.New(op).Ensure(ctx, inst)).To(Succeed())
+
+			exec := &lsv1alpha1.Execution{}
+			Expect(fakeClient.Get(ctx, kutil.ObjectKey("root", "test2"), exec)).To(Succeed())
+			Expect(exec.Spec.DeployItems).To(BeEmpty())
+			Expect(exec.Spec.DeployItemsSecretRef).ToNot(BeNil())
+			Expect(exec.Spec.DeployItemsSecretRef.Name).To(Equal(executions.DeployItemsSecretName(exec)))
+
+			secret := &corev1.Secret{}
+			Expect(fakeClient.Get(ctx, exec.Spec.DeployItemsSecretRef.NamespacedName(), secret)).To(Succeed())
+			deployItems := lsv1alpha1.DeployItemTemplateList{}
+			Expect(json.Unmarshal(secret.Data[lsv1alpha1.ExecutionDeployItemsSecretDataKey], &deployItems)).To(Succeed())
+			Expect(deployItems).To(HaveLen(3))
+			sensitiveValues := []string{}
+			Expect(json.Unmarshal(secret.Data[lsv1alpha1.SensitiveValuesSecretDataKey], &sensitiveValues)).To(Succeed())
+			Expect(sensitiveValues).To(ContainElement("my-secret-password"))
+
+			redactCtx, err := redact.NewContextFromSecret(ctx, fakeClient, exec.Spec.DeployItemsSecretRef.NamespacedName())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(redact.FromContext(redactCtx).Redact("password: my-secret-password")).To(Equal("password: " + redact.Mask))
+		})
+
+		It("should store the deploy items in the execution and remove the secret if there are no sensitive imports anymore", func() {
+			ctx, inst := Load("test2/root")
+			setSensitiveImport(inst)
+			Expect(executions.New(op).Ensure(ctx, inst)).To(Succeed())
+
+			inst.SetSensitiveImports()
+			Expect(executions.New(op).Ensure(ctx, inst)).To(Succeed())
+
+			exec := &lsv1alpha1.Execution{}
+			Expect(fakeClient.Get(ctx, kutil.ObjectKey("root", "test2"), exec)).To(Succeed())
+			Expect(exec.Spec.DeployItems).To(HaveLen(3))
+			Expect(exec.Spec.DeployItemsSecretRef).To(BeNil())
+
+			secret := &corev1.Secret{}
+			err := fakeClient.Get(ctx, kutil.ObjectKey(executions.DeployItemsSecretName(exec), "test2"), secret)
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		})
+	})
+
 })
@@ -6,10 +6,13 @@ package executions
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"sort"
 
 	lserrors "github.com/gardener/landscaper/apis/errors"
 
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -22,12 +25,16 @@ import (
 	"github.com/gardener/landscaper/apis/core/validation"
 	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
 	genericresolver "github.com/gardener/landscaper/controller-utils/pkg/landscaper/targetresolver/generic"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
 	"github.com/gardener/landscaper/pkg/api"
 	"github.com/gardener/landscaper/pkg/landscaper/installations"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/gotemplate"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/spiff"
 	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+	"github.com/gardener/landscaper/pkg/utils/redact"
+	"github.com/gardener/landscaper/pkg/utils/tracing"
 )
 
 const (
@@ -57,6 +64,14 @@ func New(op *installations.Operation) *ExecutionOperation {
 
 func (o *ExecutionOperation) RenderDeployItemTemplates(ctx context.Context,
 	inst *installations.InstallationImportsAndBlueprint) (core.DeployItemTemplateList, error) {
+	execTemplates, _, err := o.renderDeployItemTemplatesAndRollouts(ctx, inst)
+	return execTemplates, err
+}
+
+// renderDeployItemTemplatesAndRollouts templates the deploy executions of the blueprint
+// and returns the deploy item templates and the rollouts of the execution.
+func (o *ExecutionOperation) renderDeployItemTemplatesAndRollouts(ctx context.Context,
+	inst *installations.InstallationImportsAndBlueprint) (core.DeployItemTemplateList, []core.Rollout, error) {
 
 	op := "RenderDeployItemTemplates"
 
@@ -67,8 +82,11 @@ func (o *ExecutionOperation) RenderDeployItemTemplates(ctx context.Context,
 		Inst:       inst.GetInstallation(),
 	}
 	targetResolver := genericresolver.New(o.LsUncachedClient())
-	tmpl := template.New(gotemplate.New(templateStateHandler, targetResolver), spiff.New(templateStateHandler, targetResolver))
-	executions, err := tmpl.TemplateDeployExecutions(
+	tmpl := template.New(
+		gotemplate.New(templateStateHandler, targetResolver).WithContext(ctx),
+		spiff.New(templateStateHandler, targetResolver).WithContext(ctx))
+	_, span := tracing.StartSpan(ctx, "TemplateDeployExecutions")
+	output, err := tmpl.TemplateDeployExecutionsWithRollouts(
 		template.NewDeployExecutionOptions(
 			template.NewBlueprintExecutionOptions(
 				o.Context().External.InjectComponentDescriptorRef(inst.GetInstallation()),
@@ -76,15 +94,17 @@ func (o *ExecutionOperation) RenderDeployItemTemplates(ctx context.Context,
 				o.ComponentVersion,
 				o.ResolvedComponentDescriptorList,
 				inst.GetImports())))
+	tracing.EndSpan(ctx, span, err)
 
 	if err != nil {
 		inst.MergeConditions(lsv1alpha1helper.UpdatedCondition(cond, lsv1alpha1.ConditionFalse,
 			TemplatingFailedReason, "Unable to template executions"))
-		return nil, lserrors.NewWrappedError(err, op, "Template", "unable to template executions", lsv1alpha1.ErrorForInfoOnly)
+		return nil, nil, lserrors.NewWrappedError(err, op, "Template", o.templateErrorMessage(ctx, inst.GetInstallation(), err), lsv1alpha1.ErrorForInfoOnly)
 	}
 
+	executions := output.DeployItems
 	if len(executions) == 0 {
-		return nil, nil
+		return nil, nil, nil
 	}
 
 	// map deployitem specifications into templates for executions
@@ -101,10 +121,10 @@ func (o *ExecutionOperation) RenderDeployItemTemplates(ctx context.Context,
 				// targetlist import reference
 				ti := o.GetTargetListImport(elem.Target.Import)
 				if ti == nil {
-					return nil, o.deployItemSpecificationError(cond, elem.Name, "targetlist import %q not found", elem.Target.Import)
+					return nil, nil, o.deployItemSpecificationError(cond, elem.Name, "targetlist import %q not found", elem.Target.Import)
 				}
 				if *elem.Target.Index < 0 || *elem.Target.Index >= len(ti.GetTargetExtensions()) {
-					return nil, o.deployItemSpecificationError(cond, elem.Name, "index %d out of bounds", *elem.Target.Index)
+					return nil, nil, o.deployItemSpecificationError(cond, elem.Name, "index %d out of bounds", *elem.Target.Index)
 				}
 				rawTarget := ti.GetTargetExtensions()[*elem.Target.Index].GetTarget()
 				target.Name = rawTarget.Name
@@ -113,11 +133,11 @@ func (o *ExecutionOperation) RenderDeployItemTemplates(ctx context.Context,
 				// targetmap import
 				ti := o.GetTargetMapImport(elem.Target.Import)
 				if ti == nil {
-					return nil, o.deployItemSpecificationError(cond, elem.Name, "targetmap import %q not found", elem.Target.Import)
+					return nil, nil, o.deployItemSpecificationError(cond, elem.Name, "targetmap import %q not found", elem.Target.Import)
 				}
 				targetExt, ok := ti.GetTargetExtensions()[*elem.Target.Key]
 				if !ok || targetExt == nil {
-					return nil, o.deployItemSpecificationError(cond, elem.Name, "key %q not found in targetmap import %q", *elem.Target.Key, elem.Target.Import)
+					return nil, nil, o.deployItemSpecificationError(cond, elem.Name, "key %q not found in targetmap import %q", *elem.Target.Key, elem.Target.Import)
 				}
 				rawTarget := targetExt.GetTarget()
 				target.Name = rawTarget.Name
@@ -126,13 +146,13 @@ func (o *ExecutionOperation) RenderDeployItemTemplates(ctx context.Context,
 				// single target import reference
 				t := o.GetTargetImport(elem.Target.Import)
 				if t == nil {
-					return nil, o.deployItemSpecificationError(cond, elem.Name, "target import %q not found", elem.Target.Import)
+					return nil, nil, o.deployItemSpecificationError(cond, elem.Name, "target import %q not found", elem.Target.Import)
 				}
 				rawTarget := t.GetTarget()
 				target.Name = rawTarget.Name
 				target.Namespace = rawTarget.Namespace
 			} else if len(elem.Target.Name) == 0 {
-				return nil, o.deployItemSpecificationError(cond, elem.Name, "empty target reference")
+				return nil, nil, o.deployItemSpecificationError(cond, elem.Name, "empty target reference")
 			}
 		}
 
@@ -152,6 +172,7 @@ func (o *ExecutionOperation) RenderDeployItemTemplates(ctx context.Context,
 			Timeout:            timeout,
 			UpdateOnChangeOnly: elem.UpdateOnChangeOnly,
 			OnDelete:           elem.OnDelete,
+			RequireApproval:    elem.RequireApproval || inst.GetInstallation().Spec.RequireApproval,
 		}
 	}
 
@@ -159,14 +180,113 @@ func (o *ExecutionOperation) RenderDeployItemTemplates(ctx context.Context,
 		err2 := fmt.Errorf("error validating deployitem templates: %w", err)
 		inst.MergeConditions(lsv1alpha1helper.UpdatedCondition(cond, lsv1alpha1.ConditionFalse,
 			TemplatingFailedReason, err2.Error()))
-		return nil, err2
+		return nil, nil, err2
+	}
+
+	rollouts, err := o.convertRollouts(cond, output.Rollouts, executions)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := validation.ValidateRollouts(field.NewPath("rollouts"), rollouts, execTemplates).ToAggregate(); err != nil {
+		err2 := fmt.Errorf("error validating rollouts: %w", err)
+		inst.MergeConditions(lsv1alpha1helper.UpdatedCondition(cond, lsv1alpha1.ConditionFalse,
+			TemplatingFailedReason, err2.Error()))
+		return nil, nil, err2
 	}
 
-	return execTemplates, nil
+	return execTemplates, rollouts, nil
+}
+
+// convertRollouts maps rollout specifications into rollouts of the execution.
+// The targets of a rollout are the keys of the target map import that are referenced by deploy items.
+// They are rolled out in the alphabetical order of their keys.
+func (o *ExecutionOperation) convertRollouts(cond lsv1alpha1.Condition, specs []template.RolloutSpecification,
+	executions []template.DeployItemSpecification) ([]core.Rollout, error) {
+
+	if len(specs) == 0 {
+		return nil, nil
+	}
+
+	rollouts := make([]core.Rollout, len(specs))
+	for i, spec := range specs {
+		name := spec.Name
+		if len(name) == 0 {
+			name = spec.TargetMapImport
+		}
+
+		if o.GetTargetMapImport(spec.TargetMapImport) == nil {
+			return nil, o.rolloutSpecificationError(cond, name, "targetmap import %q not found", spec.TargetMapImport)
+		}
+
+		deployItemsByKey := map[string][]string{}
+		for _, elem := range executions {
+			if elem.Target != nil && elem.Target.Import == spec.TargetMapImport && elem.Target.Key != nil {
+				deployItemsByKey[*elem.Target.Key] = append(deployItemsByKey[*elem.Target.Key], elem.Name)
+			}
+		}
+
+		keys := make([]string, 0, len(deployItemsByKey))
+		for key := range deployItemsByKey {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		targets := make([]core.RolloutTarget, len(keys))
+		for j, key := range keys {
+			targets[j] = core.RolloutTarget{
+				Key:         key,
+				DeployItems: deployItemsByKey[key],
+			}
+		}
+
+		var pause *core.Duration
+		if spec.PauseBetweenBatches != nil {
+			pause = &core.Duration{Duration: spec.PauseBetweenBatches.Duration}
+		}
+
+		rollouts[i] = core.Rollout{
+			Name:                name,
+			Targets:             targets,
+			Canary:              spec.Canary,
+			BatchSize:           spec.BatchSize,
+			PauseBetweenBatches: pause,
+			MaxFailedTargets:    spec.MaxFailedTargets,
+		}
+	}
+
+	return rollouts, nil
+}
+
+// templateErrorMessage returns the message of a templating error that is stored in the last error of the installation.
+// If the error contains debug information, the message contains the location of the error in the blueprint
+// and the redacted template input. The debug information is additionally written to a secret
+// if the installation has the template debug annotation.
+func (o *ExecutionOperation) templateErrorMessage(ctx context.Context, inst *lsv1alpha1.Installation, err error) string {
+	const message = "unable to template executions"
+
+	info, ok := template.GetTemplateDebugInfo(err)
+	if !ok {
+		return message
+	}
+
+	// sensitive values must neither be part of the last error nor of the debug secret
+	redactor := redact.FromContext(ctx)
+	info.Message = redactor.Redact(info.Message)
+	info.Error = redactor.Redact(info.Error)
+
+	if lsv1alpha1helper.HasTemplateDebugAnnotation(inst.ObjectMeta) {
+		if err := template.WriteTemplateDebugSecret(ctx, o.LsUncachedClient(), inst, info); err != nil {
+			logger, _ := logging.FromContextOrNew(ctx, nil)
+			logger.Error(err, "unable to write template debug secret", lc.KeyResource, kutil.ObjectKey(template.TemplateDebugSecretName(inst), inst.Namespace).String())
+		}
+	}
+
+	return fmt.Sprintf("%s: %s", message, info.Summary())
 }
 
 func (o *ExecutionOperation) Ensure(ctx context.Context, inst *installations.InstallationImportsAndBlueprint) error {
-	execTemplates, err := o.RenderDeployItemTemplates(ctx, inst)
+	execTemplates, rollouts, err := o.renderDeployItemTemplatesAndRollouts(ctx, inst)
 	if execTemplates == nil || err != nil {
 		return err
 	}
@@ -185,9 +305,35 @@ func (o *ExecutionOperation) Ensure(ctx context.Context, inst *installations.Ins
 		return err2
 	}
 
+	versionedRollouts := make([]lsv1alpha1.Rollout, len(rollouts))
+	for i := range rollouts {
+		if err := lsv1alpha1.Convert_core_Rollout_To_v1alpha1_Rollout(&rollouts[i], &versionedRollouts[i], nil); err != nil {
+			err2 := fmt.Errorf("error converting internal representation of rollouts to versioned one: %w", err)
+			inst.MergeConditions(lsv1alpha1helper.UpdatedCondition(cond, lsv1alpha1.ConditionFalse,
+				TemplatingFailedReason, err2.Error()))
+			return err2
+		}
+	}
+
+	// deploy items that may contain sensitive values are not stored in clear text in the execution
+	deployItemsSecretRef, err := o.ensureDeployItemsSecret(ctx, inst, exec, versionedDeployItemTemplateList)
+	if err != nil {
+		inst.MergeConditions(lsv1alpha1helper.UpdatedCondition(cond, lsv1alpha1.ConditionFalse,
+			CreateOrUpdateExecutionReason, "Unable to create or update deploy items secret"))
+		return err
+	}
+
 	if _, err := o.WriterToLsUncachedClient().CreateOrUpdateExecution(ctx, read_write_layer.W000022, exec, func() error {
 		exec.Spec.Context = inst.GetInstallation().Spec.Context
+		exec.Spec.DeployItemsSecretRef = deployItemsSecretRef
 		exec.Spec.DeployItems = versionedDeployItemTemplateList
+		exec.Spec.Rollouts = versionedRollouts
+		if len(versionedRollouts) == 0 {
+			exec.Spec.Rollouts = nil
+		}
+		if deployItemsSecretRef != nil {
+			exec.Spec.DeployItems = nil
+		}
 
 		if lsv1alpha1helper.HasOperation(inst.GetInstallation().ObjectMeta, lsv1alpha1.ForceReconcileOperation) {
 			metav1.SetMetaDataAnnotation(&exec.ObjectMeta, lsv1alpha1.OperationAnnotation, string(lsv1alpha1.ForceReconcileOperation))
@@ -229,6 +375,58 @@ func (o *ExecutionOperation) Ensure(ctx context.Context, inst *installations.Ins
 	return nil
 }
 
+// ensureDeployItemsSecret stores the deploy items and the sensitive values in a secret if the installation has sensitive imports.
+// Otherwise, a secret of a previous job is removed.
+// The reference to the secret is returned if the deploy items have been stored in it.
+func (o *ExecutionOperation) ensureDeployItemsSecret(ctx context.Context, inst *installations.InstallationImportsAndBlueprint,
+	exec *lsv1alpha1.Execution, deployItems lsv1alpha1.DeployItemTemplateList) (*lsv1alpha1.ObjectReference, error) {
+
+	secret := &corev1.Secret{}
+	secret.Name = DeployItemsSecretName(exec)
+	secret.Namespace = exec.Namespace
+
+	if !inst.HasSensitiveImports() {
+		if err := o.LsUncachedClient().Delete(ctx, secret); err != nil && !apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("unable to delete deploy items secret %s: %w", secret.Name, err)
+		}
+		return nil, nil
+	}
+
+	data, err := json.Marshal(deployItems)
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal deploy items: %w", err)
+	}
+
+	// the sensitive values are stored together with the deploy items so that the execution and the deploy items
+	// are able to mask them in their statuses and logs.
+	redactor := redact.New(redact.FromContext(ctx).Values()...)
+	redactor.AddValue(inst.GetSensitiveImports())
+	sensitiveValues, err := redact.EncodeValues(redactor.Values())
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal sensitive values: %w", err)
+	}
+
+	if _, err := kutil.CreateOrUpdate(ctx, o.LsUncachedClient(), secret, func() error {
+		secret.Data = map[string][]byte{
+			lsv1alpha1.ExecutionDeployItemsSecretDataKey: data,
+			lsv1alpha1.SensitiveValuesSecretDataKey:      sensitiveValues,
+		}
+		return controllerutil.SetControllerReference(inst.GetInstallation(), secret, api.LandscaperScheme)
+	}); err != nil {
+		return nil, fmt.Errorf("unable to create or update deploy items secret %s: %w", secret.Name, err)
+	}
+
+	return &lsv1alpha1.ObjectReference{
+		Name:      secret.Name,
+		Namespace: secret.Namespace,
+	}, nil
+}
+
+// DeployItemsSecretName returns the name of the secret that contains the deploy items of an execution.
+func DeployItemsSecretName(exec *lsv1alpha1.Execution) string {
+	return exec.Name + "-deployitems"
+}
+
 // GetExecutionForInstallation returns the execution of an installation.
 // The execution can be nil if no execution has been found.
 func GetExecutionForInstallation(ctx context.Context, kubeClient client.Client, inst *lsv1alpha1.Installation) (*lsv1alpha1.Execution, error) {
@@ -243,6 +441,13 @@ func GetExecutionForInstallation(ctx context.Context, kubeClient client.Client,
 	return exec, nil
 }
 
+func (o *ExecutionOperation) rolloutSpecificationError(cond lsv1alpha1.Condition, name, message string, args ...interface{}) error {
+	err := fmt.Errorf(fmt.Sprintf("invalid rollout specification %q: ", name)+message, args...)
+	o.Inst.MergeConditions(lsv1alpha1helper.UpdatedCondition(cond, lsv1alpha1.ConditionFalse,
+		TemplatingFailedReason, err.Error()))
+	return err
+}
+
 func (o *ExecutionOperation) deployItemSpecificationError(cond lsv1alpha1.Condition, name, message string, args ...interface{}) error {
 	err := fmt.Errorf(fmt.Sprintf("invalid deployitem specification %q: ", name)+message, args...)
 	o.Inst.MergeConditions(lsv1alpha1helper.UpdatedCondition(cond, lsv1alpha1.ConditionFalse,
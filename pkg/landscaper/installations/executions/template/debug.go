@@ -0,0 +1,143 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package template
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/pkg/api"
+)
+
+const (
+	// TemplateDebugSecretSuffix is the suffix of the name of the secret that contains the debug information
+	// of the last failed templating of an installation.
+	TemplateDebugSecretSuffix = "-template-debug"
+
+	// TemplateDebugExecutionTypeKey is the secret data key of the type of the failed execution.
+	TemplateDebugExecutionTypeKey = "executionType"
+	// TemplateDebugExecutionNameKey is the secret data key of the name of the failed execution.
+	TemplateDebugExecutionNameKey = "executionName"
+	// TemplateDebugFileKey is the secret data key of the blueprint file that contains the failed template.
+	TemplateDebugFileKey = "file"
+	// TemplateDebugLineKey is the secret data key of the line in the blueprint file at which the error occurred.
+	TemplateDebugLineKey = "line"
+	// TemplateDebugColumnKey is the secret data key of the column at which the error occurred.
+	TemplateDebugColumnKey = "column"
+	// TemplateDebugErrorKey is the secret data key of the complete error message.
+	TemplateDebugErrorKey = "error"
+	// TemplateDebugInputKey is the secret data key of the redacted template input.
+	TemplateDebugInputKey = "input"
+)
+
+// TemplateDebugInfo describes where and why the templating of an execution failed.
+type TemplateDebugInfo struct {
+	// ExecutionType is the type of the execution, e.g. "deploy execution".
+	ExecutionType string
+	// ExecutionName is the name of the execution as defined in the blueprint.
+	ExecutionName string
+	// File is the path of the blueprint file that contains the template.
+	// The file is empty if the template could not be located in the blueprint.
+	File string
+	// Line is the line in the file at which the error occurred. It is 0 if unknown.
+	Line int
+	// Column is the column in the line at which the error occurred. It is 0 if unknown.
+	Column int
+	// Message is the templating error without the template source and input.
+	Message string
+	// Error is the complete error message.
+	Error string
+	// Input is the formatted template input where sensitive values are removed.
+	Input string
+}
+
+// TemplateDebugInfoProvider is implemented by templating errors that provide debug information.
+type TemplateDebugInfoProvider interface {
+	TemplateDebugInfo() *TemplateDebugInfo
+}
+
+// GetTemplateDebugInfo returns the debug information of the first error in the chain that provides it.
+func GetTemplateDebugInfo(err error) (*TemplateDebugInfo, bool) {
+	var provider TemplateDebugInfoProvider
+	if !errors.As(err, &provider) {
+		return nil, false
+	}
+	info := provider.TemplateDebugInfo()
+	return info, info != nil
+}
+
+// Location returns the location of the error in the format "<file>:<line>:<column>".
+func (i *TemplateDebugInfo) Location() string {
+	file := i.File
+	if len(file) == 0 {
+		file = i.ExecutionName
+	}
+	if i.Line == 0 {
+		return file
+	}
+	if i.Column == 0 {
+		return fmt.Sprintf("%s:%d", file, i.Line)
+	}
+	return fmt.Sprintf("%s:%d:%d", file, i.Line, i.Column)
+}
+
+// Summary returns a description of the error with its location and the redacted input.
+// In contrast to the complete error message the template source is omitted.
+func (i *TemplateDebugInfo) Summary() string {
+	builder := strings.Builder{}
+	builder.WriteString(fmt.Sprintf("%s %q failed at %s: %s", i.ExecutionType, i.ExecutionName, i.Location(), i.Message))
+	if len(i.Input) != 0 {
+		builder.WriteString("\ntemplate input:\n")
+		builder.WriteString(i.Input)
+	}
+	return builder.String()
+}
+
+// TemplateDebugSecretName returns the name of the template debug secret of an installation.
+func TemplateDebugSecretName(inst *lsv1alpha1.Installation) string {
+	return inst.Name + TemplateDebugSecretSuffix
+}
+
+// WriteTemplateDebugSecret creates or updates the template debug secret of the installation.
+// The secret is owned by the installation so that it is garbage collected together with the installation.
+func WriteTemplateDebugSecret(ctx context.Context, kubeClient client.Client, inst *lsv1alpha1.Installation, info *TemplateDebugInfo) error {
+	data := map[string][]byte{
+		TemplateDebugExecutionTypeKey: []byte(info.ExecutionType),
+		TemplateDebugExecutionNameKey: []byte(info.ExecutionName),
+		TemplateDebugFileKey:          []byte(info.File),
+		TemplateDebugLineKey:          []byte(strconv.Itoa(info.Line)),
+		TemplateDebugColumnKey:        []byte(strconv.Itoa(info.Column)),
+		TemplateDebugErrorKey:         []byte(info.Error),
+		TemplateDebugInputKey:         []byte(info.Input),
+	}
+
+	secret := &corev1.Secret{}
+	if err := kubeClient.Get(ctx, kutil.ObjectKey(TemplateDebugSecretName(inst), inst.Namespace), secret); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+
+		secret.Name = TemplateDebugSecretName(inst)
+		secret.Namespace = inst.Namespace
+		secret.Data = data
+		if err := controllerutil.SetControllerReference(inst, secret, api.LandscaperScheme); err != nil {
+			return fmt.Errorf("unable to set controller reference: %w", err)
+		}
+		return kubeClient.Create(ctx, secret)
+	}
+
+	secret.Data = data
+	return kubeClient.Update(ctx, secret)
+}
@@ -0,0 +1,292 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package funcs contains the implementation of the template functions that are offered by the go template and
+// the spiff templating, so that both templating implementations share the same semantics.
+package funcs
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	jsonpatch "github.com/evanphx/json-patch/v5"
+	"github.com/jmespath/go-jmespath"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/util/jsonpath"
+)
+
+// Normalize converts a value into its generic json representation,
+// i.e. a structure of maps, slices, strings, float64, booleans and nil.
+func Normalize(value interface{}) (interface{}, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	var res interface{}
+	if err := json.Unmarshal(data, &res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// JSONPatch applies a json patch (RFC 6902) to a document.
+// The patch is a list of patch operations.
+func JSONPatch(patch, doc interface{}) (interface{}, error) {
+	patchData, err := json.Marshal(patch)
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal json patch: %w", err)
+	}
+	docData, err := json.Marshal(doc)
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal document: %w", err)
+	}
+	decodedPatch, err := jsonpatch.DecodePatch(patchData)
+	if err != nil {
+		return nil, fmt.Errorf("invalid json patch: %w", err)
+	}
+	res, err := decodedPatch.Apply(docData)
+	if err != nil {
+		return nil, fmt.Errorf("unable to apply json patch: %w", err)
+	}
+	return unmarshalJSON(res)
+}
+
+// JSONMergePatch applies a json merge patch (RFC 7386) to a document.
+func JSONMergePatch(patch, doc interface{}) (interface{}, error) {
+	patchData, err := json.Marshal(patch)
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal merge patch: %w", err)
+	}
+	docData, err := json.Marshal(doc)
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal document: %w", err)
+	}
+	res, err := jsonpatch.MergePatch(docData, patchData)
+	if err != nil {
+		return nil, fmt.Errorf("unable to apply merge patch: %w", err)
+	}
+	return unmarshalJSON(res)
+}
+
+// JSONPath evaluates a JSONPath expression in the syntax of kubectl, e.g. "{.spec.replicas}" or ".spec.replicas".
+// If the expression selects exactly one value, the value is returned, otherwise the list of all selected values.
+// Nil is returned if nothing is selected.
+func JSONPath(path string, data interface{}) (interface{}, error) {
+	if !strings.Contains(path, "{") {
+		path = "{" + path + "}"
+	}
+	jp := jsonpath.New("query").AllowMissingKeys(true)
+	if err := jp.Parse(path); err != nil {
+		return nil, fmt.Errorf("invalid jsonpath expression %q: %w", path, err)
+	}
+
+	normalized, err := Normalize(data)
+	if err != nil {
+		return nil, err
+	}
+	results, err := jp.FindResults(normalized)
+	if err != nil {
+		return nil, fmt.Errorf("unable to evaluate jsonpath expression %q: %w", path, err)
+	}
+
+	values := make([]interface{}, 0)
+	for _, result := range results {
+		for _, value := range result {
+			values = append(values, value.Interface())
+		}
+	}
+	switch len(values) {
+	case 0:
+		return nil, nil
+	case 1:
+		return values[0], nil
+	default:
+		return values, nil
+	}
+}
+
+// JMESPath evaluates a JMESPath expression.
+func JMESPath(expression string, data interface{}) (interface{}, error) {
+	normalized, err := Normalize(data)
+	if err != nil {
+		return nil, err
+	}
+	res, err := jmespath.Search(expression, normalized)
+	if err != nil {
+		return nil, fmt.Errorf("unable to evaluate jmespath expression %q: %w", expression, err)
+	}
+	return res, nil
+}
+
+// CIDRHost returns the ip address of the host with the given number within a network prefix.
+// Negative numbers count from the end of the network, e.g. -1 is the last address.
+func CIDRHost(prefix string, hostNum int64) (string, error) {
+	_, network, err := net.ParseCIDR(prefix)
+	if err != nil {
+		return "", fmt.Errorf("invalid cidr %q: %w", prefix, err)
+	}
+	ones, bits := network.Mask.Size()
+	hostBits := uint(bits - ones)
+
+	size := new(big.Int).Lsh(big.NewInt(1), hostBits)
+	num := big.NewInt(hostNum)
+	if hostNum < 0 {
+		num.Add(num, size)
+	}
+	if num.Sign() < 0 || num.Cmp(size) >= 0 {
+		return "", fmt.Errorf("host number %d is out of range of cidr %q", hostNum, prefix)
+	}
+
+	ip := ipFromInt(new(big.Int).Or(ipToInt(network.IP), num), len(network.IP))
+	return ip.String(), nil
+}
+
+// CIDRSubnet calculates the subnet with the given number whose prefix is extended by the given number of bits.
+func CIDRSubnet(prefix string, newBits int, netNum int64) (string, error) {
+	_, network, err := net.ParseCIDR(prefix)
+	if err != nil {
+		return "", fmt.Errorf("invalid cidr %q: %w", prefix, err)
+	}
+	ones, bits := network.Mask.Size()
+	newOnes := ones + newBits
+	if newBits < 0 || newOnes > bits {
+		return "", fmt.Errorf("unable to extend the prefix of cidr %q by %d bits", prefix, newBits)
+	}
+
+	size := new(big.Int).Lsh(big.NewInt(1), uint(newBits))
+	num := big.NewInt(netNum)
+	if netNum < 0 || num.Cmp(size) >= 0 {
+		return "", fmt.Errorf("network number %d is out of range for %d additional bits", netNum, newBits)
+	}
+
+	num.Lsh(num, uint(bits-newOnes))
+	ip := ipFromInt(new(big.Int).Or(ipToInt(network.IP), num), len(network.IP))
+	subnet := net.IPNet{IP: ip, Mask: net.CIDRMask(newOnes, bits)}
+	return subnet.String(), nil
+}
+
+// CIDRNetmask returns the netmask of an IPv4 network prefix in dotted decimal notation.
+func CIDRNetmask(prefix string) (string, error) {
+	_, network, err := net.ParseCIDR(prefix)
+	if err != nil {
+		return "", fmt.Errorf("invalid cidr %q: %w", prefix, err)
+	}
+	if len(network.IP) != net.IPv4len {
+		return "", fmt.Errorf("only IPv4 networks have a netmask, but got %q", prefix)
+	}
+	return net.IP(network.Mask).String(), nil
+}
+
+// CIDRContains checks whether an ip address is contained in a network prefix.
+func CIDRContains(prefix, ip string) (bool, error) {
+	_, network, err := net.ParseCIDR(prefix)
+	if err != nil {
+		return false, fmt.Errorf("invalid cidr %q: %w", prefix, err)
+	}
+	parsedIP := net.ParseIP(ip)
+	if parsedIP == nil {
+		return false, fmt.Errorf("invalid ip address %q", ip)
+	}
+	return network.Contains(parsedIP), nil
+}
+
+func ipToInt(ip net.IP) *big.Int {
+	return new(big.Int).SetBytes(ip)
+}
+
+func ipFromInt(value *big.Int, length int) net.IP {
+	data := value.Bytes()
+	ip := make(net.IP, length)
+	copy(ip[length-len(data):], data)
+	return ip
+}
+
+// CompareSemver compares two semantic versions.
+// The result is -1 if the first version is lower, 0 if both are equal, and 1 if the first version is higher.
+func CompareSemver(version1, version2 string) (int, error) {
+	v1, err := semver.NewVersion(version1)
+	if err != nil {
+		return 0, fmt.Errorf("invalid version %q: %w", version1, err)
+	}
+	v2, err := semver.NewVersion(version2)
+	if err != nil {
+		return 0, fmt.Errorf("invalid version %q: %w", version2, err)
+	}
+	return v1.Compare(v2), nil
+}
+
+// MatchSemver checks whether a semantic version satisfies a constraint, e.g. ">= 1.2.0, < 2".
+func MatchSemver(constraint, version string) (bool, error) {
+	c, err := semver.NewConstraint(constraint)
+	if err != nil {
+		return false, fmt.Errorf("invalid version constraint %q: %w", constraint, err)
+	}
+	v, err := semver.NewVersion(version)
+	if err != nil {
+		return false, fmt.Errorf("invalid version %q: %w", version, err)
+	}
+	return c.Check(v), nil
+}
+
+// ParseKubeconfig parses a kubeconfig, which is either given as plain yaml or base64 encoded,
+// and returns the connection data of its current context.
+// The result contains the decoded kubeconfig and the fields currentContext, server, namespace,
+// certificateAuthorityData, insecureSkipTlsVerify, token, clientCertificateData, clientKeyData, username and password.
+// Certificates and keys are returned in PEM format.
+func ParseKubeconfig(kubeconfig string) (map[string]interface{}, error) {
+	data := []byte(kubeconfig)
+	if decoded, err := base64.StdEncoding.DecodeString(strings.TrimSpace(kubeconfig)); err == nil {
+		data = decoded
+	}
+
+	config, err := clientcmd.Load(data)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse kubeconfig: %w", err)
+	}
+
+	contextName := config.CurrentContext
+	kubeContext, ok := config.Contexts[contextName]
+	if !ok {
+		return nil, fmt.Errorf("current context %q of kubeconfig not found", contextName)
+	}
+	cluster, ok := config.Clusters[kubeContext.Cluster]
+	if !ok {
+		return nil, fmt.Errorf("cluster %q of kubeconfig not found", kubeContext.Cluster)
+	}
+
+	res := map[string]interface{}{
+		"kubeconfig":               string(data),
+		"currentContext":           contextName,
+		"server":                   cluster.Server,
+		"namespace":                kubeContext.Namespace,
+		"certificateAuthorityData": string(cluster.CertificateAuthorityData),
+		"insecureSkipTlsVerify":    cluster.InsecureSkipTLSVerify,
+		"token":                    "",
+		"clientCertificateData":    "",
+		"clientKeyData":            "",
+		"username":                 "",
+		"password":                 "",
+	}
+	if authInfo, ok := config.AuthInfos[kubeContext.AuthInfo]; ok {
+		res["token"] = authInfo.Token
+		res["clientCertificateData"] = string(authInfo.ClientCertificateData)
+		res["clientKeyData"] = string(authInfo.ClientKeyData)
+		res["username"] = authInfo.Username
+		res["password"] = authInfo.Password
+	}
+	return res, nil
+}
+
+func unmarshalJSON(data []byte) (interface{}, error) {
+	var res interface{}
+	if err := json.Unmarshal(data, &res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -0,0 +1,182 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package funcs_test
+
+import (
+	"encoding/base64"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/funcs"
+)
+
+const testKubeconfig = `apiVersion: v1
+kind: Config
+current-context: test
+contexts:
+- name: test
+  context:
+    cluster: test
+    user: test
+    namespace: my-namespace
+clusters:
+- name: test
+  cluster:
+    server: https://api.example.com
+users:
+- name: test
+  user:
+    token: my-token
+`
+
+var _ = Describe("Functions", func() {
+
+	doc := map[string]interface{}{
+		"spec": map[string]interface{}{
+			"replicas": 1,
+			"containers": []interface{}{
+				map[string]interface{}{"name": "a", "image": "a:1"},
+				map[string]interface{}{"name": "b", "image": "b:1"},
+			},
+		},
+	}
+
+	Context("Patches", func() {
+		It("should apply a json patch", func() {
+			patch := []interface{}{
+				map[string]interface{}{"op": "replace", "path": "/spec/replicas", "value": 3},
+				map[string]interface{}{"op": "remove", "path": "/spec/containers/1"},
+			}
+			res, err := funcs.JSONPatch(patch, doc)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res).To(Equal(map[string]interface{}{
+				"spec": map[string]interface{}{
+					"replicas": float64(3),
+					"containers": []interface{}{
+						map[string]interface{}{"name": "a", "image": "a:1"},
+					},
+				},
+			}))
+		})
+
+		It("should fail if a json patch cannot be applied", func() {
+			patch := []interface{}{
+				map[string]interface{}{"op": "remove", "path": "/spec/missing"},
+			}
+			_, err := funcs.JSONPatch(patch, doc)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should apply a merge patch", func() {
+			patch := map[string]interface{}{
+				"spec": map[string]interface{}{"replicas": 2, "containers": nil},
+			}
+			res, err := funcs.JSONMergePatch(patch, doc)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res).To(Equal(map[string]interface{}{
+				"spec": map[string]interface{}{"replicas": float64(2)},
+			}))
+		})
+	})
+
+	Context("Queries", func() {
+		It("should evaluate jsonpath expressions", func() {
+			res, err := funcs.JSONPath(".spec.replicas", doc)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res).To(Equal(float64(1)))
+
+			res, err = funcs.JSONPath("{.spec.containers[*].name}", doc)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res).To(Equal([]interface{}{"a", "b"}))
+
+			res, err = funcs.JSONPath(".spec.missing", doc)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res).To(BeNil())
+
+			_, err = funcs.JSONPath("{.spec[", doc)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should evaluate jmespath expressions", func() {
+			res, err := funcs.JMESPath("spec.containers[?name=='b'].image | [0]", doc)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res).To(Equal("b:1"))
+
+			_, err = funcs.JMESPath("spec.[", doc)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("CIDR", func() {
+		It("should calculate host addresses", func() {
+			Expect(funcs.CIDRHost("10.0.0.0/16", 5)).To(Equal("10.0.0.5"))
+			Expect(funcs.CIDRHost("10.0.0.0/16", -2)).To(Equal("10.0.255.254"))
+			Expect(funcs.CIDRHost("fd00::/64", 17)).To(Equal("fd00::11"))
+
+			_, err := funcs.CIDRHost("10.0.0.0/24", 256)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should calculate subnets", func() {
+			Expect(funcs.CIDRSubnet("10.0.0.0/16", 8, 2)).To(Equal("10.0.2.0/24"))
+			Expect(funcs.CIDRSubnet("fd00::/56", 8, 255)).To(Equal("fd00:0:0:ff::/64"))
+
+			_, err := funcs.CIDRSubnet("10.0.0.0/16", 2, 4)
+			Expect(err).To(HaveOccurred())
+			_, err = funcs.CIDRSubnet("10.0.0.0/30", 3, 0)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should calculate netmasks and check containment", func() {
+			Expect(funcs.CIDRNetmask("10.0.0.0/12")).To(Equal("255.240.0.0"))
+			_, err := funcs.CIDRNetmask("fd00::/64")
+			Expect(err).To(HaveOccurred())
+
+			Expect(funcs.CIDRContains("10.0.0.0/16", "10.0.3.4")).To(BeTrue())
+			Expect(funcs.CIDRContains("10.0.0.0/16", "10.1.0.1")).To(BeFalse())
+			_, err = funcs.CIDRContains("10.0.0.0/16", "no-ip")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("Semver", func() {
+		It("should compare versions", func() {
+			Expect(funcs.CompareSemver("v1.2.3", "1.10.0")).To(Equal(-1))
+			Expect(funcs.CompareSemver("1.2.3", "v1.2.3")).To(Equal(0))
+			Expect(funcs.CompareSemver("2.0.0", "2.0.0-rc.1")).To(Equal(1))
+
+			_, err := funcs.CompareSemver("abc", "1.0.0")
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should match version constraints", func() {
+			Expect(funcs.MatchSemver(">= 1.2, < 2", "v1.5.0")).To(BeTrue())
+			Expect(funcs.MatchSemver("~1.4", "1.5.0")).To(BeFalse())
+
+			_, err := funcs.MatchSemver("~1.4 ||| x", "1.5.0")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("Kubeconfig", func() {
+		It("should parse plain and base64 encoded kubeconfigs", func() {
+			for _, kubeconfig := range []string{testKubeconfig, base64.StdEncoding.EncodeToString([]byte(testKubeconfig))} {
+				res, err := funcs.ParseKubeconfig(kubeconfig)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(res).To(HaveKeyWithValue("kubeconfig", testKubeconfig))
+				Expect(res).To(HaveKeyWithValue("currentContext", "test"))
+				Expect(res).To(HaveKeyWithValue("server", "https://api.example.com"))
+				Expect(res).To(HaveKeyWithValue("namespace", "my-namespace"))
+				Expect(res).To(HaveKeyWithValue("token", "my-token"))
+			}
+		})
+
+		It("should fail for an invalid kubeconfig", func() {
+			_, err := funcs.ParseKubeconfig("current-context: missing")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
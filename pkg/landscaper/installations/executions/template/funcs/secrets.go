@@ -0,0 +1,376 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package funcs
+
+import (
+	"context"
+	"crypto"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"time"
+
+	lstmpl "github.com/gardener/landscaper/pkg/landscaper/installations/executions/template"
+)
+
+const (
+	// SeedStateName is the name of the state entry that contains the seed from which passwords and keys are derived.
+	SeedStateName = "function-seed"
+	// CertificateStatePrefix is the prefix of the names of the state entries that contain generated certificates.
+	CertificateStatePrefix = "certificate-"
+
+	seedLength = 32
+
+	passwordCharacters = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+
+	defaultCertificateValidity = 365 * 24 * time.Hour
+	certificateKeySize         = 2048
+)
+
+// SecretGenerator generates passwords, keys and certificates that remain stable across reconciles.
+// Passwords and keys are derived from a random seed, and certificates are stored, in the template state.
+type SecretGenerator struct {
+	state lstmpl.GenericStateHandler
+	now   func() time.Time
+}
+
+// NewSecretGenerator creates a new secret generator that persists its data with the given state handler.
+func NewSecretGenerator(state lstmpl.GenericStateHandler) *SecretGenerator {
+	return &SecretGenerator{
+		state: state,
+		now:   time.Now,
+	}
+}
+
+// WithClock sets the function which returns the current time.
+func (g *SecretGenerator) WithClock(now func() time.Time) *SecretGenerator {
+	g.now = now
+	return g
+}
+
+// GeneratePassword returns a password of the given length consisting of letters and digits.
+// The same name always results in the same password as long as the seed in the state is kept.
+func (g *SecretGenerator) GeneratePassword(ctx context.Context, name string, length int) (string, error) {
+	if length <= 0 {
+		return "", fmt.Errorf("invalid password length %d", length)
+	}
+	stream, err := g.newStream(ctx, "password", name, length)
+	if err != nil {
+		return "", err
+	}
+
+	// bytes above the largest multiple of the number of characters are skipped to keep the distribution uniform
+	limit := byte(256 - 256%len(passwordCharacters))
+	password := make([]byte, 0, length)
+	for len(password) < length {
+		b := stream.next()
+		if b >= limit {
+			continue
+		}
+		password = append(password, passwordCharacters[int(b)%len(passwordCharacters)])
+	}
+	return string(password), nil
+}
+
+// GenerateKey returns a base64 encoded key with the given number of bytes.
+// The same name always results in the same key as long as the seed in the state is kept.
+func (g *SecretGenerator) GenerateKey(ctx context.Context, name string, length int) (string, error) {
+	if length <= 0 {
+		return "", fmt.Errorf("invalid key length %d", length)
+	}
+	stream, err := g.newStream(ctx, "key", name, length)
+	if err != nil {
+		return "", err
+	}
+
+	key := make([]byte, length)
+	for i := range key {
+		key[i] = stream.next()
+	}
+	return base64.StdEncoding.EncodeToString(key), nil
+}
+
+// getSeed reads the seed from the state. A new random seed is created and stored if there is none.
+func (g *SecretGenerator) getSeed(ctx context.Context) ([]byte, error) {
+	if g.state == nil {
+		return nil, errors.New("no state is available to store the seed of generated passwords and keys")
+	}
+
+	data, err := g.state.Get(ctx, SeedStateName)
+	if err == nil && len(data) != 0 {
+		seed := make([]byte, hex.DecodedLen(len(data)))
+		if _, err := hex.Decode(seed, data); err != nil {
+			return nil, fmt.Errorf("unable to decode seed: %w", err)
+		}
+		return seed, nil
+	}
+	if err != nil && !errors.Is(err, lstmpl.StateNotFoundErr) {
+		return nil, fmt.Errorf("unable to read seed: %w", err)
+	}
+
+	seed := make([]byte, seedLength)
+	if _, err := rand.Read(seed); err != nil {
+		return nil, fmt.Errorf("unable to create seed: %w", err)
+	}
+	if err := g.state.Store(ctx, SeedStateName, []byte(hex.EncodeToString(seed))); err != nil {
+		return nil, fmt.Errorf("unable to store seed: %w", err)
+	}
+	return seed, nil
+}
+
+func (g *SecretGenerator) newStream(ctx context.Context, purpose, name string, length int) (*derivedStream, error) {
+	seed, err := g.getSeed(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &derivedStream{
+		seed: seed,
+		info: []byte(fmt.Sprintf("%s/%d/%s", purpose, length, name)),
+	}, nil
+}
+
+// derivedStream is an endless stream of bytes that is derived from a seed and some info with HMAC-SHA256.
+type derivedStream struct {
+	seed    []byte
+	info    []byte
+	counter uint64
+	block   []byte
+}
+
+func (s *derivedStream) next() byte {
+	if len(s.block) == 0 {
+		mac := hmac.New(sha256.New, s.seed)
+		_, _ = mac.Write(s.info)
+		_ = binary.Write(mac, binary.BigEndian, s.counter)
+		s.block = mac.Sum(nil)
+		s.counter++
+	}
+	b := s.block[0]
+	s.block = s.block[1:]
+	return b
+}
+
+// CertificateSpec describes a certificate that is generated by GenerateCertificate.
+type CertificateSpec struct {
+	// CommonName is the common name of the certificate.
+	CommonName string `json:"commonName"`
+	// Organization is the list of organizations of the certificate.
+	Organization []string `json:"organization,omitempty"`
+	// DNSNames is the list of dns names of the certificate.
+	DNSNames []string `json:"dnsNames,omitempty"`
+	// IPAddresses is the list of ip addresses of the certificate.
+	IPAddresses []string `json:"ipAddresses,omitempty"`
+	// IsCA defines whether the certificate is a certificate authority.
+	IsCA bool `json:"isCA,omitempty"`
+	// Validity is the duration for which the certificate is valid, e.g. "8760h". Defaults to one year.
+	Validity string `json:"validity,omitempty"`
+	// CA is the certificate authority that signs the certificate. The certificate is self-signed if it is not set.
+	CA *CertificateAuthority `json:"ca,omitempty"`
+}
+
+// CertificateAuthority contains a certificate authority in PEM format.
+type CertificateAuthority struct {
+	Certificate string `json:"certificate"`
+	PrivateKey  string `json:"privateKey"`
+}
+
+// ParseCertificateSpec converts the generic representation of a certificate spec, as it is given in templates.
+func ParseCertificateSpec(value interface{}) (*CertificateSpec, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	spec := &CertificateSpec{}
+	if err := json.Unmarshal(data, spec); err != nil {
+		return nil, fmt.Errorf("invalid certificate spec: %w", err)
+	}
+	return spec, nil
+}
+
+// generatedCertificate is the state of a generated certificate.
+type generatedCertificate struct {
+	SpecHash    string `json:"specHash"`
+	Certificate string `json:"certificate"`
+	PrivateKey  string `json:"privateKey"`
+	CA          string `json:"ca"`
+}
+
+// GenerateCertificate returns a certificate with the fields certificate, privateKey and ca in PEM format.
+// The certificate is stored in the state and returned again by subsequent calls with the same name.
+// A new certificate is generated if the spec changes or if less than a third of its validity remains.
+func (g *SecretGenerator) GenerateCertificate(ctx context.Context, name string, spec *CertificateSpec) (map[string]interface{}, error) {
+	if g.state == nil {
+		return nil, errors.New("no state is available to store generated certificates")
+	}
+	if spec == nil || len(spec.CommonName) == 0 {
+		return nil, errors.New("a certificate requires a common name")
+	}
+
+	specData, err := json.Marshal(spec)
+	if err != nil {
+		return nil, err
+	}
+	specHash := sha256.Sum256(specData)
+	stateName := CertificateStatePrefix + name
+
+	stored, err := g.getCertificate(ctx, stateName)
+	if err != nil {
+		return nil, err
+	}
+	if stored != nil && stored.SpecHash == hex.EncodeToString(specHash[:]) && !g.needsRenewal(stored) {
+		return stored.toMap(), nil
+	}
+
+	certificate, privateKey, ca, err := CreateCertificate(spec, g.now())
+	if err != nil {
+		return nil, err
+	}
+	generated := &generatedCertificate{
+		Certificate: certificate,
+		PrivateKey:  privateKey,
+		CA:          ca,
+	}
+	generated.SpecHash = hex.EncodeToString(specHash[:])
+
+	data, err := json.Marshal(generated)
+	if err != nil {
+		return nil, err
+	}
+	if err := g.state.Store(ctx, stateName, data); err != nil {
+		return nil, fmt.Errorf("unable to store certificate %q: %w", name, err)
+	}
+	return generated.toMap(), nil
+}
+
+func (g *SecretGenerator) getCertificate(ctx context.Context, stateName string) (*generatedCertificate, error) {
+	data, err := g.state.Get(ctx, stateName)
+	if err != nil {
+		if errors.Is(err, lstmpl.StateNotFoundErr) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("unable to read certificate: %w", err)
+	}
+	if len(data) == 0 {
+		return nil, nil
+	}
+	stored := &generatedCertificate{}
+	if err := json.Unmarshal(data, stored); err != nil {
+		return nil, fmt.Errorf("unable to decode certificate: %w", err)
+	}
+	return stored, nil
+}
+
+func (g *SecretGenerator) needsRenewal(stored *generatedCertificate) bool {
+	block, _ := pem.Decode([]byte(stored.Certificate))
+	if block == nil {
+		return true
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return true
+	}
+	validity := cert.NotAfter.Sub(cert.NotBefore)
+	return g.now().After(cert.NotAfter.Add(-validity / 3))
+}
+
+// CreateCertificate creates a new certificate that is valid from the given time on.
+// It returns the certificate, its private key and the certificate of the signing certificate authority in PEM format.
+func CreateCertificate(spec *CertificateSpec, now time.Time) (certificate, privateKey, ca string, err error) {
+	validity := defaultCertificateValidity
+	if len(spec.Validity) != 0 {
+		validity, err = time.ParseDuration(spec.Validity)
+		if err != nil {
+			return "", "", "", fmt.Errorf("invalid validity %q: %w", spec.Validity, err)
+		}
+	}
+
+	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return "", "", "", fmt.Errorf("unable to create serial number: %w", err)
+	}
+
+	tmpl := &x509.Certificate{
+		SerialNumber: serialNumber,
+		Subject: pkix.Name{
+			CommonName:   spec.CommonName,
+			Organization: spec.Organization,
+		},
+		DNSNames:              spec.DNSNames,
+		NotBefore:             now.Add(-time.Minute),
+		NotAfter:              now.Add(validity),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  spec.IsCA,
+	}
+	if spec.IsCA {
+		tmpl.KeyUsage |= x509.KeyUsageCertSign | x509.KeyUsageCRLSign
+	}
+	for _, ipAddress := range spec.IPAddresses {
+		ip := net.ParseIP(ipAddress)
+		if ip == nil {
+			return "", "", "", fmt.Errorf("invalid ip address %q", ipAddress)
+		}
+		tmpl.IPAddresses = append(tmpl.IPAddresses, ip)
+	}
+
+	key, err := rsa.GenerateKey(rand.Reader, certificateKeySize)
+	if err != nil {
+		return "", "", "", fmt.Errorf("unable to create private key: %w", err)
+	}
+
+	parent := tmpl
+	var signer crypto.Signer = key
+	caPEM := ""
+	if spec.CA != nil {
+		caKeyPair, keyErr := tls.X509KeyPair([]byte(spec.CA.Certificate), []byte(spec.CA.PrivateKey))
+		if keyErr != nil {
+			return "", "", "", fmt.Errorf("invalid certificate authority: %w", keyErr)
+		}
+		parent, err = x509.ParseCertificate(caKeyPair.Certificate[0])
+		if err != nil {
+			return "", "", "", fmt.Errorf("invalid certificate of certificate authority: %w", err)
+		}
+		var ok bool
+		signer, ok = caKeyPair.PrivateKey.(crypto.Signer)
+		if !ok {
+			return "", "", "", errors.New("unsupported private key of certificate authority")
+		}
+		caPEM = spec.CA.Certificate
+	}
+
+	certData, err := x509.CreateCertificate(rand.Reader, tmpl, parent, key.Public(), signer)
+	if err != nil {
+		return "", "", "", fmt.Errorf("unable to create certificate: %w", err)
+	}
+
+	certPEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certData}))
+	if len(caPEM) == 0 {
+		caPEM = certPEM
+	}
+	keyPEM := string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}))
+	return certPEM, keyPEM, caPEM, nil
+}
+
+func (c *generatedCertificate) toMap() map[string]interface{} {
+	return map[string]interface{}{
+		"certificate": c.Certificate,
+		"privateKey":  c.PrivateKey,
+		"ca":          c.CA,
+	}
+}
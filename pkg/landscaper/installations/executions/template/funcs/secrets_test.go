@@ -0,0 +1,125 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package funcs_test
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	lstmpl "github.com/gardener/landscaper/pkg/landscaper/installations/executions/template"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/funcs"
+)
+
+func parseCertificate(data interface{}) *x509.Certificate {
+	block, _ := pem.Decode([]byte(data.(string)))
+	ExpectWithOffset(1, block).ToNot(BeNil())
+	cert, err := x509.ParseCertificate(block.Bytes)
+	ExpectWithOffset(1, err).ToNot(HaveOccurred())
+	return cert
+}
+
+var _ = Describe("SecretGenerator", func() {
+
+	var (
+		ctx   context.Context
+		state lstmpl.MemoryStateHandler
+		now   time.Time
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		state = lstmpl.NewMemoryStateHandler()
+		now = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	})
+
+	newGenerator := func() *funcs.SecretGenerator {
+		return funcs.NewSecretGenerator(state).WithClock(func() time.Time { return now })
+	}
+
+	It("should derive stable passwords and keys from the seed in the state", func() {
+		password, err := newGenerator().GeneratePassword(ctx, "db", 24)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(password).To(MatchRegexp("^[a-zA-Z0-9]{24}$"))
+		Expect(state).To(HaveKey(funcs.SeedStateName))
+
+		Expect(newGenerator().GeneratePassword(ctx, "db", 24)).To(Equal(password))
+		Expect(newGenerator().GeneratePassword(ctx, "other", 24)).ToNot(Equal(password))
+
+		key, err := newGenerator().GenerateKey(ctx, "db", 32)
+		Expect(err).ToNot(HaveOccurred())
+		decoded, err := base64.StdEncoding.DecodeString(key)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(decoded).To(HaveLen(32))
+		Expect(newGenerator().GenerateKey(ctx, "db", 32)).To(Equal(key))
+
+		otherState := lstmpl.NewMemoryStateHandler()
+		Expect(funcs.NewSecretGenerator(otherState).GeneratePassword(ctx, "db", 24)).ToNot(Equal(password))
+	})
+
+	It("should fail without a state or with an invalid length", func() {
+		_, err := funcs.NewSecretGenerator(nil).GeneratePassword(ctx, "db", 24)
+		Expect(err).To(HaveOccurred())
+		_, err = newGenerator().GenerateKey(ctx, "db", 0)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should persist generated certificates and renew them", func() {
+		spec := &funcs.CertificateSpec{
+			CommonName:  "my-service",
+			DNSNames:    []string{"my-service.default.svc"},
+			IPAddresses: []string{"10.0.0.1"},
+			Validity:    "720h",
+		}
+		res, err := newGenerator().GenerateCertificate(ctx, "server", spec)
+		Expect(err).ToNot(HaveOccurred())
+		cert := parseCertificate(res["certificate"])
+		Expect(cert.Subject.CommonName).To(Equal("my-service"))
+		Expect(cert.DNSNames).To(ConsistOf("my-service.default.svc"))
+		Expect(cert.IPAddresses[0].String()).To(Equal("10.0.0.1"))
+		Expect(res["ca"]).To(Equal(res["certificate"]))
+
+		now = now.Add(24 * time.Hour)
+		Expect(newGenerator().GenerateCertificate(ctx, "server", spec)).To(Equal(res))
+
+		now = now.Add(20 * 24 * time.Hour)
+		renewed, err := newGenerator().GenerateCertificate(ctx, "server", spec)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(renewed["certificate"]).ToNot(Equal(res["certificate"]))
+
+		spec.DNSNames = append(spec.DNSNames, "my-service")
+		changed, err := newGenerator().GenerateCertificate(ctx, "server", spec)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(parseCertificate(changed["certificate"]).DNSNames).To(ContainElement("my-service"))
+	})
+
+	It("should sign certificates with a certificate authority", func() {
+		ca, err := newGenerator().GenerateCertificate(ctx, "ca", &funcs.CertificateSpec{CommonName: "my-ca", IsCA: true})
+		Expect(err).ToNot(HaveOccurred())
+
+		res, err := newGenerator().GenerateCertificate(ctx, "server", &funcs.CertificateSpec{
+			CommonName: "my-service",
+			CA: &funcs.CertificateAuthority{
+				Certificate: ca["certificate"].(string),
+				PrivateKey:  ca["privateKey"].(string),
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res["ca"]).To(Equal(ca["certificate"]))
+
+		pool := x509.NewCertPool()
+		pool.AddCert(parseCertificate(ca["certificate"]))
+		_, err = parseCertificate(res["certificate"]).Verify(x509.VerifyOptions{
+			Roots:       pool,
+			CurrentTime: now,
+		})
+		Expect(err).ToNot(HaveOccurred())
+	})
+})
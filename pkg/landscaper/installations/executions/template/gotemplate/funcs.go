@@ -30,6 +30,7 @@ import (
 	"github.com/gardener/landscaper/pkg/components/ocmlib"
 	lstmpl "github.com/gardener/landscaper/pkg/landscaper/installations/executions/template"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/common"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/funcs"
 	"github.com/gardener/landscaper/pkg/utils/blueprints"
 	"github.com/gardener/landscaper/pkg/utils/clusters"
 )
@@ -44,10 +45,12 @@ func LandscaperSprigFuncMap() gotmpl.FuncMap {
 
 // LandscaperTplFuncMap contains all additional landscaper functions that are
 // available in the executors templates.
-func LandscaperTplFuncMap(blueprint *blueprints.Blueprint,
+func LandscaperTplFuncMap(ctx context.Context,
+	blueprint *blueprints.Blueprint,
 	componentVersion model.ComponentVersion,
 	componentVersions *model.ComponentVersionList,
-	targetResolver targetresolver.TargetResolver) (map[string]interface{}, error) {
+	targetResolver targetresolver.TargetResolver,
+	state lstmpl.GenericStateHandler) (map[string]interface{}, error) {
 
 	ocmSchemaVersion := common.DetermineOCMSchemaVersion(blueprint, componentVersion)
 
@@ -61,7 +64,9 @@ func LandscaperTplFuncMap(blueprint *blueprints.Blueprint,
 		return nil, fmt.Errorf("unable to convert component descriptor list to register go template functions: %w", err)
 	}
 
-	funcs := map[string]interface{}{
+	secretGenerator := funcs.NewSecretGenerator(state)
+
+	funcMap := map[string]interface{}{
 		"readFile": readFileFunc(blueprint.Fs),
 		"readDir":  readDir(blueprint.Fs),
 
@@ -84,9 +89,26 @@ func LandscaperTplFuncMap(blueprint *blueprints.Blueprint,
 		"getServiceAccountKubeconfig":                        getServiceAccountKubeconfigGoFunc(targetResolver),
 		"getServiceAccountKubeconfigWithExpirationTimestamp": getServiceAccountKubeconfigWithExpirationTimestampGoFunc(targetResolver),
 		"getOidcKubeconfig":                                  getOidcKubeconfigGoFunc(targetResolver),
+		"parseKubeconfig":                                    funcs.ParseKubeconfig,
+
+		"jsonPatch":      funcs.JSONPatch,
+		"jsonMergePatch": funcs.JSONMergePatch,
+		"jsonPath":       funcs.JSONPath,
+		"jmesPath":       funcs.JMESPath,
+		"cidrHost":       funcs.CIDRHost,
+		"cidrSubnet":     funcs.CIDRSubnet,
+		"cidrNetmask":    funcs.CIDRNetmask,
+		"cidrContains":   funcs.CIDRContains,
+
+		"compareSemver": funcs.CompareSemver,
+		"matchSemver":   funcs.MatchSemver,
+
+		"generatePassword":    generatePasswordGoFunc(ctx, secretGenerator),
+		"generateKey":         generateKeyGoFunc(ctx, secretGenerator),
+		"generateCertificate": generateCertificateGoFunc(ctx, secretGenerator),
 	}
 
-	return funcs, nil
+	return funcMap, nil
 }
 
 // readFileFunc returns a function that reads a file from a location in a filesystem
@@ -557,3 +579,31 @@ func kubeconfigWithExpirationTimestamp(kcfg string, expirationTimestamp metav1.T
 		"expirationTimestampReadable": expirationTimestamp.Format(time.RFC3339),
 	}
 }
+
+// generatePasswordGoFunc returns a function that generates a password of the given length,
+// which remains the same for the given name across reconciles.
+func generatePasswordGoFunc(ctx context.Context, secretGenerator *funcs.SecretGenerator) func(name string, length int) (string, error) {
+	return func(name string, length int) (string, error) {
+		return secretGenerator.GeneratePassword(ctx, name, length)
+	}
+}
+
+// generateKeyGoFunc returns a function that generates a base64 encoded key with the given number of bytes,
+// which remains the same for the given name across reconciles.
+func generateKeyGoFunc(ctx context.Context, secretGenerator *funcs.SecretGenerator) func(name string, length int) (string, error) {
+	return func(name string, length int) (string, error) {
+		return secretGenerator.GenerateKey(ctx, name, length)
+	}
+}
+
+// generateCertificateGoFunc returns a function that generates a certificate for a certificate spec,
+// which is persisted across reconciles.
+func generateCertificateGoFunc(ctx context.Context, secretGenerator *funcs.SecretGenerator) func(name string, spec interface{}) (map[string]interface{}, error) {
+	return func(name string, spec interface{}) (map[string]interface{}, error) {
+		certSpec, err := funcs.ParseCertificateSpec(spec)
+		if err != nil {
+			return nil, err
+		}
+		return secretGenerator.GenerateCertificate(ctx, name, certSpec)
+	}
+}
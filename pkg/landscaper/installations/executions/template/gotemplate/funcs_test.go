@@ -0,0 +1,131 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gotemplate_test
+
+import (
+	"context"
+
+	"github.com/mandelsoft/vfs/pkg/memoryfs"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"sigs.k8s.io/yaml"
+
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/funcs"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/gotemplate"
+	"github.com/gardener/landscaper/pkg/utils/blueprints"
+)
+
+var _ = Describe("Template functions", func() {
+
+	var state template.MemoryStateHandler
+
+	BeforeEach(func() {
+		state = template.NewMemoryStateHandler()
+	})
+
+	execute := func(tmpl string, values map[string]interface{}) map[string]interface{} {
+		t, err := gotemplate.NewTemplateExecution(context.Background(), blueprints.New(nil, memoryfs.New()), nil, nil, nil, state)
+		ExpectWithOffset(1, err).ToNot(HaveOccurred())
+		data, err := t.Execute(tmpl, values)
+		ExpectWithOffset(1, err).ToNot(HaveOccurred())
+		res := map[string]interface{}{}
+		ExpectWithOffset(1, yaml.Unmarshal(data, &res)).To(Succeed())
+		return res
+	}
+
+	It("should patch and query documents", func() {
+		values := map[string]interface{}{
+			"doc": map[string]interface{}{
+				"spec": map[string]interface{}{
+					"replicas": 1,
+					"names":    []interface{}{"a", "b"},
+				},
+			},
+		}
+		res := execute(`
+jsonPatch: {{ .doc | jsonPatch (list (dict "op" "replace" "path" "/spec/replicas" "value" 3)) | toJson }}
+jsonMergePatch: {{ .doc | jsonMergePatch (dict "spec" (dict "names" nil)) | toJson }}
+jsonPath: {{ .doc | jsonPath "{.spec.names[*]}" | toJson }}
+jmesPath: {{ .doc | jmesPath "spec.names[1]" }}
+`, values)
+		Expect(res).To(HaveKeyWithValue("jsonPatch", HaveKeyWithValue("spec", HaveKeyWithValue("replicas", float64(3)))))
+		Expect(res).To(HaveKeyWithValue("jsonMergePatch", Equal(map[string]interface{}{
+			"spec": map[string]interface{}{"replicas": float64(1)},
+		})))
+		Expect(res).To(HaveKeyWithValue("jsonPath", ConsistOf("a", "b")))
+		Expect(res).To(HaveKeyWithValue("jmesPath", "b"))
+	})
+
+	It("should calculate cidrs and compare versions", func() {
+		res := execute(`
+host: {{ cidrHost "10.0.0.0/16" 5 }}
+subnet: {{ cidrSubnet "10.0.0.0/16" 8 2 }}
+netmask: {{ cidrNetmask "10.0.0.0/12" }}
+contains: {{ cidrContains "10.0.0.0/16" "10.0.3.4" }}
+compare: {{ compareSemver "1.2.3" "1.10.0" }}
+match: {{ matchSemver ">= 1.2, < 2" "v1.5.0" }}
+`, nil)
+		Expect(res).To(Equal(map[string]interface{}{
+			"host":     "10.0.0.5",
+			"subnet":   "10.0.2.0/24",
+			"netmask":  "255.240.0.0",
+			"contains": true,
+			"compare":  float64(-1),
+			"match":    true,
+		}))
+	})
+
+	It("should generate secrets that are stable across executions", func() {
+		tmpl := `
+password: {{ generatePassword "admin" 16 }}
+key: {{ generateKey "encryption" 32 }}
+cert: {{ generateCertificate "server" (dict "commonName" "my-service" "dnsNames" (list "my-service.default")) | toJson }}
+`
+		res := execute(tmpl, nil)
+		Expect(res["password"]).To(MatchRegexp("^[a-zA-Z0-9]{16}$"))
+		Expect(res["cert"]).To(HaveKeyWithValue("certificate", ContainSubstring("BEGIN CERTIFICATE")))
+		Expect(state).To(HaveKey(funcs.SeedStateName))
+		Expect(state).To(HaveKey(funcs.CertificateStatePrefix + "server"))
+
+		Expect(execute(tmpl, nil)).To(Equal(res))
+	})
+
+	It("should fail to generate secrets without a state", func() {
+		t, err := gotemplate.NewTemplateExecution(context.Background(), blueprints.New(nil, memoryfs.New()), nil, nil, nil, nil)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = t.Execute(`{{ generatePassword "admin" 16 }}`, nil)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should access the state with the context of the template execution", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		t, err := gotemplate.NewTemplateExecution(ctx, blueprints.New(nil, memoryfs.New()), nil, nil, nil, contextStateHandler{state})
+		Expect(err).ToNot(HaveOccurred())
+		_, err = t.Execute(`{{ generatePassword "admin" 16 }}`, nil)
+		Expect(err).To(MatchError(ContainSubstring(context.Canceled.Error())))
+		Expect(state).To(BeEmpty())
+	})
+})
+
+// contextStateHandler is a state handler that fails if its context is done.
+type contextStateHandler struct {
+	template.MemoryStateHandler
+}
+
+func (h contextStateHandler) Store(ctx context.Context, name string, data []byte) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return h.MemoryStateHandler.Store(ctx, name, data)
+}
+
+func (h contextStateHandler) Get(ctx context.Context, name string) ([]byte, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return h.MemoryStateHandler.Get(ctx, name)
+}
@@ -28,6 +28,7 @@ const (
 
 // Templater is the go template implementation for landscaper templating.
 type Templater struct {
+	ctx            context.Context
 	state          lstmpl.GenericStateHandler
 	inputFormatter *lstmpl.TemplateInputFormatter
 	targetResolver targetresolver.TargetResolver
@@ -36,6 +37,7 @@ type Templater struct {
 // New creates a new go template execution templater.
 func New(state lstmpl.GenericStateHandler, targetResolver targetresolver.TargetResolver) *Templater {
 	return &Templater{
+		ctx:            context.Background(),
 		state:          state,
 		inputFormatter: lstmpl.NewTemplateInputFormatter(false, "imports", "targets", "values", "state"),
 		targetResolver: targetResolver,
@@ -48,18 +50,26 @@ func (t *Templater) WithInputFormatter(inputFormatter *lstmpl.TemplateInputForma
 	return t
 }
 
+// WithContext sets the context that is used to access the state, e.g. when secrets are generated during templating.
+func (t *Templater) WithContext(ctx context.Context) *Templater {
+	t.ctx = ctx
+	return t
+}
+
 type TemplateExecution struct {
 	funcMap       map[string]interface{}
 	blueprint     *blueprints.Blueprint
 	includedNames map[string]int
 }
 
-func NewTemplateExecution(blueprint *blueprints.Blueprint,
+func NewTemplateExecution(ctx context.Context,
+	blueprint *blueprints.Blueprint,
 	cd model.ComponentVersion,
 	cdList *model.ComponentVersionList,
-	targetResolver targetresolver.TargetResolver) (*TemplateExecution, error) {
+	targetResolver targetresolver.TargetResolver,
+	state lstmpl.GenericStateHandler) (*TemplateExecution, error) {
 
-	funcs, err := LandscaperTplFuncMap(blueprint, cd, cdList, targetResolver)
+	funcs, err := LandscaperTplFuncMap(ctx, blueprint, cd, cdList, targetResolver, state)
 	if err != nil {
 		return nil, err
 	}
@@ -123,7 +133,7 @@ func (t *Templater) TemplateExecution(rawTemplate string,
 	cdList *model.ComponentVersionList,
 	values map[string]interface{}) ([]byte, error) {
 
-	te, err := NewTemplateExecution(blueprint, cd, cdList, t.targetResolver)
+	te, err := NewTemplateExecution(t.ctx, blueprint, cd, cdList, t.targetResolver, t.state)
 	if err != nil {
 		return nil, err
 	}
@@ -144,8 +154,7 @@ func (t *Templater) TemplateSubinstallationExecutions(tmplExec lsv1alpha1.Templa
 		return nil, err
 	}
 
-	ctx := context.Background()
-	defer ctx.Done()
+	ctx := t.ctx
 	state, err := t.getDeployExecutionState(ctx, tmplExec)
 	if err != nil {
 		return nil, fmt.Errorf("unable to load state: %w", err)
@@ -156,6 +165,7 @@ func (t *Templater) TemplateSubinstallationExecutions(tmplExec lsv1alpha1.Templa
 	if err != nil {
 		executeError := TemplateErrorBuilder(err).WithSource(&rawTemplate).
 			WithInput(values, t.inputFormatter).
+			WithExecution(templateName, tmplExec, blueprint).
 			Build()
 		return nil, executeError
 	}
@@ -181,18 +191,18 @@ func (t *Templater) TemplateImportExecutions(tmplExec lsv1alpha1.TemplateExecuto
 	cdList *model.ComponentVersionList,
 	values map[string]interface{}) (*lstmpl.ImportExecutorOutput, error) {
 
+	const templateName = "import execution"
+
 	rawTemplate, err := getTemplateFromExecution(tmplExec, blueprint)
 	if err != nil {
 		return nil, err
 	}
 
-	ctx := context.Background()
-	defer ctx.Done()
-
 	data, err := t.TemplateExecution(rawTemplate, blueprint, descriptor, cdList, values)
 	if err != nil {
 		executeError := TemplateErrorBuilder(err).WithSource(&rawTemplate).
 			WithInput(values, t.inputFormatter).
+			WithExecution(templateName, tmplExec, blueprint).
 			Build()
 		return nil, executeError
 	}
@@ -218,8 +228,7 @@ func (t *Templater) TemplateDeployExecutions(tmplExec lsv1alpha1.TemplateExecuto
 		return nil, err
 	}
 
-	ctx := context.Background()
-	defer ctx.Done()
+	ctx := t.ctx
 	state, err := t.getDeployExecutionState(ctx, tmplExec)
 	if err != nil {
 		return nil, fmt.Errorf("unable to load state: %w", err)
@@ -230,6 +239,7 @@ func (t *Templater) TemplateDeployExecutions(tmplExec lsv1alpha1.TemplateExecuto
 	if err != nil {
 		executeError := TemplateErrorBuilder(err).WithSource(&rawTemplate).
 			WithInput(values, t.inputFormatter).
+			WithExecution(templateName, tmplExec, blueprint).
 			Build()
 		return nil, executeError
 	}
@@ -260,8 +270,7 @@ func (t *Templater) TemplateExportExecutions(tmplExec lsv1alpha1.TemplateExecuto
 		return nil, err
 	}
 
-	ctx := context.Background()
-	defer ctx.Done()
+	ctx := t.ctx
 	state, err := t.getExportExecutionState(ctx, tmplExec)
 	if err != nil {
 		return nil, fmt.Errorf("unable to load state: %w", err)
@@ -272,6 +281,7 @@ func (t *Templater) TemplateExportExecutions(tmplExec lsv1alpha1.TemplateExecuto
 	if err != nil {
 		executeError := TemplateErrorBuilder(err).WithSource(&rawTemplate).
 			WithInput(values, t.inputFormatter).
+			WithExecution(templateName, tmplExec, blueprint).
 			Build()
 		return nil, executeError
 	}
@@ -5,11 +5,16 @@
 package gotemplate_test
 
 import (
+	"context"
+	"errors"
+
 	"github.com/mandelsoft/vfs/pkg/memoryfs"
 	"github.com/mandelsoft/vfs/pkg/vfs"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/gotemplate"
 	"github.com/gardener/landscaper/pkg/utils/blueprints"
 )
@@ -20,7 +25,7 @@ var _ = Describe("TemplateDeployExecutions", func() {
 		fs := memoryfs.New()
 		bp := blueprints.New(nil, fs)
 		tmpl := "{{ .values.test }}"
-		t, err := gotemplate.NewTemplateExecution(bp, nil, nil, nil)
+		t, err := gotemplate.NewTemplateExecution(context.Background(), bp, nil, nil, nil, nil)
 		Expect(err).ToNot(HaveOccurred())
 		values := map[string]interface{}{
 			"values": map[string]interface{}{
@@ -37,7 +42,7 @@ var _ = Describe("TemplateDeployExecutions", func() {
 		Expect(vfs.WriteFile(fs, "template.include", []byte("{{ .values.test }}"), 0600)).To(Succeed())
 		bp := blueprints.New(nil, fs)
 		tmpl := `{{ include "template.include" . }}`
-		t, err := gotemplate.NewTemplateExecution(bp, nil, nil, nil)
+		t, err := gotemplate.NewTemplateExecution(context.Background(), bp, nil, nil, nil, nil)
 		Expect(err).ToNot(HaveOccurred())
 		values := map[string]interface{}{
 			"values": map[string]interface{}{
@@ -55,7 +60,7 @@ var _ = Describe("TemplateDeployExecutions", func() {
 		bp := blueprints.New(nil, fs)
 		tmpl := `config:
 {{ include "template.include" . | indent 2 }}`
-		t, err := gotemplate.NewTemplateExecution(bp, nil, nil, nil)
+		t, err := gotemplate.NewTemplateExecution(context.Background(), bp, nil, nil, nil, nil)
 		Expect(err).ToNot(HaveOccurred())
 		values := map[string]interface{}{
 			"values": map[string]interface{}{
@@ -70,7 +75,7 @@ var _ = Describe("TemplateDeployExecutions", func() {
 	It("should render a go template with a fromYaml function", func() {
 		bp := blueprints.New(nil, memoryfs.New())
 		tmpl := `{{ $yamlData := fromYaml .values.yamlString }}{{ $yamlData.foo }}`
-		t, err := gotemplate.NewTemplateExecution(bp, nil, nil, nil)
+		t, err := gotemplate.NewTemplateExecution(context.Background(), bp, nil, nil, nil, nil)
 		Expect(err).ToNot(HaveOccurred())
 		values := map[string]interface{}{
 			"values": map[string]interface{}{
@@ -82,3 +87,73 @@ var _ = Describe("TemplateDeployExecutions", func() {
 		Expect(string(res)).To(Equal("bar"))
 	})
 })
+
+var _ = Describe("TemplateError", func() {
+
+	const blueprintDefinition = `apiVersion: landscaper.gardener.cloud/v1alpha1
+kind: Blueprint
+jsonSchema: "https://json-schema.org/draft/2019-09/schema"
+deployExecutions:
+- name: first
+  type: GoTemplate
+  template: |
+    deployItems: []
+- name: second
+  type: GoTemplate
+  template: |
+    deployItems:
+    - name: init
+      config:
+        value: {{ .values.missing.key }}
+`
+
+	It("should report the location of a failed inline template in the blueprint file", func() {
+		fs := memoryfs.New()
+		Expect(vfs.WriteFile(fs, lsv1alpha1.BlueprintFileName, []byte(blueprintDefinition), 0600)).To(Succeed())
+		bp, err := blueprints.NewFromFs(fs)
+		Expect(err).ToNot(HaveOccurred())
+
+		values := map[string]interface{}{
+			"values": map[string]interface{}{
+				"password": "secret",
+			},
+		}
+		_, err = gotemplate.New(template.NewMemoryStateHandler(), nil).
+			TemplateDeployExecutions(bp.Info.DeployExecutions[1], bp, nil, nil, values)
+		Expect(err).To(HaveOccurred())
+
+		tmplErr := &gotemplate.TemplateError{}
+		Expect(errors.As(err, &tmplErr)).To(BeTrue())
+		Expect(tmplErr.ExecutionName()).To(Equal("second"))
+		Expect(tmplErr.File()).To(Equal(lsv1alpha1.BlueprintFileName))
+		Expect(tmplErr.Line()).To(Equal(15))
+		Expect(tmplErr.Column()).To(Equal(25))
+		Expect(tmplErr.Error()).To(HavePrefix(`deploy execution "second" failed at blueprint.yaml:15:25: `))
+
+		info, ok := template.GetTemplateDebugInfo(err)
+		Expect(ok).To(BeTrue())
+		Expect(info.Input).To(ContainSubstring(`values: {"password":"[...] (string)"}`))
+		Expect(info.Input).ToNot(ContainSubstring("secret"))
+		Expect(info.Summary()).To(HavePrefix(`deploy execution "second" failed at blueprint.yaml:15:25: template: execution:4:21:`))
+		Expect(info.Summary()).ToNot(ContainSubstring("template source:"))
+	})
+
+	It("should report the location of a failed template file", func() {
+		fs := memoryfs.New()
+		Expect(vfs.WriteFile(fs, "deploy.tmpl", []byte("deployItems:\n{{ .values.missing.key }}"), 0600)).To(Succeed())
+		bp := blueprints.New(&lsv1alpha1.Blueprint{}, fs)
+
+		tmplExec := lsv1alpha1.TemplateExecutor{
+			Name: "from-file",
+			Type: lsv1alpha1.GOTemplateType,
+			File: "deploy.tmpl",
+		}
+		_, err := gotemplate.New(template.NewMemoryStateHandler(), nil).
+			TemplateDeployExecutions(tmplExec, bp, nil, nil, map[string]interface{}{})
+		Expect(err).To(HaveOccurred())
+
+		tmplErr := &gotemplate.TemplateError{}
+		Expect(errors.As(err, &tmplErr)).To(BeTrue())
+		Expect(tmplErr.Location()).To(Equal("deploy.tmpl:2:10"))
+	})
+})
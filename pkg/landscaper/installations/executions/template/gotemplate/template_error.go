@@ -5,11 +5,17 @@
 package gotemplate
 
 import (
+	"errors"
+	"fmt"
 	"regexp"
 	"strconv"
 	"strings"
 
+	"github.com/mandelsoft/vfs/pkg/vfs"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template"
+	"github.com/gardener/landscaper/pkg/utils/blueprints"
 )
 
 var (
@@ -23,10 +29,28 @@ type TemplateError struct {
 	input          map[string]interface{}
 	inputFormatter *template.TemplateInputFormatter
 	message        string
+
+	executionType string
+	executionName string
+	// file is the blueprint file that contains the template.
+	file string
+	// lineOffset is the number of lines in the file before the first line of the template.
+	lineOffset int
+	// columnOffsets contains the indentation of the template lines in the file.
+	columnOffsets []int
+	line          int
+	column        int
 }
 
+var _ template.TemplateDebugInfoProvider = &TemplateError{}
+
 // TemplateErrorBuilder creates a new TemplateError.
 func TemplateErrorBuilder(err error) *TemplateError {
+	// an already wrapped parse error is unwrapped so that the source is not added twice.
+	var tmplErr *TemplateError
+	if errors.As(err, &tmplErr) && len(tmplErr.executionName) == 0 {
+		err = tmplErr.err
+	}
 	return &TemplateError{
 		err:     err,
 		message: err.Error(),
@@ -46,9 +70,43 @@ func (e *TemplateError) WithInput(input map[string]interface{}, inputFormatter *
 	return e
 }
 
+// WithExecution adds the execution that failed to the error.
+// The template is located in the blueprint so that the error position can be reported relative to the blueprint file.
+func (e *TemplateError) WithExecution(executionType string, tmplExec lsv1alpha1.TemplateExecutor, blueprint *blueprints.Blueprint) *TemplateError {
+	e.executionType = executionType
+	e.executionName = tmplExec.Name
+
+	if len(tmplExec.Template.RawMessage) == 0 {
+		e.file = tmplExec.File
+		return e
+	}
+
+	// inline templates are searched in the blueprint definition
+	if e.source == nil || blueprint == nil || blueprint.Fs == nil {
+		return e
+	}
+	data, err := vfs.ReadFile(blueprint.Fs, lsv1alpha1.BlueprintFileName)
+	if err != nil {
+		return e
+	}
+	offset, columnOffsets, ok := locateTemplate(strings.Split(string(data), "\n"), strings.Split(*e.source, "\n"))
+	if !ok {
+		return e
+	}
+	e.file = lsv1alpha1.BlueprintFileName
+	e.lineOffset = offset
+	e.columnOffsets = columnOffsets
+	return e
+}
+
 // Build builds the error message.
 func (e *TemplateError) Build() *TemplateError {
+	e.parseLineColumn()
+
 	builder := strings.Builder{}
+	if len(e.executionName) != 0 {
+		builder.WriteString(fmt.Sprintf("%s %q failed at %s: ", e.executionType, e.executionName, e.Location()))
+	}
 	builder.WriteString(e.err.Error())
 
 	if e.source != nil {
@@ -70,37 +128,124 @@ func (e *TemplateError) Error() string {
 	return e.message
 }
 
-// formatSource extracts the significant template source code that was the reason of the template error.
-func (e *TemplateError) formatSource() string {
-	var (
-		err                    error
-		errorLine, errorColumn int
-	)
+// Unwrap returns the original templating error.
+func (e *TemplateError) Unwrap() error {
+	return e.err
+}
+
+// ExecutionName returns the name of the failed execution.
+func (e *TemplateError) ExecutionName() string {
+	return e.executionName
+}
+
+// File returns the blueprint file that contains the failed template.
+func (e *TemplateError) File() string {
+	return e.file
+}
+
+// Line returns the line of the error in the blueprint file.
+// If the template could not be located in the blueprint, the line is relative to the template.
+func (e *TemplateError) Line() int {
+	if e.line == 0 {
+		return 0
+	}
+	return e.line + e.lineOffset
+}
+
+// Column returns the column of the error in the blueprint file.
+func (e *TemplateError) Column() int {
+	if e.column == 0 {
+		return 0
+	}
+	if e.line > 0 && e.line <= len(e.columnOffsets) {
+		return e.column + e.columnOffsets[e.line-1]
+	}
+	return e.column
+}
 
-	errStr := e.err.Error()
-	formatted := strings.Builder{}
+// Location returns the location of the error in the format "<file>:<line>:<column>".
+func (e *TemplateError) Location() string {
+	return e.TemplateDebugInfo().Location()
+}
 
-	// parse error line and column
-	m := errorLineColumnRegexp.FindStringSubmatch(errStr)
+// TemplateDebugInfo returns the debug information of the error.
+// The template input is redacted by the input formatter of the error.
+func (e *TemplateError) TemplateDebugInfo() *template.TemplateDebugInfo {
+	info := &template.TemplateDebugInfo{
+		ExecutionType: e.executionType,
+		ExecutionName: e.executionName,
+		File:          e.file,
+		Line:          e.Line(),
+		Column:        e.Column(),
+		Message:       strings.SplitN(e.err.Error(), "\n", 2)[0],
+		Error:         e.message,
+	}
+	if e.input != nil && e.inputFormatter != nil {
+		info.Input = e.inputFormatter.Format(e.input, "\t")
+	}
+	return info
+}
+
+// parseLineColumn parses the error line and column of the original go template error.
+func (e *TemplateError) parseLineColumn() {
+	m := errorLineColumnRegexp.FindStringSubmatch(e.err.Error())
 	if m == nil {
-		return ""
+		return
 	}
 
 	if len(m) >= 2 {
-		// error line
-		errorLine, err = strconv.Atoi(m[1])
+		line, err := strconv.Atoi(m[1])
 		if err != nil {
-			return ""
+			return
 		}
+		e.line = line
 	}
 	if len(m) >= 4 {
-		// error column
-		errorColumn, err = strconv.Atoi(m[3])
-		if err != nil {
-			errorColumn = 0
+		column, err := strconv.Atoi(m[3])
+		if err == nil {
+			e.column = column
+		}
+	}
+}
+
+// formatSource extracts the significant template source code that was the reason of the template error.
+func (e *TemplateError) formatSource() string {
+	if e.line == 0 {
+		return ""
+	}
+	return CreateSourceSnippet(e.line, e.column, strings.Split(*e.source, "\n"))
+}
+
+// locateTemplate searches the lines of an inline template in the lines of the blueprint definition.
+// It returns the number of lines before the template and the indentation of each template line.
+// The indentation of yaml block scalars is ignored when comparing the lines.
+func locateTemplate(fileLines, templateLines []string) (int, []int, bool) {
+	// trailing empty lines are added by yaml block scalars and do not help to locate the template
+	for len(templateLines) > 0 && len(strings.TrimSpace(templateLines[len(templateLines)-1])) == 0 {
+		templateLines = templateLines[:len(templateLines)-1]
+	}
+	if len(templateLines) == 0 {
+		return 0, nil, false
+	}
+
+	for start := 0; start+len(templateLines) <= len(fileLines); start++ {
+		columnOffsets := make([]int, len(templateLines))
+		matches := true
+		for i, tmplLine := range templateLines {
+			fileLine := fileLines[start+i]
+			if strings.TrimSpace(fileLine) != strings.TrimSpace(tmplLine) {
+				matches = false
+				break
+			}
+			columnOffsets[i] = indentation(fileLine) - indentation(tmplLine)
+		}
+		if matches {
+			return start, columnOffsets, true
 		}
 	}
+	return 0, nil, false
+}
 
-	formatted.WriteString(CreateSourceSnippet(errorLine, errorColumn, strings.Split(*e.source, "\n")))
-	return formatted.String()
+func indentation(line string) int {
+	return len(line) - len(strings.TrimLeft(line, " \t"))
 }
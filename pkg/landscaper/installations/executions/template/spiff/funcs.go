@@ -28,11 +28,12 @@ import (
 	"github.com/gardener/landscaper/pkg/components/ocmlib"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/common"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/funcs"
 	"github.com/gardener/landscaper/pkg/utils/blueprints"
 	"github.com/gardener/landscaper/pkg/utils/clusters"
 )
 
-func LandscaperSpiffFuncs(blueprint *blueprints.Blueprint, functions spiffing.Functions, componentVersion model.ComponentVersion, componentVersions *model.ComponentVersionList, targetResolver targetresolver.TargetResolver) error {
+func LandscaperSpiffFuncs(ctx context.Context, blueprint *blueprints.Blueprint, functions spiffing.Functions, componentVersion model.ComponentVersion, componentVersions *model.ComponentVersionList, targetResolver targetresolver.TargetResolver, state template.GenericStateHandler) error {
 	ocmSchemaVersion := common.DetermineOCMSchemaVersion(blueprint, componentVersion)
 
 	cd, err := model.GetComponentDescriptor(componentVersion)
@@ -57,6 +58,23 @@ func LandscaperSpiffFuncs(blueprint *blueprints.Blueprint, functions spiffing.Fu
 	functions.RegisterFunction("getServiceAccountKubeconfig", getServiceAccountKubeconfigSpiffFunc(targetResolver, false))
 	functions.RegisterFunction("getServiceAccountKubeconfigWithExpirationTimestamp", getServiceAccountKubeconfigSpiffFunc(targetResolver, true))
 	functions.RegisterFunction("getOidcKubeconfig", getOidcKubeconfigSpiffFunc(targetResolver))
+	functions.RegisterFunction("parseKubeconfig", parseKubeconfigSpiffFunc)
+
+	functions.RegisterFunction("jsonPatch", jsonPatchSpiffFunc)
+	functions.RegisterFunction("jsonMergePatch", jsonMergePatchSpiffFunc)
+	functions.RegisterFunction("jsonPath", jsonPathSpiffFunc)
+	functions.RegisterFunction("jmesPath", jmesPathSpiffFunc)
+	functions.RegisterFunction("cidrHost", cidrHostSpiffFunc)
+	functions.RegisterFunction("cidrSubnet", cidrSubnetSpiffFunc)
+	functions.RegisterFunction("cidrNetmask", cidrNetmaskSpiffFunc)
+	functions.RegisterFunction("cidrContains", cidrContainsSpiffFunc)
+	functions.RegisterFunction("compareSemver", compareSemverSpiffFunc)
+	functions.RegisterFunction("matchSemver", matchSemverSpiffFunc)
+
+	secretGenerator := funcs.NewSecretGenerator(state)
+	functions.RegisterFunction("generatePassword", generatePasswordSpiffFunc(ctx, secretGenerator))
+	functions.RegisterFunction("generateKey", generateKeySpiffFunc(ctx, secretGenerator))
+	functions.RegisterFunction("generateCertificate", generateCertificateSpiffFunc(ctx, secretGenerator))
 
 	return nil
 }
@@ -552,3 +570,308 @@ func kubeconfigWithExpirationTimestamp(kcfg string, expirationTimestamp metav1.T
 
 	return result.Value(), info, true
 }
+
+// spiffValue converts a spiff argument into its generic representation.
+func spiffValue(arg interface{}) (interface{}, error) {
+	data, err := spiffyaml.Marshal(spiffyaml.NewNode(arg, ""))
+	if err != nil {
+		return nil, err
+	}
+	var val interface{}
+	if err := yaml.Unmarshal(data, &val); err != nil {
+		return nil, err
+	}
+	return val, nil
+}
+
+// spiffResult converts a generic value into a spiff result.
+func spiffResult(value interface{}, info dynaml.EvaluationInfo, binding dynaml.Binding) (interface{}, dynaml.EvaluationInfo, bool) {
+	data, err := yaml.Marshal(value)
+	if err != nil {
+		return info.Error(err.Error())
+	}
+
+	node, err := spiffyaml.Parse("", data)
+	if err != nil {
+		return info.Error(err.Error())
+	}
+
+	result, err := binding.Flow(node, false)
+	if err != nil {
+		return info.Error(err.Error())
+	}
+
+	return result.Value(), info, true
+}
+
+// patchSpiffFunc returns a spiff function that applies a patch to a document.
+func patchSpiffFunc(name string, patchFunc func(patch, doc interface{}) (interface{}, error)) dynaml.Function {
+	return func(args []interface{}, binding dynaml.Binding) (interface{}, dynaml.EvaluationInfo, bool) {
+		info := dynaml.DefaultInfo()
+		if len(args) != 2 {
+			return info.Error("templating function %s expects 2 arguments: patch and document", name)
+		}
+
+		patch, err := spiffValue(args[0])
+		if err != nil {
+			return info.Error("templating function %s expects a patch as 1st argument: %w", name, err)
+		}
+		doc, err := spiffValue(args[1])
+		if err != nil {
+			return info.Error("templating function %s expects a document as 2nd argument: %w", name, err)
+		}
+
+		res, err := patchFunc(patch, doc)
+		if err != nil {
+			return info.Error(err)
+		}
+		return spiffResult(res, info, binding)
+	}
+}
+
+var jsonPatchSpiffFunc = patchSpiffFunc("jsonPatch", funcs.JSONPatch)
+
+var jsonMergePatchSpiffFunc = patchSpiffFunc("jsonMergePatch", funcs.JSONMergePatch)
+
+// querySpiffFunc returns a spiff function that evaluates a query expression on some data.
+func querySpiffFunc(name string, queryFunc func(expression string, data interface{}) (interface{}, error)) dynaml.Function {
+	return func(args []interface{}, binding dynaml.Binding) (interface{}, dynaml.EvaluationInfo, bool) {
+		info := dynaml.DefaultInfo()
+		if len(args) != 2 {
+			return info.Error("templating function %s expects 2 arguments: expression and data", name)
+		}
+
+		expression, ok := args[0].(string)
+		if !ok {
+			return info.Error("templating function %s expects a string as 1st argument, namely the expression", name)
+		}
+		data, err := spiffValue(args[1])
+		if err != nil {
+			return info.Error("templating function %s expects data as 2nd argument: %w", name, err)
+		}
+
+		res, err := queryFunc(expression, data)
+		if err != nil {
+			return info.Error(err)
+		}
+		return spiffResult(res, info, binding)
+	}
+}
+
+var jsonPathSpiffFunc = querySpiffFunc("jsonPath", funcs.JSONPath)
+
+var jmesPathSpiffFunc = querySpiffFunc("jmesPath", funcs.JMESPath)
+
+func cidrHostSpiffFunc(args []interface{}, binding dynaml.Binding) (interface{}, dynaml.EvaluationInfo, bool) {
+	info := dynaml.DefaultInfo()
+	if len(args) != 2 {
+		return info.Error("templating function cidrHost expects 2 arguments: cidr and host number")
+	}
+
+	prefix, ok := args[0].(string)
+	if !ok {
+		return info.Error("templating function cidrHost expects a string as 1st argument, namely the cidr")
+	}
+	hostNum, err := toInt64(args[1])
+	if err != nil {
+		return info.Error("templating function cidrHost expects an integer as 2nd argument, namely the host number: %w", err)
+	}
+
+	res, err := funcs.CIDRHost(prefix, hostNum)
+	if err != nil {
+		return info.Error(err)
+	}
+	return res, info, true
+}
+
+func cidrSubnetSpiffFunc(args []interface{}, binding dynaml.Binding) (interface{}, dynaml.EvaluationInfo, bool) {
+	info := dynaml.DefaultInfo()
+	if len(args) != 3 {
+		return info.Error("templating function cidrSubnet expects 3 arguments: cidr, additional bits and network number")
+	}
+
+	prefix, ok := args[0].(string)
+	if !ok {
+		return info.Error("templating function cidrSubnet expects a string as 1st argument, namely the cidr")
+	}
+	newBits, err := toInt64(args[1])
+	if err != nil {
+		return info.Error("templating function cidrSubnet expects an integer as 2nd argument, namely the additional bits: %w", err)
+	}
+	netNum, err := toInt64(args[2])
+	if err != nil {
+		return info.Error("templating function cidrSubnet expects an integer as 3rd argument, namely the network number: %w", err)
+	}
+
+	res, err := funcs.CIDRSubnet(prefix, int(newBits), netNum)
+	if err != nil {
+		return info.Error(err)
+	}
+	return res, info, true
+}
+
+func cidrNetmaskSpiffFunc(args []interface{}, binding dynaml.Binding) (interface{}, dynaml.EvaluationInfo, bool) {
+	info := dynaml.DefaultInfo()
+	if len(args) != 1 {
+		return info.Error("templating function cidrNetmask expects 1 argument: cidr")
+	}
+
+	prefix, ok := args[0].(string)
+	if !ok {
+		return info.Error("templating function cidrNetmask expects a string as argument, namely the cidr")
+	}
+
+	res, err := funcs.CIDRNetmask(prefix)
+	if err != nil {
+		return info.Error(err)
+	}
+	return res, info, true
+}
+
+func cidrContainsSpiffFunc(args []interface{}, binding dynaml.Binding) (interface{}, dynaml.EvaluationInfo, bool) {
+	info := dynaml.DefaultInfo()
+	if len(args) != 2 {
+		return info.Error("templating function cidrContains expects 2 arguments: cidr and ip address")
+	}
+
+	prefix, ok := args[0].(string)
+	if !ok {
+		return info.Error("templating function cidrContains expects a string as 1st argument, namely the cidr")
+	}
+	ip, ok := args[1].(string)
+	if !ok {
+		return info.Error("templating function cidrContains expects a string as 2nd argument, namely the ip address")
+	}
+
+	res, err := funcs.CIDRContains(prefix, ip)
+	if err != nil {
+		return info.Error(err)
+	}
+	return res, info, true
+}
+
+func compareSemverSpiffFunc(args []interface{}, binding dynaml.Binding) (interface{}, dynaml.EvaluationInfo, bool) {
+	info := dynaml.DefaultInfo()
+	if len(args) != 2 {
+		return info.Error("templating function compareSemver expects 2 arguments: two versions")
+	}
+
+	version1, ok := args[0].(string)
+	if !ok {
+		return info.Error("templating function compareSemver expects a string as 1st argument, namely a version")
+	}
+	version2, ok := args[1].(string)
+	if !ok {
+		return info.Error("templating function compareSemver expects a string as 2nd argument, namely a version")
+	}
+
+	res, err := funcs.CompareSemver(version1, version2)
+	if err != nil {
+		return info.Error(err)
+	}
+	return int64(res), info, true
+}
+
+func matchSemverSpiffFunc(args []interface{}, binding dynaml.Binding) (interface{}, dynaml.EvaluationInfo, bool) {
+	info := dynaml.DefaultInfo()
+	if len(args) != 2 {
+		return info.Error("templating function matchSemver expects 2 arguments: version constraint and version")
+	}
+
+	constraint, ok := args[0].(string)
+	if !ok {
+		return info.Error("templating function matchSemver expects a string as 1st argument, namely the version constraint")
+	}
+	version, ok := args[1].(string)
+	if !ok {
+		return info.Error("templating function matchSemver expects a string as 2nd argument, namely the version")
+	}
+
+	res, err := funcs.MatchSemver(constraint, version)
+	if err != nil {
+		return info.Error(err)
+	}
+	return res, info, true
+}
+
+func parseKubeconfigSpiffFunc(args []interface{}, binding dynaml.Binding) (interface{}, dynaml.EvaluationInfo, bool) {
+	info := dynaml.DefaultInfo()
+	if len(args) != 1 {
+		return info.Error("templating function parseKubeconfig expects 1 argument: kubeconfig")
+	}
+
+	kubeconfig, ok := args[0].(string)
+	if !ok {
+		return info.Error("templating function parseKubeconfig expects a string as argument, namely the kubeconfig")
+	}
+
+	res, err := funcs.ParseKubeconfig(kubeconfig)
+	if err != nil {
+		return info.Error(err)
+	}
+	return spiffResult(res, info, binding)
+}
+
+// generateSecretSpiffFunc returns a spiff function that generates a password or key of a given length,
+// which remains the same for the given name across reconciles.
+func generateSecretSpiffFunc(ctx context.Context, name string, generate func(ctx context.Context, name string, length int) (string, error)) dynaml.Function {
+	return func(args []interface{}, binding dynaml.Binding) (interface{}, dynaml.EvaluationInfo, bool) {
+		info := dynaml.DefaultInfo()
+		if len(args) != 2 {
+			return info.Error("templating function %s expects 2 arguments: name and length", name)
+		}
+
+		secretName, ok := args[0].(string)
+		if !ok {
+			return info.Error("templating function %s expects a string as 1st argument, namely the name", name)
+		}
+		length, err := toInt64(args[1])
+		if err != nil {
+			return info.Error("templating function %s expects an integer as 2nd argument, namely the length: %w", name, err)
+		}
+
+		res, err := generate(ctx, secretName, int(length))
+		if err != nil {
+			return info.Error(err)
+		}
+		return res, info, true
+	}
+}
+
+func generatePasswordSpiffFunc(ctx context.Context, secretGenerator *funcs.SecretGenerator) dynaml.Function {
+	return generateSecretSpiffFunc(ctx, "generatePassword", secretGenerator.GeneratePassword)
+}
+
+func generateKeySpiffFunc(ctx context.Context, secretGenerator *funcs.SecretGenerator) dynaml.Function {
+	return generateSecretSpiffFunc(ctx, "generateKey", secretGenerator.GenerateKey)
+}
+
+// generateCertificateSpiffFunc returns a spiff function that generates a certificate for a certificate spec,
+// which is persisted across reconciles.
+func generateCertificateSpiffFunc(ctx context.Context, secretGenerator *funcs.SecretGenerator) dynaml.Function {
+	return func(args []interface{}, binding dynaml.Binding) (interface{}, dynaml.EvaluationInfo, bool) {
+		info := dynaml.DefaultInfo()
+		if len(args) != 2 {
+			return info.Error("templating function generateCertificate expects 2 arguments: name and certificate spec")
+		}
+
+		name, ok := args[0].(string)
+		if !ok {
+			return info.Error("templating function generateCertificate expects a string as 1st argument, namely the name")
+		}
+		rawSpec, err := spiffValue(args[1])
+		if err != nil {
+			return info.Error("templating function generateCertificate expects a certificate spec as 2nd argument: %w", err)
+		}
+		spec, err := funcs.ParseCertificateSpec(rawSpec)
+		if err != nil {
+			return info.Error(err)
+		}
+
+		res, err := secretGenerator.GenerateCertificate(ctx, name, spec)
+		if err != nil {
+			return info.Error(err)
+		}
+		return spiffResult(res, info, binding)
+	}
+}
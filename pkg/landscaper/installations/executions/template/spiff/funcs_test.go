@@ -0,0 +1,155 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package spiff_test
+
+import (
+	"context"
+	"encoding/base64"
+
+	"github.com/mandelsoft/spiff/spiffing"
+	spiffyaml "github.com/mandelsoft/spiff/yaml"
+	"github.com/mandelsoft/vfs/pkg/memoryfs"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"sigs.k8s.io/yaml"
+
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/funcs"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/spiff"
+	"github.com/gardener/landscaper/pkg/utils/blueprints"
+)
+
+var _ = Describe("Template functions", func() {
+
+	var state template.MemoryStateHandler
+
+	BeforeEach(func() {
+		state = template.NewMemoryStateHandler()
+	})
+
+	evaluate := func(tmpl string, values map[string]interface{}) (map[string]interface{}, error) {
+		bp := blueprints.New(nil, memoryfs.New())
+		functions := spiffing.NewFunctions()
+		if err := spiff.LandscaperSpiffFuncs(context.Background(), bp, functions, nil, nil, nil, state); err != nil {
+			return nil, err
+		}
+		s, err := spiffing.New().WithFunctions(functions).WithValues(values)
+		if err != nil {
+			return nil, err
+		}
+		node, err := spiffyaml.Unmarshal("template", []byte(tmpl))
+		if err != nil {
+			return nil, err
+		}
+		res, err := s.Cascade(node, nil)
+		if err != nil {
+			return nil, err
+		}
+		data, err := spiffyaml.Marshal(res)
+		if err != nil {
+			return nil, err
+		}
+		out := map[string]interface{}{}
+		return out, yaml.Unmarshal(data, &out)
+	}
+
+	execute := func(tmpl string, values map[string]interface{}) map[string]interface{} {
+		res, err := evaluate(tmpl, values)
+		ExpectWithOffset(1, err).ToNot(HaveOccurred())
+		return res
+	}
+
+	It("should patch and query documents", func() {
+		values := map[string]interface{}{
+			"values": map[string]interface{}{
+				"doc": map[string]interface{}{
+					"spec": map[string]interface{}{
+						"replicas": 1,
+						"names":    []interface{}{"a", "b"},
+					},
+				},
+			},
+		}
+		res := execute(`
+jsonPatch: (( jsonPatch([{"op"="replace", "path"="/spec/replicas", "value"=3}], values.doc) ))
+jsonMergePatch: (( jsonMergePatch({"spec"={"names"=~}}, values.doc) ))
+jsonPath: (( jsonPath("{.spec.names[*]}", values.doc) ))
+jmesPath: (( jmesPath("spec.names[1]", values.doc) ))
+`, values)
+		Expect(res).To(HaveKeyWithValue("jsonPatch", HaveKeyWithValue("spec", HaveKeyWithValue("replicas", float64(3)))))
+		Expect(res).To(HaveKeyWithValue("jsonMergePatch", Equal(map[string]interface{}{
+			"spec": map[string]interface{}{"replicas": float64(1)},
+		})))
+		Expect(res).To(HaveKeyWithValue("jsonPath", ConsistOf("a", "b")))
+		Expect(res).To(HaveKeyWithValue("jmesPath", "b"))
+	})
+
+	It("should calculate cidrs and compare versions", func() {
+		res := execute(`
+host: (( cidrHost("10.0.0.0/16", 5) ))
+subnet: (( cidrSubnet("10.0.0.0/16", 8, 2) ))
+netmask: (( cidrNetmask("10.0.0.0/12") ))
+contains: (( cidrContains("10.0.0.0/16", "10.0.3.4") ))
+compare: (( compareSemver("1.2.3", "1.10.0") ))
+match: (( matchSemver(">= 1.2, < 2", "v1.5.0") ))
+`, nil)
+		Expect(res).To(Equal(map[string]interface{}{
+			"host":     "10.0.0.5",
+			"subnet":   "10.0.2.0/24",
+			"netmask":  "255.240.0.0",
+			"contains": true,
+			"compare":  float64(-1),
+			"match":    true,
+		}))
+	})
+
+	It("should generate secrets that are stable across executions", func() {
+		tmpl := `
+password: (( generatePassword("admin", 16) ))
+key: (( generateKey("encryption", 32) ))
+cert: (( generateCertificate("server", {"commonName"="my-service", "dnsNames"=["my-service.default"]}) ))
+`
+		res := execute(tmpl, nil)
+		Expect(res["password"]).To(MatchRegexp("^[a-zA-Z0-9]{16}$"))
+		Expect(res["cert"]).To(HaveKeyWithValue("certificate", ContainSubstring("BEGIN CERTIFICATE")))
+		Expect(state).To(HaveKey(funcs.SeedStateName))
+		Expect(state).To(HaveKey(funcs.CertificateStatePrefix + "server"))
+
+		Expect(execute(tmpl, nil)).To(Equal(res))
+	})
+
+	It("should derive the same secrets as the go template functions", func() {
+		generator := funcs.NewSecretGenerator(state)
+		password, err := generator.GeneratePassword(context.Background(), "admin", 16)
+		Expect(err).ToNot(HaveOccurred())
+
+		res := execute(`password: (( generatePassword("admin", 16) ))`, nil)
+		Expect(res).To(HaveKeyWithValue("password", password))
+	})
+
+	It("should parse a base64 encoded kubeconfig", func() {
+		kubeconfig := "apiVersion: v1\nkind: Config\ncurrent-context: test\n" +
+			"contexts:\n- name: test\n  context: {cluster: test, user: test}\n" +
+			"clusters:\n- name: test\n  cluster: {server: \"https://api.example.com\"}\n" +
+			"users:\n- name: test\n  user: {token: my-token}\n"
+		values := map[string]interface{}{
+			"values": map[string]interface{}{
+				"kubeconfig": base64.StdEncoding.EncodeToString([]byte(kubeconfig)),
+			},
+		}
+		res := execute(`kubeconfig: (( parseKubeconfig(values.kubeconfig) ))`, values)
+		Expect(res).To(HaveKeyWithValue("kubeconfig", And(
+			HaveKeyWithValue("server", "https://api.example.com"),
+			HaveKeyWithValue("token", "my-token"),
+		)))
+	})
+
+	It("should fail for invalid arguments", func() {
+		_, err := evaluate(`host: (( cidrHost("10.0.0.0/24", 256) ))`, nil)
+		Expect(err).To(HaveOccurred())
+		_, err = evaluate(`compare: (( compareSemver("abc") ))`, nil)
+		Expect(err).To(HaveOccurred())
+	})
+})
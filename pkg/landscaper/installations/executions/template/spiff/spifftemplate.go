@@ -22,6 +22,7 @@ import (
 
 // Templater describes the spiff template implementation for execution templater.
 type Templater struct {
+	ctx            context.Context
 	state          template.GenericStateHandler
 	inputFormatter *template.TemplateInputFormatter
 	targetResolver targetresolver.TargetResolver
@@ -30,6 +31,7 @@ type Templater struct {
 // New creates a new spiff execution templater.
 func New(state template.GenericStateHandler, targetResolver targetresolver.TargetResolver) *Templater {
 	return &Templater{
+		ctx:            context.Background(),
 		state:          state,
 		inputFormatter: template.NewTemplateInputFormatter(false, "imports", "values", "state"),
 		targetResolver: targetResolver,
@@ -42,6 +44,12 @@ func (t *Templater) WithInputFormatter(inputFormatter *template.TemplateInputFor
 	return t
 }
 
+// WithContext sets the context that is used to access the state, e.g. when secrets are generated during templating.
+func (t *Templater) WithContext(ctx context.Context) *Templater {
+	t.ctx = ctx
+	return t
+}
+
 func (t Templater) Type() lsv1alpha1.TemplateType {
 	return lsv1alpha1.SpiffTemplateType
 }
@@ -56,15 +64,14 @@ func (t *Templater) TemplateSubinstallationExecutions(tmplExec lsv1alpha1.Templa
 	if err != nil {
 		return nil, err
 	}
-	ctx := context.Background()
-	defer ctx.Done()
+	ctx := t.ctx
 	stateNode, err := t.getDeployExecutionState(ctx, tmplExec)
 	if err != nil {
 		return nil, fmt.Errorf("unable to load state: %w", err)
 	}
 
 	functions := spiffing.NewFunctions()
-	if err = LandscaperSpiffFuncs(blueprint, functions, cd, cdList, t.targetResolver); err != nil {
+	if err = LandscaperSpiffFuncs(ctx, blueprint, functions, cd, cdList, t.targetResolver, t.state); err != nil {
 		return nil, err
 	}
 
@@ -105,11 +112,10 @@ func (t *Templater) TemplateImportExecutions(tmplExec lsv1alpha1.TemplateExecuto
 	if err != nil {
 		return nil, err
 	}
-	ctx := context.Background()
-	defer ctx.Done()
+	ctx := t.ctx
 
 	functions := spiffing.NewFunctions()
-	if err = LandscaperSpiffFuncs(blueprint, functions, descriptor, cdList, t.targetResolver); err != nil {
+	if err = LandscaperSpiffFuncs(ctx, blueprint, functions, descriptor, cdList, t.targetResolver, t.state); err != nil {
 		return nil, err
 	}
 
@@ -147,15 +153,14 @@ func (t *Templater) TemplateDeployExecutions(tmplExec lsv1alpha1.TemplateExecuto
 	if err != nil {
 		return nil, err
 	}
-	ctx := context.Background()
-	defer ctx.Done()
+	ctx := t.ctx
 	stateNode, err := t.getDeployExecutionState(ctx, tmplExec)
 	if err != nil {
 		return nil, fmt.Errorf("unable to load state: %w", err)
 	}
 
 	functions := spiffing.NewFunctions()
-	if err = LandscaperSpiffFuncs(blueprint, functions, descriptor, cdList, t.targetResolver); err != nil {
+	if err = LandscaperSpiffFuncs(ctx, blueprint, functions, descriptor, cdList, t.targetResolver, t.state); err != nil {
 		return nil, err
 	}
 
@@ -196,15 +201,14 @@ func (t *Templater) TemplateExportExecutions(tmplExec lsv1alpha1.TemplateExecuto
 	if err != nil {
 		return nil, err
 	}
-	ctx := context.Background()
-	defer ctx.Done()
+	ctx := t.ctx
 	stateNode, err := t.getExportExecutionState(ctx, tmplExec)
 	if err != nil {
 		return nil, fmt.Errorf("unable to load state: %w", err)
 	}
 
 	functions := spiffing.NewFunctions()
-	if err = LandscaperSpiffFuncs(blueprint, functions, descriptor, cdList, t.targetResolver); err != nil {
+	if err = LandscaperSpiffFuncs(ctx, blueprint, functions, descriptor, cdList, t.targetResolver, t.state); err != nil {
 		return nil, err
 	}
 
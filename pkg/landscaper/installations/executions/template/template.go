@@ -14,6 +14,7 @@ import (
 	_ "github.com/open-component-model/ocm/pkg/contexts/ocm/compdesc/versions/v2"
 	ocmruntime "github.com/open-component-model/ocm/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	"github.com/gardener/landscaper/apis/core"
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
@@ -74,6 +75,7 @@ type ExecutionTemplater interface {
 // SubinstallationExecutorOutput describes the output of deploy executor.
 type SubinstallationExecutorOutput struct {
 	Subinstallations []*lsv1alpha1.InstallationTemplate `json:"subinstallations"`
+	Rollouts         []RolloutSpecification             `json:"rollouts,omitempty"`
 }
 
 func (o SubinstallationExecutorOutput) MarshalJSON() ([]byte, error) {
@@ -82,7 +84,8 @@ func (o SubinstallationExecutorOutput) MarshalJSON() ([]byte, error) {
 
 func (o *SubinstallationExecutorOutput) UnmarshalJSON(data []byte) error {
 	type helperStruct struct {
-		Subinstallations []json.RawMessage `json:"subinstallations"`
+		Subinstallations []json.RawMessage      `json:"subinstallations"`
+		Rollouts         []RolloutSpecification `json:"rollouts,omitempty"`
 	}
 	rawList := &helperStruct{}
 	if err := json.Unmarshal(data, rawList); err != nil {
@@ -91,6 +94,7 @@ func (o *SubinstallationExecutorOutput) UnmarshalJSON(data []byte) error {
 
 	out := SubinstallationExecutorOutput{
 		Subinstallations: make([]*lsv1alpha1.InstallationTemplate, len(rawList.Subinstallations)),
+		Rollouts:         rawList.Rollouts,
 	}
 	for i, raw := range rawList.Subinstallations {
 		instTmpl := lsv1alpha1.InstallationTemplate{}
@@ -158,11 +162,45 @@ type DeployItemSpecification struct {
 	UpdateOnChangeOnly bool `json:"updateOnChangeOnly,omitempty"`
 
 	OnDelete *core.OnDeleteConfig
+
+	// RequireApproval specifies that every job of the deploy item must be approved before it is deployed.
+	// +optional
+	RequireApproval bool `json:"requireApproval,omitempty"`
 }
 
 // DeployExecutorOutput describes the output of deploy executor.
 type DeployExecutorOutput struct {
 	DeployItems []DeployItemSpecification `json:"deployItems"`
+	Rollouts    []RolloutSpecification    `json:"rollouts,omitempty"`
+}
+
+// RolloutSpecification defines the progressive rollout of the deploy items or subinstallations
+// for the targets of a target map import.
+// It is translated into a rollout of the execution object or of the subinstallations of the installation.
+type RolloutSpecification struct {
+	// Name is the unique name of the rollout. Defaults to the name of the target map import.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// TargetMapImport is the name of the target map import. All deploy items or subinstallations that reference
+	// a target of this import are rolled out.
+	TargetMapImport string `json:"targetMapImport"`
+
+	// Canary is the key of a target that is rolled out first and must succeed before the other targets are rolled out.
+	// +optional
+	Canary string `json:"canary,omitempty"`
+
+	// BatchSize is the number or the percentage of targets that are rolled out in parallel.
+	// +optional
+	BatchSize *intstr.IntOrString `json:"batchSize,omitempty"`
+
+	// PauseBetweenBatches is the time between the end of a batch and the start of the next batch.
+	// +optional
+	PauseBetweenBatches *lsv1alpha1.Duration `json:"pauseBetweenBatches,omitempty"`
+
+	// MaxFailedTargets is the number of failed targets that is tolerated before the rollout is stopped.
+	// +optional
+	MaxFailedTargets *int `json:"maxFailedTargets,omitempty"`
 }
 
 // ExportExecutorOutput describes the output of export executor.
@@ -215,11 +253,24 @@ func (o *Templater) TemplateImportExecutions(opts BlueprintExecutionOptions) ([]
 // TemplateSubinstallationExecutions templates all subinstallation executions and
 // returns a aggregated list of all templated installation templates.
 func (o *Templater) TemplateSubinstallationExecutions(opts DeployExecutionOptions) ([]*lsv1alpha1.InstallationTemplate, error) {
+	output, err := o.TemplateSubinstallationExecutionsWithRollouts(opts)
+	if err != nil {
+		return nil, err
+	}
+	return output.Subinstallations, nil
+}
+
+// TemplateSubinstallationExecutionsWithRollouts templates all subinstallation executions and returns the installation
+// templates and the rollouts of all subinstallation executions.
+func (o *Templater) TemplateSubinstallationExecutionsWithRollouts(opts DeployExecutionOptions) (*SubinstallationExecutorOutput, error) {
 	values, err := opts.Values()
 	if err != nil {
 		return nil, err
 	}
-	installationTemplates := make([]*lsv1alpha1.InstallationTemplate, 0)
+
+	result := &SubinstallationExecutorOutput{
+		Subinstallations: make([]*lsv1alpha1.InstallationTemplate, 0),
+	}
 	for _, tmplExec := range opts.Blueprint.Info.SubinstallationExecutions {
 		impl, ok := o.impl[tmplExec.Type]
 		if !ok {
@@ -230,24 +281,37 @@ func (o *Templater) TemplateSubinstallationExecutions(opts DeployExecutionOption
 		if err != nil {
 			return nil, err
 		}
+		result.Rollouts = append(result.Rollouts, output.Rollouts...)
 		if output.Subinstallations == nil {
 			continue
 		}
-		installationTemplates = append(installationTemplates, output.Subinstallations...)
+		result.Subinstallations = append(result.Subinstallations, output.Subinstallations...)
 	}
 
-	return installationTemplates, nil
+	return result, nil
 }
 
 // TemplateDeployExecutions templates all deploy executions and returns a aggregated list of all templated deploy item templates.
 func (o *Templater) TemplateDeployExecutions(opts DeployExecutionOptions) ([]DeployItemSpecification, error) {
+	output, err := o.TemplateDeployExecutionsWithRollouts(opts)
+	if err != nil {
+		return nil, err
+	}
+	return output.DeployItems, nil
+}
+
+// TemplateDeployExecutionsWithRollouts templates all deploy executions and returns the deploy items and the rollouts
+// of all deploy executions.
+func (o *Templater) TemplateDeployExecutionsWithRollouts(opts DeployExecutionOptions) (*DeployExecutorOutput, error) {
 
 	values, err := opts.Values()
 	if err != nil {
 		return nil, err
 	}
 
-	deployItemTemplateList := []DeployItemSpecification{}
+	result := &DeployExecutorOutput{
+		DeployItems: []DeployItemSpecification{},
+	}
 	for _, tmplExec := range opts.Blueprint.Info.DeployExecutions {
 		impl, ok := o.impl[tmplExec.Type]
 		if !ok {
@@ -258,13 +322,14 @@ func (o *Templater) TemplateDeployExecutions(opts DeployExecutionOptions) ([]Dep
 		if err != nil {
 			return nil, err
 		}
+		result.Rollouts = append(result.Rollouts, output.Rollouts...)
 		if output.DeployItems == nil {
 			continue
 		}
-		deployItemTemplateList = append(deployItemTemplateList, output.DeployItems...)
+		result.DeployItems = append(result.DeployItems, output.DeployItems...)
 	}
 
-	return deployItemTemplateList, nil
+	return result, nil
 }
 
 // TemplateExportExecutions templates all exports.
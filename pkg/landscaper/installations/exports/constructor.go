@@ -30,6 +30,7 @@ import (
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/gotemplate"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/spiff"
+	"github.com/gardener/landscaper/pkg/utils/tracing"
 )
 
 // Constructor is a struct that contains all values
@@ -84,8 +85,9 @@ func (c *Constructor) Construct(ctx context.Context) ([]*dataobjects.DataObject,
 	targetResolver := genericresolver.New(c.LsUncachedClient())
 
 	tmpl := template.New(
-		gotemplate.New(stateHdlr, targetResolver),
-		spiff.New(stateHdlr, targetResolver))
+		gotemplate.New(stateHdlr, targetResolver).WithContext(ctx),
+		spiff.New(stateHdlr, targetResolver).WithContext(ctx))
+	_, span := tracing.StartSpan(ctx, "TemplateExportExecutions")
 	exports, err := tmpl.TemplateExportExecutions(
 		template.NewExportExecutionOptions(
 			template.NewBlueprintExecutionOptions(
@@ -94,6 +96,7 @@ func (c *Constructor) Construct(ctx context.Context) ([]*dataobjects.DataObject,
 				c.ComponentVersion,
 				c.ResolvedComponentDescriptorList,
 				c.Inst.GetImports()), internalExports))
+	tracing.EndSpan(ctx, span, err)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -0,0 +1,147 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package generatedsecrets implements the certificates, keys and passwords that are defined in the
+// generatedSecrets section of a blueprint. The values are generated once, stored in secrets owned by the
+// installation and regenerated if their definition changes or their rotation time is reached.
+package generatedsecrets
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+)
+
+// Manager ensures the generated secrets of an installation.
+type Manager struct {
+	store Store
+	now   func() time.Time
+}
+
+// NewManager creates a new manager that persists the generated secrets in the given store.
+func NewManager(store Store) *Manager {
+	return &Manager{
+		store: store,
+		now:   time.Now,
+	}
+}
+
+// WithClock sets the function that returns the current time.
+func (m *Manager) WithClock(now func() time.Time) *Manager {
+	m.now = now
+	return m
+}
+
+// Ensure returns the values of the generated secrets by the names of their definitions together with their status.
+// Values are generated if they do not exist yet, if their definition or the imports they depend on changed,
+// or if their rotation time is reached. The secrets of definitions that no longer exist are deleted.
+func (m *Manager) Ensure(ctx context.Context, defs []lsv1alpha1.GeneratedSecretDefinition,
+	imports map[string]interface{}) (map[string]interface{}, []lsv1alpha1.GeneratedSecretStatus, error) {
+
+	now := m.now()
+	values := make(map[string]interface{}, len(defs))
+	status := make([]lsv1alpha1.GeneratedSecretStatus, 0, len(defs))
+	secrets := make(map[string]*Secret, len(defs))
+
+	for _, def := range defs {
+		sans, err := resolveSANs(def, imports)
+		if err != nil {
+			return nil, nil, fmt.Errorf("generated secret %q: %w", def.Name, err)
+		}
+
+		var ca *Secret
+		if def.Type == lsv1alpha1.GeneratedSecretTypeCertificate && def.Certificate != nil && len(def.Certificate.CA) != 0 {
+			ca = secrets[def.Certificate.CA]
+			if ca == nil {
+				return nil, nil, fmt.Errorf("generated secret %q: certificate authority %q is not defined before", def.Name, def.Certificate.CA)
+			}
+		}
+
+		hash, err := specHash(def, sans, ca)
+		if err != nil {
+			return nil, nil, fmt.Errorf("generated secret %q: unable to calculate hash: %w", def.Name, err)
+		}
+
+		secret, err := m.store.Get(ctx, def.Name)
+		if err != nil {
+			return nil, nil, err
+		}
+		if secret == nil || secret.SpecHash != hash || isRotationDue(def, secret, now) {
+			secret, err = generate(def, sans, ca, now)
+			if err != nil {
+				return nil, nil, err
+			}
+			secret.SpecHash = hash
+			if err := m.store.Store(ctx, secret); err != nil {
+				return nil, nil, err
+			}
+		}
+
+		secretStatus, err := newStatus(def, secret)
+		if err != nil {
+			return nil, nil, err
+		}
+		secrets[def.Name] = secret
+		values[def.Name] = secret.Value(def.Type)
+		status = append(status, secretStatus)
+	}
+
+	if err := m.store.Prune(ctx, sets.KeySet(secrets)); err != nil {
+		return nil, nil, err
+	}
+	return values, status, nil
+}
+
+// isRotationDue checks whether the values of a generated secret have to be regenerated.
+// Values whose certificate cannot be read are regenerated, too.
+func isRotationDue(def lsv1alpha1.GeneratedSecretDefinition, secret *Secret, now time.Time) bool {
+	rotationTime, err := secret.rotationTime(def)
+	if err != nil {
+		return true
+	}
+	return rotationTime != nil && !now.Before(*rotationTime)
+}
+
+func newStatus(def lsv1alpha1.GeneratedSecretDefinition, secret *Secret) (lsv1alpha1.GeneratedSecretStatus, error) {
+	status := lsv1alpha1.GeneratedSecretStatus{
+		Name:           def.Name,
+		SecretName:     secret.SecretName,
+		GenerationTime: metav1.NewTime(secret.GenerationTime),
+	}
+	expirationTime, err := secret.expirationTime(def.Type)
+	if err != nil {
+		return status, fmt.Errorf("generated secret %q: %w", def.Name, err)
+	}
+	if expirationTime != nil {
+		t := metav1.NewTime(*expirationTime)
+		status.ExpirationTime = &t
+	}
+	rotationTime, err := secret.rotationTime(def)
+	if err != nil {
+		return status, fmt.Errorf("generated secret %q: %w", def.Name, err)
+	}
+	if rotationTime != nil {
+		t := metav1.NewTime(*rotationTime)
+		status.RotationTime = &t
+	}
+	return status, nil
+}
+
+// NextRotationTime returns the earliest rotation time of the given generated secrets
+// or nil if none of them is rotated.
+func NextRotationTime(status []lsv1alpha1.GeneratedSecretStatus) *time.Time {
+	var next *time.Time
+	for _, s := range status {
+		if s.RotationTime != nil && (next == nil || s.RotationTime.Time.Before(*next)) {
+			t := s.RotationTime.Time
+			next = &t
+		}
+	}
+	return next
+}
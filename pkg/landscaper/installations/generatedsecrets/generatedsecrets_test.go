@@ -0,0 +1,223 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package generatedsecrets_test
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"net"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"golang.org/x/crypto/ssh"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/api"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/generatedsecrets"
+)
+
+var _ = Describe("Generated Secrets", func() {
+
+	var (
+		ctx   context.Context
+		now   time.Time
+		store generatedsecrets.MemoryStore
+		mgr   *generatedsecrets.Manager
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		now = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		store = generatedsecrets.NewMemoryStore()
+		mgr = generatedsecrets.NewManager(store).WithClock(func() time.Time { return now })
+	})
+
+	parseCertificate := func(value interface{}) *x509.Certificate {
+		values, ok := value.(map[string]interface{})
+		Expect(ok).To(BeTrue())
+		block, _ := pem.Decode([]byte(values[generatedsecrets.CertificateKey].(string)))
+		Expect(block).ToNot(BeNil())
+		cert, err := x509.ParseCertificate(block.Bytes)
+		Expect(err).ToNot(HaveOccurred())
+		return cert
+	}
+
+	It("should generate a certificate that is signed by a generated certificate authority", func() {
+		defs := []lsv1alpha1.GeneratedSecretDefinition{
+			{
+				Name:        "ca",
+				Type:        lsv1alpha1.GeneratedSecretTypeCA,
+				Certificate: &lsv1alpha1.GeneratedCertificate{CommonName: "my-ca"},
+			},
+			{
+				Name: "tls",
+				Type: lsv1alpha1.GeneratedSecretTypeCertificate,
+				Certificate: &lsv1alpha1.GeneratedCertificate{
+					CommonName: "my-server",
+					DNSNames:   []string{"my-server.example.com"},
+					SANsFromImports: []lsv1alpha1.GeneratedSecretImportReference{
+						{Import: "config", Path: ".hosts"},
+					},
+					CA: "ca",
+				},
+			},
+		}
+		imports := map[string]interface{}{
+			"config": map[string]interface{}{
+				"hosts": []interface{}{"api.example.com", "10.0.0.1"},
+			},
+		}
+
+		values, status, err := mgr.Ensure(ctx, defs, imports)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(status).To(HaveLen(2))
+
+		caCert := parseCertificate(values["ca"])
+		Expect(caCert.IsCA).To(BeTrue())
+		Expect(caCert.NotAfter.Sub(now)).To(BeNumerically(">=", 10*365*24*time.Hour))
+
+		cert := parseCertificate(values["tls"])
+		Expect(cert.IsCA).To(BeFalse())
+		Expect(cert.Subject.CommonName).To(Equal("my-server"))
+		Expect(cert.DNSNames).To(ConsistOf("my-server.example.com", "api.example.com"))
+		Expect(cert.IPAddresses).To(HaveLen(1))
+		Expect(cert.IPAddresses[0].Equal(net.ParseIP("10.0.0.1"))).To(BeTrue())
+		Expect(cert.CheckSignatureFrom(caCert)).To(Succeed())
+		Expect(values["tls"]).To(HaveKeyWithValue(generatedsecrets.CAKey, values["ca"].(map[string]interface{})[generatedsecrets.CertificateKey]))
+
+		// a third of the validity is the default renewal period
+		Expect(status[1].ExpirationTime.Time).To(Equal(cert.NotAfter))
+		Expect(status[1].RotationTime.Time).To(Equal(cert.NotAfter.Add(-cert.NotAfter.Sub(cert.NotBefore) / 3)))
+	})
+
+	It("should keep the generated values until the definition changes", func() {
+		defs := []lsv1alpha1.GeneratedSecretDefinition{
+			{Name: "password", Type: lsv1alpha1.GeneratedSecretTypePassword},
+		}
+		values, status, err := mgr.Ensure(ctx, defs, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(values["password"]).To(HaveLen(32))
+		Expect(status[0].RotationTime).To(BeNil())
+
+		now = now.Add(24 * time.Hour)
+		again, _, err := mgr.Ensure(ctx, defs, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(again["password"]).To(Equal(values["password"]))
+
+		defs[0].Password = &lsv1alpha1.GeneratedPassword{Length: 16}
+		changed, _, err := mgr.Ensure(ctx, defs, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(changed["password"]).To(HaveLen(16))
+	})
+
+	It("should reissue a certificate if an imported subject alternative name changes", func() {
+		defs := []lsv1alpha1.GeneratedSecretDefinition{
+			{
+				Name: "tls",
+				Type: lsv1alpha1.GeneratedSecretTypeCertificate,
+				Certificate: &lsv1alpha1.GeneratedCertificate{
+					CommonName:      "my-server",
+					SANsFromImports: []lsv1alpha1.GeneratedSecretImportReference{{Import: "host"}},
+				},
+			},
+		}
+		values, _, err := mgr.Ensure(ctx, defs, map[string]interface{}{"host": "a.example.com"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(parseCertificate(values["tls"]).DNSNames).To(ConsistOf("a.example.com"))
+
+		values, _, err = mgr.Ensure(ctx, defs, map[string]interface{}{"host": "b.example.com"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(parseCertificate(values["tls"]).DNSNames).To(ConsistOf("b.example.com"))
+	})
+
+	It("should rotate a generated secret when its rotation interval has passed", func() {
+		defs := []lsv1alpha1.GeneratedSecretDefinition{
+			{
+				Name:       "ssh",
+				Type:       lsv1alpha1.GeneratedSecretTypeSSHKeyPair,
+				Rotation:   &lsv1alpha1.GeneratedSecretRotation{Interval: &lsv1alpha1.Duration{Duration: time.Hour}},
+				SSHKeyPair: &lsv1alpha1.GeneratedSSHKeyPair{Algorithm: lsv1alpha1.SSHKeyAlgorithmEd25519},
+			},
+		}
+		values, status, err := mgr.Ensure(ctx, defs, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(status[0].RotationTime.Time).To(Equal(now.Add(time.Hour)))
+		Expect(generatedsecrets.NextRotationTime(status)).To(PointTo(Equal(now.Add(time.Hour))))
+
+		keyPair := values["ssh"].(map[string]interface{})
+		publicKey, _, _, _, err := ssh.ParseAuthorizedKey([]byte(keyPair[generatedsecrets.PublicKeyKey].(string)))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(publicKey.Type()).To(Equal(ssh.KeyAlgoED25519))
+		_, err = ssh.ParsePrivateKey([]byte(keyPair[generatedsecrets.PrivateKeyKey].(string)))
+		Expect(err).ToNot(HaveOccurred())
+
+		now = now.Add(30 * time.Minute)
+		again, _, err := mgr.Ensure(ctx, defs, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(again["ssh"]).To(Equal(values["ssh"]))
+
+		now = now.Add(30 * time.Minute)
+		rotated, status, err := mgr.Ensure(ctx, defs, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(rotated["ssh"]).ToNot(Equal(values["ssh"]))
+		Expect(status[0].GenerationTime.Time).To(Equal(now))
+	})
+
+	It("should store the generated secrets in secrets owned by the installation and delete obsolete ones", func() {
+		kubeClient := fake.NewClientBuilder().WithScheme(api.LandscaperScheme).Build()
+		inst := &lsv1alpha1.Installation{}
+		inst.Name = "my-inst"
+		inst.Namespace = "default"
+		inst.UID = "123"
+		kubeStore := &generatedsecrets.KubernetesStore{KubeClient: kubeClient, Inst: inst}
+		kubeMgr := generatedsecrets.NewManager(kubeStore).WithClock(func() time.Time { return now })
+
+		defs := []lsv1alpha1.GeneratedSecretDefinition{
+			{Name: "password", Type: lsv1alpha1.GeneratedSecretTypePassword},
+			{Name: "other", Type: lsv1alpha1.GeneratedSecretTypePassword},
+		}
+		values, status, err := kubeMgr.Ensure(ctx, defs, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(status[0].SecretName).To(Equal(kubeStore.SecretName("password")))
+
+		secret := &corev1.Secret{}
+		Expect(kubeClient.Get(ctx, client.ObjectKey{Name: status[0].SecretName, Namespace: "default"}, secret)).To(Succeed())
+		Expect(secret.Labels).To(HaveKeyWithValue(lsv1alpha1.GeneratedSecretInstallationLabel, "my-inst"))
+		Expect(secret.Annotations).To(HaveKeyWithValue(lsv1alpha1.GeneratedSecretNameAnnotation, "password"))
+		Expect(metav1.IsControlledBy(secret, inst)).To(BeTrue())
+		Expect(string(secret.Data[generatedsecrets.PasswordKey])).To(Equal(values["password"]))
+
+		again, _, err := kubeMgr.Ensure(ctx, defs[:1], nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(again["password"]).To(Equal(values["password"]))
+
+		secrets := &corev1.SecretList{}
+		Expect(kubeClient.List(ctx, secrets)).To(Succeed())
+		Expect(secrets.Items).To(HaveLen(1))
+		Expect(secrets.Items[0].Name).To(Equal(status[0].SecretName))
+	})
+
+	It("should fail if the subject alternative names of an import are no strings", func() {
+		defs := []lsv1alpha1.GeneratedSecretDefinition{
+			{
+				Name: "tls",
+				Type: lsv1alpha1.GeneratedSecretTypeCertificate,
+				Certificate: &lsv1alpha1.GeneratedCertificate{
+					CommonName:      "my-server",
+					SANsFromImports: []lsv1alpha1.GeneratedSecretImportReference{{Import: "config"}},
+				},
+			},
+		}
+		_, _, err := mgr.Ensure(ctx, defs, map[string]interface{}{"config": map[string]interface{}{"a": "b"}})
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,340 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package generatedsecrets
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"sort"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/funcs"
+)
+
+const (
+	// CertificateKey is the key of the PEM encoded certificate of a generated certificate authority or certificate.
+	CertificateKey = "certificate"
+	// PrivateKeyKey is the key of the PEM encoded private key of a generated certificate authority, certificate
+	// or ssh key pair.
+	PrivateKeyKey = "privateKey"
+	// CAKey is the key of the PEM encoded certificate authority of a generated certificate authority or certificate.
+	CAKey = "ca"
+	// PublicKeyKey is the key of the public key of a generated ssh key pair in the authorized_keys format.
+	PublicKeyKey = "publicKey"
+	// PasswordKey is the key of a generated password.
+	PasswordKey = "password"
+
+	defaultCAValidity          = 10 * 365 * 24 * time.Hour
+	defaultCertificateValidity = 365 * 24 * time.Hour
+	defaultRSABits             = 4096
+	defaultPasswordLength      = 32
+
+	passwordCharacters = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+)
+
+// Secret contains the generated values of a generated secret definition.
+type Secret struct {
+	// Name is the name of the generated secret definition.
+	Name string
+	// SecretName is the name of the kubernetes secret in which the values are stored.
+	SecretName string
+	// SpecHash is the hash of the definition from which the values were generated.
+	SpecHash string
+	// GenerationTime is the time when the values were generated.
+	GenerationTime time.Time
+	// Data contains the generated values.
+	Data map[string]string
+}
+
+// Value returns the value of the generated secret as it is available in the templates.
+// Passwords are returned as string, all other types as map of the generated values.
+func (s *Secret) Value(secretType lsv1alpha1.GeneratedSecretType) interface{} {
+	if secretType == lsv1alpha1.GeneratedSecretTypePassword {
+		return s.Data[PasswordKey]
+	}
+	value := make(map[string]interface{}, len(s.Data))
+	for k, v := range s.Data {
+		value[k] = v
+	}
+	return value
+}
+
+// expirationTime returns the expiration time of a generated certificate.
+// Nil is returned for all other secret types.
+func (s *Secret) expirationTime(secretType lsv1alpha1.GeneratedSecretType) (*time.Time, error) {
+	if !isCertificate(secretType) {
+		return nil, nil
+	}
+	cert, err := parseCertificate(s.Data[CertificateKey])
+	if err != nil {
+		return nil, err
+	}
+	return &cert.NotAfter, nil
+}
+
+// rotationTime returns the time from which on the values of a generated secret are regenerated.
+// Nil is returned if the secret is not rotated.
+func (s *Secret) rotationTime(def lsv1alpha1.GeneratedSecretDefinition) (*time.Time, error) {
+	var rotation *time.Time
+	if isCertificate(def.Type) {
+		cert, err := parseCertificate(s.Data[CertificateKey])
+		if err != nil {
+			return nil, err
+		}
+		renewBefore := cert.NotAfter.Sub(cert.NotBefore) / 3
+		if def.Rotation != nil && def.Rotation.RenewBefore != nil {
+			renewBefore = def.Rotation.RenewBefore.Duration
+		}
+		t := cert.NotAfter.Add(-renewBefore)
+		rotation = &t
+	}
+	if def.Rotation != nil && def.Rotation.Interval != nil {
+		t := s.GenerationTime.Add(def.Rotation.Interval.Duration)
+		if rotation == nil || t.Before(*rotation) {
+			rotation = &t
+		}
+	}
+	return rotation, nil
+}
+
+// specHash calculates the hash of all inputs from which the values of a generated secret are generated.
+// The rotation settings are not part of the hash as their change does not require new values.
+func specHash(def lsv1alpha1.GeneratedSecretDefinition, sans []string, ca *Secret) (string, error) {
+	spec := struct {
+		Type        lsv1alpha1.GeneratedSecretType   `json:"type"`
+		Certificate *lsv1alpha1.GeneratedCertificate `json:"certificate,omitempty"`
+		SSHKeyPair  *lsv1alpha1.GeneratedSSHKeyPair  `json:"sshKeyPair,omitempty"`
+		Password    *lsv1alpha1.GeneratedPassword    `json:"password,omitempty"`
+		SANs        []string                         `json:"sans,omitempty"`
+		CA          string                           `json:"ca,omitempty"`
+	}{
+		Type:        def.Type,
+		Certificate: def.Certificate,
+		SSHKeyPair:  def.SSHKeyPair,
+		Password:    def.Password,
+		SANs:        sans,
+	}
+	if ca != nil {
+		// certificates are reissued if their certificate authority is rotated
+		spec.CA = ca.Data[CertificateKey]
+	}
+	data, err := json.Marshal(spec)
+	if err != nil {
+		return "", err
+	}
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:]), nil
+}
+
+// generate generates new values for a generated secret definition.
+// The additional subject alternative names and the signing certificate authority are only used for certificates.
+func generate(def lsv1alpha1.GeneratedSecretDefinition, sans []string, ca *Secret, now time.Time) (*Secret, error) {
+	var (
+		data map[string]string
+		err  error
+	)
+	switch def.Type {
+	case lsv1alpha1.GeneratedSecretTypeCA, lsv1alpha1.GeneratedSecretTypeCertificate:
+		data, err = generateCertificate(def, sans, ca, now)
+	case lsv1alpha1.GeneratedSecretTypeSSHKeyPair:
+		data, err = generateSSHKeyPair(def.SSHKeyPair)
+	case lsv1alpha1.GeneratedSecretTypePassword:
+		data, err = generatePassword(def.Password)
+	default:
+		err = fmt.Errorf("unsupported type %q", def.Type)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to generate secret %q: %w", def.Name, err)
+	}
+	return &Secret{
+		Name:           def.Name,
+		GenerationTime: now,
+		Data:           data,
+	}, nil
+}
+
+func generateCertificate(def lsv1alpha1.GeneratedSecretDefinition, sans []string, ca *Secret, now time.Time) (map[string]string, error) {
+	if def.Certificate == nil {
+		return nil, errors.New("no certificate configuration defined")
+	}
+	isCA := def.Type == lsv1alpha1.GeneratedSecretTypeCA
+	validity := defaultCertificateValidity
+	if isCA {
+		validity = defaultCAValidity
+	}
+	if def.Certificate.Validity != nil {
+		validity = def.Certificate.Validity.Duration
+	}
+
+	spec := &funcs.CertificateSpec{
+		CommonName:   def.Certificate.CommonName,
+		Organization: def.Certificate.Organization,
+		DNSNames:     append([]string{}, def.Certificate.DNSNames...),
+		IPAddresses:  append([]string{}, def.Certificate.IPAddresses...),
+		IsCA:         isCA,
+		Validity:     validity.String(),
+	}
+	for _, san := range sans {
+		if net.ParseIP(san) != nil {
+			spec.IPAddresses = append(spec.IPAddresses, san)
+		} else {
+			spec.DNSNames = append(spec.DNSNames, san)
+		}
+	}
+	if ca != nil {
+		spec.CA = &funcs.CertificateAuthority{
+			Certificate: ca.Data[CertificateKey],
+			PrivateKey:  ca.Data[PrivateKeyKey],
+		}
+	}
+
+	certificate, privateKey, caCertificate, err := funcs.CreateCertificate(spec, now)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]string{
+		CertificateKey: certificate,
+		PrivateKeyKey:  privateKey,
+		CAKey:          caCertificate,
+	}, nil
+}
+
+func generateSSHKeyPair(config *lsv1alpha1.GeneratedSSHKeyPair) (map[string]string, error) {
+	algorithm := lsv1alpha1.SSHKeyAlgorithmEd25519
+	bits := defaultRSABits
+	if config != nil {
+		if len(config.Algorithm) != 0 {
+			algorithm = config.Algorithm
+		}
+		if config.Bits != 0 {
+			bits = config.Bits
+		}
+	}
+
+	var (
+		privateKey crypto.PrivateKey
+		publicKey  crypto.PublicKey
+	)
+	switch algorithm {
+	case lsv1alpha1.SSHKeyAlgorithmEd25519:
+		pub, priv, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return nil, fmt.Errorf("unable to create ed25519 key: %w", err)
+		}
+		privateKey, publicKey = priv, pub
+	case lsv1alpha1.SSHKeyAlgorithmRSA:
+		key, err := rsa.GenerateKey(rand.Reader, bits)
+		if err != nil {
+			return nil, fmt.Errorf("unable to create rsa key: %w", err)
+		}
+		privateKey, publicKey = key, key.Public()
+	default:
+		return nil, fmt.Errorf("unsupported ssh key algorithm %q", algorithm)
+	}
+
+	sshPublicKey, err := ssh.NewPublicKey(publicKey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to encode public key: %w", err)
+	}
+	block, err := ssh.MarshalPrivateKey(privateKey, "")
+	if err != nil {
+		return nil, fmt.Errorf("unable to encode private key: %w", err)
+	}
+	return map[string]string{
+		PrivateKeyKey: string(pem.EncodeToMemory(block)),
+		PublicKeyKey:  string(ssh.MarshalAuthorizedKey(sshPublicKey)),
+	}, nil
+}
+
+func generatePassword(config *lsv1alpha1.GeneratedPassword) (map[string]string, error) {
+	length := defaultPasswordLength
+	if config != nil && config.Length != 0 {
+		length = config.Length
+	}
+	numCharacters := big.NewInt(int64(len(passwordCharacters)))
+	password := make([]byte, length)
+	for i := range password {
+		n, err := rand.Int(rand.Reader, numCharacters)
+		if err != nil {
+			return nil, fmt.Errorf("unable to create password: %w", err)
+		}
+		password[i] = passwordCharacters[n.Int64()]
+	}
+	return map[string]string{
+		PasswordKey: string(password),
+	}, nil
+}
+
+// resolveSANs returns the sorted list of subject alternative names that are read from the imports.
+func resolveSANs(def lsv1alpha1.GeneratedSecretDefinition, imports map[string]interface{}) ([]string, error) {
+	if def.Certificate == nil || len(def.Certificate.SANsFromImports) == 0 {
+		return nil, nil
+	}
+	sans := map[string]struct{}{}
+	for _, ref := range def.Certificate.SANsFromImports {
+		value, ok := imports[ref.Import]
+		if !ok {
+			// optional imports that are not satisfied do not contribute any names
+			continue
+		}
+		if len(ref.Path) != 0 {
+			var err error
+			value, err = funcs.JSONPath(ref.Path, value)
+			if err != nil {
+				return nil, fmt.Errorf("unable to read subject alternative names from import %q: %w", ref.Import, err)
+			}
+		}
+		switch v := value.(type) {
+		case nil:
+		case string:
+			sans[v] = struct{}{}
+		case []interface{}:
+			for _, elem := range v {
+				s, ok := elem.(string)
+				if !ok {
+					return nil, fmt.Errorf("subject alternative names of import %q must be strings but found %T", ref.Import, elem)
+				}
+				sans[s] = struct{}{}
+			}
+		default:
+			return nil, fmt.Errorf("subject alternative names of import %q must be a string or a list of strings but found %T", ref.Import, value)
+		}
+	}
+
+	res := make([]string, 0, len(sans))
+	for san := range sans {
+		if len(san) != 0 {
+			res = append(res, san)
+		}
+	}
+	sort.Strings(res)
+	return res, nil
+}
+
+func isCertificate(secretType lsv1alpha1.GeneratedSecretType) bool {
+	return secretType == lsv1alpha1.GeneratedSecretTypeCA || secretType == lsv1alpha1.GeneratedSecretTypeCertificate
+}
+
+func parseCertificate(data string) (*x509.Certificate, error) {
+	block, _ := pem.Decode([]byte(data))
+	if block == nil {
+		return nil, errors.New("no PEM encoded certificate found")
+	}
+	return x509.ParseCertificate(block.Bytes)
+}
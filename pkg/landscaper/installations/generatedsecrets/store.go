@@ -0,0 +1,155 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package generatedsecrets
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/base32"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/pkg/api"
+)
+
+const (
+	// SpecHashAnnotation is the annotation of generated secrets that contains the hash of the definition
+	// from which the values were generated.
+	SpecHashAnnotation = "landscaper.gardener.cloud/generated-secret-spec-hash"
+	// GenerationTimeAnnotation is the annotation of generated secrets that contains the time when the values were generated.
+	GenerationTimeAnnotation = "landscaper.gardener.cloud/generated-secret-generation-time"
+)
+
+// Store persists generated secrets.
+type Store interface {
+	// Get returns the generated secret with the given name or nil if it does not exist.
+	Get(ctx context.Context, name string) (*Secret, error)
+	// Store creates or updates a generated secret and sets its secret name.
+	Store(ctx context.Context, secret *Secret) error
+	// Prune deletes all generated secrets whose names are not contained in the given set.
+	Prune(ctx context.Context, names sets.Set[string]) error
+}
+
+// KubernetesStore stores generated secrets in secrets that are owned by the installation.
+type KubernetesStore struct {
+	KubeClient client.Client
+	Inst       *lsv1alpha1.Installation
+}
+
+var _ Store = &KubernetesStore{}
+
+func (s *KubernetesStore) Get(ctx context.Context, name string) (*Secret, error) {
+	secret := &corev1.Secret{}
+	if err := s.KubeClient.Get(ctx, kutil.ObjectKey(s.SecretName(name), s.Inst.Namespace), secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("unable to get secret of generated secret %q: %w", name, err)
+	}
+
+	res := &Secret{
+		Name:       name,
+		SecretName: secret.Name,
+		SpecHash:   secret.Annotations[SpecHashAnnotation],
+		Data:       make(map[string]string, len(secret.Data)),
+	}
+	if generationTime, err := time.Parse(time.RFC3339, secret.Annotations[GenerationTimeAnnotation]); err == nil {
+		res.GenerationTime = generationTime
+	} else {
+		// fall back to the creation of the secret if the annotation was removed
+		res.GenerationTime = secret.CreationTimestamp.Time
+	}
+	for k, v := range secret.Data {
+		res.Data[k] = string(v)
+	}
+	return res, nil
+}
+
+func (s *KubernetesStore) Store(ctx context.Context, secret *Secret) error {
+	obj := &corev1.Secret{}
+	obj.Name = s.SecretName(secret.Name)
+	obj.Namespace = s.Inst.Namespace
+	if _, err := kutil.CreateOrUpdate(ctx, s.KubeClient, obj, func() error {
+		metav1.SetMetaDataLabel(&obj.ObjectMeta, lsv1alpha1.GeneratedSecretInstallationLabel, s.Inst.Name)
+		metav1.SetMetaDataAnnotation(&obj.ObjectMeta, lsv1alpha1.GeneratedSecretNameAnnotation, secret.Name)
+		metav1.SetMetaDataAnnotation(&obj.ObjectMeta, SpecHashAnnotation, secret.SpecHash)
+		metav1.SetMetaDataAnnotation(&obj.ObjectMeta, GenerationTimeAnnotation, secret.GenerationTime.UTC().Format(time.RFC3339))
+		obj.Type = corev1.SecretTypeOpaque
+		obj.Data = make(map[string][]byte, len(secret.Data))
+		for k, v := range secret.Data {
+			obj.Data[k] = []byte(v)
+		}
+		return controllerutil.SetControllerReference(s.Inst, obj, api.LandscaperScheme)
+	}); err != nil {
+		return fmt.Errorf("unable to store generated secret %q: %w", secret.Name, err)
+	}
+	secret.SecretName = obj.Name
+	return nil
+}
+
+func (s *KubernetesStore) Prune(ctx context.Context, names sets.Set[string]) error {
+	secretList := &corev1.SecretList{}
+	if err := s.KubeClient.List(ctx, secretList, client.InNamespace(s.Inst.Namespace),
+		client.MatchingLabels{lsv1alpha1.GeneratedSecretInstallationLabel: s.Inst.Name}); err != nil {
+		return fmt.Errorf("unable to list generated secrets: %w", err)
+	}
+	for i := range secretList.Items {
+		secret := &secretList.Items[i]
+		if names.Has(secret.Annotations[lsv1alpha1.GeneratedSecretNameAnnotation]) {
+			continue
+		}
+		if err := s.KubeClient.Delete(ctx, secret); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("unable to delete obsolete generated secret %q: %w", secret.Name, err)
+		}
+	}
+	return nil
+}
+
+// SecretName returns the name of the secret that contains the values of the generated secret with the given name.
+func (s *KubernetesStore) SecretName(name string) string {
+	h := sha1.New()
+	_, _ = h.Write([]byte(fmt.Sprintf("%s/%s", s.Inst.Name, name)))
+	// we need base32 encoding as some base64 characters are not supported by k8s
+	return "gs-" + base32.NewEncoding(lsv1alpha1helper.Base32EncodeStdLowerCase).WithPadding(base32.NoPadding).EncodeToString(h.Sum(nil))
+}
+
+// MemoryStore keeps generated secrets in memory.
+// It is used to render blueprints without a cluster.
+type MemoryStore map[string]*Secret
+
+var _ Store = MemoryStore{}
+
+// NewMemoryStore creates a new empty memory store.
+func NewMemoryStore() MemoryStore {
+	return MemoryStore{}
+}
+
+func (m MemoryStore) Get(_ context.Context, name string) (*Secret, error) {
+	return m[name], nil
+}
+
+func (m MemoryStore) Store(_ context.Context, secret *Secret) error {
+	m[secret.Name] = secret
+	return nil
+}
+
+func (m MemoryStore) Prune(_ context.Context, names sets.Set[string]) error {
+	for name := range m {
+		if !names.Has(name) {
+			delete(m, name)
+		}
+	}
+	return nil
+}
@@ -9,6 +9,8 @@ import (
 	"fmt"
 
 	cdv2 "github.com/gardener/component-spec/bindings-go/apis/v2"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -132,7 +134,15 @@ func GetDataImport(ctx context.Context,
 		rawDataObject = &lsv1alpha1.DataObject{}
 		doName := lsv1alpha1helper.GenerateDataObjectName(contextName, dataImport.DataRef)
 		if err := kubeClient.Get(ctx, kubernetes.ObjectKey(doName, inst.GetInstallation().Namespace), rawDataObject); err != nil {
-			return nil, nil, fmt.Errorf("unable to fetch data object %s (%s/%s): %w", doName, contextName, dataImport.DataRef, err)
+			if !apierrors.IsNotFound(err) {
+				return nil, nil, fmt.Errorf("unable to fetch data object %s (%s/%s): %w", doName, contextName, dataImport.DataRef, err)
+			}
+			// sensitive data is stored in a secret instead of a data object
+			sensitiveDataObject, err2 := getSensitiveDataObject(ctx, kubeClient, doName, inst.GetInstallation().Namespace)
+			if err2 != nil {
+				return nil, nil, fmt.Errorf("unable to fetch data object %s (%s/%s): %w", doName, contextName, dataImport.DataRef, err)
+			}
+			rawDataObject = sensitiveDataObject
 		}
 	}
 	if dataImport.SecretRef != nil {
@@ -168,6 +178,22 @@ func GetDataImport(ctx context.Context,
 	return do, owner, nil
 }
 
+// getSensitiveDataObject reads a secret that contains sensitive data instead of a data object.
+// The secret is converted into a data object with the metadata of the secret.
+func getSensitiveDataObject(ctx context.Context, kubeClient client.Client, name, namespace string) (*lsv1alpha1.DataObject, error) {
+	secret := &corev1.Secret{}
+	if err := kubeClient.Get(ctx, kubernetes.ObjectKey(name, namespace), secret); err != nil {
+		return nil, err
+	}
+	if !dataobjects.IsSensitive(secret) {
+		return nil, fmt.Errorf("secret %s/%s is not marked as sensitive data object", namespace, name)
+	}
+	rawDataObject := &lsv1alpha1.DataObject{}
+	rawDataObject.ObjectMeta = *secret.ObjectMeta.DeepCopy()
+	rawDataObject.Data.RawMessage = secret.Data[lsv1alpha1.DataObjectSecretDataKey]
+	return rawDataObject, nil
+}
+
 // GetTargetImport fetches the target import from the cluster.
 func GetTargetImport(ctx context.Context, kubeClient client.Client, contextName string, inst *lsv1alpha1.Installation, targetImport lsv1alpha1.TargetImport) (*dataobjects.TargetExtension, error) {
 	targetName := targetImport.Target
@@ -17,6 +17,7 @@ import (
 
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	"github.com/gardener/landscaper/pkg/api"
+	"github.com/gardener/landscaper/pkg/landscaper/dataobjects"
 	"github.com/gardener/landscaper/pkg/landscaper/installations"
 	"github.com/gardener/landscaper/test/utils/envtest"
 )
@@ -109,6 +110,60 @@ var _ = Describe("helper", func() {
 			Expect(err).To(HaveOccurred())
 		})
 
+		It("should get an import from the secret of a sensitive dataobject", func() {
+			ctx := context.Background()
+			defer ctx.Done()
+			secret := &corev1.Secret{}
+			secret.Name = "test-do"
+			secret.Namespace = "default"
+			secret.Labels = map[string]string{lsv1alpha1.DataObjectSensitiveLabel: "true"}
+			secret.Data = map[string][]byte{
+				lsv1alpha1.DataObjectSecretDataKey: []byte("\"val1\""),
+			}
+			Expect(kubeClient.Create(ctx, secret)).To(Succeed())
+
+			do, owner, err := installations.GetDataImport(ctx, kubeClient, "",
+				installations.NewInstallationAndImports(&lsv1alpha1.Installation{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: secret.Namespace,
+					},
+				}),
+				lsv1alpha1.DataImport{
+					Name:    "imp",
+					DataRef: "#test-do",
+				},
+			)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(owner).To(BeNil())
+			Expect(do.Data).To(Equal("val1"))
+			Expect(dataobjects.IsSensitive(do.Raw)).To(BeTrue())
+		})
+
+		It("should throw an error if the secret of a dataobject is not marked as sensitive", func() {
+			ctx := context.Background()
+			defer ctx.Done()
+			secret := &corev1.Secret{}
+			secret.Name = "test-do"
+			secret.Namespace = "default"
+			secret.Data = map[string][]byte{
+				lsv1alpha1.DataObjectSecretDataKey: []byte("\"val1\""),
+			}
+			Expect(kubeClient.Create(ctx, secret)).To(Succeed())
+
+			_, _, err := installations.GetDataImport(ctx, kubeClient, "",
+				installations.NewInstallationAndImports(&lsv1alpha1.Installation{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: secret.Namespace,
+					},
+				}),
+				lsv1alpha1.DataImport{
+					Name:    "imp",
+					DataRef: "#test-do",
+				},
+			)
+			Expect(err).To(HaveOccurred())
+		})
+
 		It("should get an import from a configmap", func() {
 			ctx := context.Background()
 			defer ctx.Done()
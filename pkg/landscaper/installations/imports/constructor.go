@@ -6,16 +6,19 @@ package imports
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 
 	"github.com/mandelsoft/spiff/spiffing"
 	spiffyaml "github.com/mandelsoft/spiff/yaml"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/yaml"
 
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
 	genericresolver "github.com/gardener/landscaper/controller-utils/pkg/landscaper/targetresolver/generic"
 	"github.com/gardener/landscaper/pkg/landscaper/dataobjects"
 	"github.com/gardener/landscaper/pkg/landscaper/dataobjects/jsonpath"
@@ -23,11 +26,18 @@ import (
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/gotemplate"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/spiff"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/generatedsecrets"
+	"github.com/gardener/landscaper/pkg/landscaper/jsonschema"
+	"github.com/gardener/landscaper/pkg/utils/redact"
 )
 
 const (
 	// TemplatingFailedReason is the reason that is defined during templating.
 	TemplatingFailedReason = "ImportValidationFailed"
+
+	// MaxImportValidationErrors is the maximal number of import validation errors that are recorded
+	// in the status of an installation.
+	MaxImportValidationErrors = 50
 )
 
 // NewConstructor creates a new Import Constructor.
@@ -106,6 +116,17 @@ func (c *Constructor) Construct(ctx context.Context, imps *Imports) error {
 		}
 	}
 
+	// the sensitive values are registered before the imports are validated as validation errors might contain the values.
+	sensitiveImports := sets.New[string](inst.GetSensitiveImportNames()...)
+	for name, do := range imps.DataObjects {
+		if dataobjects.IsSensitive(do.Raw) {
+			sensitiveImports.Insert(name)
+		}
+		if sensitiveImports.Has(name) {
+			redact.FromContext(ctx).AddValue(do.Data)
+		}
+	}
+
 	// performs the importDataMappings
 	templatedDataMappings, err := c.templateDataMappings(fldPath, imps.DataObjects, imps.Targets, imps.TargetLists, imps.TargetMaps) // returns a map mapping logical names to data content
 	if err != nil {
@@ -113,21 +134,96 @@ func (c *Constructor) Construct(ctx context.Context, imps *Imports) error {
 	}
 
 	// combines imported values, results of the importDataMappings, default values, and conditional imports
-	imports, err := c.constructImports(inst.GetBlueprint().Info.Imports, imps.DataObjects, imps.Targets,
+	imports, validationErrors, err := c.constructImports(inst.GetBlueprint().Info.Imports, imps.DataObjects, imps.Targets,
 		imps.TargetLists, imps.TargetMaps, templatedDataMappings, fldPath)
 	if err != nil {
 		return err
 	}
+	if err := c.setImportValidationErrors(ctx, validationErrors); err != nil {
+		return err
+	}
+
+	// the generated secrets are available as sensitive imports
+	if err := c.addGeneratedSecrets(ctx, imports, sensitiveImports); err != nil {
+		return err
+	}
 
 	c.SetTargetImports(imps.Targets)
 	c.SetTargetListImports(imps.TargetLists)
 	c.SetTargetMapImports(imps.TargetMaps)
 
 	inst.SetImports(imports)
+	inst.SetSensitiveImports(sets.List(sensitiveImports)...)
+	redact.FromContext(ctx).AddValue(inst.GetSensitiveImports())
+	return nil
+}
+
+// addGeneratedSecrets ensures the generated secrets of the blueprint, adds their values to the imports
+// and records their status in the installation.
+func (c *Constructor) addGeneratedSecrets(ctx context.Context, imports map[string]interface{}, sensitiveImports sets.Set[string]) error {
+	installation := c.Inst.GetInstallation()
+	defs := c.Inst.GetBlueprint().Info.GeneratedSecrets
+	if len(defs) == 0 && len(installation.Status.GeneratedSecrets) == 0 {
+		return nil
+	}
+
+	store := &generatedsecrets.KubernetesStore{
+		KubeClient: c.LsUncachedClient(),
+		Inst:       installation,
+	}
+	values, status, err := generatedsecrets.NewManager(store).Ensure(ctx, defs, imports)
+	if err != nil {
+		return installations.NewErrorf(installations.SecretGenerationFailed, err, "unable to generate secrets")
+	}
+	for name, value := range values {
+		imports[name] = value
+		sensitiveImports.Insert(name)
+	}
+	if len(status) == 0 {
+		status = nil
+	}
+	installation.Status.GeneratedSecrets = status
 	return nil
 }
 
-// constructImports is an auxiliary function that can be called in a recursive manner to traverse the tree of conditional imports
+// setImportValidationErrors records the violations of the import schemas in the status of the installation.
+// An error that summarizes all violations is returned if there are any.
+func (c *Constructor) setImportValidationErrors(ctx context.Context, validationErrors []lsv1alpha1.ImportValidationError) error {
+	installation := c.Inst.GetInstallation()
+	if len(validationErrors) == 0 {
+		installation.Status.ImportValidationErrors = nil
+		return nil
+	}
+
+	total := len(validationErrors)
+	if total > MaxImportValidationErrors {
+		validationErrors = validationErrors[:MaxImportValidationErrors]
+	}
+
+	// the messages might contain parts of sensitive imports
+	redactor := redact.FromContext(ctx)
+	messages := make([]string, 0, len(validationErrors)+1)
+	for i := range validationErrors {
+		validationErrors[i].Message = redactor.Redact(validationErrors[i].Message)
+		messages = append(messages, formatImportValidationError(validationErrors[i]))
+	}
+	if total > len(validationErrors) {
+		messages = append(messages, fmt.Sprintf("... and %d more", total-len(validationErrors)))
+	}
+	installation.Status.ImportValidationErrors = validationErrors
+	return installations.NewErrorf(installations.SchemaValidationFailed, nil,
+		"%d imported values do not have the expected schema: %s", total, strings.Join(messages, "; "))
+}
+
+func formatImportValidationError(validationError lsv1alpha1.ImportValidationError) string {
+	if len(validationError.Pointer) == 0 {
+		return fmt.Sprintf("import %q: %s", validationError.Import, validationError.Message)
+	}
+	return fmt.Sprintf("import %q at %q: %s", validationError.Import, validationError.Pointer, validationError.Message)
+}
+
+// constructImports is an auxiliary function that can be called in a recursive manner to traverse the tree of conditional imports.
+// Values of data imports that violate their schema do not abort the construction but are returned as validation errors.
 func (c *Constructor) constructImports(
 	importList lsv1alpha1.ImportDefinitionList,
 	importedDataObjects map[string]*dataobjects.DataObject,
@@ -135,61 +231,91 @@ func (c *Constructor) constructImports(
 	importedTargetLists map[string]*dataobjects.TargetExtensionList,
 	importedTargetMaps map[string]*dataobjects.TargetMapExtension,
 	templatedDataMappings map[string]interface{},
-	fldPath *field.Path) (map[string]interface{}, error) {
+	fldPath *field.Path) (map[string]interface{}, []lsv1alpha1.ImportValidationError, error) {
 
 	imports := map[string]interface{}{}
+	var validationErrors []lsv1alpha1.ImportValidationError
 	for _, def := range importList {
 		var err error
 		defPath := fldPath.Child(def.Name)
 		switch def.Type {
 		case lsv1alpha1.ImportTypeData:
+			source := lsv1alpha1.ImportSource{Type: lsv1alpha1.ImportSourceTypeDefault}
 			if val, ok := templatedDataMappings[def.Name]; ok {
 				imports[def.Name] = val
+				source = lsv1alpha1.ImportSource{Type: lsv1alpha1.ImportSourceTypeImportDataMapping}
 			} else if val, ok := importedDataObjects[def.Name]; ok {
 				imports[def.Name] = val.Data
+				source = dataObjectImportSource(val)
 			}
-			if _, ok := imports[def.Name]; !ok {
-				if def.Required != nil && !*def.Required {
-					if len(def.Default.Value.RawMessage) != 0 {
-						// there is a default defined in the blueprint
-						var defVal interface{}
-						if err := yaml.Unmarshal(def.Default.Value.RawMessage, &defVal); err != nil {
-							return nil, installations.NewErrorf(installations.InvalidDefaultValue, err, "default value defined for import %q of type %s cannot be unmarshalled", def.Name, lsv1alpha1.ImportTypeData)
-						}
-						imports[def.Name] = defVal
-					} else {
-						continue // don't throw an error if the import is not required
+			_, imported := imports[def.Name]
+			if !imported {
+				if def.Required == nil || *def.Required {
+					return nil, nil, installations.NewImportNotFoundErrorf(nil, "blueprint defines import %q of type %s, which is not satisfied", def.Name, lsv1alpha1.ImportTypeData)
+				}
+				if len(def.Default.Value.RawMessage) != 0 {
+					// there is a default defined in the blueprint
+					var defVal interface{}
+					if err := yaml.Unmarshal(def.Default.Value.RawMessage, &defVal); err != nil {
+						return nil, nil, installations.NewErrorf(installations.InvalidDefaultValue, err, "default value defined for import %q of type %s cannot be unmarshalled", def.Name, lsv1alpha1.ImportTypeData)
 					}
-				} else {
-					return nil, installations.NewImportNotFoundErrorf(nil, "blueprint defines import %q of type %s, which is not satisfied", def.Name, lsv1alpha1.ImportTypeData)
+					imports[def.Name] = defVal
+					imported = true
+				} else if def.Schema == nil {
+					continue // don't throw an error if the import is not required
 				}
 			}
 			if def.Schema == nil {
-				return nil, installations.NewErrorf(installations.SchemaValidationFailed, fmt.Errorf("schema is nil"), "%s: no schema defined", defPath.String())
+				return nil, nil, installations.NewErrorf(installations.SchemaValidationFailed, fmt.Errorf("schema is nil"), "%s: no schema defined", defPath.String())
 			}
 			validator, err := c.JSONSchemaValidator(def.Schema.RawMessage)
 			if err != nil {
-				return imports, installations.NewErrorf(installations.SchemaValidationFailed, err, "%s: validator creation failed", defPath.String())
+				return imports, nil, installations.NewErrorf(installations.SchemaValidationFailed, err, "%s: validator creation failed", defPath.String())
+			}
+			// the default values of the schema are applied before the validation.
+			// Optional imports that are not satisfied get the default value of their schema.
+			defaulted, err := validator.ApplyDefaults(imports[def.Name])
+			if err != nil {
+				return imports, nil, installations.NewErrorf(installations.SchemaValidationFailed, err, "%s: unable to apply the default values of the schema", defPath.String())
+			}
+			if !imported && defaulted == nil {
+				continue // don't throw an error if the import is not required
 			}
+			imports[def.Name] = defaulted
 			if err := validator.ValidateGoStruct(imports[def.Name]); err != nil {
-				return imports, installations.NewErrorf(installations.SchemaValidationFailed, err, "%s: imported datatype does not have the expected schema", defPath.String())
+				var schemaErrs jsonschema.ValidationErrors
+				if !errors.As(err, &schemaErrs) {
+					return imports, nil, installations.NewErrorf(installations.SchemaValidationFailed, err, "%s: imported datatype does not have the expected schema", defPath.String())
+				}
+				// all violations of all imports are reported together.
+				// The conditional imports of an invalid import are not evaluated.
+				for _, schemaErr := range schemaErrs {
+					validationErrors = append(validationErrors, lsv1alpha1.ImportValidationError{
+						Import:  def.Name,
+						Source:  source,
+						Pointer: schemaErr.Pointer(),
+						Message: schemaErr.Description,
+					})
+				}
+				continue
 			}
 			if len(def.ConditionalImports) > 0 {
 				// recursively check conditional imports
-				conditionalImports, err := c.constructImports(def.ConditionalImports, importedDataObjects, importedTargets, importedTargetLists, importedTargetMaps, templatedDataMappings, defPath)
+				conditionalImports, conditionalValidationErrors, err := c.constructImports(def.ConditionalImports, importedDataObjects, importedTargets, importedTargetLists, importedTargetMaps, templatedDataMappings, defPath)
 				if err != nil {
-					return nil, err
+					return nil, nil, err
 				}
 				for k, v := range conditionalImports {
 					imports[k] = v
 				}
+				validationErrors = append(validationErrors, conditionalValidationErrors...)
 			}
 			continue
 		case lsv1alpha1.ImportTypeTarget:
 			if val, ok := importedTargets[def.Name]; ok {
 				imports[def.Name], err = val.GetData()
 				if err != nil {
-					return nil, installations.NewErrorf(installations.SchemaValidationFailed, err, "%s: imported target cannot be parsed", defPath.String())
+					return nil, nil, installations.NewErrorf(installations.SchemaValidationFailed, err, "%s: imported target cannot be parsed", defPath.String())
 				}
 			}
 			data, ok := imports[def.Name]
@@ -197,22 +323,22 @@ func (c *Constructor) constructImports(
 				if def.Required != nil && !*def.Required {
 					continue // don't throw an error if the import is not required
 				}
-				return nil, installations.NewImportNotFoundErrorf(nil, "blueprint defines import %q of type %s, which is not satisfied", def.Name, lsv1alpha1.ImportTypeTarget)
+				return nil, nil, installations.NewImportNotFoundErrorf(nil, "blueprint defines import %q of type %s, which is not satisfied", def.Name, lsv1alpha1.ImportTypeTarget)
 			}
 
 			var targetType string
 			if err := jsonpath.GetValue(".spec.type", data, &targetType); err != nil {
-				return nil, installations.NewErrorf(installations.SchemaValidationFailed, err, "%s: imported target does not match the expected target template schema", defPath.String())
+				return nil, nil, installations.NewErrorf(installations.SchemaValidationFailed, err, "%s: imported target does not match the expected target template schema", defPath.String())
 			}
 			if def.TargetType != targetType {
-				return nil, installations.NewErrorf(installations.SchemaValidationFailed, nil, "%s: imported target type is %s but expected %s", defPath.String(), targetType, def.TargetType)
+				return nil, nil, installations.NewErrorf(installations.SchemaValidationFailed, nil, "%s: imported target type is %s but expected %s", defPath.String(), targetType, def.TargetType)
 			}
 			continue
 		case lsv1alpha1.ImportTypeTargetList:
 			if val, ok := importedTargetLists[def.Name]; ok {
 				imports[def.Name], err = val.GetData()
 				if err != nil {
-					return nil, installations.NewErrorf(installations.SchemaValidationFailed, err, "%s: imported target cannot be parsed", defPath.String())
+					return nil, nil, installations.NewErrorf(installations.SchemaValidationFailed, err, "%s: imported target cannot be parsed", defPath.String())
 				}
 			}
 			data, ok := imports[def.Name]
@@ -220,20 +346,20 @@ func (c *Constructor) constructImports(
 				if def.Required != nil && !*def.Required {
 					continue // don't throw an error if the import is not required
 				}
-				return nil, installations.NewImportNotFoundErrorf(nil, "blueprint defines import %q of type %s, which is not satisfied", def.Name, lsv1alpha1.ImportTypeTargetList)
+				return nil, nil, installations.NewImportNotFoundErrorf(nil, "blueprint defines import %q of type %s, which is not satisfied", def.Name, lsv1alpha1.ImportTypeTargetList)
 			}
 
 			var targetType string
 			listData, ok := data.([]interface{})
 			if !ok {
-				return nil, installations.NewErrorf(installations.SchemaValidationFailed, nil, "%s: targetlist import is not a list", defPath.String())
+				return nil, nil, installations.NewErrorf(installations.SchemaValidationFailed, nil, "%s: targetlist import is not a list", defPath.String())
 			}
 			for i, elem := range listData {
 				if err := jsonpath.GetValue(".spec.type", elem, &targetType); err != nil {
-					return nil, installations.NewErrorf(installations.SchemaValidationFailed, err, "%s: element at position %d of the imported targetlist does not match the expected target template schema", defPath.String(), i)
+					return nil, nil, installations.NewErrorf(installations.SchemaValidationFailed, err, "%s: element at position %d of the imported targetlist does not match the expected target template schema", defPath.String(), i)
 				}
 				if def.TargetType != targetType {
-					return nil, installations.NewErrorf(installations.SchemaValidationFailed, nil, "%s: type of the element at position %d of the imported targetlist is %s but expected %s", defPath.String(), i, targetType, def.TargetType)
+					return nil, nil, installations.NewErrorf(installations.SchemaValidationFailed, nil, "%s: type of the element at position %d of the imported targetlist is %s but expected %s", defPath.String(), i, targetType, def.TargetType)
 				}
 			}
 			continue
@@ -241,7 +367,7 @@ func (c *Constructor) constructImports(
 			if val, ok := importedTargetMaps[def.Name]; ok {
 				imports[def.Name], err = val.GetData()
 				if err != nil {
-					return nil, installations.NewErrorf(installations.SchemaValidationFailed, err, "%s: imported target cannot be parsed", defPath.String())
+					return nil, nil, installations.NewErrorf(installations.SchemaValidationFailed, err, "%s: imported target cannot be parsed", defPath.String())
 				}
 			}
 			data, ok := imports[def.Name]
@@ -249,29 +375,62 @@ func (c *Constructor) constructImports(
 				if def.Required != nil && !*def.Required {
 					continue // don't throw an error if the import is not required
 				}
-				return nil, installations.NewImportNotFoundErrorf(nil, "blueprint defines import %q of type %s, which is not satisfied", def.Name, lsv1alpha1.ImportTypeTargetMap)
+				return nil, nil, installations.NewImportNotFoundErrorf(nil, "blueprint defines import %q of type %s, which is not satisfied", def.Name, lsv1alpha1.ImportTypeTargetMap)
 			}
 
 			var targetType string
 			mapData, ok := data.(map[string]interface{})
 			if !ok {
-				return nil, installations.NewErrorf(installations.SchemaValidationFailed, nil, "%s: targetmap import is not a map", defPath.String())
+				return nil, nil, installations.NewErrorf(installations.SchemaValidationFailed, nil, "%s: targetmap import is not a map", defPath.String())
 			}
 			for targetMapKey, elem := range mapData {
 				if err := jsonpath.GetValue(".spec.type", elem, &targetType); err != nil {
-					return nil, installations.NewErrorf(installations.SchemaValidationFailed, err, "%s: element at position %s of the imported targetmap does not match the expected target template schema", defPath.String(), targetMapKey)
+					return nil, nil, installations.NewErrorf(installations.SchemaValidationFailed, err, "%s: element at position %s of the imported targetmap does not match the expected target template schema", defPath.String(), targetMapKey)
 				}
 				if def.TargetType != targetType {
-					return nil, installations.NewErrorf(installations.SchemaValidationFailed, nil, "%s: type of the element at position %s of the imported targetmap is %s but expected %s", defPath.String(), targetMapKey, targetType, def.TargetType)
+					return nil, nil, installations.NewErrorf(installations.SchemaValidationFailed, nil, "%s: type of the element at position %s of the imported targetmap is %s but expected %s", defPath.String(), targetMapKey, targetType, def.TargetType)
 				}
 			}
 			continue
 		default:
-			return nil, fmt.Errorf("%s: unknown import type '%s'", defPath.String(), string(def.Type))
+			return nil, nil, fmt.Errorf("%s: unknown import type '%s'", defPath.String(), string(def.Type))
 		}
 	}
 
-	return imports, nil
+	return imports, validationErrors, nil
+}
+
+// dataObjectImportSource describes the source of an import whose value is read from the given data object.
+func dataObjectImportSource(do *dataobjects.DataObject) lsv1alpha1.ImportSource {
+	if do.Def != nil && do.Def.SecretRef != nil {
+		return lsv1alpha1.ImportSource{
+			Type: lsv1alpha1.ImportSourceTypeSecret,
+			Name: do.Def.SecretRef.Name,
+			Key:  do.Def.SecretRef.Key,
+		}
+	}
+	if do.Def != nil && do.Def.ConfigMapRef != nil {
+		return lsv1alpha1.ImportSource{
+			Type: lsv1alpha1.ImportSourceTypeConfigMap,
+			Name: do.Def.ConfigMapRef.Name,
+			Key:  do.Def.ConfigMapRef.Key,
+		}
+	}
+	source := lsv1alpha1.ImportSource{
+		Type: lsv1alpha1.ImportSourceTypeDataObject,
+	}
+	if do.Def != nil {
+		source.Name = do.Def.DataRef
+	}
+	if strings.HasPrefix(do.Metadata.Source, lsv1alpha1helper.InstallationPrefix) {
+		source.Installation = strings.TrimPrefix(do.Metadata.Source, lsv1alpha1helper.InstallationPrefix)
+	} else if do.Raw != nil {
+		// data objects without source label are identified by their owner
+		if owner := kutil.GetOwner(do.Raw.ObjectMeta); owner != nil && owner.Kind == "Installation" {
+			source.Installation = owner.Name
+		}
+	}
+	return source
 }
 
 func (c *Constructor) templateDataMappings(
@@ -341,7 +500,7 @@ func (c *Constructor) templateDataMappings(
 
 // RenderImportExecutions renders the blueprint's ImportExecutions.
 // Has to be called after import construction (c.Construct(...))
-func (c *Constructor) RenderImportExecutions() error {
+func (c *Constructor) RenderImportExecutions(ctx context.Context) error {
 	cond := lsv1alpha1helper.GetOrInitCondition(c.Operation.Inst.GetInstallation().Status.Conditions, lsv1alpha1.ValidateImportsCondition)
 
 	templateStateHandler := template.KubernetesStateHandler{
@@ -350,8 +509,8 @@ func (c *Constructor) RenderImportExecutions() error {
 	}
 	targetResolver := genericresolver.New(c.Operation.LsUncachedClient())
 	tmpl := template.New(
-		gotemplate.New(templateStateHandler, targetResolver),
-		spiff.New(templateStateHandler, targetResolver))
+		gotemplate.New(templateStateHandler, targetResolver).WithContext(ctx),
+		spiff.New(templateStateHandler, targetResolver).WithContext(ctx))
 	errors, bindings, err := tmpl.TemplateImportExecutions(
 		template.NewBlueprintExecutionOptions(
 			c.Operation.Context().External.InjectComponentDescriptorRef(c.Operation.Inst.GetInstallation()),
@@ -0,0 +1,60 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package imports
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/landscaper/installations"
+)
+
+var _ = Describe("Import validation errors", func() {
+
+	newValidationErrors := func(n int) []lsv1alpha1.ImportValidationError {
+		validationErrors := make([]lsv1alpha1.ImportValidationError, 0, n)
+		for i := 0; i < n; i++ {
+			validationErrors = append(validationErrors, lsv1alpha1.ImportValidationError{
+				Import:  "my-import",
+				Pointer: fmt.Sprintf("/%d", i),
+				Message: "Invalid type",
+			})
+		}
+		return validationErrors
+	}
+
+	newConstructor := func() *Constructor {
+		return &Constructor{
+			Operation: &installations.Operation{
+				Inst: installations.NewInstallationImportsAndBlueprint(&lsv1alpha1.Installation{}, nil),
+			},
+		}
+	}
+
+	It("should report all violations if their number is below the maximum", func() {
+		c := newConstructor()
+		err := c.setImportValidationErrors(context.Background(), newValidationErrors(3))
+		Expect(installations.IsSchemaValidationFailedError(err)).To(BeTrue())
+		Expect(err.Error()).To(ContainSubstring("3 imported values do not have the expected schema"))
+		Expect(err.Error()).To(ContainSubstring(`import "my-import" at "/2"`))
+		Expect(err.Error()).ToNot(ContainSubstring("more"))
+		Expect(c.Inst.GetInstallation().Status.ImportValidationErrors).To(HaveLen(3))
+	})
+
+	It("should only report the maximal number of violations", func() {
+		c := newConstructor()
+		err := c.setImportValidationErrors(context.Background(), newValidationErrors(MaxImportValidationErrors+10))
+		Expect(installations.IsSchemaValidationFailedError(err)).To(BeTrue())
+		Expect(err.Error()).To(ContainSubstring(fmt.Sprintf("%d imported values do not have the expected schema", MaxImportValidationErrors+10)))
+		Expect(err.Error()).To(HaveSuffix("; ... and 10 more"))
+		Expect(strings.Count(err.Error(), `import "my-import"`)).To(Equal(MaxImportValidationErrors))
+		Expect(c.Inst.GetInstallation().Status.ImportValidationErrors).To(HaveLen(MaxImportValidationErrors))
+	})
+})
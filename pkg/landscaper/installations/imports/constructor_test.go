@@ -215,6 +215,17 @@ var _ = Describe("Constructor", func() {
 			c := imports.NewConstructor(op)
 			err = c.Construct(ctx, nil)
 			Expect(installations.IsSchemaValidationFailedError(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring(`import "a.b"`))
+			Expect(inInstA.GetInstallation().Status.ImportValidationErrors).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+				"Import": Equal("a.b"),
+				"Source": Equal(lsv1alpha1.ImportSource{
+					Type:         lsv1alpha1.ImportSourceTypeDataObject,
+					Name:         "root.a",
+					Installation: "root",
+				}),
+				"Pointer": BeEmpty(),
+				"Message": ContainSubstring("Invalid type"),
+			})))
 		})
 
 		It("should handle missing schema definition in import gracefully", func() {
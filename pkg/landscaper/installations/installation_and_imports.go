@@ -1,6 +1,8 @@
 package installations
 
 import (
+	"k8s.io/apimachinery/pkg/util/sets"
+
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
 )
@@ -9,6 +11,8 @@ import (
 type InstallationAndImports struct {
 	imports      map[string]interface{}
 	installation *lsv1alpha1.Installation
+	// sensitiveImports contains the names of the imports with sensitive values.
+	sensitiveImports sets.Set[string]
 }
 
 // NewInstallationAndImports creates a new object containing the installation, the imports and the status of the imports
@@ -28,6 +32,37 @@ func (i *InstallationAndImports) SetImports(imports map[string]interface{}) {
 	i.imports = imports
 }
 
+// SetSensitiveImports sets the names of the imports with sensitive values.
+func (i *InstallationAndImports) SetSensitiveImports(names ...string) {
+	i.sensitiveImports = sets.New[string](names...)
+}
+
+// IsSensitiveImport returns true if the import with the given name contains sensitive values.
+func (i *InstallationAndImports) IsSensitiveImport(name string) bool {
+	return i.sensitiveImports.Has(name)
+}
+
+// HasSensitiveImports returns true if at least one of the set imports contains sensitive values.
+func (i *InstallationAndImports) HasSensitiveImports() bool {
+	for name := range i.sensitiveImports {
+		if _, ok := i.imports[name]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// GetSensitiveImports returns the values of all imports with sensitive values.
+func (i *InstallationAndImports) GetSensitiveImports() map[string]interface{} {
+	sensitive := map[string]interface{}{}
+	for name := range i.sensitiveImports {
+		if val, ok := i.imports[name]; ok {
+			sensitive[name] = val
+		}
+	}
+	return sensitive
+}
+
 func (i *InstallationAndImports) GetInstallation() *lsv1alpha1.Installation {
 	return i.installation
 }
@@ -52,6 +52,17 @@ func (i *InstallationImportsAndBlueprint) GetExportDefinition(key string) (lsv1a
 	return lsv1alpha1.ExportDefinition{}, fmt.Errorf("export with key %s not found", key)
 }
 
+// GetSensitiveImportNames returns the names of all imports that are marked as sensitive in the blueprint.
+func (i *InstallationImportsAndBlueprint) GetSensitiveImportNames() []string {
+	names := make([]string, 0)
+	for _, def := range i.getFlattenedImports(i.blueprint.Info.Imports) {
+		if def.Sensitive {
+			names = append(names, def.Name)
+		}
+	}
+	return names
+}
+
 // getFlattenedImports is an auxiliary method that flattens the tree of conditional imports into a list
 func (i *InstallationImportsAndBlueprint) getFlattenedImports(importList lsv1alpha1.ImportDefinitionList) lsv1alpha1.ImportDefinitionList {
 	res := lsv1alpha1.ImportDefinitionList{}
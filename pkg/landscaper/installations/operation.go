@@ -117,6 +117,7 @@ func (o *Operation) JSONSchemaValidator(schema []byte) (*jsonschema.Validator, e
 		ComponentVersion:  o.ComponentVersion,
 		RegistryAccess:    o.ComponentsRegistry(),
 		RepositoryContext: o.context.External.RepositoryContext,
+		JSONSchemaVersion: o.Inst.GetBlueprint().Info.JSONSchemaVersion,
 	})
 	err := v.CompileSchema(schema)
 	if err != nil {
@@ -528,6 +529,17 @@ func (o *Operation) createOrUpdateDataImport(ctx context.Context, src string, im
 		return fmt.Errorf("unable to build data object for import '%s': %w", importDef.Name, err)
 	}
 
+	if importDef.Sensitive {
+		if err := o.createOrUpdateSensitiveDataImport(ctx, raw); err != nil {
+			o.Inst.GetInstallation().Status.Conditions = lsv1alpha1helper.MergeConditions(o.Inst.GetInstallation().Status.Conditions,
+				lsv1alpha1helper.UpdatedCondition(cond, lsv1alpha1.ConditionFalse,
+					"CreateDataObjects",
+					fmt.Sprintf("unable to create secret for sensitive import '%s'", importDef.Name)))
+			return fmt.Errorf("unable to create or update secret '%s' for sensitive import '%s': %w", raw.Name, importDef.Name, err)
+		}
+		return nil
+	}
+
 	// we do not need to set controller ownership as we anyway need a separate garbage collection.
 	if _, err := o.WriterToLsUncachedClient().CreateOrUpdateCoreDataObject(ctx, read_write_layer.W000070, raw, func() error {
 		if err := controllerutil.SetOwnerReference(o.Inst.GetInstallation(), raw, api.LandscaperScheme); err != nil {
@@ -544,6 +556,35 @@ func (o *Operation) createOrUpdateDataImport(ctx context.Context, src string, im
 	return nil
 }
 
+// createOrUpdateSensitiveDataImport stores the data of a sensitive import in a secret instead of a data object.
+// The secret gets the name and the metadata of the data object so that it is found by the subinstallations
+// which import the data.
+func (o *Operation) createOrUpdateSensitiveDataImport(ctx context.Context, raw *lsv1alpha1.DataObject) error {
+	secret := &corev1.Secret{}
+	secret.Name = raw.Name
+	secret.Namespace = raw.Namespace
+	if _, err := kutil.CreateOrUpdate(ctx, o.LsUncachedClient(), secret, func() error {
+		secret.Labels = raw.Labels
+		metav1.SetMetaDataLabel(&secret.ObjectMeta, lsv1alpha1.DataObjectSensitiveLabel, "true")
+		secret.Annotations = raw.Annotations
+		secret.Data = map[string][]byte{
+			lsv1alpha1.DataObjectSecretDataKey: raw.Data.RawMessage,
+		}
+		return controllerutil.SetOwnerReference(o.Inst.GetInstallation(), secret, api.LandscaperScheme)
+	}); err != nil {
+		return err
+	}
+
+	// remove the data object of a previous job in which the import was not yet marked as sensitive
+	do := &lsv1alpha1.DataObject{}
+	do.Name = raw.Name
+	do.Namespace = raw.Namespace
+	if err := o.WriterToLsUncachedClient().DeleteDataObject(ctx, read_write_layer.W000150, do); err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
 func (o *Operation) createOrUpdateTargetImport(ctx context.Context, src string, importDef lsv1alpha1.ImportDefinition, values interface{}) error {
 	cond := lsv1alpha1helper.GetOrInitCondition(o.Inst.GetInstallation().Status.Conditions, lsv1alpha1.CreateImportsCondition)
 	data, err := json.Marshal(values)
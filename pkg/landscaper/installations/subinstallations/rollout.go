@@ -0,0 +1,224 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package subinstallations
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/landscaper/apis/core"
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/apis/core/validation"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template"
+	"github.com/gardener/landscaper/pkg/utils/dependencies"
+	"github.com/gardener/landscaper/pkg/utils/rollout"
+)
+
+// convertRollouts maps rollout specifications into rollouts of the subinstallations.
+// The targets of a rollout are the keys of the target map import that are referenced by target imports of the
+// installation templates in the form "<import>[<key>]". They are rolled out in the alphabetical order of their keys.
+func (o *Operation) convertRollouts(specs []template.RolloutSpecification,
+	installationTmpl []*lsv1alpha1.InstallationTemplate) ([]lsv1alpha1.Rollout, error) {
+
+	if len(specs) == 0 {
+		return nil, nil
+	}
+
+	rollouts := make([]core.Rollout, len(specs))
+	for i, spec := range specs {
+		name := spec.Name
+		if len(name) == 0 {
+			name = spec.TargetMapImport
+		}
+
+		if o.GetTargetMapImport(spec.TargetMapImport) == nil {
+			return nil, fmt.Errorf("invalid rollout specification %q: targetmap import %q not found", name, spec.TargetMapImport)
+		}
+
+		installationsByKey := map[string][]string{}
+		for _, instTmpl := range installationTmpl {
+			for _, key := range targetMapKeys(instTmpl, spec.TargetMapImport) {
+				installationsByKey[key] = append(installationsByKey[key], instTmpl.Name)
+			}
+		}
+
+		keys := make([]string, 0, len(installationsByKey))
+		for key := range installationsByKey {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		targets := make([]core.RolloutTarget, len(keys))
+		for j, key := range keys {
+			targets[j] = core.RolloutTarget{
+				Key:           key,
+				Installations: installationsByKey[key],
+			}
+		}
+
+		var pause *core.Duration
+		if spec.PauseBetweenBatches != nil {
+			pause = &core.Duration{Duration: spec.PauseBetweenBatches.Duration}
+		}
+
+		rollouts[i] = core.Rollout{
+			Name:                name,
+			Targets:             targets,
+			Canary:              spec.Canary,
+			BatchSize:           spec.BatchSize,
+			PauseBetweenBatches: pause,
+			MaxFailedTargets:    spec.MaxFailedTargets,
+		}
+	}
+
+	tmplNames := make([]string, len(installationTmpl))
+	for i, instTmpl := range installationTmpl {
+		tmplNames[i] = instTmpl.Name
+	}
+	if err := validation.ValidateSubInstallationRollouts(field.NewPath("rollouts"), rollouts, tmplNames).ToAggregate(); err != nil {
+		return nil, fmt.Errorf("error validating rollouts: %w", err)
+	}
+
+	versionedRollouts := make([]lsv1alpha1.Rollout, len(rollouts))
+	for i := range rollouts {
+		if err := lsv1alpha1.Convert_core_Rollout_To_v1alpha1_Rollout(&rollouts[i], &versionedRollouts[i], nil); err != nil {
+			return nil, fmt.Errorf("error converting internal representation of rollouts to versioned one: %w", err)
+		}
+	}
+	return versionedRollouts, nil
+}
+
+// targetMapKeys returns the keys of the targets of a target map import that are imported by an installation template.
+func targetMapKeys(instTmpl *lsv1alpha1.InstallationTemplate, targetMapImport string) []string {
+	prefix := targetMapImport + "["
+	keys := []string{}
+	for _, targetImport := range instTmpl.Imports.Targets {
+		for _, targetName := range append([]string{targetImport.Target}, targetImport.Targets...) {
+			if strings.HasPrefix(targetName, prefix) && strings.HasSuffix(targetName, "]") {
+				keys = append(keys, strings.TrimSuffix(strings.TrimPrefix(targetName, prefix), "]"))
+			}
+		}
+	}
+	return keys
+}
+
+// RolloutClassification describes which subinstallations of an installation may be started in the current job
+// according to the rollouts of the subinstallations.
+type RolloutClassification struct {
+	// Statuses describe the progress of the rollouts.
+	Statuses []lsv1alpha1.RolloutStatus
+
+	// held contains the names of the subinstallations which must not be started yet.
+	held sets.Set[string]
+	// stopped contains the names of the held subinstallations which are not started in the current job,
+	// because a rollout has been stopped.
+	stopped sets.Set[string]
+}
+
+// IsHeld returns true if the subinstallation must not be started yet.
+func (c *RolloutClassification) IsHeld(subInst *lsv1alpha1.Installation) bool {
+	return c.held.Has(subInst.Name)
+}
+
+// IsStopped returns true if the subinstallation is not started in the current job, because a rollout has been stopped.
+func (c *RolloutClassification) IsStopped(subInst *lsv1alpha1.Installation) bool {
+	return c.stopped.Has(subInst.Name)
+}
+
+// ClassifyForRollouts evaluates the rollouts of the subinstallations of an installation.
+// The subinstallations of batches which are not yet allowed to start are held. Subinstallations that depend on held
+// subinstallations are held as well, because they could not finish before their predecessors.
+func ClassifyForRollouts(jobID string, rollouts []lsv1alpha1.Rollout, subInsts []*lsv1alpha1.Installation,
+	now time.Time) *RolloutClassification {
+
+	c := &RolloutClassification{
+		held:    sets.New[string](),
+		stopped: sets.New[string](),
+	}
+	if len(rollouts) == 0 {
+		return c
+	}
+
+	subInstsByTmplName := map[string]*lsv1alpha1.Installation{}
+	for _, subInst := range subInsts {
+		subInstsByTmplName[subInst.Annotations[lsv1alpha1.SubinstallationNameAnnotation]] = subInst
+	}
+
+	c.Statuses = make([]lsv1alpha1.RolloutStatus, len(rollouts))
+	for i := range rollouts {
+		targets := make([]*rollout.Target, len(rollouts[i].Targets))
+		for j, t := range rollouts[i].Targets {
+			targets[j] = newRolloutTarget(jobID, t, subInstsByTmplName)
+		}
+
+		result := rollout.Evaluate(&rollouts[i], targets, now)
+		for _, t := range rollouts[i].Targets {
+			if !result.Held.Has(t.Key) {
+				continue
+			}
+			for _, tmplName := range t.Installations {
+				if subInst, ok := subInstsByTmplName[tmplName]; ok {
+					c.held.Insert(subInst.Name)
+					if result.Status.Stopped {
+						c.stopped.Insert(subInst.Name)
+					}
+				}
+			}
+		}
+
+		c.Statuses[i] = result.Status
+	}
+
+	for changed := true; changed; {
+		changed = false
+		for _, subInst := range subInsts {
+			if c.stopped.Has(subInst.Name) {
+				continue
+			}
+			for predecessor := range dependencies.FetchPredecessorsFromInstallation(subInst, subInsts) {
+				if c.stopped.Has(predecessor) || (c.held.Has(predecessor) && !c.held.Has(subInst.Name)) {
+					c.held.Insert(subInst.Name)
+					if c.stopped.Has(predecessor) {
+						c.stopped.Insert(subInst.Name)
+					}
+					changed = true
+					break
+				}
+			}
+		}
+	}
+
+	return c
+}
+
+// newRolloutTarget determines the state of a target from the subinstallations of the target.
+func newRolloutTarget(jobID string, t lsv1alpha1.RolloutTarget, subInstsByTmplName map[string]*lsv1alpha1.Installation) *rollout.Target {
+	objects := []rollout.ObjectState{}
+	for _, tmplName := range t.Installations {
+		subInst, ok := subInstsByTmplName[tmplName]
+		if !ok {
+			// missing subinstallations are treated as failed
+			objects = append(objects, rollout.ObjectState{Started: true, Finished: true})
+			continue
+		}
+
+		obj := rollout.ObjectState{
+			Started:   subInst.Status.JobID == jobID,
+			Finished:  subInst.Status.JobIDFinished == jobID,
+			Succeeded: subInst.Status.InstallationPhase == lsv1alpha1.InstallationPhases.Succeeded,
+		}
+		if subInst.Status.TransitionTimes != nil && subInst.Status.TransitionTimes.FinishedTime != nil {
+			obj.FinishedTime = subInst.Status.TransitionTimes.FinishedTime.Time
+		}
+		objects = append(objects, obj)
+	}
+
+	return rollout.NewTarget(t.Key, objects)
+}
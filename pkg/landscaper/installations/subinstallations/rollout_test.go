@@ -0,0 +1,145 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package subinstallations_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/subinstallations"
+)
+
+var _ = Describe("Rollouts", func() {
+
+	const (
+		currJobID = "02"
+		prevJobID = "01"
+	)
+
+	now := time.Date(2024, time.May, 1, 8, 0, 0, 0, time.UTC)
+
+	buildSubInst := func(key string, jobID, jobIDFinished string, phase lsv1alpha1.InstallationPhase, finished time.Time) *lsv1alpha1.Installation {
+		subInst := &lsv1alpha1.Installation{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "sub-" + key + "-abcde",
+				Annotations: map[string]string{
+					lsv1alpha1.SubinstallationNameAnnotation: "sub-" + key,
+				},
+			},
+			Spec: lsv1alpha1.InstallationSpec{
+				Imports: lsv1alpha1.InstallationImports{
+					Targets: []lsv1alpha1.TargetImport{{Name: "cluster", Target: "clusters[" + key + "]"}},
+				},
+			},
+			Status: lsv1alpha1.InstallationStatus{
+				JobID:             jobID,
+				JobIDFinished:     jobIDFinished,
+				InstallationPhase: phase,
+			},
+		}
+		if !finished.IsZero() {
+			subInst.Status.TransitionTimes = &lsv1alpha1.TransitionTimes{
+				FinishedTime: &metav1.Time{Time: finished},
+			}
+		}
+		return subInst
+	}
+
+	notStarted := func(key string) *lsv1alpha1.Installation {
+		return buildSubInst(key, prevJobID, prevJobID, lsv1alpha1.InstallationPhases.Succeeded, time.Time{})
+	}
+
+	succeeded := func(key string, finished time.Time) *lsv1alpha1.Installation {
+		return buildSubInst(key, currJobID, currJobID, lsv1alpha1.InstallationPhases.Succeeded, finished)
+	}
+
+	failed := func(key string, finished time.Time) *lsv1alpha1.Installation {
+		return buildSubInst(key, currJobID, currJobID, lsv1alpha1.InstallationPhases.Failed, finished)
+	}
+
+	buildRollout := func(keys ...string) lsv1alpha1.Rollout {
+		rollout := lsv1alpha1.Rollout{Name: "clusters"}
+		for _, key := range keys {
+			rollout.Targets = append(rollout.Targets, lsv1alpha1.RolloutTarget{Key: key, Installations: []string{"sub-" + key}})
+		}
+		return rollout
+	}
+
+	classify := func(rollout lsv1alpha1.Rollout, subInsts ...*lsv1alpha1.Installation) *subinstallations.RolloutClassification {
+		return subinstallations.ClassifyForRollouts(currJobID, []lsv1alpha1.Rollout{rollout}, subInsts, now)
+	}
+
+	It("should hold all subinstallations except the canary", func() {
+		rollout := buildRollout("a", "b", "c")
+		rollout.Canary = "b"
+		a, b, c := notStarted("a"), notStarted("b"), notStarted("c")
+
+		classification := classify(rollout, a, b, c)
+		Expect(classification.IsHeld(a)).To(BeTrue())
+		Expect(classification.IsHeld(b)).To(BeFalse())
+		Expect(classification.IsHeld(c)).To(BeTrue())
+		Expect(classification.IsStopped(a)).To(BeFalse())
+		Expect(classification.Statuses).To(HaveLen(1))
+		Expect(classification.Statuses[0].Batches).To(Equal(2))
+	})
+
+	It("should release the next batch after the pause", func() {
+		rollout := buildRollout("a", "b")
+		rollout.BatchSize = ptr.To(intstr.FromInt32(1))
+		rollout.PauseBetweenBatches = &lsv1alpha1.Duration{Duration: 10 * time.Minute}
+		b := notStarted("b")
+
+		classification := classify(rollout, succeeded("a", now.Add(-5*time.Minute)), b)
+		Expect(classification.IsHeld(b)).To(BeTrue())
+		Expect(classification.Statuses[0].CompletedBatches).To(Equal(1))
+		Expect(classification.Statuses[0].NextBatchTime.Time).To(Equal(now.Add(5 * time.Minute)))
+
+		classification = classify(rollout, succeeded("a", now.Add(-15*time.Minute)), b)
+		Expect(classification.IsHeld(b)).To(BeFalse())
+		Expect(classification.Statuses[0].NextBatchTime).To(BeNil())
+	})
+
+	It("should stop the rollout if too many targets have failed", func() {
+		rollout := buildRollout("a", "b", "c")
+		rollout.BatchSize = ptr.To(intstr.FromInt32(1))
+		rollout.MaxFailedTargets = ptr.To(1)
+		c := notStarted("c")
+
+		classification := classify(rollout, failed("a", now), failed("b", now), c)
+		Expect(classification.IsHeld(c)).To(BeTrue())
+		Expect(classification.IsStopped(c)).To(BeTrue())
+		Expect(classification.Statuses[0].Stopped).To(BeTrue())
+		Expect(classification.Statuses[0].FailedTargets).To(ConsistOf("a", "b"))
+	})
+
+	It("should hold subinstallations which depend on held subinstallations", func() {
+		rollout := buildRollout("a", "b")
+		rollout.Canary = "a"
+		a, b := failed("a", now), notStarted("b")
+		b.Spec.Exports.Data = []lsv1alpha1.DataExport{{Name: "out", DataRef: "b-out"}}
+
+		aggregate := &lsv1alpha1.Installation{
+			ObjectMeta: metav1.ObjectMeta{Name: "aggregate-abcde"},
+			Spec: lsv1alpha1.InstallationSpec{
+				Imports: lsv1alpha1.InstallationImports{
+					Data: []lsv1alpha1.DataImport{{Name: "in", DataRef: "b-out"}},
+				},
+			},
+		}
+		other := &lsv1alpha1.Installation{ObjectMeta: metav1.ObjectMeta{Name: "other-abcde"}}
+
+		classification := classify(rollout, a, b, aggregate, other)
+		Expect(classification.IsStopped(b)).To(BeTrue())
+		Expect(classification.IsHeld(aggregate)).To(BeTrue())
+		Expect(classification.IsStopped(aggregate)).To(BeTrue())
+		Expect(classification.IsHeld(other)).To(BeFalse())
+	})
+})
@@ -25,6 +25,7 @@ import (
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/spiff"
 	"github.com/gardener/landscaper/pkg/utils/dependencies"
 	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+	"github.com/gardener/landscaper/pkg/utils/tracing"
 )
 
 // Ensure ensures that all referenced definitions are mapped to a sub-installation.
@@ -41,7 +42,9 @@ func (o *Operation) Ensure(ctx context.Context, subInstCache *lsv1alpha1.SubInst
 		return err
 	}
 
-	installationTmpl, err := o.getInstallationTemplates()
+	_, span := tracing.StartSpan(ctx, "TemplateSubinstallationExecutions")
+	installationTmpl, rolloutSpecs, err := o.getInstallationTemplates(ctx)
+	tracing.EndSpan(ctx, span, err)
 	if err != nil {
 		err = fmt.Errorf("unable to get installation templates of blueprint: %w", err)
 		return o.NewError(err, "GetInstallationTemplates", err.Error())
@@ -64,6 +67,11 @@ func (o *Operation) Ensure(ctx context.Context, subInstCache *lsv1alpha1.SubInst
 		return err
 	}
 
+	rollouts, err := o.convertRollouts(rolloutSpecs, installationTmpl)
+	if err != nil {
+		return o.NewError(err, "ConvertRollouts", err.Error())
+	}
+
 	// delete removed subreferences
 	orphaned, err := o.cleanupOrphanedSubInstallations(ctx, subInstallations, installationTmpl)
 	if err != nil {
@@ -79,6 +87,7 @@ func (o *Operation) Ensure(ctx context.Context, subInstCache *lsv1alpha1.SubInst
 		ActiveSubs:   subinsts,
 		OrphanedSubs: orphaned,
 	}
+	inst.Status.SubInstallationRollouts = rollouts
 
 	cond = lsv1alpha1helper.UpdatedCondition(cond, lsv1alpha1.ConditionTrue,
 		"InstallationsInstalled", "All Installations are successfully installed")
@@ -182,17 +191,21 @@ func (o *Operation) cleanupOrphanedSubInstallations(ctx context.Context,
 	return orphaned, nil
 }
 
-// getInstallationTemplates returns all installation templates defined by the referenced blueprint.
-func (o *Operation) getInstallationTemplates() ([]*lsv1alpha1.InstallationTemplate, error) {
+// getInstallationTemplates returns all installation templates defined by the referenced blueprint
+// together with the rollouts of the templated subinstallations.
+func (o *Operation) getInstallationTemplates(ctx context.Context) ([]*lsv1alpha1.InstallationTemplate, []template.RolloutSpecification, error) {
 	var instTmpls []*lsv1alpha1.InstallationTemplate
+	var rolloutSpecs []template.RolloutSpecification
 	if len(o.Inst.GetBlueprint().Info.SubinstallationExecutions) != 0 {
 		templateStateHandler := template.KubernetesStateHandler{
 			KubeClient: o.LsUncachedClient(),
 			Inst:       o.Inst.GetInstallation(),
 		}
 		targetResolver := genericresolver.New(o.LsUncachedClient())
-		tmpl := template.New(gotemplate.New(templateStateHandler, targetResolver), spiff.New(templateStateHandler, targetResolver))
-		templatedTmpls, err := tmpl.TemplateSubinstallationExecutions(template.NewDeployExecutionOptions(
+		tmpl := template.New(
+			gotemplate.New(templateStateHandler, targetResolver).WithContext(ctx),
+			spiff.New(templateStateHandler, targetResolver).WithContext(ctx))
+		output, err := tmpl.TemplateSubinstallationExecutionsWithRollouts(template.NewDeployExecutionOptions(
 			template.NewBlueprintExecutionOptions(
 				o.Context().External.InjectComponentDescriptorRef(o.Inst.GetInstallation().DeepCopy()),
 				o.Inst.GetBlueprint(),
@@ -201,18 +214,19 @@ func (o *Operation) getInstallationTemplates() ([]*lsv1alpha1.InstallationTempla
 				o.Inst.GetImports())))
 
 		if err != nil {
-			return nil, fmt.Errorf("unable to template subinstllations: %w", err)
+			return nil, nil, fmt.Errorf("unable to template subinstllations: %w", err)
 		}
-		instTmpls = append(instTmpls, templatedTmpls...)
+		instTmpls = append(instTmpls, output.Subinstallations...)
+		rolloutSpecs = output.Rollouts
 	}
 	if len(o.Inst.GetBlueprint().Info.Subinstallations) != 0 {
 		defaultTemplates, err := o.Inst.GetBlueprint().GetSubinstallations()
 		if err != nil {
-			return nil, fmt.Errorf("unable to get default subinstallation templates: %w", err)
+			return nil, nil, fmt.Errorf("unable to get default subinstallation templates: %w", err)
 		}
 		instTmpls = append(instTmpls, defaultTemplates...)
 	}
-	return instTmpls, nil
+	return instTmpls, rolloutSpecs, nil
 }
 
 func (o *Operation) createOrUpdateSubinstallations(ctx context.Context,
@@ -309,6 +323,7 @@ func (o *Operation) createOrUpdateNewInstallation(ctx context.Context,
 			Exports:             subInstTmpl.Exports,
 			ExportDataMappings:  subInstTmpl.ExportDataMappings,
 			Optimization:        subInstTmpl.Optimization,
+			RequireApproval:     inst.Spec.RequireApproval,
 		}
 
 		o.Scheme().Default(subInst)
@@ -0,0 +1,200 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package jsonschema
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// maxDefaultingDepth is the maximal depth of the data up to which default values are applied.
+// It prevents endless defaulting of recursive schemas whose default values contain the recursive property again.
+const maxDefaultingDepth = 64
+
+// ApplyDefaults returns a copy of the given data with the default values of the compiled schema applied.
+// A nil value is treated as missing and replaced by the default value of the schema itself.
+// Default values are applied to missing properties of objects and recursively to the values of properties and
+// array items. Subschemas of "allOf" and references within the schema (e.g. to "$defs" or "definitions") are
+// considered, too. Subschemas of "anyOf", "oneOf" and "if"/"then"/"else" are ignored
+// as it is not determined which of them applies.
+func (v *Validator) ApplyDefaults(data interface{}) (interface{}, error) {
+	if v.Schema == nil {
+		return nil, errors.New("internal error: schema has not been compiled")
+	}
+	data, err := copyJSON(data)
+	if err != nil {
+		return nil, fmt.Errorf("unable to copy data: %w", err)
+	}
+	if data == nil {
+		def, ok, err := v.defaultValue(v.resolved, sets.New[string]())
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, nil
+		}
+		data = def
+	}
+	return v.applyDefaults(v.resolved, data, sets.New[string](), 0)
+}
+
+// applyDefaults applies the default values of the given schema to the given data.
+// The data is modified in place.
+// The already followed references are tracked to prevent endless loops of references that do not descend into the data.
+func (v *Validator) applyDefaults(schema interface{}, data interface{}, followedRefs sets.Set[string], depth int) (interface{}, error) {
+	schemaMap, ok := schema.(map[string]interface{})
+	if !ok || depth > maxDefaultingDepth {
+		return data, nil
+	}
+
+	var err error
+	if sub, ref, ok := v.followReference(schemaMap, followedRefs); ok {
+		data, err = v.applyDefaults(sub, data, followedRefs.Clone().Insert(ref), depth)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if allOf, ok := schemaMap["allOf"].([]interface{}); ok {
+		for _, sub := range allOf {
+			data, err = v.applyDefaults(sub, data, followedRefs, depth)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	switch typed := data.(type) {
+	case map[string]interface{}:
+		properties, _ := schemaMap["properties"].(map[string]interface{})
+		for name, propSchema := range properties {
+			value, ok := typed[name]
+			if !ok {
+				value, ok, err = v.defaultValue(propSchema, sets.New[string]())
+				if err != nil {
+					return nil, err
+				}
+				if !ok {
+					continue
+				}
+			}
+			typed[name], err = v.applyDefaults(propSchema, value, sets.New[string](), depth+1)
+			if err != nil {
+				return nil, err
+			}
+		}
+	case []interface{}:
+		// positional item schemas are defined by "prefixItems" since draft 2020-12 and by "items" before.
+		itemSchemas, _ := schemaMap["prefixItems"].([]interface{})
+		if items, ok := schemaMap["items"].([]interface{}); ok {
+			itemSchemas = items
+		}
+		for i := range typed {
+			var itemSchema interface{}
+			if i < len(itemSchemas) {
+				itemSchema = itemSchemas[i]
+			} else if items, ok := schemaMap["items"].(map[string]interface{}); ok {
+				itemSchema = items
+			} else if items, ok := schemaMap["additionalItems"].(map[string]interface{}); ok {
+				itemSchema = items
+			}
+			typed[i], err = v.applyDefaults(itemSchema, typed[i], sets.New[string](), depth+1)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	return data, nil
+}
+
+// defaultValue returns a copy of the default value of the given schema.
+// The default value of a referenced schema is used if the schema itself does not define a default value.
+func (v *Validator) defaultValue(schema interface{}, followedRefs sets.Set[string]) (interface{}, bool, error) {
+	schemaMap, ok := schema.(map[string]interface{})
+	if !ok {
+		return nil, false, nil
+	}
+	if def, ok := schemaMap["default"]; ok {
+		res, err := copyJSON(def)
+		if err != nil {
+			return nil, false, fmt.Errorf("unable to read default value: %w", err)
+		}
+		return res, true, nil
+	}
+	if sub, ref, ok := v.followReference(schemaMap, followedRefs); ok {
+		return v.defaultValue(sub, followedRefs.Clone().Insert(ref))
+	}
+	return nil, false, nil
+}
+
+// followReference returns the subschema that is referenced by a json pointer reference within the schema.
+// References that cannot be resolved or that have already been followed are ignored.
+func (v *Validator) followReference(schema map[string]interface{}, followedRefs sets.Set[string]) (interface{}, string, bool) {
+	ref, ok := schema[keyRef].(string)
+	if !ok || !strings.HasPrefix(ref, "#") || followedRefs.Has(ref) {
+		return nil, "", false
+	}
+	sub, ok := resolvePointer(v.resolved, strings.TrimPrefix(ref, "#"))
+	if !ok {
+		return nil, "", false
+	}
+	return sub, ref, true
+}
+
+// resolvePointer returns the value that is referenced by the given url encoded json pointer.
+func resolvePointer(doc interface{}, pointer string) (interface{}, bool) {
+	pointer, err := url.PathUnescape(pointer)
+	if err != nil {
+		return nil, false
+	}
+	if len(pointer) == 0 {
+		return doc, true
+	}
+	if !strings.HasPrefix(pointer, "/") {
+		// anchors are not supported
+		return nil, false
+	}
+	for _, token := range strings.Split(pointer[1:], "/") {
+		token = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+		switch typed := doc.(type) {
+		case map[string]interface{}:
+			value, ok := typed[token]
+			if !ok {
+				return nil, false
+			}
+			doc = value
+		case []interface{}:
+			i, err := strconv.Atoi(token)
+			if err != nil || i < 0 || i >= len(typed) {
+				return nil, false
+			}
+			doc = typed[i]
+		default:
+			return nil, false
+		}
+	}
+	return doc, true
+}
+
+// copyJSON returns a deep copy of the given json compatible value that only consists of generic json types.
+func copyJSON(data interface{}) (interface{}, error) {
+	if data == nil {
+		return nil, nil
+	}
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	var res interface{}
+	if err := json.Unmarshal(raw, &res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -10,6 +10,7 @@ import (
 	"context"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -38,6 +39,7 @@ import (
 	"github.com/mandelsoft/vfs/pkg/vfs"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/xeipuuv/gojsonschema"
 
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	"github.com/gardener/landscaper/apis/mediatype"
@@ -81,6 +83,39 @@ var _ = Describe("jsonschema", func() {
 			schemaBytes := []byte(`{ "type": 7}`)
 			Expect(jsonschema.ValidateSchema(schemaBytes)).To(HaveOccurred())
 		})
+		It("should validate a schema with a loader", func() {
+			Expect(jsonschema.ValidateSchemaWithLoader(gojsonschema.NewBytesLoader([]byte(`{ "type": "string"}`)))).To(Succeed())
+			Expect(jsonschema.ValidateSchemaWithLoader(gojsonschema.NewBytesLoader([]byte(`{ "type": 7}`)))).To(HaveOccurred())
+		})
+	})
+
+	Context("formats", func() {
+		AfterEach(func() {
+			jsonschema.SetAssertAllFormats(false)
+		})
+
+		It("should assert the formats that have always been validated", func() {
+			schemaBytes := []byte(`{ "type": "string", "format": "date-time" }`)
+			Expect(jsonschema.ValidateBytes(schemaBytes, []byte(`"2024-01-01T00:00:00Z"`), nil)).To(Succeed())
+			Expect(jsonschema.ValidateBytes(schemaBytes, []byte(`"yesterday"`), nil)).To(HaveOccurred())
+		})
+
+		It("should ignore the formats duration, period and semver by default", func() {
+			for _, format := range []string{"duration", "period", "semver"} {
+				schemaBytes := []byte(`{ "type": "string", "format": "` + format + `" }`)
+				Expect(jsonschema.ValidateBytes(schemaBytes, []byte(`"invalid"`), nil)).To(Succeed(), format)
+			}
+		})
+
+		It("should assert the formats duration, period and semver if all formats are asserted", func() {
+			jsonschema.SetAssertAllFormats(true)
+			for _, format := range []string{"duration", "period", "semver"} {
+				schemaBytes := []byte(`{ "type": "string", "format": "` + format + `" }`)
+				Expect(jsonschema.ValidateBytes(schemaBytes, []byte(`"invalid"`), nil)).To(HaveOccurred(), format)
+			}
+			schemaBytes := []byte(`{ "type": "string", "format": "semver" }`)
+			Expect(jsonschema.ValidateBytes(schemaBytes, []byte(`"1.2.3"`), nil)).To(Succeed())
+		})
 	})
 
 	It("should pass a simple string", func() {
@@ -104,6 +139,230 @@ var _ = Describe("jsonschema", func() {
 		Expect(jsonschema.ValidateBytes(schemaBytes, data, nil)).To(HaveOccurred())
 	})
 
+	Context("jsonschema versions", func() {
+		It("should validate a schema according to draft 2020-12 with $defs", func() {
+			schemaBytes := []byte(`{
+  "$schema": "https://json-schema.org/draft/2020-12/schema",
+  "type": "object",
+  "properties": {
+    "port": { "$ref": "#/$defs/port" }
+  },
+  "$defs": {
+    "port": { "type": "integer", "minimum": 1, "maximum": 65535 }
+  }
+}`)
+			Expect(jsonschema.ValidateBytes(schemaBytes, []byte(`{"port": 443}`), nil)).To(Succeed())
+			Expect(jsonschema.ValidateBytes(schemaBytes, []byte(`{"port": 0}`), nil)).To(HaveOccurred())
+		})
+
+		It("should validate unevaluatedProperties and dependentRequired", func() {
+			schemaBytes := []byte(`{
+  "type": "object",
+  "allOf": [
+    { "properties": { "user": { "type": "string" } } }
+  ],
+  "properties": {
+    "password": { "type": "string" }
+  },
+  "dependentRequired": {
+    "password": ["user"]
+  },
+  "unevaluatedProperties": false
+}`)
+			config := &jsonschema.ReferenceContext{
+				JSONSchemaVersion: "https://json-schema.org/draft/2020-12/schema",
+			}
+			Expect(jsonschema.ValidateBytes(schemaBytes, []byte(`{"user": "a", "password": "b"}`), config)).To(Succeed())
+
+			err := jsonschema.ValidateBytes(schemaBytes, []byte(`{"password": "b"}`), config)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("user"))
+
+			err = jsonschema.ValidateBytes(schemaBytes, []byte(`{"user": "a", "other": "c"}`), config)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("other"))
+		})
+
+		It("should use the jsonschema version of the reference context if the schema defines none", func() {
+			schemaBytes := []byte(`{ "type": "object", "dependentRequired": { "password": ["user"] } }`)
+			data := []byte(`{"password": "b"}`)
+
+			// dependentRequired is unknown in draft-07 and therefore ignored
+			Expect(jsonschema.ValidateBytes(schemaBytes, data, &jsonschema.ReferenceContext{
+				JSONSchemaVersion: "http://json-schema.org/draft-07/schema#",
+			})).To(Succeed())
+			Expect(jsonschema.ValidateBytes(schemaBytes, data, &jsonschema.ReferenceContext{
+				JSONSchemaVersion: "https://json-schema.org/draft/2019-09/schema",
+			})).To(HaveOccurred())
+		})
+
+		It("should use the default jsonschema version of blueprints if no version is given", func() {
+			schemaBytes := []byte(`{ "type": "object", "dependentRequired": { "password": ["user"] } }`)
+			Expect(jsonschema.ValidateBytes(schemaBytes, []byte(`{"password": "b"}`), nil)).To(HaveOccurred())
+		})
+
+		It("should forbid an unknown jsonschema version", func() {
+			schemaBytes := []byte(`{ "type": "string" }`)
+			err := jsonschema.ValidateBytes(schemaBytes, []byte(`"a"`), &jsonschema.ReferenceContext{
+				JSONSchemaVersion: "https://example.com/schema",
+			})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("unsupported jsonschema version"))
+		})
+
+		It("should report all violations", func() {
+			schemaBytes := []byte(`{
+  "type": "object",
+  "properties": {
+    "name": { "type": "string" },
+    "replicas": { "type": "integer" }
+  },
+  "required": ["image"]
+}`)
+			err := jsonschema.ValidateBytes(schemaBytes, []byte(`{"name": 1, "replicas": "2"}`), nil)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("name: Invalid value: 1: Invalid type. Expected: string, given: integer"))
+			Expect(err.Error()).To(ContainSubstring(`replicas: Invalid value: "2": Invalid type. Expected: integer, given: string`))
+			Expect(err.Error()).To(ContainSubstring("image"))
+		})
+
+		It("should return the json pointers of all invalid values", func() {
+			schemaBytes := []byte(`{
+  "type": "object",
+  "properties": {
+    "limits": {
+      "type": "object",
+      "properties": {
+        "cpu": { "type": "string" }
+      }
+    },
+    "a/b~c": { "type": "integer" }
+  }
+}`)
+			err := jsonschema.ValidateBytes(schemaBytes, []byte(`{"limits": {"cpu": 1}, "a/b~c": "x"}`), nil)
+			Expect(err).To(HaveOccurred())
+			var schemaErrs jsonschema.ValidationErrors
+			Expect(errors.As(err, &schemaErrs)).To(BeTrue())
+			pointers := make([]string, 0, len(schemaErrs))
+			for _, schemaErr := range schemaErrs {
+				pointers = append(pointers, schemaErr.Pointer())
+			}
+			Expect(pointers).To(ConsistOf("/limits/cpu", "/a~1b~0c"))
+		})
+	})
+
+	Context("defaults", func() {
+		compile := func(schema string) *jsonschema.Validator {
+			v := jsonschema.NewValidator(&jsonschema.ReferenceContext{
+				JSONSchemaVersion: "https://json-schema.org/draft/2020-12/schema",
+			})
+			Expect(v.CompileSchema([]byte(schema))).To(Succeed())
+			return v
+		}
+
+		It("should apply the defaults of missing properties", func() {
+			v := compile(`{
+  "type": "object",
+  "properties": {
+    "replicas": { "type": "integer", "default": 3 },
+    "image": { "type": "string", "default": "nginx" },
+    "resources": {
+      "type": "object",
+      "default": {},
+      "properties": {
+        "cpu": { "type": "string", "default": "100m" }
+      }
+    }
+  }
+}`)
+			data := map[string]interface{}{
+				"image": "alpine",
+			}
+			res, err := v.ApplyDefaults(data)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res).To(Equal(map[string]interface{}{
+				"replicas": float64(3),
+				"image":    "alpine",
+				"resources": map[string]interface{}{
+					"cpu": "100m",
+				},
+			}))
+			// the given data must not be modified
+			Expect(data).To(HaveLen(1))
+		})
+
+		It("should apply defaults of referenced definitions, allOf subschemas and array items", func() {
+			v := compile(`{
+  "type": "object",
+  "allOf": [
+    { "properties": { "debug": { "type": "boolean", "default": false } } }
+  ],
+  "properties": {
+    "ports": {
+      "type": "array",
+      "items": { "$ref": "#/$defs/port" }
+    }
+  },
+  "$defs": {
+    "port": {
+      "type": "object",
+      "properties": {
+        "protocol": { "type": "string", "default": "TCP" }
+      }
+    }
+  }
+}`)
+			res, err := v.ApplyDefaults(map[string]interface{}{
+				"ports": []interface{}{
+					map[string]interface{}{"port": 80},
+					map[string]interface{}{"port": 53, "protocol": "UDP"},
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res).To(Equal(map[string]interface{}{
+				"debug": false,
+				"ports": []interface{}{
+					map[string]interface{}{"port": float64(80), "protocol": "TCP"},
+					map[string]interface{}{"port": float64(53), "protocol": "UDP"},
+				},
+			}))
+		})
+
+		It("should return the default of the schema for missing data", func() {
+			v := compile(`{
+  "type": "object",
+  "default": { "replicas": 1 },
+  "properties": {
+    "replicas": { "type": "integer" },
+    "image": { "type": "string", "default": "nginx" }
+  }
+}`)
+			res, err := v.ApplyDefaults(nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res).To(Equal(map[string]interface{}{
+				"replicas": float64(1),
+				"image":    "nginx",
+			}))
+
+			v = compile(`{ "type": "string" }`)
+			res, err = v.ApplyDefaults(nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res).To(BeNil())
+		})
+
+		It("should terminate for recursive schemas", func() {
+			v := compile(`{
+  "type": "object",
+  "properties": {
+    "child": { "$ref": "#", "default": {} }
+  }
+}`)
+			res, err := v.ApplyDefaults(map[string]interface{}{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res).To(HaveKey("child"))
+		})
+	})
+
 	Context("BlueprintReferenceTemplate", func() {
 		var config *jsonschema.ReferenceContext
 		BeforeEach(func() {
@@ -17,7 +17,6 @@ import (
 	"github.com/gardener/landscaper/pkg/components/model/types"
 
 	"github.com/mandelsoft/vfs/pkg/vfs"
-	"github.com/xeipuuv/gojsonschema"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
@@ -25,6 +24,9 @@ import (
 	"github.com/gardener/landscaper/pkg/landscaper/registry/components/cdutils"
 )
 
+// keyRef is the keyword of jsonschema references.
+const keyRef = "$ref"
+
 // ReferenceContext describes the context of the current reference.
 type ReferenceContext struct {
 	// LocalTypes is a map of blueprint locally defined types.
@@ -39,6 +41,9 @@ type ReferenceContext struct {
 	// RepositoryContext can be used to overwrite the effective repository context of the component descriptor.
 	// If not set, the effective repository context of the ComponentDescriptor will be used.
 	RepositoryContext *types.UnstructuredTypedObject
+	// JSONSchemaVersion is the default jsonschema version that is used for schemas without a "$schema" keyword.
+	// Schemas are validated according to the default jsonschema version of blueprints if not set.
+	JSONSchemaVersion string
 }
 
 type ReferenceResolver struct {
@@ -79,16 +84,17 @@ func (rr *ReferenceResolver) resolveMap(data map[string]interface{}, currentPath
 		return err, nil
 	}
 
-	if isRef {
+	if isRef && isLandscaperReference(uri) {
 		// current map is a reference
 		sub, err := rr.resolveReference(uri, currentPath, alreadyResolved)
 		if err != nil {
-			return nil, fmt.Errorf("error resolving reference at %s: %w", currentPath.Child(gojsonschema.KEY_REF).String(), err)
+			return nil, fmt.Errorf("error resolving reference at %s: %w", currentPath.Child(keyRef).String(), err)
 		}
 		return sub, nil
 	}
 
-	// current map is not a reference
+	// current map is not a reference or a reference that is resolved by the jsonschema validator itself.
+	// The siblings of such references are kept as they are evaluated since draft 2019-09.
 	// iterate over entries and resolve each of them
 	res := map[string]interface{}{}
 	for k, v := range data {
@@ -106,18 +112,32 @@ func (rr *ReferenceResolver) resolveMap(data map[string]interface{}, currentPath
 // If it is a reference, it returns true and the URL of the reference.
 // Otherwise, it returns false and an empty string.
 func checkForReference(data map[string]interface{}, currentPath *field.Path) (bool, string, error) {
-	value, ok := data[gojsonschema.KEY_REF]
+	value, ok := data[keyRef]
 	if !ok {
 		// no reference
 		return false, "", nil
 	}
 	typedValue, ok := value.(string)
 	if !ok {
-		return true, "", fmt.Errorf("invalid reference value at %s: expected string, got %v", currentPath.Child(gojsonschema.KEY_REF).String(), value)
+		return true, "", fmt.Errorf("invalid reference value at %s: expected string, got %v", currentPath.Child(keyRef).String(), value)
 	}
 	return true, typedValue, nil
 }
 
+// isLandscaperReference checks whether the given reference uses one of the "local", "blueprint", or "cd" schemes.
+// Invalid references are reported as landscaper references so that their error is returned by the resolver.
+func isLandscaperReference(ref string) bool {
+	uri, err := url.Parse(ref)
+	if err != nil {
+		return true
+	}
+	switch uri.Scheme {
+	case "local", "blueprint", "cd":
+		return true
+	}
+	return false
+}
+
 // resolveList is a helper function which can recursively resolve a list
 func (rr *ReferenceResolver) resolveList(data []interface{}, currentPath *field.Path, alreadyResolved stringSet) (interface{}, error) {
 	resList := make([]interface{}, len(data))
@@ -152,9 +172,9 @@ func (rr *ReferenceResolver) resolveReference(s string, currentPath *field.Path,
 	}
 
 	// unknown reference scheme
-	// rebuild reference because it is replaced in calling method
+	// rebuild reference so that it is resolved by the jsonschema validator
 	return map[string]interface{}{
-		gojsonschema.KEY_REF: s,
+		keyRef: s,
 	}, nil
 }
 
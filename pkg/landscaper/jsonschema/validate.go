@@ -5,15 +5,54 @@
 package jsonschema
 
 import (
+	"encoding/json"
 	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"time"
 
+	jsonschemav6 "github.com/santhosh-tekuri/jsonschema/v6"
+	"github.com/santhosh-tekuri/jsonschema/v6/kind"
 	"github.com/xeipuuv/gojsonschema"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+)
+
+const (
+	// schemaURL is the location of the compiled schema.
+	// Relative references of the schema are resolved against it.
+	schemaURL = "file:///landscaper/schema.json"
+	// remoteRefTimeout is the timeout for loading schemas that are referenced via http(s).
+	remoteRefTimeout = 15 * time.Second
 )
 
+var errorPrinter = message.NewPrinter(language.English)
+
+// optionalFormats are the formats that are only asserted if all formats are asserted,
+// as previous versions of the landscaper did not validate them.
+var optionalFormats = []string{"duration", "period", "semver"}
+
+// assertAllFormats defines whether the optional formats are asserted.
+var assertAllFormats atomic.Bool
+
+// SetAssertAllFormats defines whether the formats duration, period and semver are asserted.
+// These formats are ignored by default.
+func SetAssertAllFormats(assert bool) {
+	assertAllFormats.Store(assert)
+}
+
 type Validator struct {
 	Context *ReferenceContext
-	Schema  *gojsonschema.Schema
+	Schema  *jsonschemav6.Schema
+
+	// resolved is the compiled schema with all references of the ReferenceContext resolved.
+	// It is used to apply the default values of the schema.
+	resolved interface{}
 }
 
 // NewValidator returns a new Validator with the given reference context.
@@ -25,31 +64,40 @@ func NewValidator(context *ReferenceContext) *Validator {
 
 // ValidateSchema validates a jsonschema schema definition.
 func ValidateSchema(schemaBytes []byte) error {
-	_, err := gojsonschema.NewSchemaLoader().Compile(gojsonschema.NewBytesLoader(schemaBytes))
+	schema, err := decodeJSON(schemaBytes)
+	if err != nil {
+		return err
+	}
+	_, err = compile(schema, "")
 	return err
 }
 
 // ValidateSchemaWithLoader validates a jsonschema schema definition by using the given loader.
+//
+// Deprecated: use ValidateSchema instead.
 func ValidateSchemaWithLoader(loader gojsonschema.JSONLoader) error {
-	_, err := gojsonschema.NewSchemaLoader().Compile(loader)
+	schema, err := loader.LoadJSON()
+	if err != nil {
+		return err
+	}
+	_, err = compile(schema, "")
 	return err
 }
 
 func ValidateGoStruct(schemaBytes []byte, data interface{}, context *ReferenceContext) error {
-	return validate(schemaBytes, gojsonschema.NewGoLoader(data), context)
+	v := NewValidator(context)
+	if err := v.CompileSchema(schemaBytes); err != nil {
+		return err
+	}
+	return v.ValidateGoStruct(data)
 }
 
 func ValidateBytes(schemaBytes []byte, data []byte, context *ReferenceContext) error {
-	return validate(schemaBytes, gojsonschema.NewBytesLoader(data), context)
-}
-
-func validate(schemaBytes []byte, documentLoader gojsonschema.JSONLoader, context *ReferenceContext) error {
 	v := NewValidator(context)
-	err := v.CompileSchema(schemaBytes)
-	if err != nil {
+	if err := v.CompileSchema(schemaBytes); err != nil {
 		return err
 	}
-	return v.validate(documentLoader)
+	return v.ValidateBytes(data)
 }
 
 // CompileSchema compiles the given schema and sets it as schema for the validator
@@ -59,37 +107,217 @@ func (v *Validator) CompileSchema(schemaBytes []byte) error {
 	if err != nil {
 		return err
 	}
-	schema, err := gojsonschema.NewSchemaLoader().Compile(gojsonschema.NewGoLoader(resolved))
+	schema, err := compile(resolved, ref.JSONSchemaVersion)
 	if err != nil {
 		return err
 	}
 	v.Schema = schema
+	v.resolved = resolved
 	return nil
 }
 
 func (v *Validator) ValidateGoStruct(data interface{}) error {
-	return v.validate(gojsonschema.NewGoLoader(data))
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("unable to encode data: %w", err)
+	}
+	return v.ValidateBytes(raw)
 }
 
 func (v *Validator) ValidateBytes(data []byte) error {
-	return v.validate(gojsonschema.NewBytesLoader(data))
+	doc, err := decodeJSON(data)
+	if err != nil {
+		return fmt.Errorf("unable to decode data: %w", err)
+	}
+	return v.validate(doc)
 }
 
-func (v *Validator) validate(documentLoader gojsonschema.JSONLoader) error {
+func (v *Validator) validate(doc interface{}) error {
 	if v.Schema == nil {
 		return errors.New("internal error: schema has not been compiled")
 	}
-	res, err := v.Schema.Validate(documentLoader)
-	if err != nil {
+	err := v.Schema.Validate(doc)
+	if err == nil {
+		return nil
+	}
+	validationErr := &jsonschemav6.ValidationError{}
+	if !errors.As(err, &validationErr) {
 		return err
 	}
 
-	if !res.Valid() {
-		var allErrs field.ErrorList
-		for _, err := range res.Errors() {
-			allErrs = append(allErrs, field.Invalid(field.NewPath(err.Field()), err.Value(), err.Description()))
+	var errs ValidationErrors
+	for _, cause := range flattenValidationError(validationErr) {
+		value := instanceValue(doc, cause.InstanceLocation)
+		errs = append(errs, &ValidationError{
+			Location:    cause.InstanceLocation,
+			Value:       value,
+			Description: errorDescription(cause, value),
+		})
+	}
+	return errs
+}
+
+// ValidationError describes a value that violates the schema.
+type ValidationError struct {
+	// Location is the list of object keys and array indices that lead to the invalid value within the validated data.
+	Location []string
+	// Value is the invalid value.
+	Value interface{}
+	// Description describes the violation.
+	Description string
+}
+
+// Pointer returns the json pointer of the invalid value within the validated data.
+func (e *ValidationError) Pointer() string {
+	pointer := strings.Builder{}
+	for _, token := range e.Location {
+		pointer.WriteString("/")
+		pointer.WriteString(strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1"))
+	}
+	return pointer.String()
+}
+
+// ValidationErrors contains all violations of the schema by the validated data.
+type ValidationErrors []*ValidationError
+
+// ToFieldErrors converts the validation errors into a field error list.
+func (errs ValidationErrors) ToFieldErrors() field.ErrorList {
+	allErrs := make(field.ErrorList, 0, len(errs))
+	for _, err := range errs {
+		allErrs = append(allErrs, field.Invalid(instancePath(err.Location), err.Value, err.Description))
+	}
+	return allErrs
+}
+
+func (errs ValidationErrors) Error() string {
+	return errs.ToFieldErrors().ToAggregate().Error()
+}
+
+// compile compiles the given decoded schema.
+// Schemas without a "$schema" keyword are compiled according to the given jsonschema version.
+func compile(schema interface{}, jsonSchemaVersion string) (*jsonschemav6.Schema, error) {
+	draft, err := DraftForVersion(jsonSchemaVersion)
+	if err != nil {
+		return nil, err
+	}
+	httpLoader := &httpURLLoader{Timeout: remoteRefTimeout}
+	compiler := jsonschemav6.NewCompiler()
+	compiler.DefaultDraft(draft)
+	compiler.AssertFormat()
+	if !assertAllFormats.Load() {
+		for _, name := range optionalFormats {
+			compiler.RegisterFormat(&jsonschemav6.Format{Name: name, Validate: func(interface{}) error { return nil }})
 		}
-		return allErrs.ToAggregate()
 	}
-	return nil
+	compiler.UseLoader(jsonschemav6.SchemeURLLoader{
+		"http":  httpLoader,
+		"https": httpLoader,
+	})
+	if err := compiler.AddResource(schemaURL, schema); err != nil {
+		return nil, err
+	}
+	return compiler.Compile(schemaURL)
+}
+
+// DraftForVersion returns the jsonschema draft that is identified by the given version.
+// The version is the url of the draft's meta schema as it is used in the "$schema" keyword.
+// An empty version defaults to the default jsonschema version of blueprints.
+func DraftForVersion(version string) (*jsonschemav6.Draft, error) {
+	if len(version) == 0 {
+		version = lsv1alpha1.DefaultJSONSchemaVersion
+	}
+	u := strings.TrimSuffix(version, "#")
+	u = strings.TrimPrefix(u, "http://")
+	u = strings.TrimPrefix(u, "https://")
+	switch u {
+	case "json-schema.org/draft-04/schema":
+		return jsonschemav6.Draft4, nil
+	case "json-schema.org/draft-06/schema":
+		return jsonschemav6.Draft6, nil
+	case "json-schema.org/draft-07/schema":
+		return jsonschemav6.Draft7, nil
+	case "json-schema.org/draft/2019-09/schema":
+		return jsonschemav6.Draft2019, nil
+	case "json-schema.org/draft/2020-12/schema", "json-schema.org/schema":
+		return jsonschemav6.Draft2020, nil
+	}
+	return nil, fmt.Errorf("unsupported jsonschema version %q", version)
+}
+
+// flattenValidationError returns the validation errors that describe the actual violations.
+// Errors that only group other errors are omitted, except for anyOf and oneOf errors
+// which are reported together with the errors of their subschemas.
+func flattenValidationError(err *jsonschemav6.ValidationError) []*jsonschemav6.ValidationError {
+	if len(err.Causes) == 0 {
+		return []*jsonschemav6.ValidationError{err}
+	}
+	var res []*jsonschemav6.ValidationError
+	switch err.ErrorKind.(type) {
+	case *kind.AnyOf, *kind.OneOf:
+		res = append(res, err)
+	}
+	for _, cause := range err.Causes {
+		res = append(res, flattenValidationError(cause)...)
+	}
+	return res
+}
+
+// instancePath converts the location of a value in the validated data into a field path.
+func instancePath(location []string) *field.Path {
+	if len(location) == 0 {
+		return field.NewPath("(root)")
+	}
+	path := field.NewPath(location[0])
+	for _, token := range location[1:] {
+		path = path.Child(token)
+	}
+	return path
+}
+
+// instanceValue returns the value at the given location of the validated data.
+func instanceValue(doc interface{}, location []string) interface{} {
+	for _, token := range location {
+		switch typed := doc.(type) {
+		case map[string]interface{}:
+			doc = typed[token]
+		case []interface{}:
+			var i int
+			if _, err := fmt.Sscan(token, &i); err != nil || i < 0 || i >= len(typed) {
+				return nil
+			}
+			doc = typed[i]
+		default:
+			return nil
+		}
+	}
+	return doc
+}
+
+// errorDescription returns the human-readable description of a validation error of the given value.
+func errorDescription(err *jsonschemav6.ValidationError, value interface{}) string {
+	if typeErr, ok := err.ErrorKind.(*kind.Type); ok {
+		given := typeErr.Got
+		if num, ok := value.(json.Number); ok {
+			if _, err := num.Int64(); err == nil {
+				given = "integer"
+			}
+		}
+		return fmt.Sprintf("Invalid type. Expected: %s, given: %s", strings.Join(typeErr.Want, " or "), given)
+	}
+	return err.ErrorKind.LocalizedString(errorPrinter)
+}
+
+// httpURLLoader loads remote schemas that are referenced via http(s).
+type httpURLLoader http.Client
+
+func (l *httpURLLoader) Load(url string) (any, error) {
+	resp, err := (*http.Client)(l).Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s returned status code %d", url, resp.StatusCode)
+	}
+	return jsonschemav6.UnmarshalJSON(resp.Body)
 }
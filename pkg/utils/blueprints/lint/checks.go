@@ -0,0 +1,405 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lint
+
+import (
+	"regexp"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/yaml"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/apis/core/validation"
+)
+
+// rawDefinition is an import or export definition as written in the blueprint file.
+// Decoded blueprints cannot be used to detect untyped definitions as the type is defaulted during decoding.
+type rawDefinition struct {
+	Name    string          `json:"name"`
+	Type    string          `json:"type"`
+	Imports []rawDefinition `json:"imports"`
+}
+
+// checkUntypedDefinitions reports import and export definitions without a type.
+func (l *linter) checkUntypedDefinitions() {
+	lines, err := l.fileLines(lsv1alpha1.BlueprintFileName)
+	if err != nil {
+		return
+	}
+	raw := struct {
+		Imports []rawDefinition `json:"imports"`
+		Exports []rawDefinition `json:"exports"`
+	}{}
+	if err := yaml.Unmarshal([]byte(strings.Join(lines, "\n")), &raw); err != nil {
+		return
+	}
+
+	var checkImports func(defs []rawDefinition)
+	checkImports = func(defs []rawDefinition) {
+		for _, def := range defs {
+			if len(def.Type) == 0 {
+				l.report(RuleDeprecatedUntypedDefinition, lsv1alpha1.BlueprintFileName,
+					l.findEntryLine(lsv1alpha1.BlueprintFileName, "imports", "name", def.Name),
+					"import %q does not define its type", def.Name)
+			}
+			checkImports(def.Imports)
+		}
+	}
+	checkImports(raw.Imports)
+	for _, def := range raw.Exports {
+		if len(def.Type) == 0 {
+			l.report(RuleDeprecatedUntypedDefinition, lsv1alpha1.BlueprintFileName,
+				l.findEntryLine(lsv1alpha1.BlueprintFileName, "exports", "name", def.Name),
+				"export %q does not define its type", def.Name)
+		}
+	}
+}
+
+// checkImportReferences reports templates that reference undeclared imports and records the used imports.
+func (l *linter) checkImportReferences() {
+	declared := sets.New[string]()
+	for _, imp := range l.imports {
+		declared.Insert(imp.Name)
+	}
+	// bindings of import executions are added to the imports
+	for _, src := range l.sourcesOf(importExecution) {
+		for _, e := range l.entries[src] {
+			if len(e.parents) == 1 && e.parents[0] == "bindings" {
+				declared.Insert(e.key)
+			}
+		}
+	}
+
+	for _, src := range l.sources {
+		reported := sets.New[string]()
+		for _, ref := range l.references[src] {
+			if ref.binding != importsBinding {
+				continue
+			}
+			if len(ref.name) == 0 {
+				// the imports are used as a whole, so every import might be used
+				l.usedImports.Insert(sets.List(declared)...)
+				continue
+			}
+			l.usedImports.Insert(ref.name)
+			if !ref.certain || declared.Has(ref.name) || reported.Has(ref.name) {
+				continue
+			}
+			reported.Insert(ref.name)
+			l.report(RuleUndeclaredImportReference, src.file, src.line(l, src.lineOfOffset(ref.offset)),
+				"%s %q references the undeclared import %q", src.kind, src.executor.Name, ref.name)
+		}
+	}
+}
+
+// checkDeployItemTargets reports deploy items whose target is not a declared target import
+// or that reference their target by name.
+func (l *linter) checkDeployItemTargets() {
+	targetImports := l.importNames(lsv1alpha1.ImportTypeTarget, lsv1alpha1.ImportTypeTargetList, lsv1alpha1.ImportTypeTargetMap)
+	for _, src := range l.sourcesOf(deployExecution) {
+		for _, e := range l.entries[src] {
+			if !e.hasParents("deployItems", "target") {
+				continue
+			}
+			switch e.key {
+			case "import":
+				name, ok := e.literal()
+				if !ok {
+					continue
+				}
+				l.usedImports.Insert(name)
+				if targetImports.Has(name) {
+					continue
+				}
+				l.report(RuleUndeclaredTarget, src.file, src.line(l, e.line),
+					"deploy execution %q references %q which is not declared as target or targetMap import", src.executor.Name, name)
+			case "name":
+				l.report(RuleDeprecatedTargetName, src.file, src.line(l, e.line),
+					"deploy execution %q references a target by name, use \"import\" to reference an imported target instead", src.executor.Name)
+			}
+		}
+	}
+}
+
+// checkExports reports exports that are not set by any export execution
+// and export executions that use the deprecated values binding.
+func (l *linter) checkExports() {
+	var (
+		exportSources = l.sourcesOf(exportExecution)
+		set           = sets.New[string]()
+		dynamic       = false
+	)
+	for _, src := range exportSources {
+		dynamic = dynamic || hasDynamicExports(src, l.entries[src])
+		for _, e := range l.entries[src] {
+			if len(e.parents) == 1 && e.parents[0] == "exports" {
+				set.Insert(e.key)
+			}
+		}
+		reported := sets.New[int]()
+		for _, ref := range l.references[src] {
+			if ref.binding != valuesBinding || !ref.certain {
+				continue
+			}
+			line := src.line(l, src.lineOfOffset(ref.offset))
+			if reported.Has(line) {
+				continue
+			}
+			reported.Insert(line)
+			l.report(RuleDeprecatedExportValues, src.file, line,
+				"export execution %q uses the deprecated binding \"values\"", src.executor.Name)
+		}
+	}
+
+	if dynamic {
+		return
+	}
+	for _, exp := range l.blueprint.Info.Exports {
+		if set.Has(exp.Name) || setByLiteral(exportSources, exp.Name) {
+			continue
+		}
+		l.report(RuleUnsetExport, lsv1alpha1.BlueprintFileName,
+			l.findEntryLine(lsv1alpha1.BlueprintFileName, "exports", "name", exp.Name),
+			"export %q is not set by any export execution", exp.Name)
+	}
+}
+
+// dynamicExportsRegex matches template actions that generate yaml structures.
+var dynamicExportsRegex = regexp.MustCompile(`\b(toYaml|toJson|include|template)\b`)
+
+// hasDynamicExports returns true if the exports of a GoTemplate are generated by template functions,
+// so that the exported keys cannot be determined statically.
+func hasDynamicExports(src *templateSource, entries []entry) bool {
+	if src.executor.Type != lsv1alpha1.GOTemplateType {
+		return false
+	}
+	lines := strings.Split(src.text, "\n")
+	for _, e := range entries {
+		if e.key != "exports" || len(e.parents) != 0 {
+			continue
+		}
+		if _, ok := e.literal(); !ok && len(strings.TrimSpace(e.value)) != 0 {
+			return true
+		}
+		for _, line := range lines[e.line+1:] {
+			trimmed := strings.TrimSpace(line)
+			if len(trimmed) == 0 {
+				continue
+			}
+			if isTemplateAction(trimmed) {
+				if dynamicExportsRegex.MatchString(trimmed) {
+					return true
+				}
+				continue
+			}
+			if match := keyRegex.FindStringSubmatch(line); match != nil && len(match[1])+len(match[2]) == 0 {
+				// next top-level key
+				break
+			}
+		}
+	}
+	return false
+}
+
+// setByLiteral returns true if a GoTemplate contains the name as string literal, e.g. as key of a dict.
+func setByLiteral(sources []*templateSource, name string) bool {
+	for _, src := range sources {
+		if src.executor.Type != lsv1alpha1.GOTemplateType {
+			continue
+		}
+		if strings.Contains(src.text, `"`+name+`"`) {
+			return true
+		}
+	}
+	return false
+}
+
+// subinstallationImport is a reference of a subinstallation to a value of its parent or of a sibling.
+type subinstallationImport struct {
+	importType lsv1alpha1.ImportType
+	name       string
+	file       string
+	line       int
+}
+
+// checkSubinstallations reports subinstallation imports that cannot be satisfied and
+// export executions that use values that are not exported by any subinstallation.
+func (l *linter) checkSubinstallations() {
+	var (
+		exportedData    = sets.New[string]()
+		exportedTargets = sets.New[string]()
+		imports         = make([]subinstallationImport, 0)
+		// dynamicExports is true if the exports of a templated subinstallation cannot be determined statically.
+		dynamicExports = false
+	)
+
+	templates, err := l.blueprint.GetSubinstallations()
+	if err != nil {
+		l.report(RuleInvalidTemplate, lsv1alpha1.BlueprintFileName,
+			l.findLine(lsv1alpha1.BlueprintFileName, "subinstallations:"),
+			"unable to read subinstallations: %s", err.Error())
+	}
+	for i, tmpl := range templates {
+		if tmpl == nil {
+			continue
+		}
+		file, section := lsv1alpha1.BlueprintFileName, "subinstallations"
+		if len(l.blueprint.Info.Subinstallations[i].File) != 0 {
+			file, section = l.blueprint.Info.Subinstallations[i].File, ""
+		}
+		addImport := func(importType lsv1alpha1.ImportType, key, name string) {
+			imports = append(imports, subinstallationImport{
+				importType: importType,
+				name:       name,
+				file:       file,
+				line:       l.findEntryLine(file, section, key, name),
+			})
+		}
+
+		for _, exp := range tmpl.Exports.Data {
+			exportedData.Insert(exp.DataRef)
+		}
+		for _, exp := range tmpl.Exports.Targets {
+			exportedTargets.Insert(exp.Target)
+		}
+		for _, imp := range tmpl.Imports.Data {
+			if len(imp.DataRef) != 0 {
+				addImport(lsv1alpha1.ImportTypeData, "dataRef", imp.DataRef)
+			}
+		}
+		for _, imp := range tmpl.Imports.Targets {
+			switch {
+			case len(imp.Target) != 0:
+				addImport(lsv1alpha1.ImportTypeTarget, "target", imp.Target)
+			case imp.Targets != nil:
+				for _, t := range imp.Targets {
+					addImport(lsv1alpha1.ImportTypeTarget, "", t)
+				}
+			case len(imp.TargetListReference) != 0:
+				addImport(lsv1alpha1.ImportTypeTargetList, "targetListRef", imp.TargetListReference)
+			case imp.TargetMap != nil:
+				for _, t := range imp.TargetMap {
+					addImport(lsv1alpha1.ImportTypeTarget, "", t)
+				}
+			case len(imp.TargetMapReference) != 0:
+				addImport(lsv1alpha1.ImportTypeTargetMap, "targetMapRef", imp.TargetMapReference)
+			}
+		}
+	}
+
+	for _, src := range l.sourcesOf(subinstallationExecution) {
+		for _, e := range l.entries[src] {
+			value, isLiteral := e.literal()
+			switch {
+			case e.key == "dataRef" && e.hasParents("exports", "data"):
+				if !isLiteral {
+					dynamicExports = true
+					continue
+				}
+				exportedData.Insert(value)
+			case e.key == "target" && e.hasParents("exports", "targets"):
+				if !isLiteral {
+					dynamicExports = true
+					continue
+				}
+				exportedTargets.Insert(value)
+			case !isLiteral:
+				continue
+			case e.key == "dataRef" && e.hasParents("imports", "data"):
+				imports = append(imports, subinstallationImport{importType: lsv1alpha1.ImportTypeData, name: value, file: src.file, line: src.line(l, e.line)})
+			case e.key == "target" && e.hasParents("imports", "targets"):
+				imports = append(imports, subinstallationImport{importType: lsv1alpha1.ImportTypeTarget, name: value, file: src.file, line: src.line(l, e.line)})
+			case e.key == "targetListRef" && e.hasParents("imports", "targets"):
+				imports = append(imports, subinstallationImport{importType: lsv1alpha1.ImportTypeTargetList, name: value, file: src.file, line: src.line(l, e.line)})
+			case e.key == "targetMapRef" && e.hasParents("imports", "targets"):
+				imports = append(imports, subinstallationImport{importType: lsv1alpha1.ImportTypeTargetMap, name: value, file: src.file, line: src.line(l, e.line)})
+			}
+		}
+		// templated subinstallations may reference imports in any form, e.g. in a targetMap
+		for _, imp := range l.imports {
+			if containsWord(src.text, imp.Name) {
+				l.usedImports.Insert(imp.Name)
+			}
+		}
+	}
+
+	var (
+		dataImports       = l.importNames(lsv1alpha1.ImportTypeData)
+		targetImports     = l.importNames(lsv1alpha1.ImportTypeTarget)
+		targetListImports = l.importNames(lsv1alpha1.ImportTypeTargetList)
+		targetMapImports  = l.importNames(lsv1alpha1.ImportTypeTargetMap)
+	)
+	for _, imp := range imports {
+		isIndexed, name := validation.IsIndexed(imp.name)
+		l.usedImports.Insert(name)
+
+		var satisfied bool
+		switch {
+		case imp.importType == lsv1alpha1.ImportTypeTargetList:
+			satisfied = targetListImports.Has(name)
+		case imp.importType == lsv1alpha1.ImportTypeTargetMap:
+			satisfied = targetMapImports.Has(name)
+		case isIndexed:
+			satisfied = targetListImports.Has(name) || targetMapImports.Has(name)
+		case imp.importType == lsv1alpha1.ImportTypeData:
+			satisfied = dynamicExports || dataImports.Has(name) || exportedData.Has(name)
+		default:
+			satisfied = dynamicExports || targetImports.Has(name) || exportedTargets.Has(name)
+		}
+		if satisfied {
+			continue
+		}
+		l.report(RuleUnresolvedSubinstallationImport, imp.file, imp.line,
+			"%s import %q of a subinstallation is neither imported by the blueprint nor exported by another subinstallation", imp.importType, imp.name)
+	}
+
+	if dynamicExports {
+		return
+	}
+	availableData := dataImports.Union(exportedData)
+	availableTargets := targetImports.Union(targetListImports).Union(targetMapImports).Union(exportedTargets)
+	for _, src := range l.sourcesOf(exportExecution) {
+		reported := sets.New[string]()
+		for _, ref := range l.references[src] {
+			if !ref.certain || len(ref.name) == 0 || reported.Has(ref.binding+"/"+ref.name) {
+				continue
+			}
+			switch ref.binding {
+			case dataObjectsBinding:
+				if availableData.Has(ref.name) {
+					continue
+				}
+			case targetsBinding:
+				if availableTargets.Has(ref.name) {
+					continue
+				}
+			default:
+				continue
+			}
+			reported.Insert(ref.binding + "/" + ref.name)
+			l.report(RuleUnresolvedSubinstallationExport, src.file, src.line(l, src.lineOfOffset(ref.offset)),
+				"export execution %q references %s %q which is not exported by any subinstallation", src.executor.Name, ref.binding, ref.name)
+		}
+	}
+}
+
+// checkUnusedImports reports imports that are not used.
+// Imports with conditional imports are not reported as they might only be used as condition.
+func (l *linter) checkUnusedImports() {
+	for _, imp := range l.imports {
+		if l.usedImports.Has(imp.Name) || len(imp.ConditionalImports) != 0 {
+			continue
+		}
+		l.report(RuleUnusedImport, lsv1alpha1.BlueprintFileName,
+			l.findEntryLine(lsv1alpha1.BlueprintFileName, "imports", "name", imp.Name),
+			"import %q is not used", imp.Name)
+	}
+}
+
+// containsWord returns true if the text contains the word delimited by characters that cannot be part of a name.
+func containsWord(text, word string) bool {
+	return regexp.MustCompile(`(^|[^A-Za-z0-9_\-])` + regexp.QuoteMeta(word) + `($|[^A-Za-z0-9_\-])`).MatchString(text)
+}
@@ -0,0 +1,396 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lint
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/mandelsoft/vfs/pkg/vfs"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/utils/blueprints"
+)
+
+// Finding is a problem of a blueprint reported by a rule.
+type Finding struct {
+	// RuleID is the id of the rule that reported the finding.
+	RuleID string `json:"rule"`
+	// Severity is the effective severity of the finding.
+	Severity Severity `json:"severity"`
+	// Message describes the problem.
+	Message string `json:"message"`
+	// File is the file of the blueprint filesystem that contains the problem.
+	File string `json:"file,omitempty"`
+	// Line is the 1-based line of the problem in the file. It is 0 if unknown.
+	Line int `json:"line,omitempty"`
+}
+
+// String returns the finding in the format "<file>:<line>: <severity> [<rule>] <message>".
+func (f Finding) String() string {
+	location := f.File
+	if f.Line != 0 {
+		location = fmt.Sprintf("%s:%d", f.File, f.Line)
+	}
+	return fmt.Sprintf("%s: %s [%s] %s", location, f.Severity, f.RuleID, f.Message)
+}
+
+// Result is the result of a lint run.
+type Result struct {
+	// Findings are the reported findings ordered by their location.
+	Findings []Finding `json:"findings"`
+	// Suppressed is the number of findings that have been suppressed by inline directives.
+	Suppressed int `json:"suppressed"`
+}
+
+// HasFindings returns true if the result contains findings with at least the given severity.
+func (r *Result) HasFindings(minSeverity Severity) bool {
+	for _, f := range r.Findings {
+		if f.Severity.rank() >= minSeverity.rank() {
+			return true
+		}
+	}
+	return false
+}
+
+// HasErrors returns true if the result contains findings with severity error.
+func (r *Result) HasErrors() bool {
+	return r.HasFindings(SeverityError)
+}
+
+// Linter statically checks blueprints for common mistakes.
+// In contrast to the validation of blueprints, the linter also inspects the templates of the blueprint.
+// As templates are not rendered, the checks of templates are heuristics that avoid false positives
+// for dynamically generated content.
+type Linter struct {
+	// disabled contains the ids of the disabled rules.
+	disabled sets.Set[string]
+	// severities contains overwritten severities by rule id.
+	severities map[string]Severity
+}
+
+// New creates a new linter with all rules enabled.
+func New() *Linter {
+	return &Linter{
+		disabled:   sets.New[string](),
+		severities: map[string]Severity{},
+	}
+}
+
+// WithDisabledRules disables the rules with the given ids.
+func (l *Linter) WithDisabledRules(ids ...string) *Linter {
+	l.disabled.Insert(ids...)
+	return l
+}
+
+// WithSeverity overwrites the severity of the rule with the given id.
+func (l *Linter) WithSeverity(id string, severity Severity) *Linter {
+	l.severities[id] = severity
+	return l
+}
+
+// Lint checks the blueprint and its filesystem.
+// An error is only returned if the linter is misconfigured; problems of the blueprint are reported as findings.
+func (l *Linter) Lint(blueprint *blueprints.Blueprint) (*Result, error) {
+	if blueprint == nil || blueprint.Info == nil {
+		return nil, fmt.Errorf("blueprint may not be nil")
+	}
+	for _, id := range sets.List(l.disabled) {
+		if _, ok := GetRule(id); !ok {
+			return nil, fmt.Errorf("unknown rule %q", id)
+		}
+	}
+	for id := range l.severities {
+		if _, ok := GetRule(id); !ok {
+			return nil, fmt.Errorf("unknown rule %q", id)
+		}
+	}
+
+	run := &linter{
+		Linter:    l,
+		blueprint: blueprint,
+		files:     map[string][]string{},
+		fileErrs:  map[string]error{},
+		findings:  make([]Finding, 0),
+	}
+	run.run()
+	return run.result(), nil
+}
+
+// linter contains the state of a single lint run.
+type linter struct {
+	*Linter
+	blueprint *blueprints.Blueprint
+
+	// files caches the lines of the files of the blueprint filesystem.
+	files    map[string][]string
+	fileErrs map[string]error
+	findings []Finding
+
+	// imports are all import definitions including conditional imports.
+	imports []lsv1alpha1.ImportDefinition
+	// sources are the successfully loaded template sources.
+	sources []*templateSource
+	// references contains the references of the parsed templates.
+	references map[*templateSource][]reference
+	// entries contains the yaml mapping entries of the templates.
+	entries map[*templateSource][]entry
+	// usedImports contains the imports that are used by subinstallations or deploy item targets.
+	usedImports sets.Set[string]
+}
+
+func (l *linter) run() {
+	l.imports = flattenImports(l.blueprint.Info.Imports)
+	l.usedImports = sets.New[string]()
+	l.loadSources()
+
+	l.checkUntypedDefinitions()
+	l.checkImportReferences()
+	l.checkDeployItemTargets()
+	l.checkExports()
+	l.checkSubinstallations()
+	// must run last as the other checks collect the used imports
+	l.checkUnusedImports()
+}
+
+// result applies the configuration and the inline suppressions to the findings.
+func (l *linter) result() *Result {
+	res := &Result{
+		Findings: make([]Finding, 0, len(l.findings)),
+	}
+	suppressionsByFile := map[string]*suppressions{}
+	for _, f := range l.findings {
+		if l.disabled.Has(f.RuleID) {
+			continue
+		}
+		s, ok := suppressionsByFile[f.File]
+		if !ok {
+			if lines, err := l.fileLines(f.File); err == nil {
+				s = parseSuppressions(lines)
+			}
+			suppressionsByFile[f.File] = s
+		}
+		if s.suppresses(f.RuleID, f.Line) {
+			res.Suppressed++
+			continue
+		}
+		if severity, ok := l.severities[f.RuleID]; ok {
+			f.Severity = severity
+		}
+		res.Findings = append(res.Findings, f)
+	}
+
+	sort.SliceStable(res.Findings, func(i, j int) bool {
+		a, b := res.Findings[i], res.Findings[j]
+		if a.File != b.File {
+			return a.File < b.File
+		}
+		if a.Line != b.Line {
+			return a.Line < b.Line
+		}
+		return a.RuleID < b.RuleID
+	})
+	return res
+}
+
+func (l *linter) report(rule Rule, file string, line int, format string, args ...interface{}) {
+	l.findings = append(l.findings, Finding{
+		RuleID:   rule.ID,
+		Severity: rule.Severity,
+		Message:  fmt.Sprintf(format, args...),
+		File:     file,
+		Line:     line,
+	})
+}
+
+// fileLines returns the lines of a file of the blueprint filesystem.
+func (l *linter) fileLines(path string) ([]string, error) {
+	if lines, ok := l.files[path]; ok {
+		return lines, nil
+	}
+	if err, ok := l.fileErrs[path]; ok {
+		return nil, err
+	}
+	if l.blueprint.Fs == nil {
+		err := fmt.Errorf("blueprint has no filesystem")
+		l.fileErrs[path] = err
+		return nil, err
+	}
+	data, err := vfs.ReadFile(l.blueprint.Fs, path)
+	if err != nil {
+		l.fileErrs[path] = err
+		return nil, err
+	}
+	lines := strings.Split(string(data), "\n")
+	l.files[path] = lines
+	return lines, nil
+}
+
+// findLine returns the 1-based line of the first line of the file that contains the text.
+func (l *linter) findLine(file, text string) int {
+	if len(text) == 0 {
+		return 0
+	}
+	lines, err := l.fileLines(file)
+	if err != nil {
+		return 0
+	}
+	for i, line := range lines {
+		if strings.Contains(line, text) {
+			return i + 1
+		}
+	}
+	return 0
+}
+
+// findEntryLine returns the 1-based line of the first yaml entry "<key>: <value>" inside of the given top-level section.
+// If key is empty, list items with the value are matched.
+// The whole file is searched if the section is empty.
+func (l *linter) findEntryLine(file, section, key, value string) int {
+	lines, err := l.fileLines(file)
+	if err != nil {
+		return 0
+	}
+	prefix := `-\s+`
+	if len(key) != 0 {
+		prefix = `(-\s+)?["']?` + regexp.QuoteMeta(key) + `["']?:\s*`
+	}
+	entryRegex := regexp.MustCompile(`^\s*` + prefix + `["']?` + regexp.QuoteMeta(value) + `["']?\s*(#.*)?$`)
+
+	start, end := 0, len(lines)
+	if len(section) != 0 {
+		start, end = sectionRange(lines, section)
+	}
+	for i := start; i < end; i++ {
+		if entryRegex.MatchString(lines[i]) {
+			return i + 1
+		}
+	}
+	return 0
+}
+
+// sectionRange returns the 0-based range of lines of a top-level yaml key.
+// The whole file is returned if the key does not exist.
+func sectionRange(lines []string, section string) (int, int) {
+	start := -1
+	for i, line := range lines {
+		if start < 0 {
+			if strings.HasPrefix(line, section+":") {
+				start = i
+			}
+			continue
+		}
+		if len(line) != 0 && line[0] != ' ' && line[0] != '\t' && line[0] != '#' && line[0] != '-' {
+			return start, i
+		}
+	}
+	if start < 0 {
+		return 0, len(lines)
+	}
+	return start, len(lines)
+}
+
+// loadSources reads and parses all templates of the blueprint.
+func (l *linter) loadSources() {
+	l.sources = make([]*templateSource, 0)
+	l.references = map[*templateSource][]reference{}
+	l.entries = map[*templateSource][]entry{}
+
+	executions := []struct {
+		kind      executionKind
+		executors []lsv1alpha1.TemplateExecutor
+	}{
+		{importExecution, l.blueprint.Info.ImportExecutions},
+		{deployExecution, l.blueprint.Info.DeployExecutions},
+		{exportExecution, l.blueprint.Info.ExportExecutions},
+		{subinstallationExecution, l.blueprint.Info.SubinstallationExecutions},
+	}
+	for _, execs := range executions {
+		for _, exec := range execs.executors {
+			src, err := l.loadTemplateSource(execs.kind, exec)
+			if err != nil {
+				l.report(RuleInvalidTemplate, lsv1alpha1.BlueprintFileName,
+					l.findEntryLine(lsv1alpha1.BlueprintFileName, string(execs.kind), "name", exec.Name),
+					"%s %q: %s", execs.kind, exec.Name, err.Error())
+				continue
+			}
+
+			refs, err := templateReferences(src)
+			if err != nil {
+				l.report(RuleInvalidTemplate, src.file, src.line(l, parseErrorLine(err)),
+					"%s %q: unable to parse template: %s", execs.kind, exec.Name, err.Error())
+				continue
+			}
+			l.sources = append(l.sources, src)
+			l.references[src] = refs
+			l.entries[src] = scanEntries(src.text)
+		}
+	}
+}
+
+var parseErrorLineRegex = regexp.MustCompile(`^template: [^:]*:(\d+):`)
+
+// parseErrorLine returns the 0-based line of a GoTemplate parse error.
+func parseErrorLine(err error) int {
+	match := parseErrorLineRegex.FindStringSubmatch(err.Error())
+	if match == nil {
+		return -1
+	}
+	line, _ := strconv.Atoi(match[1])
+	return line - 1
+}
+
+// sourcesOf returns the template sources of the given kind.
+func (l *linter) sourcesOf(kind executionKind) []*templateSource {
+	sources := make([]*templateSource, 0)
+	for _, src := range l.sources {
+		if src.kind == kind {
+			sources = append(sources, src)
+		}
+	}
+	return sources
+}
+
+// importNames returns the names of the imports of the given types.
+func (l *linter) importNames(types ...lsv1alpha1.ImportType) sets.Set[string] {
+	names := sets.New[string]()
+	for _, imp := range l.imports {
+		for _, t := range types {
+			if importType(imp) == t {
+				names.Insert(imp.Name)
+			}
+		}
+	}
+	return names
+}
+
+// flattenImports returns the import definitions including all conditional imports.
+func flattenImports(defs lsv1alpha1.ImportDefinitionList) []lsv1alpha1.ImportDefinition {
+	imports := make([]lsv1alpha1.ImportDefinition, 0, len(defs))
+	for _, def := range defs {
+		imports = append(imports, def)
+		imports = append(imports, flattenImports(def.ConditionalImports)...)
+	}
+	return imports
+}
+
+// importType returns the type of an import definition.
+// Untyped definitions are typed by their schema or target type like the landscaper does for backwards compatibility.
+func importType(def lsv1alpha1.ImportDefinition) lsv1alpha1.ImportType {
+	if len(def.Type) != 0 {
+		return def.Type
+	}
+	if def.Schema != nil {
+		return lsv1alpha1.ImportTypeData
+	}
+	if len(def.TargetType) != 0 {
+		return lsv1alpha1.ImportTypeTarget
+	}
+	return ""
+}
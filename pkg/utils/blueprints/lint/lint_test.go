@@ -0,0 +1,100 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lint_test
+
+import (
+	"github.com/mandelsoft/vfs/pkg/osfs"
+	"github.com/mandelsoft/vfs/pkg/projectionfs"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+
+	"github.com/gardener/landscaper/pkg/utils/blueprints"
+	"github.com/gardener/landscaper/pkg/utils/blueprints/lint"
+)
+
+func readBlueprint(dir string) *blueprints.Blueprint {
+	fs, err := projectionfs.New(osfs.New(), dir)
+	Expect(err).ToNot(HaveOccurred())
+	blueprint, err := blueprints.NewFromFs(fs)
+	Expect(err).ToNot(HaveOccurred())
+	return blueprint
+}
+
+func finding(rule lint.Rule, file string, line int) OmegaMatcher {
+	return MatchFields(IgnoreExtras, Fields{
+		"RuleID":   Equal(rule.ID),
+		"Severity": Equal(rule.Severity),
+		"File":     Equal(file),
+		"Line":     Equal(line),
+	})
+}
+
+var _ = Describe("Linter", func() {
+
+	It("should not report findings for a correct blueprint", func() {
+		res, err := lint.New().Lint(readBlueprint("./testdata/00-clean"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res.Findings).To(BeEmpty())
+		Expect(res.HasErrors()).To(BeFalse())
+	})
+
+	It("should report all findings with their location", func() {
+		res, err := lint.New().Lint(readBlueprint("./testdata/01-findings"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res.Findings).To(ConsistOf(
+			finding(lint.RuleUnusedImport, "blueprint.yaml", 9),
+			finding(lint.RuleDeprecatedUntypedDefinition, "blueprint.yaml", 13),
+			finding(lint.RuleUnsetExport, "blueprint.yaml", 22),
+			finding(lint.RuleUndeclaredTarget, "blueprint.yaml", 35),
+			finding(lint.RuleUndeclaredImportReference, "blueprint.yaml", 38),
+			finding(lint.RuleDeprecatedTargetName, "blueprint.yaml", 42),
+			finding(lint.RuleDeprecatedExportValues, "blueprint.yaml", 50),
+			finding(lint.RuleUnresolvedSubinstallationExport, "blueprint.yaml", 51),
+			finding(lint.RuleUnresolvedSubinstallationImport, "blueprint.yaml", 62),
+		))
+		Expect(res.HasErrors()).To(BeTrue())
+		Expect(res.Findings[0].String()).To(Equal(`blueprint.yaml:9: warning [unused-import] import "unused" is not used`))
+	})
+
+	It("should suppress findings by inline directives", func() {
+		res, err := lint.New().Lint(readBlueprint("./testdata/02-suppressed"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res.Findings).To(ConsistOf(
+			finding(lint.RuleUndeclaredImportReference, "/deploy-execution.yaml", 7),
+		))
+		Expect(res.Suppressed).To(Equal(5))
+	})
+
+	It("should disable rules and overwrite severities", func() {
+		res, err := lint.New().
+			WithDisabledRules(lint.RuleUnusedImport.ID, lint.RuleDeprecatedUntypedDefinition.ID).
+			WithSeverity(lint.RuleUnsetExport.ID, lint.SeverityInfo).
+			Lint(readBlueprint("./testdata/01-findings"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res.Findings).To(ContainElement(MatchFields(IgnoreExtras, Fields{
+			"RuleID":   Equal(lint.RuleUnsetExport.ID),
+			"Severity": Equal(lint.SeverityInfo),
+		})))
+		Expect(res.Findings).ToNot(ContainElement(MatchFields(IgnoreExtras, Fields{
+			"RuleID": BeElementOf(lint.RuleUnusedImport.ID, lint.RuleDeprecatedUntypedDefinition.ID),
+		})))
+	})
+
+	It("should fail for unknown rules", func() {
+		_, err := lint.New().WithDisabledRules("unknown").Lint(readBlueprint("./testdata/00-clean"))
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should report templates that cannot be parsed", func() {
+		blueprint := readBlueprint("./testdata/00-clean")
+		blueprint.Info.DeployExecutions[0].Template.RawMessage = []byte(`"deployItems: {{ .imports.cluster "`)
+		res, err := lint.New().Lint(blueprint)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res.Findings).To(ContainElement(MatchFields(IgnoreExtras, Fields{
+			"RuleID": Equal(lint.RuleInvalidTemplate.ID),
+		})))
+	})
+})
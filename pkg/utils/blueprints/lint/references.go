@@ -0,0 +1,208 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lint
+
+import (
+	"regexp"
+	"text/template/parse"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+)
+
+const (
+	importsBinding     = "imports"
+	valuesBinding      = "values"
+	dataObjectsBinding = "dataobjects"
+	targetsBinding     = "targets"
+)
+
+// trackedBindings are the top-level template bindings whose references are collected.
+var trackedBindings = map[string]bool{
+	importsBinding:     true,
+	valuesBinding:      true,
+	dataObjectsBinding: true,
+	targetsBinding:     true,
+}
+
+// reference is an access to a top-level binding of the template values.
+type reference struct {
+	// binding is the top-level binding, e.g. "imports".
+	binding string
+	// name is the accessed key of the binding.
+	// It is empty if the binding is used as a whole, e.g. "toYaml .imports".
+	name string
+	// offset is the byte offset of the reference in the template text.
+	offset int
+	// certain is false if it is not known whether the reference refers to the template values,
+	// e.g. because the dot is rebound inside of a range.
+	certain bool
+}
+
+// templateReferences returns the references of a template to the tracked bindings.
+func templateReferences(src *templateSource) ([]reference, error) {
+	if src.executor.Type == lsv1alpha1.GOTemplateType {
+		return goTemplateReferences(src.text)
+	}
+	return spiffReferences(src.text), nil
+}
+
+// goTemplateReferences parses a GoTemplate and returns its references.
+// Functions are not checked as the templating functions are only known at runtime.
+func goTemplateReferences(text string) ([]reference, error) {
+	const rootName = "template"
+	tree := parse.New(rootName)
+	tree.Mode = parse.SkipFuncCheck
+	trees := map[string]*parse.Tree{}
+	if _, err := tree.Parse(text, "", "", trees); err != nil {
+		return nil, err
+	}
+
+	w := &goTemplateWalker{
+		refs: make([]reference, 0),
+	}
+	for name, t := range trees {
+		if t.Root == nil {
+			continue
+		}
+		// the data of named templates depends on their invocation
+		w.walk(t.Root, name == rootName)
+	}
+	return w.refs, nil
+}
+
+type goTemplateWalker struct {
+	refs []reference
+}
+
+// walk collects the references of a node.
+// dotIsRoot defines whether the dot refers to the template values.
+func (w *goTemplateWalker) walk(node parse.Node, dotIsRoot bool) {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return
+		}
+		for _, child := range n.Nodes {
+			w.walk(child, dotIsRoot)
+		}
+	case *parse.ActionNode:
+		w.walk(n.Pipe, dotIsRoot)
+	case *parse.IfNode:
+		w.walk(n.Pipe, dotIsRoot)
+		w.walk(n.List, dotIsRoot)
+		w.walk(n.ElseList, dotIsRoot)
+	case *parse.RangeNode:
+		w.walk(n.Pipe, dotIsRoot)
+		w.walk(n.List, false)
+		w.walk(n.ElseList, dotIsRoot)
+	case *parse.WithNode:
+		w.walk(n.Pipe, dotIsRoot)
+		w.walk(n.List, false)
+		w.walk(n.ElseList, dotIsRoot)
+	case *parse.TemplateNode:
+		w.walk(n.Pipe, dotIsRoot)
+	case *parse.PipeNode:
+		if n == nil {
+			return
+		}
+		for _, cmd := range n.Cmds {
+			w.walk(cmd, dotIsRoot)
+		}
+	case *parse.CommandNode:
+		w.walkCommand(n, dotIsRoot)
+	case *parse.ChainNode:
+		w.walk(n.Node, dotIsRoot)
+	case *parse.FieldNode:
+		w.add(n.Ident, int(n.Pos), dotIsRoot)
+	case *parse.VariableNode:
+		if len(n.Ident) > 1 && n.Ident[0] == "$" {
+			w.add(n.Ident[1:], int(n.Pos), dotIsRoot)
+		}
+	}
+}
+
+// walkCommand collects the references of a command.
+// Accesses of the form "index .imports "name"" are resolved to a reference of the key.
+func (w *goTemplateWalker) walkCommand(cmd *parse.CommandNode, dotIsRoot bool) {
+	args := cmd.Args
+	if len(args) >= 3 {
+		if ident, ok := args[0].(*parse.IdentifierNode); ok && ident.Ident == "index" {
+			if binding, ok := bindingOf(args[1]); ok {
+				if key, ok := args[2].(*parse.StringNode); ok {
+					w.refs = append(w.refs, reference{
+						binding: binding,
+						name:    key.Text,
+						offset:  int(args[1].Position()),
+						certain: dotIsRoot,
+					})
+					args = args[3:]
+				}
+			}
+		}
+	}
+	for _, arg := range args {
+		w.walk(arg, dotIsRoot)
+	}
+}
+
+func (w *goTemplateWalker) add(idents []string, offset int, certain bool) {
+	if len(idents) == 0 || !trackedBindings[idents[0]] {
+		return
+	}
+	ref := reference{
+		binding: idents[0],
+		offset:  offset,
+		certain: certain,
+	}
+	if len(idents) > 1 {
+		ref.name = idents[1]
+	}
+	w.refs = append(w.refs, ref)
+}
+
+// bindingOf returns the binding if the node is a plain access of a tracked binding like ".imports" or "$.imports".
+func bindingOf(node parse.Node) (string, bool) {
+	var idents []string
+	switch n := node.(type) {
+	case *parse.FieldNode:
+		idents = n.Ident
+	case *parse.VariableNode:
+		if len(n.Ident) < 2 || n.Ident[0] != "$" {
+			return "", false
+		}
+		idents = n.Ident[1:]
+	default:
+		return "", false
+	}
+	if len(idents) != 1 || !trackedBindings[idents[0]] {
+		return "", false
+	}
+	return idents[0], true
+}
+
+var (
+	spiffExpressionRegex = regexp.MustCompile(`\(\((.*?)\)\)`)
+	spiffReferenceRegex  = regexp.MustCompile(`(^|[^A-Za-z0-9_.\-])(imports|values|dataobjects|targets)(\.([A-Za-z0-9_\-]+))?`)
+)
+
+// spiffReferences returns the references of the expressions of a spiff template.
+func spiffReferences(text string) []reference {
+	refs := make([]reference, 0)
+	for _, expr := range spiffExpressionRegex.FindAllStringSubmatchIndex(text, -1) {
+		exprStart, exprEnd := expr[2], expr[3]
+		for _, match := range spiffReferenceRegex.FindAllStringSubmatchIndex(text[exprStart:exprEnd], -1) {
+			ref := reference{
+				binding: text[exprStart+match[4] : exprStart+match[5]],
+				offset:  exprStart + match[4],
+				certain: true,
+			}
+			if match[8] >= 0 {
+				ref.name = text[exprStart+match[8] : exprStart+match[9]]
+			}
+			refs = append(refs, ref)
+		}
+	}
+	return refs
+}
@@ -0,0 +1,158 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lint
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Severity describes how severe a finding is.
+type Severity string
+
+const (
+	// SeverityError marks findings that will most probably break an installation of the blueprint.
+	SeverityError Severity = "error"
+	// SeverityWarning marks findings that indicate unnecessary or deprecated definitions.
+	SeverityWarning Severity = "warning"
+	// SeverityInfo marks findings that are only informational.
+	SeverityInfo Severity = "info"
+)
+
+// rank returns a comparable representation of the severity.
+// Higher values are more severe.
+func (s Severity) rank() int {
+	switch s {
+	case SeverityError:
+		return 3
+	case SeverityWarning:
+		return 2
+	case SeverityInfo:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// ParseSeverity parses a severity.
+func ParseSeverity(s string) (Severity, error) {
+	switch Severity(s) {
+	case SeverityError, SeverityWarning, SeverityInfo:
+		return Severity(s), nil
+	default:
+		return "", fmt.Errorf("unknown severity %q, expected one of %s, %s or %s", s, SeverityError, SeverityWarning, SeverityInfo)
+	}
+}
+
+// Rule describes a check of the linter.
+type Rule struct {
+	// ID is the unique identifier of the rule that is used to reference the rule in findings and suppressions.
+	ID string
+	// Severity is the default severity of the findings of the rule.
+	Severity Severity
+	// Description is a short description of what the rule checks.
+	Description string
+}
+
+var (
+	// RuleInvalidTemplate reports templates that cannot be read or parsed.
+	RuleInvalidTemplate = Rule{
+		ID:          "invalid-template",
+		Severity:    SeverityError,
+		Description: "A template or subinstallation file cannot be read or a GoTemplate cannot be parsed.",
+	}
+	// RuleUnusedImport reports imports that are never used.
+	RuleUnusedImport = Rule{
+		ID:          "unused-import",
+		Severity:    SeverityWarning,
+		Description: "An import is neither referenced by a template nor by a subinstallation.",
+	}
+	// RuleUnsetExport reports exports that are not set by any export execution.
+	RuleUnsetExport = Rule{
+		ID:          "unset-export",
+		Severity:    SeverityError,
+		Description: "An export is not set by any export execution.",
+	}
+	// RuleUndeclaredTarget reports deploy items that reference a target import that is not declared.
+	RuleUndeclaredTarget = Rule{
+		ID:          "undeclared-target",
+		Severity:    SeverityError,
+		Description: "A deploy item references a target import that is not declared as target or targetMap import.",
+	}
+	// RuleUndeclaredImportReference reports templates that access an import that is not declared.
+	RuleUndeclaredImportReference = Rule{
+		ID:          "undeclared-import-reference",
+		Severity:    SeverityError,
+		Description: "A template references an import via \".imports.<name>\" that is not declared by the blueprint.",
+	}
+	// RuleUnresolvedSubinstallationImport reports subinstallation imports that are neither satisfied by the blueprint nor by a sibling.
+	RuleUnresolvedSubinstallationImport = Rule{
+		ID:          "unresolved-subinstallation-import",
+		Severity:    SeverityError,
+		Description: "A subinstallation imports a value that is neither imported by the blueprint nor exported by another subinstallation.",
+	}
+	// RuleUnresolvedSubinstallationExport reports export executions that use values that are not exported by any subinstallation.
+	RuleUnresolvedSubinstallationExport = Rule{
+		ID:          "unresolved-subinstallation-export",
+		Severity:    SeverityError,
+		Description: "An export execution references a data object or target that is not exported by any subinstallation.",
+	}
+	// RuleDeprecatedTargetName reports deploy items that reference their target by name.
+	RuleDeprecatedTargetName = Rule{
+		ID:          "deprecated-target-name",
+		Severity:    SeverityWarning,
+		Description: "A deploy item references its target by name instead of by import.",
+	}
+	// RuleDeprecatedExportValues reports export executions that use the deprecated "values" binding.
+	RuleDeprecatedExportValues = Rule{
+		ID:          "deprecated-export-values",
+		Severity:    SeverityWarning,
+		Description: "An export execution uses the deprecated \"values\" binding instead of \"deployitems\", \"dataobjects\" and \"targets\".",
+	}
+	// RuleDeprecatedUntypedDefinition reports import and export definitions without type.
+	RuleDeprecatedUntypedDefinition = Rule{
+		ID:          "deprecated-untyped-definition",
+		Severity:    SeverityWarning,
+		Description: "An import or export definition does not specify its type.",
+	}
+)
+
+// catalog contains all rules of the linter by their id.
+var catalog = map[string]Rule{}
+
+func init() {
+	for _, rule := range []Rule{
+		RuleInvalidTemplate,
+		RuleUnusedImport,
+		RuleUnsetExport,
+		RuleUndeclaredTarget,
+		RuleUndeclaredImportReference,
+		RuleUnresolvedSubinstallationImport,
+		RuleUnresolvedSubinstallationExport,
+		RuleDeprecatedTargetName,
+		RuleDeprecatedExportValues,
+		RuleDeprecatedUntypedDefinition,
+	} {
+		catalog[rule.ID] = rule
+	}
+}
+
+// Rules returns the catalog of all rules ordered by their id.
+func Rules() []Rule {
+	rules := make([]Rule, 0, len(catalog))
+	for _, rule := range catalog {
+		rules = append(rules, rule)
+	}
+	sort.Slice(rules, func(i, j int) bool {
+		return rules[i].ID < rules[j].ID
+	})
+	return rules
+}
+
+// GetRule returns the rule with the given id.
+func GetRule(id string) (Rule, bool) {
+	rule, ok := catalog[id]
+	return rule, ok
+}
@@ -0,0 +1,244 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lint
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+)
+
+// executionKind describes the blueprint field that defines a template execution.
+type executionKind string
+
+const (
+	importExecution          executionKind = "importExecutions"
+	deployExecution          executionKind = "deployExecutions"
+	exportExecution          executionKind = "exportExecutions"
+	subinstallationExecution executionKind = "subinstallationExecutions"
+)
+
+// templateSource is the source of a template execution of the blueprint.
+type templateSource struct {
+	kind     executionKind
+	executor lsv1alpha1.TemplateExecutor
+	// file is the file of the blueprint filesystem that contains the template.
+	file string
+	// text is the template source.
+	// Inline spiff templates are converted to yaml.
+	text string
+	// startLine is the 1-based line of the file at which the template starts.
+	// It is 0 if the template could not be located in the file.
+	startLine int
+}
+
+// line returns the 1-based line in the file for the 0-based line of the template text.
+// Templates that could not be located are searched for the content of the line.
+func (s *templateSource) line(l *linter, textLine int) int {
+	if s.startLine != 0 {
+		return s.startLine + textLine
+	}
+	lines := strings.Split(s.text, "\n")
+	if textLine < 0 || textLine >= len(lines) {
+		return 0
+	}
+	return l.findLine(s.file, strings.TrimSpace(lines[textLine]))
+}
+
+// lineOfOffset returns the 0-based line of a byte offset in the template text.
+func (s *templateSource) lineOfOffset(offset int) int {
+	if offset > len(s.text) {
+		offset = len(s.text)
+	}
+	return strings.Count(s.text[:offset], "\n")
+}
+
+// loadTemplateSource reads the source of a template execution.
+func (l *linter) loadTemplateSource(kind executionKind, exec lsv1alpha1.TemplateExecutor) (*templateSource, error) {
+	src := &templateSource{
+		kind:     kind,
+		executor: exec,
+		file:     lsv1alpha1.BlueprintFileName,
+	}
+
+	if len(exec.File) != 0 {
+		lines, err := l.fileLines(exec.File)
+		if err != nil {
+			return src, fmt.Errorf("unable to read template file %q: %w", exec.File, err)
+		}
+		src.file = exec.File
+		src.text = strings.Join(lines, "\n")
+		src.startLine = 1
+		return src, nil
+	}
+
+	if exec.Type == lsv1alpha1.GOTemplateType {
+		var text string
+		if err := json.Unmarshal(exec.Template.RawMessage, &text); err != nil {
+			return src, fmt.Errorf("a GoTemplate must be a string: %w", err)
+		}
+		src.text = text
+		if lines, err := l.fileLines(src.file); err == nil {
+			if start, ok := locate(lines, strings.Split(text, "\n")); ok {
+				src.startLine = start + 1
+			}
+		}
+		return src, nil
+	}
+
+	data, err := yaml.JSONToYAML(exec.Template.RawMessage)
+	if err != nil {
+		return src, fmt.Errorf("unable to convert template to yaml: %w", err)
+	}
+	src.text = string(data)
+	return src, nil
+}
+
+// locate returns the 0-based line of fileLines at which the templateLines start.
+// Leading whitespace is ignored as templates are indented in yaml block scalars.
+func locate(fileLines, templateLines []string) (int, bool) {
+	// trailing empty lines are added by yaml block scalars and do not help to locate the template
+	for len(templateLines) > 0 && len(strings.TrimSpace(templateLines[len(templateLines)-1])) == 0 {
+		templateLines = templateLines[:len(templateLines)-1]
+	}
+	if len(templateLines) == 0 {
+		return 0, false
+	}
+
+	for start := 0; start+len(templateLines) <= len(fileLines); start++ {
+		matches := true
+		for i, tmplLine := range templateLines {
+			if strings.TrimSpace(fileLines[start+i]) != strings.TrimSpace(tmplLine) {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			return start, true
+		}
+	}
+	return 0, false
+}
+
+// keyRegex matches yaml mapping entries in block style.
+var keyRegex = regexp.MustCompile(`^(\s*)(-\s+)?["']?([A-Za-z0-9_.\-]+)["']?\s*:(?:\s+(.*))?$`)
+
+// entry is a yaml mapping entry of a template.
+type entry struct {
+	key   string
+	value string
+	// parents are the keys of the enclosing mappings.
+	parents []string
+	// line is the 0-based line of the entry in the template text.
+	line int
+}
+
+// literal returns the value of the entry if it is a static scalar.
+func (e entry) literal() (string, bool) {
+	value := strings.TrimSpace(stripComment(e.value))
+	if len(value) == 0 || strings.Contains(value, "{{") || strings.Contains(value, "((") ||
+		strings.HasPrefix(value, "{") || strings.HasPrefix(value, "[") || strings.HasPrefix(value, "|") || strings.HasPrefix(value, ">") {
+		return "", false
+	}
+	return strings.Trim(value, `"'`), true
+}
+
+// hasParents returns true if the innermost enclosing keys of the entry are the given keys.
+func (e entry) hasParents(keys ...string) bool {
+	if len(e.parents) < len(keys) {
+		return false
+	}
+	offset := len(e.parents) - len(keys)
+	for i, key := range keys {
+		if e.parents[offset+i] != key {
+			return false
+		}
+	}
+	return true
+}
+
+// scanEntries returns the yaml mapping entries of a template.
+// Templates are not necessarily valid yaml before they are rendered, therefore the entries are
+// determined line by line based on their indentation.
+// Lines that only contain template actions or comments are ignored.
+func scanEntries(text string) []entry {
+	type level struct {
+		indent int
+		key    string
+	}
+	var (
+		entries = make([]entry, 0)
+		stack   = make([]level, 0)
+	)
+	for i, line := range strings.Split(text, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if len(trimmed) == 0 || strings.HasPrefix(trimmed, "#") || isTemplateAction(trimmed) {
+			continue
+		}
+		match := keyRegex.FindStringSubmatch(line)
+		if match == nil {
+			continue
+		}
+		indent := len(match[1]) + len(match[2])
+		for len(stack) > 0 && stack[len(stack)-1].indent >= indent {
+			stack = stack[:len(stack)-1]
+		}
+		parents := make([]string, len(stack))
+		for j, lvl := range stack {
+			parents[j] = lvl.key
+		}
+		e := entry{
+			key:     match[3],
+			value:   match[4],
+			parents: parents,
+			line:    i,
+		}
+		entries = append(entries, e)
+		entries = append(entries, scanFlowMapping(e)...)
+		stack = append(stack, level{indent: indent, key: match[3]})
+	}
+	return entries
+}
+
+// scanFlowMapping returns the entries of a simple flow mapping like "{import: cluster}".
+func scanFlowMapping(parent entry) []entry {
+	value := strings.TrimSpace(stripComment(parent.value))
+	if !strings.HasPrefix(value, "{") || !strings.HasSuffix(value, "}") || strings.Contains(value, "{{") {
+		return nil
+	}
+	parents := append(append(make([]string, 0, len(parent.parents)+1), parent.parents...), parent.key)
+	entries := make([]entry, 0)
+	for _, pair := range strings.Split(strings.Trim(value, "{}"), ",") {
+		key, val, ok := strings.Cut(pair, ":")
+		if !ok {
+			continue
+		}
+		entries = append(entries, entry{
+			key:     strings.Trim(strings.TrimSpace(key), `"'`),
+			value:   strings.TrimSpace(val),
+			parents: parents,
+			line:    parent.line,
+		})
+	}
+	return entries
+}
+
+// isTemplateAction returns true if the line only consists of GoTemplate actions.
+func isTemplateAction(trimmed string) bool {
+	return strings.HasPrefix(trimmed, "{{") && strings.HasSuffix(trimmed, "}}")
+}
+
+// stripComment removes a trailing yaml comment from a value.
+func stripComment(value string) string {
+	if idx := strings.Index(value, " #"); idx >= 0 {
+		return value[:idx]
+	}
+	return value
+}
@@ -0,0 +1,71 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lint
+
+import (
+	"regexp"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+const (
+	// IgnoreDirective suppresses the listed rules for the line of the directive and the following line.
+	// Example: "# blueprint-lint:ignore unused-import,deprecated-untyped-definition"
+	IgnoreDirective = "blueprint-lint:ignore"
+	// IgnoreFileDirective suppresses the listed rules for the whole file that contains the directive.
+	// Example: "# blueprint-lint:ignore-file unset-export"
+	IgnoreFileDirective = "blueprint-lint:ignore-file"
+)
+
+var directiveRegex = regexp.MustCompile(`blueprint-lint:(ignore-file|ignore)\s+([A-Za-z0-9\-]+(?:\s*,\s*[A-Za-z0-9\-]+)*)`)
+
+// suppressions contains the rules that are suppressed by inline directives of a file.
+type suppressions struct {
+	// file contains the rules that are suppressed in the whole file.
+	file sets.Set[string]
+	// lines contains the rules that are suppressed by the 1-based line of the directive.
+	lines map[int]sets.Set[string]
+}
+
+// parseSuppressions reads the suppression directives of a file.
+func parseSuppressions(lines []string) *suppressions {
+	s := &suppressions{
+		file:  sets.New[string](),
+		lines: map[int]sets.Set[string]{},
+	}
+	for i, line := range lines {
+		for _, match := range directiveRegex.FindAllStringSubmatch(line, -1) {
+			rules := make([]string, 0)
+			for _, rule := range strings.Split(match[2], ",") {
+				rules = append(rules, strings.TrimSpace(rule))
+			}
+			if match[1] == "ignore-file" {
+				s.file.Insert(rules...)
+				continue
+			}
+			if _, ok := s.lines[i+1]; !ok {
+				s.lines[i+1] = sets.New[string]()
+			}
+			s.lines[i+1].Insert(rules...)
+		}
+	}
+	return s
+}
+
+// suppresses returns true if the rule is suppressed for the given 1-based line.
+// A directive applies to its own line (trailing comment) and to the line that follows it.
+func (s *suppressions) suppresses(ruleID string, line int) bool {
+	if s == nil {
+		return false
+	}
+	if s.file.Has(ruleID) {
+		return true
+	}
+	if line == 0 {
+		return false
+	}
+	return s.lines[line].Has(ruleID) || s.lines[line-1].Has(ruleID)
+}
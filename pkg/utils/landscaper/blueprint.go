@@ -6,6 +6,7 @@ package landscaper
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -23,6 +24,7 @@ import (
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/gotemplate"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/spiff"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/generatedsecrets"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/subinstallations"
 	"github.com/gardener/landscaper/pkg/landscaper/jsonschema"
 	"github.com/gardener/landscaper/pkg/utils/blueprints"
@@ -37,8 +39,15 @@ type BlueprintRenderer struct {
 	registryAccess model.RegistryAccess
 	// repositoryContext is an optional repository context used to overwrite the effective repository context of component descriptors.
 	repositoryContext *types.UnstructuredTypedObject
+	// ctx is the context that is used to resolve blueprints and to generate secrets.
+	ctx context.Context
+	// generatedSecretsStore returns the store for the generated secrets of an installation.
+	generatedSecretsStore GeneratedSecretsStoreFunc
 }
 
+// GeneratedSecretsStoreFunc returns the store that persists the generated secrets of an installation.
+type GeneratedSecretsStoreFunc func(input *ResolvedInstallation) generatedsecrets.Store
+
 // ResolvedInstallation contains a tuple of component descriptor, installation and blueprint.
 type ResolvedInstallation struct {
 	ComponentVersion model.ComponentVersion
@@ -64,10 +73,27 @@ func NewBlueprintRenderer(cdList *model.ComponentVersionList, registryAccess mod
 		cdList:            cdList,
 		registryAccess:    registryAccess,
 		repositoryContext: repositoryContext,
+		ctx:               context.Background(),
+		generatedSecretsStore: func(_ *ResolvedInstallation) generatedsecrets.Store {
+			return generatedsecrets.NewMemoryStore()
+		},
 	}
 	return renderer
 }
 
+// WithContext sets the context that is used to resolve blueprints and to generate secrets.
+func (r *BlueprintRenderer) WithContext(ctx context.Context) *BlueprintRenderer {
+	r.ctx = ctx
+	return r
+}
+
+// WithGeneratedSecretsStore sets the function that returns the store for the generated secrets of an installation.
+// By default, the generated secrets are only kept in memory, so that every rendering generates new values.
+func (r *BlueprintRenderer) WithGeneratedSecretsStore(store GeneratedSecretsStoreFunc) *BlueprintRenderer {
+	r.generatedSecretsStore = store
+	return r
+}
+
 // RenderDeployItemsAndSubInstallations renders deploy items and subinstallations of a given blueprint using the given imports.
 // The import values are validated with the JSON schemas defined in the blueprint.
 func (r *BlueprintRenderer) RenderDeployItemsAndSubInstallations(input *ResolvedInstallation, imports map[string]interface{}) (*RenderedDeployItemsSubInstallations, error) {
@@ -79,11 +105,17 @@ func (r *BlueprintRenderer) RenderDeployItemsAndSubInstallations(input *Resolved
 		return nil, fmt.Errorf("blueprint may not be nil")
 	}
 
-	if err := r.validateImports(input, imports); err != nil {
+	imports, err := r.validateImports(input, imports)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = r.addGeneratedSecrets(input, imports)
+	if err != nil {
 		return nil, err
 	}
 
-	imports, err := r.RenderImportExecutions(input, imports)
+	imports, err = r.RenderImportExecutions(input, imports)
 	if err != nil {
 		return nil, err
 	}
@@ -109,8 +141,7 @@ func (r *BlueprintRenderer) RenderDeployItemsAndSubInstallations(input *Resolved
 
 // RenderImportExecutions renders the export executions of the given blueprint and returns the rendered exports.
 func (r *BlueprintRenderer) RenderImportExecutions(input *ResolvedInstallation, imports map[string]interface{}) (map[string]interface{}, error) {
-	ctx := context.Background()
-	defer ctx.Done()
+	ctx := r.ctx
 
 	if input == nil {
 		return nil, fmt.Errorf("render input may not be nil")
@@ -128,8 +159,8 @@ func (r *BlueprintRenderer) RenderImportExecutions(input *ResolvedInstallation,
 	templateStateHandler := template.NewMemoryStateHandler()
 	formatter := template.NewTemplateInputFormatter(true)
 	tmpl := template.New(
-		gotemplate.New(templateStateHandler, nil).WithInputFormatter(formatter),
-		spiff.New(templateStateHandler, nil).WithInputFormatter(formatter))
+		gotemplate.New(templateStateHandler, nil).WithContext(ctx).WithInputFormatter(formatter),
+		spiff.New(templateStateHandler, nil).WithContext(ctx).WithInputFormatter(formatter))
 	errorList, bindings, err := tmpl.TemplateImportExecutions(
 		template.NewBlueprintExecutionOptions(
 			input.Installation,
@@ -152,10 +183,30 @@ func (r *BlueprintRenderer) RenderImportExecutions(input *ResolvedInstallation,
 	return imports, nil
 }
 
+// addGeneratedSecrets generates the secrets that are defined in the blueprint and adds them to the imports.
+// The secrets are persisted in the store of the renderer, which keeps them only in memory by default.
+func (r *BlueprintRenderer) addGeneratedSecrets(input *ResolvedInstallation, imports map[string]interface{}) (map[string]interface{}, error) {
+	defs := input.Blueprint.Info.GeneratedSecrets
+	if len(defs) == 0 {
+		return imports, nil
+	}
+
+	values, _, err := generatedsecrets.NewManager(r.generatedSecretsStore(input)).Ensure(r.ctx, defs, imports)
+	if err != nil {
+		return nil, fmt.Errorf("unable to generate secrets: %w", err)
+	}
+	if imports == nil {
+		imports = make(map[string]interface{}, len(values))
+	}
+	for k, v := range values {
+		imports[k] = v
+	}
+	return imports, nil
+}
+
 // RenderExportExecutions renders the export executions of the given blueprint and returns the rendered exports.
 func (r *BlueprintRenderer) RenderExportExecutions(input *ResolvedInstallation, imports, installationDataImports, installationTargetImports, deployItemsExports map[string]interface{}) (map[string]interface{}, error) {
-	ctx := context.Background()
-	defer ctx.Done()
+	ctx := r.ctx
 
 	if input == nil {
 		return nil, fmt.Errorf("render input may not be nil")
@@ -174,8 +225,8 @@ func (r *BlueprintRenderer) RenderExportExecutions(input *ResolvedInstallation,
 	templateStateHandler := template.NewMemoryStateHandler()
 	formatter := template.NewTemplateInputFormatter(true)
 	tmpl := template.New(
-		gotemplate.New(templateStateHandler, nil).WithInputFormatter(formatter),
-		spiff.New(templateStateHandler, nil).WithInputFormatter(formatter))
+		gotemplate.New(templateStateHandler, nil).WithContext(ctx).WithInputFormatter(formatter),
+		spiff.New(templateStateHandler, nil).WithContext(ctx).WithInputFormatter(formatter))
 	exports, err := tmpl.TemplateExportExecutions(
 		template.NewExportExecutionOptions(
 			template.NewBlueprintExecutionOptions(
@@ -194,14 +245,13 @@ func (r *BlueprintRenderer) RenderExportExecutions(input *ResolvedInstallation,
 
 // renderDeployItems renders deploy items.
 func (r *BlueprintRenderer) renderDeployItems(input *ResolvedInstallation, imports map[string]interface{}) ([]*lsv1alpha1.DeployItem, map[string][]byte, error) {
-	ctx := context.Background()
-	defer ctx.Done()
+	ctx := r.ctx
 
 	templateStateHandler := template.NewMemoryStateHandler()
 	formatter := template.NewTemplateInputFormatter(true)
 	tmpl := template.New(
-		gotemplate.New(templateStateHandler, nil).WithInputFormatter(formatter),
-		spiff.New(templateStateHandler, nil).WithInputFormatter(formatter))
+		gotemplate.New(templateStateHandler, nil).WithContext(ctx).WithInputFormatter(formatter),
+		spiff.New(templateStateHandler, nil).WithContext(ctx).WithInputFormatter(formatter))
 	executions, err := tmpl.TemplateDeployExecutions(
 		template.NewDeployExecutionOptions(
 			template.NewBlueprintExecutionOptions(
@@ -310,8 +360,7 @@ func (r *BlueprintRenderer) renderDeployItems(input *ResolvedInstallation, impor
 
 // renderSubInstallations renders subinstallations.
 func (r *BlueprintRenderer) renderSubInstallations(input *ResolvedInstallation, imports map[string]interface{}) ([]ResolvedInstallation, map[string][]byte, error) {
-	ctx := context.Background()
-	defer ctx.Done()
+	ctx := r.ctx
 
 	inputRepositoryContext, err := r.getRepositoryContext(input)
 	if err != nil {
@@ -333,8 +382,8 @@ func (r *BlueprintRenderer) renderSubInstallations(input *ResolvedInstallation,
 	templateStateHandler := template.NewMemoryStateHandler()
 	formatter := template.NewTemplateInputFormatter(true)
 	tmpl := template.New(
-		gotemplate.New(templateStateHandler, nil).WithInputFormatter(formatter),
-		spiff.New(templateStateHandler, nil).WithInputFormatter(formatter))
+		gotemplate.New(templateStateHandler, nil).WithContext(ctx).WithInputFormatter(formatter),
+		spiff.New(templateStateHandler, nil).WithContext(ctx).WithInputFormatter(formatter))
 	subInstallationTemplates, err := tmpl.TemplateSubinstallationExecutions(
 		template.NewDeployExecutionOptions(
 			template.NewBlueprintExecutionOptions(
@@ -413,12 +462,13 @@ func (r *BlueprintRenderer) renderSubInstallations(input *ResolvedInstallation,
 	return subInstallations, templateStateHandler, nil
 }
 
-// validateImports validates the imports with the JSON schemas defined in the blueprint
-func (r *BlueprintRenderer) validateImports(input *ResolvedInstallation, imports map[string]interface{}) error {
+// validateImports validates the imports with the JSON schemas defined in the blueprint.
+// It returns the imports with the default values of the JSON schemas applied.
+func (r *BlueprintRenderer) validateImports(input *ResolvedInstallation, imports map[string]interface{}) (map[string]interface{}, error) {
 
 	inputRepositoryContext, err := r.getRepositoryContext(input)
 	if err != nil {
-		return fmt.Errorf("unable to get repository context during validation of imports: %w", err)
+		return nil, fmt.Errorf("unable to get repository context during validation of imports: %w", err)
 	}
 
 	validatorConfig := &jsonschema.ReferenceContext{
@@ -427,6 +477,12 @@ func (r *BlueprintRenderer) validateImports(input *ResolvedInstallation, imports
 		ComponentVersion:  input.ComponentVersion,
 		RegistryAccess:    r.registryAccess,
 		RepositoryContext: inputRepositoryContext,
+		JSONSchemaVersion: input.Blueprint.Info.JSONSchemaVersion,
+	}
+
+	res := make(map[string]interface{}, len(imports))
+	for name, value := range imports {
+		res[name] = value
 	}
 
 	var allErr field.ErrorList
@@ -436,17 +492,47 @@ func (r *BlueprintRenderer) validateImports(input *ResolvedInstallation, imports
 		if !ok {
 			if *importDef.Required {
 				allErr = append(allErr, field.Required(fldPath, "Import is required"))
+				continue
+			}
+			if importDef.Type != lsv1alpha1.ImportTypeData {
+				continue
 			}
-			continue
 		}
 		switch importDef.Type {
 		case lsv1alpha1.ImportTypeData:
-			if err := jsonschema.ValidateGoStruct(importDef.Schema.RawMessage, value, validatorConfig); err != nil {
-				allErr = append(allErr, field.Invalid(
-					fldPath,
-					value,
-					fmt.Sprintf("invalid imported value: %s", err.Error())))
+			validator := jsonschema.NewValidator(validatorConfig)
+			if err := validator.CompileSchema(importDef.Schema.RawMessage); err != nil {
+				allErr = append(allErr, field.Invalid(fldPath, value, fmt.Sprintf("invalid import schema: %s", err.Error())))
+				continue
 			}
+			// optional imports that are not satisfied get the default value of their schema
+			defaulted, err := validator.ApplyDefaults(value)
+			if err != nil {
+				allErr = append(allErr, field.Invalid(fldPath, value, fmt.Sprintf("unable to apply default values: %s", err.Error())))
+				continue
+			}
+			if !ok && defaulted == nil {
+				continue
+			}
+			if err := validator.ValidateGoStruct(defaulted); err != nil {
+				var schemaErrs jsonschema.ValidationErrors
+				if !errors.As(err, &schemaErrs) {
+					allErr = append(allErr, field.Invalid(
+						fldPath,
+						defaulted,
+						fmt.Sprintf("invalid imported value: %s", err.Error())))
+					continue
+				}
+				// report every violation with the json pointer of the invalid value within the import
+				for _, schemaErr := range schemaErrs {
+					allErr = append(allErr, field.Invalid(
+						fldPath.Key(schemaErr.Pointer()),
+						schemaErr.Value,
+						fmt.Sprintf("invalid imported value: %s", schemaErr.Description)))
+				}
+				continue
+			}
+			res[importDef.Name] = defaulted
 		case lsv1alpha1.ImportTypeTarget:
 			allErr = append(allErr, validateTargetImport(value, importDef.TargetType, fldPath)...)
 
@@ -461,7 +547,10 @@ func (r *BlueprintRenderer) validateImports(input *ResolvedInstallation, imports
 		}
 	}
 
-	return allErr.ToAggregate()
+	if err := allErr.ToAggregate(); err != nil {
+		return nil, err
+	}
+	return res, nil
 }
 
 // getRepositoryContext retrieves the correct repository context.
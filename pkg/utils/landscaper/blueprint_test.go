@@ -1,13 +1,22 @@
 package landscaper_test
 
 import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+
 	"github.com/mandelsoft/vfs/pkg/osfs"
 	"github.com/mandelsoft/vfs/pkg/projectionfs"
 	"github.com/mandelsoft/vfs/pkg/vfs"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/yaml"
 
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/api"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/generatedsecrets"
 	"github.com/gardener/landscaper/pkg/utils/blueprints"
 	lsutils "github.com/gardener/landscaper/pkg/utils/landscaper"
 )
@@ -48,6 +57,101 @@ var _ = Describe("Landscaper", func() {
 			Expect(err).ToNot(HaveOccurred())
 		})
 
+		It("should render a blueprint with generated secrets", func() {
+			renderer := lsutils.NewBlueprintRenderer(nil, nil, nil)
+			out, err := renderer.RenderDeployItemsAndSubInstallations(&lsutils.ResolvedInstallation{
+				ComponentVersion: nil,
+				Installation:     nil,
+				Blueprint:        GetBlueprint("./testdata/02-blueprint-with-generated-secrets/blueprint"),
+			}, GetImports("./testdata/02-blueprint-with-generated-secrets/values.yaml"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(out.DeployItems).To(HaveLen(1))
+
+			config := map[string]interface{}{}
+			Expect(yaml.Unmarshal(out.DeployItems[0].Spec.Configuration.Raw, &config)).To(Succeed())
+			status, ok := config["providerStatus"].(map[string]interface{})
+			Expect(ok).To(BeTrue())
+			Expect(status["password"]).To(HaveLen(16))
+
+			block, _ := pem.Decode([]byte(status["certificate"].(string)))
+			Expect(block).ToNot(BeNil())
+			cert, err := x509.ParseCertificate(block.Bytes)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cert.DNSNames).To(ConsistOf("server.example.com"))
+			Expect(cert.Issuer.CommonName).To(Equal("my-ca"))
+		})
+
+		It("should render the same generated secrets as the controller for the same store", func() {
+			ctx := context.Background()
+			blueprint := GetBlueprint("./testdata/02-blueprint-with-generated-secrets/blueprint")
+			kubeClient := fake.NewClientBuilder().WithScheme(api.LandscaperScheme).Build()
+			inst := &lsv1alpha1.Installation{}
+			inst.Name = "my-inst"
+			inst.Namespace = "default"
+			inst.UID = "123"
+
+			// the controller persists the generated secrets in secrets owned by the installation
+			controllerStore := &generatedsecrets.KubernetesStore{KubeClient: kubeClient, Inst: inst}
+			values, _, err := generatedsecrets.NewManager(controllerStore).Ensure(ctx, blueprint.Info.GeneratedSecrets,
+				GetImports("./testdata/02-blueprint-with-generated-secrets/values.yaml"))
+			Expect(err).ToNot(HaveOccurred())
+
+			renderer := lsutils.NewBlueprintRenderer(nil, nil, nil).
+				WithContext(ctx).
+				WithGeneratedSecretsStore(func(input *lsutils.ResolvedInstallation) generatedsecrets.Store {
+					return &generatedsecrets.KubernetesStore{KubeClient: kubeClient, Inst: input.Installation}
+				})
+			out, err := renderer.RenderDeployItemsAndSubInstallations(&lsutils.ResolvedInstallation{
+				ComponentVersion: nil,
+				Installation:     inst,
+				Blueprint:        blueprint,
+			}, GetImports("./testdata/02-blueprint-with-generated-secrets/values.yaml"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(out.DeployItems).To(HaveLen(1))
+
+			config := map[string]interface{}{}
+			Expect(yaml.Unmarshal(out.DeployItems[0].Spec.Configuration.Raw, &config)).To(Succeed())
+			Expect(config["providerStatus"]).To(MatchKeys(IgnoreExtras, Keys{
+				"password":    Equal(values["password"]),
+				"certificate": Equal(values["tls"].(map[string]interface{})["certificate"]),
+				"ca":          Equal(values["ca"].(map[string]interface{})["certificate"]),
+			}))
+		})
+
+		It("should apply the default values of the import schemas", func() {
+			renderer := lsutils.NewBlueprintRenderer(nil, nil, nil)
+			out, err := renderer.RenderDeployItemsAndSubInstallations(&lsutils.ResolvedInstallation{
+				ComponentVersion: nil,
+				Installation:     nil,
+				Blueprint:        GetBlueprint("./testdata/03-blueprint-with-schema-defaults/blueprint"),
+			}, GetImports("./testdata/03-blueprint-with-schema-defaults/values.yaml"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(out.DeployItems).To(HaveLen(1))
+
+			config := map[string]interface{}{}
+			Expect(yaml.Unmarshal(out.DeployItems[0].Spec.Configuration.Raw, &config)).To(Succeed())
+			Expect(config["providerStatus"]).To(MatchKeys(IgnoreExtras, Keys{
+				"host":     Equal("server.example.com"),
+				"port":     BeEquivalentTo(8080),
+				"tls":      Equal(true),
+				"replicas": BeEquivalentTo(2),
+			}))
+		})
+
+		It("should reject imports that violate their schema", func() {
+			imports := GetImports("./testdata/03-blueprint-with-schema-defaults/values.yaml")
+			imports["server"].(map[string]interface{})["other"] = "value"
+
+			renderer := lsutils.NewBlueprintRenderer(nil, nil, nil)
+			_, err := renderer.RenderDeployItemsAndSubInstallations(&lsutils.ResolvedInstallation{
+				ComponentVersion: nil,
+				Installation:     nil,
+				Blueprint:        GetBlueprint("./testdata/03-blueprint-with-schema-defaults/blueprint"),
+			}, imports)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("other"))
+		})
+
 	})
 
 })
@@ -0,0 +1,84 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package blueprinttest
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// normalize converts the given value into its generic json representation
+// so that it can be compared with values read from test case files.
+func normalize(in interface{}) (interface{}, error) {
+	raw, err := json.Marshal(in)
+	if err != nil {
+		return nil, err
+	}
+	var out interface{}
+	if err := json.Unmarshal(raw, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// compareSubset compares the expected value with the actual value.
+// Maps are compared as subsets, i.e. keys that are only present in the actual value are ignored.
+// Lists and scalar values have to be equal.
+func compareSubset(path string, expected, actual interface{}) []Failure {
+	expected, err := normalize(expected)
+	if err != nil {
+		return []Failure{{Path: path, Message: fmt.Sprintf("unable to normalize expected value: %s", err.Error())}}
+	}
+	return compareNormalized(path, expected, actual)
+}
+
+func compareNormalized(path string, expected, actual interface{}) []Failure {
+	switch exp := expected.(type) {
+	case map[string]interface{}:
+		act, ok := actual.(map[string]interface{})
+		if !ok {
+			return []Failure{{Path: path, Message: "type mismatch, expected an object", Expected: expected, Actual: actual}}
+		}
+		failures := make([]Failure, 0)
+		for _, key := range sortedKeys(exp) {
+			actValue, ok := act[key]
+			if !ok {
+				failures = append(failures, Failure{Path: path + "/" + key, Message: "missing value", Expected: exp[key]})
+				continue
+			}
+			failures = append(failures, compareNormalized(path+"/"+key, exp[key], actValue)...)
+		}
+		return failures
+	case []interface{}:
+		act, ok := actual.([]interface{})
+		if !ok {
+			return []Failure{{Path: path, Message: "type mismatch, expected a list", Expected: expected, Actual: actual}}
+		}
+		if len(exp) != len(act) {
+			return []Failure{{Path: path, Message: fmt.Sprintf("expected %d list elements but got %d", len(exp), len(act)), Expected: expected, Actual: actual}}
+		}
+		failures := make([]Failure, 0)
+		for i := range exp {
+			failures = append(failures, compareNormalized(fmt.Sprintf("%s/%d", path, i), exp[i], act[i])...)
+		}
+		return failures
+	default:
+		if !reflect.DeepEqual(expected, actual) {
+			return []Failure{{Path: path, Message: "value mismatch", Expected: expected, Actual: actual}}
+		}
+		return nil
+	}
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
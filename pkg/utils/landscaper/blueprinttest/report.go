@@ -0,0 +1,123 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package blueprinttest
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// junitTestSuites is the root element of a JUnit XML report.
+type junitTestSuites struct {
+	XMLName xml.Name         `xml:"testsuites"`
+	Suites  []junitTestSuite `xml:"testsuite"`
+}
+
+// junitTestSuite is a JUnit XML test suite.
+type junitTestSuite struct {
+	Name     string          `xml:"name,attr"`
+	Tests    int             `xml:"tests,attr"`
+	Failures int             `xml:"failures,attr"`
+	Errors   int             `xml:"errors,attr"`
+	Time     string          `xml:"time,attr"`
+	Cases    []junitTestCase `xml:"testcase"`
+}
+
+// junitTestCase is a JUnit XML test case.
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitMessage `xml:"failure,omitempty"`
+	Error     *junitMessage `xml:"error,omitempty"`
+}
+
+// junitMessage is a JUnit XML failure or error.
+type junitMessage struct {
+	Message string `xml:"message,attr"`
+	Content string `xml:",chardata"`
+}
+
+// WriteJUnitReport writes the suite results as JUnit XML report.
+func WriteJUnitReport(w io.Writer, suites ...*SuiteResult) error {
+	report := junitTestSuites{}
+	for _, suite := range suites {
+		junitSuite := junitTestSuite{
+			Name:     suite.Name,
+			Tests:    suite.Tests,
+			Failures: suite.Failed,
+			Errors:   suite.Errors,
+			Time:     fmt.Sprintf("%.3f", suite.Duration.Seconds()),
+		}
+		for _, result := range suite.Results {
+			testCase := junitTestCase{
+				Name:      result.Name,
+				ClassName: suite.Name,
+				Time:      fmt.Sprintf("%.3f", result.Duration.Seconds()),
+			}
+			if len(result.Error) > 0 {
+				testCase.Error = &junitMessage{
+					Message: "test case could not be executed",
+					Content: result.Error,
+				}
+			} else if !result.Passed {
+				testCase.Failure = &junitMessage{
+					Message: fmt.Sprintf("%d mismatch(es)", len(result.Failures)),
+					Content: FormatFailures(result.Failures),
+				}
+			}
+			junitSuite.Cases = append(junitSuite.Cases, testCase)
+		}
+		report.Suites = append(report.Suites, junitSuite)
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(report); err != nil {
+		return fmt.Errorf("unable to encode junit report: %w", err)
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+// WriteJSONReport writes the suite results as JSON report.
+func WriteJSONReport(w io.Writer, suites ...*SuiteResult) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(suites); err != nil {
+		return fmt.Errorf("unable to encode json report: %w", err)
+	}
+	return nil
+}
+
+// FormatFailures returns a human-readable representation of the given failures.
+func FormatFailures(failures []Failure) string {
+	var sb strings.Builder
+	for _, failure := range failures {
+		sb.WriteString(fmt.Sprintf("%s: %s", failure.Path, failure.Message))
+		if failure.Expected != nil {
+			sb.WriteString(fmt.Sprintf("\n  expected: %s", formatValue(failure.Expected)))
+		}
+		if failure.Actual != nil {
+			sb.WriteString(fmt.Sprintf("\n  actual:   %s", formatValue(failure.Actual)))
+		}
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
+
+func formatValue(v interface{}) string {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	return string(raw)
+}
@@ -0,0 +1,341 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package blueprinttest
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/mandelsoft/vfs/pkg/osfs"
+	"github.com/mandelsoft/vfs/pkg/projectionfs"
+	"github.com/mandelsoft/vfs/pkg/vfs"
+	"github.com/open-component-model/ocm/pkg/contexts/datacontext"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm"
+	"sigs.k8s.io/yaml"
+
+	"github.com/gardener/landscaper/apis/config"
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/components/model"
+	"github.com/gardener/landscaper/pkg/components/model/types"
+	"github.com/gardener/landscaper/pkg/components/registries"
+	"github.com/gardener/landscaper/pkg/utils/blueprints"
+	lsutils "github.com/gardener/landscaper/pkg/utils/landscaper"
+)
+
+// Runner executes declarative test cases against a blueprint.
+type Runner struct {
+	// blueprintDir is the directory of the blueprint on the local filesystem.
+	blueprintDir string
+	// testsDir is the directory containing the test case files.
+	testsDir string
+	// fs is the filesystem used to read the blueprint and the test cases.
+	fs vfs.FileSystem
+}
+
+// NewRunner creates a new test runner for the blueprint in the given directory.
+// The test cases are read from the "tests" directory inside the blueprint directory unless overwritten with WithTestsDir.
+func NewRunner(blueprintDir string) *Runner {
+	return &Runner{
+		blueprintDir: blueprintDir,
+		testsDir:     filepath.Join(blueprintDir, DefaultTestsDir),
+		fs:           osfs.New(),
+	}
+}
+
+// WithTestsDir sets the directory that contains the test case files.
+func (r *Runner) WithTestsDir(testsDir string) *Runner {
+	r.testsDir = testsDir
+	return r
+}
+
+// WithFileSystem sets the filesystem used to read the blueprint, the test cases and the local registries.
+func (r *Runner) WithFileSystem(fs vfs.FileSystem) *Runner {
+	r.fs = fs
+	return r
+}
+
+// LoadTestCases reads all test case files (*.yaml, *.yml, *.json) of the tests directory.
+// The test cases are ordered by their file name.
+func (r *Runner) LoadTestCases() ([]*TestCase, error) {
+	entries, err := vfs.ReadDir(r.fs, r.testsDir)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read tests directory %q: %w", r.testsDir, err)
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Name() < entries[j].Name()
+	})
+
+	testCases := make([]*TestCase, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		ext := filepath.Ext(entry.Name())
+		if ext != ".yaml" && ext != ".yml" && ext != ".json" {
+			continue
+		}
+
+		file := filepath.Join(r.testsDir, entry.Name())
+		data, err := vfs.ReadFile(r.fs, file)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read test case %q: %w", file, err)
+		}
+
+		testCase := &TestCase{}
+		if err := yaml.UnmarshalStrict(data, testCase); err != nil {
+			return nil, fmt.Errorf("unable to decode test case %q: %w", file, err)
+		}
+		if len(testCase.Name) == 0 {
+			testCase.Name = strings.TrimSuffix(entry.Name(), ext)
+		}
+		testCase.File = file
+		testCases = append(testCases, testCase)
+	}
+
+	return testCases, nil
+}
+
+// Run loads and executes all test cases of the blueprint.
+func (r *Runner) Run(ctx context.Context) (*SuiteResult, error) {
+	testCases, err := r.LoadTestCases()
+	if err != nil {
+		return nil, err
+	}
+	return r.RunTestCases(ctx, testCases), nil
+}
+
+// RunTestCases executes the given test cases and aggregates their results.
+func (r *Runner) RunTestCases(ctx context.Context, testCases []*TestCase) *SuiteResult {
+	start := time.Now()
+	suite := &SuiteResult{
+		Name:    r.blueprintDir,
+		Results: make([]TestResult, 0, len(testCases)),
+	}
+
+	for _, testCase := range testCases {
+		result := r.RunTestCase(ctx, testCase)
+		suite.Tests++
+		if len(result.Error) > 0 {
+			suite.Errors++
+		} else if !result.Passed {
+			suite.Failed++
+		}
+		suite.Results = append(suite.Results, result)
+	}
+
+	suite.Duration = time.Since(start)
+	return suite
+}
+
+// RunTestCase executes a single test case.
+func (r *Runner) RunTestCase(ctx context.Context, testCase *TestCase) TestResult {
+	start := time.Now()
+	result := TestResult{
+		Name: testCase.Name,
+		File: testCase.File,
+	}
+
+	failures, err := r.runTestCase(ctx, testCase)
+	result.Duration = time.Since(start)
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	result.Failures = failures
+	result.Passed = len(failures) == 0
+	return result
+}
+
+func (r *Runner) runTestCase(ctx context.Context, testCase *TestCase) ([]Failure, error) {
+	ctx = logging.NewContext(ctx, logging.Discard())
+	octx := ocm.New(datacontext.MODE_EXTENDED)
+	ctx = octx.BindTo(ctx)
+	defer func() {
+		_ = octx.Finalize()
+	}()
+
+	blueprintFs, err := projectionfs.New(r.fs, r.blueprintDir)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create blueprint filesystem: %w", err)
+	}
+	blueprint, err := blueprints.NewFromFs(blueprintFs)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		registryAccess    model.RegistryAccess
+		componentVersion  model.ComponentVersion
+		componentVersions *model.ComponentVersionList
+		repositoryContext *types.UnstructuredTypedObject
+	)
+
+	if testCase.Component != nil {
+		registryAccess, repositoryContext, err = r.newRegistryAccess(ctx, testCase)
+		if err != nil {
+			return nil, err
+		}
+
+		componentVersion, err = getComponentVersion(ctx, registryAccess, repositoryContext, testCase.Component.ComponentName, testCase.Component.Version)
+		if err != nil {
+			return nil, err
+		}
+
+		componentVersions = &model.ComponentVersionList{
+			Components: []model.ComponentVersion{componentVersion},
+		}
+		for _, additional := range testCase.Component.AdditionalComponents {
+			cv, err := getComponentVersion(ctx, registryAccess, repositoryContext, additional.ComponentName, additional.Version)
+			if err != nil {
+				return nil, err
+			}
+			componentVersions.Components = append(componentVersions.Components, cv)
+		}
+	}
+
+	imports := testCase.Imports
+	if imports == nil {
+		imports = map[string]interface{}{}
+	}
+
+	simulator, err := lsutils.NewInstallationSimulator(componentVersions, registryAccess, repositoryContext, testCase.ExportTemplates)
+	if err != nil {
+		return nil, err
+	}
+	recorder := newRecordingCallbacks()
+	simulator.SetCallbacks(recorder)
+
+	exports, runErr := simulator.Run(componentVersion, blueprint, imports)
+
+	failures := make([]Failure, 0)
+	if testCase.ExpectedError != nil {
+		if runErr == nil {
+			return append(failures, Failure{
+				Path:     "error",
+				Message:  "expected rendering to fail but it succeeded",
+				Expected: *testCase.ExpectedError,
+			}), nil
+		}
+		if !strings.Contains(runErr.Error(), *testCase.ExpectedError) {
+			failures = append(failures, Failure{
+				Path:     "error",
+				Message:  "error does not contain the expected message",
+				Expected: *testCase.ExpectedError,
+				Actual:   runErr.Error(),
+			})
+		}
+		return failures, nil
+	}
+
+	if runErr != nil {
+		// the deploy items that have been rendered before the error occurred are still compared
+		failures = append(failures, Failure{
+			Path:    "error",
+			Message: "unexpected rendering error",
+			Actual:  runErr.Error(),
+		})
+	}
+
+	for _, diPath := range sortedKeys(testCase.ExpectedDeployItems) {
+		fldPath := "deployItems/" + diPath
+		actual, ok := recorder.deployItems[diPath]
+		if !ok {
+			failures = append(failures, Failure{
+				Path:     fldPath,
+				Message:  "deploy item was not rendered",
+				Expected: testCase.ExpectedDeployItems[diPath],
+			})
+			continue
+		}
+		failures = append(failures, compareSubset(fldPath, testCase.ExpectedDeployItems[diPath], actual)...)
+	}
+
+	if runErr == nil && len(testCase.ExpectedExports) > 0 {
+		actualExports := map[string]interface{}{}
+		if exports != nil {
+			for k, v := range exports.DataObjects {
+				actualExports[k] = v
+			}
+			for k, v := range exports.Targets {
+				actualExports[k] = v
+			}
+		}
+		actualNormalized, err := normalize(actualExports)
+		if err != nil {
+			return nil, fmt.Errorf("unable to normalize exports: %w", err)
+		}
+		failures = append(failures, compareSubset("exports", testCase.ExpectedExports, actualNormalized)...)
+	}
+
+	return failures, nil
+}
+
+// newRegistryAccess creates a registry access for the local registry of the test case.
+func (r *Runner) newRegistryAccess(ctx context.Context, testCase *TestCase) (model.RegistryAccess, *types.UnstructuredTypedObject, error) {
+	registryPath := testCase.Component.RegistryPath
+	if !filepath.IsAbs(registryPath) && len(testCase.File) > 0 {
+		registryPath = filepath.Join(filepath.Dir(testCase.File), registryPath)
+	}
+
+	registryAccess, err := registries.GetFactory().NewRegistryAccess(ctx, &model.RegistryAccessOptions{
+		Fs:                  r.fs,
+		LocalRegistryConfig: &config.LocalRegistryConfiguration{RootPath: registryPath},
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to create registry access for local registry %q: %w", registryPath, err)
+	}
+
+	repositoryContext := &types.UnstructuredTypedObject{}
+	if err := repositoryContext.UnmarshalJSON([]byte(`{"type":"local"}`)); err != nil {
+		return nil, nil, err
+	}
+	return registryAccess, repositoryContext, nil
+}
+
+func getComponentVersion(ctx context.Context, registryAccess model.RegistryAccess, repositoryContext *types.UnstructuredTypedObject, name, version string) (model.ComponentVersion, error) {
+	cv, err := registryAccess.GetComponentVersion(ctx, &lsv1alpha1.ComponentDescriptorReference{
+		RepositoryContext: repositoryContext,
+		ComponentName:     name,
+		Version:           version,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to get component version %s:%s: %w", name, version, err)
+	}
+	return cv, nil
+}
+
+// recordingCallbacks records the rendered deploy items of a simulation run.
+type recordingCallbacks struct {
+	// deployItems contains the normalized deploy items by their path.
+	deployItems map[string]interface{}
+}
+
+var _ lsutils.InstallationSimulatorCallbacks = &recordingCallbacks{}
+
+func newRecordingCallbacks() *recordingCallbacks {
+	return &recordingCallbacks{
+		deployItems: map[string]interface{}{},
+	}
+}
+
+func (c *recordingCallbacks) OnInstallation(_ string, _ *lsv1alpha1.Installation)       {}
+func (c *recordingCallbacks) OnInstallationTemplateState(_ string, _ map[string][]byte) {}
+func (c *recordingCallbacks) OnImports(_ string, _ map[string]interface{})              {}
+func (c *recordingCallbacks) OnDeployItemTemplateState(_ string, _ map[string][]byte)   {}
+func (c *recordingCallbacks) OnExports(_ string, _ map[string]interface{})              {}
+
+func (c *recordingCallbacks) OnDeployItem(path string, deployItem *lsv1alpha1.DeployItem) {
+	normalized, err := normalize(deployItem)
+	if err != nil {
+		normalized = map[string]interface{}{"error": err.Error()}
+	}
+	c.deployItems[path+"/"+deployItem.Name] = normalized
+}
@@ -0,0 +1,98 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package blueprinttest_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"encoding/xml"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+
+	"github.com/gardener/landscaper/pkg/utils/landscaper/blueprinttest"
+)
+
+var _ = Describe("Blueprint Test Runner", func() {
+
+	var (
+		ctx    context.Context
+		runner *blueprinttest.Runner
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		runner = blueprinttest.NewRunner("./testdata/00-simple")
+	})
+
+	It("should load all test cases of the tests directory ordered by file name", func() {
+		testCases, err := runner.LoadTestCases()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(testCases).To(HaveLen(3))
+		Expect(testCases[0].Name).To(Equal("renders deploy item and exports"))
+		Expect(testCases[1].Name).To(Equal("reports mismatches"))
+		Expect(testCases[2].Name).To(Equal("fails on invalid imports"))
+	})
+
+	It("should run the test cases and report mismatches", func() {
+		suite, err := runner.Run(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(suite.Tests).To(Equal(3))
+		Expect(suite.Failed).To(Equal(1))
+		Expect(suite.Errors).To(Equal(0))
+		Expect(suite.Passed()).To(BeFalse())
+
+		Expect(suite.Results[0].Passed).To(BeTrue(), blueprinttest.FormatFailures(suite.Results[0].Failures))
+		Expect(suite.Results[2].Passed).To(BeTrue(), blueprinttest.FormatFailures(suite.Results[2].Failures))
+
+		mismatch := suite.Results[1]
+		Expect(mismatch.Passed).To(BeFalse())
+		Expect(mismatch.Failures).To(ConsistOf(
+			MatchFields(IgnoreExtras, Fields{
+				"Path":     Equal("deployItems/root/deploy/spec/config/replicas"),
+				"Expected": BeNumerically("==", 2),
+				"Actual":   BeNumerically("==", 1),
+			}),
+			MatchFields(IgnoreExtras, Fields{
+				"Path":    Equal("deployItems/root/missing"),
+				"Message": Equal("deploy item was not rendered"),
+			}),
+		))
+	})
+
+	It("should write junit and json reports", func() {
+		suite, err := runner.Run(ctx)
+		Expect(err).ToNot(HaveOccurred())
+
+		junit := &bytes.Buffer{}
+		Expect(blueprinttest.WriteJUnitReport(junit, suite)).To(Succeed())
+		var junitReport struct {
+			Suites []struct {
+				Tests    int `xml:"tests,attr"`
+				Failures int `xml:"failures,attr"`
+				Cases    []struct {
+					Name    string    `xml:"name,attr"`
+					Failure *struct{} `xml:"failure"`
+				} `xml:"testcase"`
+			} `xml:"testsuite"`
+		}
+		Expect(xml.Unmarshal(junit.Bytes(), &junitReport)).To(Succeed())
+		Expect(junitReport.Suites).To(HaveLen(1))
+		Expect(junitReport.Suites[0].Tests).To(Equal(3))
+		Expect(junitReport.Suites[0].Failures).To(Equal(1))
+		Expect(junitReport.Suites[0].Cases[1].Failure).ToNot(BeNil())
+
+		jsonReport := &bytes.Buffer{}
+		Expect(blueprinttest.WriteJSONReport(jsonReport, suite)).To(Succeed())
+		var decoded []blueprinttest.SuiteResult
+		Expect(json.Unmarshal(jsonReport.Bytes(), &decoded)).To(Succeed())
+		Expect(decoded).To(HaveLen(1))
+		Expect(decoded[0].Results).To(HaveLen(3))
+		Expect(decoded[0].Results[1].Failures).To(HaveLen(2))
+	})
+
+})
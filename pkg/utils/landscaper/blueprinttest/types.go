@@ -0,0 +1,122 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package blueprinttest
+
+import (
+	"time"
+
+	lsutils "github.com/gardener/landscaper/pkg/utils/landscaper"
+)
+
+const (
+	// DefaultTestsDir is the directory inside a blueprint that contains the declarative test cases.
+	DefaultTestsDir = "tests"
+)
+
+// TestCase defines a declarative blueprint test case.
+// A test case renders the blueprint with the given imports and compares the rendered deploy items,
+// the exports and the error of the rendering with the expected values.
+type TestCase struct {
+	// Name is the name of the test case.
+	// Defaults to the file name of the test case.
+	Name string `json:"name,omitempty"`
+	// Description is an optional human-readable description of the test case.
+	Description string `json:"description,omitempty"`
+	// Component optionally references a component version in a local registry which is used as the
+	// component descriptor of the blueprint (mocked component descriptors).
+	// +optional
+	Component *ComponentReference `json:"component,omitempty"`
+	// Imports contains the import values for the blueprint.
+	Imports map[string]interface{} `json:"imports,omitempty"`
+	// ExportTemplates are used to simulate the exports of deploy items and installations.
+	// +optional
+	ExportTemplates lsutils.ExportTemplates `json:"exportTemplates,omitempty"`
+	// ExpectedDeployItems maps the path of a rendered deploy item (installation path and deploy item name,
+	// e.g. "root/my-deploy-item") to its expected content.
+	// The expected content is compared as a subset, i.e. only the fields that are defined are compared.
+	// +optional
+	ExpectedDeployItems map[string]interface{} `json:"expectedDeployItems,omitempty"`
+	// ExpectedExports contains the expected exports of the blueprint.
+	// The exports are compared as a subset, i.e. only the exports that are defined are compared.
+	// +optional
+	ExpectedExports map[string]interface{} `json:"expectedExports,omitempty"`
+	// ExpectedError defines that the rendering must fail with an error that contains the given string.
+	// +optional
+	ExpectedError *string `json:"expectedError,omitempty"`
+
+	// File is the file the test case was read from.
+	File string `json:"-"`
+}
+
+// ComponentReference references a component version in a local registry.
+type ComponentReference struct {
+	// RegistryPath is the path to the local registry.
+	// Relative paths are resolved relative to the directory of the test case file.
+	RegistryPath string `json:"registryPath"`
+	// ComponentName is the name of the component.
+	ComponentName string `json:"componentName"`
+	// Version is the version of the component.
+	Version string `json:"version"`
+	// AdditionalComponents references further components of the registry that should be available during the rendering.
+	// +optional
+	AdditionalComponents []ComponentNameVersion `json:"additionalComponents,omitempty"`
+}
+
+// ComponentNameVersion is a tuple of component name and version.
+type ComponentNameVersion struct {
+	// ComponentName is the name of the component.
+	ComponentName string `json:"componentName"`
+	// Version is the version of the component.
+	Version string `json:"version"`
+}
+
+// Failure describes a single mismatch between the expected and the actual result of a test case.
+type Failure struct {
+	// Path is the path of the mismatching value, e.g. "deployItems/root/my-item/spec/config/values".
+	Path string `json:"path"`
+	// Message describes the mismatch.
+	Message string `json:"message"`
+	// Expected is the expected value.
+	Expected interface{} `json:"expected,omitempty"`
+	// Actual is the actual value.
+	Actual interface{} `json:"actual,omitempty"`
+}
+
+// TestResult is the result of a single test case.
+type TestResult struct {
+	// Name is the name of the test case.
+	Name string `json:"name"`
+	// File is the file of the test case.
+	File string `json:"file,omitempty"`
+	// Passed is true if the test case passed.
+	Passed bool `json:"passed"`
+	// Failures contains the mismatches of the test case.
+	Failures []Failure `json:"failures,omitempty"`
+	// Error contains an error that prevented the execution of the test case.
+	Error string `json:"error,omitempty"`
+	// Duration is the duration of the test case execution.
+	Duration time.Duration `json:"duration"`
+}
+
+// SuiteResult is the result of all test cases of a blueprint.
+type SuiteResult struct {
+	// Name is the name of the suite, usually the blueprint path.
+	Name string `json:"name"`
+	// Tests is the number of executed test cases.
+	Tests int `json:"tests"`
+	// Failed is the number of failed test cases.
+	Failed int `json:"failed"`
+	// Errors is the number of test cases that could not be executed.
+	Errors int `json:"errors"`
+	// Duration is the duration of the suite execution.
+	Duration time.Duration `json:"duration"`
+	// Results contains the results of the test cases.
+	Results []TestResult `json:"results"`
+}
+
+// Passed returns true if all test cases of the suite passed.
+func (r *SuiteResult) Passed() bool {
+	return r.Failed == 0 && r.Errors == 0
+}
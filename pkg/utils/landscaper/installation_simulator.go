@@ -6,6 +6,7 @@ package landscaper
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"path"
 	"regexp"
@@ -204,6 +205,18 @@ func (s *InstallationSimulator) SetCallbacks(callbacks InstallationSimulatorCall
 	return s
 }
 
+// SetContext sets the context that is used to resolve blueprints and to generate secrets.
+func (s *InstallationSimulator) SetContext(ctx context.Context) *InstallationSimulator {
+	s.blueprintRenderer.WithContext(ctx)
+	return s
+}
+
+// SetGeneratedSecretsStore sets the function that returns the store for the generated secrets of an installation.
+func (s *InstallationSimulator) SetGeneratedSecretsStore(store GeneratedSecretsStoreFunc) *InstallationSimulator {
+	s.blueprintRenderer.WithGeneratedSecretsStore(store)
+	return s
+}
+
 // Run starts the simulation for the given component descriptor, blueprint and imports and returns the calculated exports.
 func (s *InstallationSimulator) Run(componentVersion model.ComponentVersion, blueprint *blueprints.Blueprint, imports map[string]interface{}) (*BlueprintExports, error) {
 	ctx := &ResolvedInstallation{
@@ -10,6 +10,7 @@ import (
 	lserrors "github.com/gardener/landscaper/apis/errors"
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	"github.com/gardener/landscaper/pkg/utils/redact"
 )
 
 type LogHelper struct {
@@ -20,14 +21,18 @@ func (LogHelper) LogErrorAndGetReconcileResult(ctx context.Context, lsError lser
 
 	if lsError == nil {
 		return reconcile.Result{}, nil
-	} else if lserrors.ContainsErrorCode(lsError, lsv1alpha1.ErrorNoRetry) {
-		logger.Info(lsError.Error())
+	}
+
+	// sensitive values must not be logged
+	redactor := redact.FromContext(ctx)
+	if lserrors.ContainsErrorCode(lsError, lsv1alpha1.ErrorNoRetry) {
+		logger.Info(redactor.Redact(lsError.Error()))
 		return reconcile.Result{Requeue: false}, nil
 	} else if lserrors.ContainsErrorCode(lsError, lsv1alpha1.ErrorForInfoOnly) {
-		logger.Info(lsError.Error())
+		logger.Info(redactor.Redact(lsError.Error()))
 		return reconcile.Result{Requeue: true}, nil
 	} else {
-		logger.Error(lsError, lsError.Error())
+		logger.Error(redactor.RedactError(lsError), redactor.Redact(lsError.Error()))
 		return reconcile.Result{Requeue: true}, nil
 	}
 }
@@ -39,7 +44,8 @@ func (LogHelper) LogStandardErrorAndGetReconcileResult(ctx context.Context, err
 		return reconcile.Result{}, nil
 	}
 
-	logger.Error(err, err.Error())
+	redactor := redact.FromContext(ctx)
+	logger.Error(redactor.RedactError(err), redactor.Redact(err.Error()))
 	return reconcile.Result{Requeue: true}, nil
 }
 
@@ -0,0 +1,92 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package maintenancewindow
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+// GetMaintenanceWindows returns the maintenance windows of the context with the given name.
+// No windows are returned if the context name is empty or the context does not exist.
+func GetMaintenanceWindows(ctx context.Context, c client.Reader, namespace, contextName string,
+	readID read_write_layer.ReadID) ([]lsv1alpha1.MaintenanceWindow, error) {
+
+	if len(contextName) == 0 {
+		return nil, nil
+	}
+
+	lsCtx := &lsv1alpha1.Context{}
+	if err := read_write_layer.GetContext(ctx, c, client.ObjectKey{Namespace: namespace, Name: contextName}, lsCtx, readID); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return lsCtx.MaintenanceWindows, nil
+}
+
+// ParseCronSpec parses the cron spec of a maintenance window.
+// The schedule is evaluated in UTC unless the spec defines a time zone with the prefix "CRON_TZ=" or "TZ=".
+func ParseCronSpec(spec string) (cron.Schedule, error) {
+	spec = strings.TrimSpace(spec)
+	if !strings.HasPrefix(spec, "CRON_TZ=") && !strings.HasPrefix(spec, "TZ=") {
+		spec = "CRON_TZ=UTC " + spec
+	}
+	return cron.ParseStandard(spec)
+}
+
+// NextAllowedTime returns the given time if it lies within one of the windows or if there are no windows.
+// Otherwise, it returns the begin of the next window.
+func NextAllowedTime(windows []lsv1alpha1.MaintenanceWindow, t time.Time) (time.Time, error) {
+	if len(windows) == 0 {
+		return t, nil
+	}
+
+	var next time.Time
+	for i, w := range windows {
+		schedule, err := ParseCronSpec(w.CronSpec)
+		if err != nil {
+			return t, fmt.Errorf("invalid cron spec of maintenance window %d: %w", i, err)
+		}
+		if w.Duration.Duration <= 0 {
+			return t, fmt.Errorf("invalid duration of maintenance window %d: duration must be positive", i)
+		}
+
+		// the window is open if it has begun within the last duration
+		if begin := schedule.Next(t.Add(-w.Duration.Duration)); !begin.IsZero() && !begin.After(t) {
+			return t, nil
+		}
+
+		if begin := schedule.Next(t); !begin.IsZero() && (next.IsZero() || begin.Before(next)) {
+			next = begin
+		}
+	}
+
+	if next.IsZero() {
+		return t, fmt.Errorf("none of the maintenance windows begins within the next five years")
+	}
+	return next, nil
+}
+
+// DurationUntilAllowed returns the duration from the given time until the begin of the next window.
+// It returns zero if the given time lies within one of the windows or if there are no windows.
+func DurationUntilAllowed(windows []lsv1alpha1.MaintenanceWindow, t time.Time) (time.Duration, error) {
+	next, err := NextAllowedTime(windows, t)
+	if err != nil {
+		return 0, err
+	}
+	return next.Sub(t), nil
+}
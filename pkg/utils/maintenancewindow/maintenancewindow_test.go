@@ -0,0 +1,118 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package maintenancewindow_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/api"
+	"github.com/gardener/landscaper/pkg/utils/maintenancewindow"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+var _ = Describe("Maintenance Windows", func() {
+
+	// every day from 22:00 to 02:00 UTC
+	nightly := lsv1alpha1.MaintenanceWindow{
+		CronSpec: "0 22 * * *",
+		Duration: lsv1alpha1.Duration{Duration: 4 * time.Hour},
+	}
+
+	// every saturday from 10:00 to 11:00 in Berlin (08:00 to 09:00 UTC in summer)
+	saturday := lsv1alpha1.MaintenanceWindow{
+		CronSpec: "CRON_TZ=Europe/Berlin 0 10 * * 6",
+		Duration: lsv1alpha1.Duration{Duration: time.Hour},
+	}
+
+	// 2024-06-05 is a wednesday
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2024, time.June, day, hour, minute, 0, 0, time.UTC)
+	}
+
+	It("should allow everything if there are no windows", func() {
+		next, err := maintenancewindow.NextAllowedTime(nil, at(5, 12, 0))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(next).To(Equal(at(5, 12, 0)))
+	})
+
+	It("should return the given time within a window", func() {
+		for _, t := range []time.Time{at(5, 22, 0), at(5, 23, 59), at(6, 1, 59)} {
+			next, err := maintenancewindow.NextAllowedTime([]lsv1alpha1.MaintenanceWindow{nightly}, t)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(next).To(Equal(t))
+		}
+	})
+
+	It("should return the begin of the next window outside of a window", func() {
+		next, err := maintenancewindow.NextAllowedTime([]lsv1alpha1.MaintenanceWindow{nightly}, at(6, 2, 0))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(next).To(Equal(at(6, 22, 0)))
+
+		d, err := maintenancewindow.DurationUntilAllowed([]lsv1alpha1.MaintenanceWindow{nightly}, at(6, 12, 0))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(d).To(Equal(10 * time.Hour))
+	})
+
+	It("should return the earliest begin of several windows", func() {
+		windows := []lsv1alpha1.MaintenanceWindow{nightly, saturday}
+
+		next, err := maintenancewindow.NextAllowedTime(windows, at(8, 7, 0))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(next).To(Equal(at(8, 8, 0)))
+
+		next, err = maintenancewindow.NextAllowedTime(windows, at(8, 8, 30))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(next).To(Equal(at(8, 8, 30)))
+	})
+
+	It("should evaluate windows without a time zone in UTC independent of the local time zone", func() {
+		local := time.Local
+		defer func() { time.Local = local }()
+		time.Local = time.FixedZone("UTC+5", 5*60*60)
+
+		// the controllers pass the current time in the local time zone
+		next, err := maintenancewindow.NextAllowedTime([]lsv1alpha1.MaintenanceWindow{nightly}, at(5, 12, 0).In(time.Local))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(next).To(BeTemporally("==", at(5, 22, 0)))
+	})
+
+	It("should fail for an invalid window", func() {
+		_, err := maintenancewindow.NextAllowedTime([]lsv1alpha1.MaintenanceWindow{{CronSpec: "invalid"}}, at(5, 12, 0))
+		Expect(err).To(HaveOccurred())
+
+		_, err = maintenancewindow.NextAllowedTime([]lsv1alpha1.MaintenanceWindow{{CronSpec: "0 22 * * *"}}, at(5, 12, 0))
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should read the windows from the context", func() {
+		ctx := context.Background()
+		lsCtx := &lsv1alpha1.Context{
+			ObjectMeta: metav1.ObjectMeta{Name: "prod", Namespace: "test"},
+			ContextConfiguration: lsv1alpha1.ContextConfiguration{
+				MaintenanceWindows: []lsv1alpha1.MaintenanceWindow{nightly},
+			},
+		}
+		cl := fake.NewClientBuilder().WithScheme(api.LandscaperScheme).WithObjects(lsCtx).Build()
+
+		windows, err := maintenancewindow.GetMaintenanceWindows(ctx, cl, "test", "prod", read_write_layer.R000116)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(windows).To(ConsistOf(nightly))
+
+		windows, err = maintenancewindow.GetMaintenanceWindows(ctx, cl, "test", "missing", read_write_layer.R000116)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(windows).To(BeEmpty())
+
+		windows, err = maintenancewindow.GetMaintenanceWindows(ctx, cl, "test", "", read_write_layer.R000116)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(windows).To(BeEmpty())
+	})
+})
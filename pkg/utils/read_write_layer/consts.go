@@ -153,6 +153,15 @@ const (
 	W000147 WriteID = "w000147"
 	W000148 WriteID = "w000148"
 	W000149 WriteID = "w000149"
+	W000150 WriteID = "w000150"
+	W000151 WriteID = "w000151"
+	W000152 WriteID = "w000152"
+	W000153 WriteID = "w000153"
+	W000154 WriteID = "w000154"
+	W000155 WriteID = "w000155"
+	W000156 WriteID = "w000156"
+	W000157 WriteID = "w000157"
+	W000158 WriteID = "w000158"
 )
 
 type ReadID string
@@ -270,6 +279,16 @@ const (
 	R000108 ReadID = "r000108"
 	R000109 ReadID = "r000109"
 	R000110 ReadID = "r000110"
+	R000111 ReadID = "r000111"
+	R000112 ReadID = "r000112"
+	R000113 ReadID = "r000113"
+	R000114 ReadID = "r000114"
+	R000115 ReadID = "r000115"
+	R000116 ReadID = "r000116"
+	R000117 ReadID = "r000117"
+	R000118 ReadID = "r000118"
+	R000119 ReadID = "r000119"
+	R000120 ReadID = "r000120"
 )
 
 const (
@@ -289,6 +308,7 @@ const (
 	opDIDelete              = "history: deployitem delete"
 	opTargetCreateOrUpdate  = "history: target create or update"
 	opTargetDelete          = "history: target delete"
+	opTargetStatus          = "history: target status update"
 	opSyncObjectCreate      = "history: syncobject create"
 	opSyncObjectSpec        = "history: syncobject update"
 	opSyncObjectDelete      = "history: syncobject delete"
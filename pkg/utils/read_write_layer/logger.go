@@ -10,6 +10,7 @@ import (
 	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	"github.com/gardener/landscaper/pkg/utils/redact"
 )
 
 const (
@@ -41,14 +42,14 @@ func (w *Writer) logContextUpdate(ctx context.Context, writeID WriteID, msg stri
 			lc.KeyResourceVersionNew, resourceVersionNew,
 		)
 	} else if apierrors.IsConflict(err) {
-		message := msg + ": " + err.Error()
+		message := msg + ": " + redact.FromContext(ctx).Redact(err.Error())
 		logger.Info(message,
 			lc.KeyWriteID, writeID,
 			lc.KeyGenerationOld, generationOld,
 			lc.KeyResourceVersionOld, resourceVersionOld,
 		)
 	} else {
-		logger.Error(err, msg,
+		logger.Error(redact.FromContext(ctx).RedactError(err), msg,
 			lc.KeyWriteID, writeID,
 			lc.KeyGenerationOld, generationOld,
 			lc.KeyResourceVersionOld, resourceVersionOld,
@@ -71,14 +72,14 @@ func (w *Writer) logTargetUpdate(ctx context.Context, writeID WriteID, msg strin
 			lc.KeyResourceVersionNew, resourceVersionNew,
 		)
 	} else if apierrors.IsConflict(err) {
-		message := msg + ": " + err.Error()
+		message := msg + ": " + redact.FromContext(ctx).Redact(err.Error())
 		logger.Info(message,
 			lc.KeyWriteID, writeID,
 			lc.KeyGenerationOld, generationOld,
 			lc.KeyResourceVersionOld, resourceVersionOld,
 		)
 	} else {
-		logger.Error(err, msg,
+		logger.Error(redact.FromContext(ctx).RedactError(err), msg,
 			lc.KeyWriteID, writeID,
 			lc.KeyGenerationOld, generationOld,
 			lc.KeyResourceVersionOld, resourceVersionOld,
@@ -107,14 +108,14 @@ func (w *Writer) logSyncObjectUpdateBasic(ctx context.Context, writeID WriteID,
 			lc.KeyResourceVersionNew, resourceVersionNew,
 		)
 	} else if apierrors.IsConflict(err) || (logAlreadyExistsAsInfo && apierrors.IsAlreadyExists(err)) {
-		message := msg + ": " + err.Error()
+		message := msg + ": " + redact.FromContext(ctx).Redact(err.Error())
 		logger.Info(message,
 			lc.KeyWriteID, writeID,
 			lc.KeyGenerationOld, generationOld,
 			lc.KeyResourceVersionOld, resourceVersionOld,
 		)
 	} else {
-		logger.Error(err, msg,
+		logger.Error(redact.FromContext(ctx).RedactError(err), msg,
 			lc.KeyWriteID, writeID,
 			lc.KeyGenerationOld, generationOld,
 			lc.KeyResourceVersionOld, resourceVersionOld,
@@ -137,14 +138,14 @@ func (w *Writer) logDataObjectUpdate(ctx context.Context, writeID WriteID, msg s
 			lc.KeyResourceVersionNew, resourceVersionNew,
 		)
 	} else if apierrors.IsConflict(err) {
-		message := msg + ": " + err.Error()
+		message := msg + ": " + redact.FromContext(ctx).Redact(err.Error())
 		logger.Info(message,
 			lc.KeyWriteID, writeID,
 			lc.KeyGenerationOld, generationOld,
 			lc.KeyResourceVersionOld, resourceVersionOld,
 		)
 	} else {
-		logger.Error(err, msg,
+		logger.Error(redact.FromContext(ctx).RedactError(err), msg,
 			lc.KeyWriteID, writeID,
 			lc.KeyGenerationOld, generationOld,
 			lc.KeyResourceVersionOld, resourceVersionOld,
@@ -172,7 +173,7 @@ func (w *Writer) logInstallationUpdate(ctx context.Context, writeID WriteID, msg
 			lc.KeyResourceVersionNew, resourceVersionNew,
 		)
 	} else if apierrors.IsConflict(err) {
-		message := msg + ": " + err.Error()
+		message := msg + ": " + redact.FromContext(ctx).Redact(err.Error())
 		logger.Info(message,
 			lc.KeyWriteID, writeID,
 			lc.KeyInstallationPhase, installation.Status.InstallationPhase,
@@ -182,7 +183,7 @@ func (w *Writer) logInstallationUpdate(ctx context.Context, writeID WriteID, msg
 			lc.KeyResourceVersionOld, resourceVersionOld,
 		)
 	} else {
-		logger.Error(err, msg,
+		logger.Error(redact.FromContext(ctx).RedactError(err), msg,
 			lc.KeyWriteID, writeID,
 			lc.KeyInstallationPhase, installation.Status.InstallationPhase,
 			lc.KeyJobID, installation.Status.JobID,
@@ -213,7 +214,7 @@ func (w *Writer) logExecutionUpdate(ctx context.Context, writeID WriteID, msg st
 			lc.KeyResourceVersionNew, resourceVersionNew,
 		)
 	} else if apierrors.IsConflict(err) {
-		message := msg + ": " + err.Error()
+		message := msg + ": " + redact.FromContext(ctx).Redact(err.Error())
 		logger.Info(message,
 			lc.KeyWriteID, writeID,
 			lc.KeyExecutionPhase, execution.Status.ExecutionPhase,
@@ -223,7 +224,7 @@ func (w *Writer) logExecutionUpdate(ctx context.Context, writeID WriteID, msg st
 			lc.KeyResourceVersionOld, resourceVersionOld,
 		)
 	} else {
-		logger.Error(err, msg,
+		logger.Error(redact.FromContext(ctx).RedactError(err), msg,
 			lc.KeyWriteID, writeID,
 			lc.KeyExecutionPhase, execution.Status.ExecutionPhase,
 			lc.KeyJobID, execution.Status.JobID,
@@ -255,7 +256,7 @@ func (w *Writer) logDeployItemUpdate(ctx context.Context, writeID WriteID, msg s
 		)
 
 	} else if apierrors.IsConflict(err) {
-		message := msg + ": " + err.Error()
+		message := msg + ": " + redact.FromContext(ctx).Redact(err.Error())
 		logger.Info(message,
 			lc.KeyWriteID, writeID,
 			lc.KeyDeployItemPhase, deployItem.Status.Phase,
@@ -265,7 +266,7 @@ func (w *Writer) logDeployItemUpdate(ctx context.Context, writeID WriteID, msg s
 			lc.KeyResourceVersionOld, resourceVersionOld,
 		)
 	} else {
-		logger.Error(err, msg,
+		logger.Error(redact.FromContext(ctx).RedactError(err), msg,
 			lc.KeyWriteID, writeID,
 			lc.KeyDeployItemPhase, deployItem.Status.Phase,
 			lc.KeyJobID, deployItem.Status.GetJobID(),
@@ -9,6 +9,7 @@ import (
 
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	"github.com/gardener/landscaper/pkg/utils/redact"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 
@@ -74,6 +75,13 @@ func (w *Writer) CreateOrUpdateCoreTarget(ctx context.Context, writeID WriteID,
 	return result, errorWithWriteID(err, writeID)
 }
 
+func (w *Writer) UpdateTargetStatus(ctx context.Context, writeID WriteID, target *lsv1alpha1.Target) error {
+	generationOld, resourceVersionOld := getGenerationAndResourceVersion(target)
+	err := updateStatus(ctx, w.client.Status(), target, writeID, opTargetStatus)
+	w.logTargetUpdate(ctx, writeID, opTargetStatus, target, generationOld, resourceVersionOld, err)
+	return errorWithWriteID(err, writeID)
+}
+
 func (w *Writer) DeleteTarget(ctx context.Context, writeID WriteID, target *lsv1alpha1.Target) error {
 	generationOld, resourceVersionOld := getGenerationAndResourceVersion(target)
 	err := delete(ctx, w.client, target, writeID, opTargetDelete)
@@ -224,7 +232,7 @@ func create(ctx context.Context, c client.Client, object client.Object, writeID
 
 	if debugEnabled {
 		if err != nil {
-			log = log.WithValues(lc.KeyError, err.Error())
+			log = log.WithValues(lc.KeyError, redact.FromContext(ctx).Redact(err.Error()))
 		}
 
 		duration := time.Since(start).Milliseconds()
@@ -254,7 +262,7 @@ func createOrUpdateKubernetes(ctx context.Context, c client.Client, object clien
 
 	if debugEnabled {
 		if err != nil {
-			log = log.WithValues(lc.KeyError, err.Error())
+			log = log.WithValues(lc.KeyError, redact.FromContext(ctx).Redact(err.Error()))
 		}
 
 		duration := time.Since(start).Milliseconds()
@@ -284,7 +292,7 @@ func createOrPatchCore(ctx context.Context, c client.Client, object client.Objec
 
 	if debugEnabled {
 		if err != nil {
-			log = log.WithValues(lc.KeyError, err.Error())
+			log = log.WithValues(lc.KeyError, redact.FromContext(ctx).Redact(err.Error()))
 		}
 
 		duration := time.Since(start).Milliseconds()
@@ -314,7 +322,7 @@ func createOrUpdateCore(ctx context.Context, c client.Client, object client.Obje
 
 	if debugEnabled {
 		if err != nil {
-			log = log.WithValues(lc.KeyError, err.Error())
+			log = log.WithValues(lc.KeyError, redact.FromContext(ctx).Redact(err.Error()))
 		}
 
 		duration := time.Since(start).Milliseconds()
@@ -343,7 +351,7 @@ func update(ctx context.Context, c client.Client, object client.Object, writeID
 
 	if debugEnabled {
 		if err != nil {
-			log = log.WithValues(lc.KeyError, err.Error())
+			log = log.WithValues(lc.KeyError, redact.FromContext(ctx).Redact(err.Error()))
 		}
 
 		duration := time.Since(start).Milliseconds()
@@ -372,7 +380,7 @@ func updateStatus(ctx context.Context, c client.StatusWriter, object client.Obje
 
 	if debugEnabled {
 		if err != nil {
-			log = log.WithValues(lc.KeyError, err.Error())
+			log = log.WithValues(lc.KeyError, redact.FromContext(ctx).Redact(err.Error()))
 		}
 
 		duration := time.Since(start).Milliseconds()
@@ -401,7 +409,7 @@ func delete(ctx context.Context, c client.Client, object client.Object, writeID
 
 	if debugEnabled {
 		if err != nil {
-			log = log.WithValues(lc.KeyError, err.Error())
+			log = log.WithValues(lc.KeyError, redact.FromContext(ctx).Redact(err.Error()))
 		}
 
 		duration := time.Since(start).Milliseconds()
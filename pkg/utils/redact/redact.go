@@ -0,0 +1,205 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redact
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	lserrors "github.com/gardener/landscaper/apis/errors"
+)
+
+const (
+	// Mask is the text that replaces sensitive values.
+	Mask = "[REDACTED]"
+
+	// minValueLength is the minimal length of a value that is redacted.
+	// Shorter values like booleans or small numbers would mask unrelated parts of a message.
+	minValueLength = 4
+)
+
+type contextKey struct{}
+
+// Redactor masks sensitive values in messages.
+// A nil Redactor does not mask anything.
+type Redactor struct {
+	mux    sync.RWMutex
+	values map[string]struct{}
+	// sorted contains the values ordered by descending length so that longer values are masked first.
+	sorted []string
+}
+
+// New creates a new redactor for the given values.
+func New(values ...string) *Redactor {
+	r := &Redactor{
+		values: map[string]struct{}{},
+	}
+	r.Add(values...)
+	return r
+}
+
+// NewContext returns a new context that carries the redactor.
+func NewContext(ctx context.Context, r *Redactor) context.Context {
+	return context.WithValue(ctx, contextKey{}, r)
+}
+
+// FromContext returns the redactor of the context.
+// If the context does not carry a redactor nil is returned which can be safely used.
+func FromContext(ctx context.Context) *Redactor {
+	if ctx == nil {
+		return nil
+	}
+	r, _ := ctx.Value(contextKey{}).(*Redactor)
+	return r
+}
+
+// Add adds sensitive values to the redactor.
+func (r *Redactor) Add(values ...string) {
+	if r == nil {
+		return
+	}
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	changed := false
+	for _, v := range values {
+		if len(v) < minValueLength {
+			continue
+		}
+		if _, ok := r.values[v]; ok {
+			continue
+		}
+		r.values[v] = struct{}{}
+		changed = true
+	}
+	if !changed {
+		return
+	}
+	r.sorted = make([]string, 0, len(r.values))
+	for v := range r.values {
+		r.sorted = append(r.sorted, v)
+	}
+	sort.Slice(r.sorted, func(i, j int) bool {
+		if len(r.sorted[i]) != len(r.sorted[j]) {
+			return len(r.sorted[i]) > len(r.sorted[j])
+		}
+		return r.sorted[i] < r.sorted[j]
+	})
+}
+
+// AddValue adds all leaf values of a structured value to the redactor.
+// Nested string values are additionally added in their json encoded form
+// as they are json encoded when they are part of a serialized object.
+func (r *Redactor) AddValue(value interface{}) {
+	if r == nil {
+		return
+	}
+	r.Add(CollectValues(value)...)
+}
+
+// Len returns the number of sensitive values.
+func (r *Redactor) Len() int {
+	if r == nil {
+		return 0
+	}
+	r.mux.RLock()
+	defer r.mux.RUnlock()
+	return len(r.values)
+}
+
+// Values returns all sensitive values of the redactor.
+func (r *Redactor) Values() []string {
+	if r == nil {
+		return nil
+	}
+	r.mux.RLock()
+	defer r.mux.RUnlock()
+	values := make([]string, len(r.sorted))
+	copy(values, r.sorted)
+	return values
+}
+
+// Redact masks all sensitive values in the given message.
+func (r *Redactor) Redact(message string) string {
+	if r == nil {
+		return message
+	}
+	r.mux.RLock()
+	defer r.mux.RUnlock()
+	for _, v := range r.sorted {
+		message = strings.ReplaceAll(message, v, Mask)
+	}
+	return message
+}
+
+// Contains returns true if the message contains any sensitive value.
+func (r *Redactor) Contains(message string) bool {
+	if r == nil {
+		return false
+	}
+	r.mux.RLock()
+	defer r.mux.RUnlock()
+	for _, v := range r.sorted {
+		if strings.Contains(message, v) {
+			return true
+		}
+	}
+	return false
+}
+
+// RedactError returns an error whose message does not contain sensitive values.
+// The original error is returned if it does not contain any sensitive value so that it can still be unwrapped.
+func (r *Redactor) RedactError(err error) error {
+	if err == nil || !r.Contains(err.Error()) {
+		return err
+	}
+	return errors.New(r.Redact(err.Error()))
+}
+
+// RedactLsError returns a landscaper error whose message does not contain sensitive values.
+// The operation, reason and error codes of the original error are kept.
+func (r *Redactor) RedactLsError(err lserrors.LsError) lserrors.LsError {
+	if err == nil || !r.Contains(err.Error()) {
+		return err
+	}
+	lsErr := err.LandscaperError()
+	return lserrors.NewWrappedError(r.RedactError(err.Unwrap()), lsErr.Operation, lsErr.Reason, r.Redact(lsErr.Message),
+		lserrors.CollectErrorCodes(err)...)
+}
+
+// CollectValues returns the string representation of all leaf values of a structured value.
+func CollectValues(value interface{}) []string {
+	values := make([]string, 0)
+	collectValues(value, &values)
+	return values
+}
+
+func collectValues(value interface{}, values *[]string) {
+	switch v := value.(type) {
+	case nil:
+	case map[string]interface{}:
+		for _, val := range v {
+			collectValues(val, values)
+		}
+	case []interface{}:
+		for _, val := range v {
+			collectValues(val, values)
+		}
+	case string:
+		*values = append(*values, v)
+		if encoded, err := json.Marshal(v); err == nil {
+			// strip the quotes as only the escaped content is part of an encoded object
+			if escaped := string(encoded[1 : len(encoded)-1]); escaped != v {
+				*values = append(*values, escaped)
+			}
+		}
+	default:
+		*values = append(*values, fmt.Sprint(v))
+	}
+}
@@ -0,0 +1,130 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redact_test
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lserrors "github.com/gardener/landscaper/apis/errors"
+	"github.com/gardener/landscaper/pkg/utils/redact"
+)
+
+var _ = Describe("Redactor", func() {
+
+	It("should mask sensitive values", func() {
+		r := redact.New("my-password", "my-pass")
+		Expect(r.Redact("the password is my-password")).To(Equal("the password is " + redact.Mask))
+		Expect(r.Redact("the password is my-pass")).To(Equal("the password is " + redact.Mask))
+		Expect(r.Contains("nothing to hide")).To(BeFalse())
+	})
+
+	It("should ignore short values", func() {
+		r := redact.New("abc", "true")
+		Expect(r.Len()).To(Equal(1))
+		Expect(r.Redact("abc is true")).To(Equal("abc is " + redact.Mask))
+	})
+
+	It("should mask all leaf values and their json encoded form", func() {
+		r := redact.New()
+		r.AddValue(map[string]interface{}{
+			"user": "admin",
+			"cert": "line1\nline2",
+			"nested": []interface{}{
+				map[string]interface{}{"port": 12345},
+			},
+		})
+		Expect(r.Redact(`{"cert":"line1\nline2"}`)).To(Equal(`{"cert":"` + redact.Mask + `"}`))
+		Expect(r.Redact("login admin:12345")).To(Equal("login " + redact.Mask + ":" + redact.Mask))
+	})
+
+	It("should not mask anything with a nil redactor", func() {
+		r := redact.FromContext(context.Background())
+		Expect(r).To(BeNil())
+		r.Add("my-password")
+		Expect(r.Redact("my-password")).To(Equal("my-password"))
+		err := errors.New("my-password")
+		Expect(r.RedactError(err)).To(BeIdenticalTo(err))
+	})
+
+	It("should be carried by a context", func() {
+		ctx := redact.NewContext(context.Background(), redact.New("my-password"))
+		Expect(redact.FromContext(ctx).Redact("my-password")).To(Equal(redact.Mask))
+	})
+
+	It("should keep errors without sensitive values", func() {
+		r := redact.New("my-password")
+		err := errors.New("unrelated")
+		Expect(r.RedactError(err)).To(BeIdenticalTo(err))
+	})
+
+	It("should redact landscaper errors and keep their codes", func() {
+		r := redact.New("my-password")
+		lsErr := lserrors.NewWrappedError(errors.New("invalid my-password"), "op", "reason", "value my-password is invalid",
+			"ERR_CONFIGURATION_PROBLEM")
+		redacted := r.RedactLsError(lsErr)
+		Expect(redacted.Error()).ToNot(ContainSubstring("my-password"))
+		Expect(redacted.LandscaperError().Message).To(Equal("value " + redact.Mask + " is invalid"))
+		Expect(redacted.LandscaperError().Operation).To(Equal("op"))
+		Expect(redacted.LandscaperError().Reason).To(Equal("reason"))
+		Expect(redacted.LandscaperError().Codes).To(ContainElement(lsErr.LandscaperError().Codes[0]))
+	})
+
+	Context("secret", func() {
+
+		var (
+			ctx        context.Context
+			kubeClient client.Client
+		)
+
+		BeforeEach(func() {
+			ctx = context.Background()
+			kubeClient = fake.NewClientBuilder().Build()
+
+			values, err := redact.EncodeValues(redact.New("my-password").Values())
+			Expect(err).ToNot(HaveOccurred())
+			secret := &corev1.Secret{}
+			secret.Name = "exec-deployitems"
+			secret.Namespace = "default"
+			secret.Data = map[string][]byte{
+				lsv1alpha1.SensitiveValuesSecretDataKey: values,
+			}
+			Expect(kubeClient.Create(ctx, secret)).To(Succeed())
+		})
+
+		It("should read the sensitive values of an object from the referenced secret", func() {
+			di := &lsv1alpha1.DeployItem{}
+			di.Namespace = "default"
+			di.Annotations = map[string]string{lsv1alpha1.SensitiveValuesSecretAnnotation: "exec-deployitems"}
+
+			redactCtx, err := redact.NewContextForObject(ctx, kubeClient, di)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(redact.FromContext(redactCtx).Redact("my-password")).To(Equal(redact.Mask))
+		})
+
+		It("should not add a redactor for objects without sensitive values", func() {
+			di := &lsv1alpha1.DeployItem{}
+			di.Namespace = "default"
+
+			redactCtx, err := redact.NewContextForObject(ctx, kubeClient, di)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(redact.FromContext(redactCtx)).To(BeNil())
+		})
+
+		It("should use an empty redactor if the secret does not exist", func() {
+			redactCtx, err := redact.NewContextFromSecret(ctx, kubeClient, client.ObjectKey{Name: "other", Namespace: "default"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(redact.FromContext(redactCtx)).ToNot(BeNil())
+			Expect(redact.FromContext(redactCtx).Len()).To(Equal(0))
+		})
+	})
+})
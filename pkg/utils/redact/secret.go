@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redact
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+)
+
+// EncodeValues encodes sensitive values so that they can be stored in a secret.
+func EncodeValues(values []string) ([]byte, error) {
+	return json.Marshal(values)
+}
+
+// NewContextFromSecret returns a new context that carries a redactor for the sensitive values
+// that are stored in the given secret.
+// A redactor without values is used if the secret does not exist (anymore).
+func NewContextFromSecret(ctx context.Context, kubeClient client.Reader, key client.ObjectKey) (context.Context, error) {
+	secret := &corev1.Secret{}
+	if err := kubeClient.Get(ctx, key, secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			return NewContext(ctx, New()), nil
+		}
+		return ctx, fmt.Errorf("unable to get secret %s with sensitive values: %w", key.String(), err)
+	}
+
+	values := []string{}
+	if data, ok := secret.Data[lsv1alpha1.SensitiveValuesSecretDataKey]; ok {
+		if err := json.Unmarshal(data, &values); err != nil {
+			return ctx, fmt.Errorf("unable to decode sensitive values of secret %s: %w", key.String(), err)
+		}
+	}
+	return NewContext(ctx, New(values...)), nil
+}
+
+// NewContextForObject returns a new context that carries a redactor for the sensitive values of the given object.
+// The sensitive values are read from the secret that is referenced by the SensitiveValuesSecretAnnotation.
+// The given context is returned if the object has no sensitive values.
+func NewContextForObject(ctx context.Context, kubeClient client.Reader, obj metav1.Object) (context.Context, error) {
+	secretName, ok := obj.GetAnnotations()[lsv1alpha1.SensitiveValuesSecretAnnotation]
+	if !ok || len(secretName) == 0 {
+		return ctx, nil
+	}
+	return NewContextFromSecret(ctx, kubeClient, client.ObjectKey{Name: secretName, Namespace: obj.GetNamespace()})
+}
@@ -0,0 +1,241 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rollout
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+)
+
+// TargetState is the state of a target of a rollout in the current job.
+type TargetState int
+
+const (
+	TargetNotStarted TargetState = iota
+	TargetRunning
+	TargetSucceeded
+	TargetFailed
+)
+
+// ObjectState is the state of an object of a target in the current job, e.g. of a deploy item or of a subinstallation.
+type ObjectState struct {
+	Started      bool
+	Finished     bool
+	Succeeded    bool
+	FinishedTime time.Time
+}
+
+// Target is a target of a rollout.
+type Target struct {
+	Key          string
+	State        TargetState
+	FinishedTime time.Time
+}
+
+// NewTarget determines the state of a target from the states of its objects.
+// A target has succeeded if all its objects have succeeded, and it has failed as soon as one of its objects has failed.
+func NewTarget(key string, objects []ObjectState) *Target {
+	target := &Target{Key: key}
+
+	started, running, failed, allFinished := false, false, false, true
+	for _, obj := range objects {
+		if !obj.Started {
+			allFinished = false
+			continue
+		}
+
+		started = true
+		if !obj.Finished {
+			running = true
+			allFinished = false
+			continue
+		}
+
+		if !obj.Succeeded {
+			failed = true
+		}
+		if obj.FinishedTime.After(target.FinishedTime) {
+			target.FinishedTime = obj.FinishedTime
+		}
+	}
+
+	switch {
+	case running:
+		target.State = TargetRunning
+	case failed:
+		target.State = TargetFailed
+	case allFinished:
+		target.State = TargetSucceeded
+	case started:
+		target.State = TargetRunning
+	default:
+		target.State = TargetNotStarted
+	}
+
+	return target
+}
+
+// Result is the evaluation of a rollout.
+type Result struct {
+	// Status describes the progress of the rollout.
+	Status lsv1alpha1.RolloutStatus
+	// Held contains the keys of the targets that must not be started yet.
+	Held sets.Set[string]
+	// Waiting contains the keys of the held targets that are started after the pause between two batches.
+	Waiting sets.Set[string]
+}
+
+// Evaluate groups the targets of a rollout into batches and determines the targets that must not be started yet.
+// The targets must be given in the order of the targets of the rollout.
+func Evaluate(rollout *lsv1alpha1.Rollout, targets []*Target, now time.Time) Result {
+	result := Result{
+		Held:    sets.New[string](),
+		Waiting: sets.New[string](),
+	}
+
+	batches := ComputeBatches(rollout, targets)
+	result.Status = lsv1alpha1.RolloutStatus{
+		Name:    rollout.Name,
+		Batches: len(batches),
+	}
+	status := &result.Status
+
+	allowed := true
+	waiting := false
+	for j, batch := range batches {
+		if !allowed {
+			for _, target := range batch {
+				result.Held.Insert(target.Key)
+				if waiting {
+					result.Waiting.Insert(target.Key)
+				}
+			}
+			continue
+		}
+
+		if !isBatchFinished(batch) {
+			allowed = false
+			continue
+		}
+
+		status.CompletedBatches++
+		var batchFinishedTime time.Time
+		for _, target := range batch {
+			if target.State == TargetFailed {
+				status.FailedTargets = append(status.FailedTargets, target.Key)
+				if len(rollout.Canary) != 0 && target.Key == rollout.Canary {
+					status.Stopped = true
+				}
+			}
+			if target.FinishedTime.After(batchFinishedTime) {
+				batchFinishedTime = target.FinishedTime
+			}
+		}
+
+		if len(status.FailedTargets) > maxFailedTargets(rollout) {
+			status.Stopped = true
+		}
+
+		if status.Stopped {
+			allowed = false
+			continue
+		}
+
+		if j+1 < len(batches) && rollout.PauseBetweenBatches != nil && !batchFinishedTime.IsZero() {
+			nextBatchTime := batchFinishedTime.Add(rollout.PauseBetweenBatches.Duration)
+			if now.Before(nextBatchTime) {
+				status.NextBatchTime = &metav1.Time{Time: nextBatchTime}
+				allowed = false
+				waiting = true
+			}
+		}
+	}
+
+	return result
+}
+
+// ComputeBatches groups the targets of a rollout into batches.
+// If the rollout has a canary, the first batch consists only of the canary.
+func ComputeBatches(rollout *lsv1alpha1.Rollout, targets []*Target) [][]*Target {
+	var canary *Target
+	others := []*Target{}
+	for _, target := range targets {
+		if len(rollout.Canary) != 0 && target.Key == rollout.Canary {
+			canary = target
+		} else {
+			others = append(others, target)
+		}
+	}
+
+	batches := [][]*Target{}
+	if canary != nil {
+		batches = append(batches, []*Target{canary})
+	}
+
+	batchSize := batchSize(rollout, len(others))
+	for start := 0; start < len(others); start += batchSize {
+		end := start + batchSize
+		if end > len(others) {
+			end = len(others)
+		}
+		batches = append(batches, others[start:end])
+	}
+
+	return batches
+}
+
+// DurationUntilNextBatch returns the duration until the next batch of one of the rollouts is started after a pause.
+// It returns zero if no rollout is waiting for its next batch.
+func DurationUntilNextBatch(statuses []lsv1alpha1.RolloutStatus, now time.Time) time.Duration {
+	var delay time.Duration
+	for _, status := range statuses {
+		if status.NextBatchTime == nil {
+			continue
+		}
+		d := status.NextBatchTime.Sub(now)
+		if d <= 0 {
+			d = time.Second
+		}
+		if delay == 0 || d < delay {
+			delay = d
+		}
+	}
+	return delay
+}
+
+// batchSize returns the number of targets of a batch.
+func batchSize(rollout *lsv1alpha1.Rollout, numberOfTargets int) int {
+	if rollout.BatchSize == nil {
+		return max(numberOfTargets, 1)
+	}
+
+	batchSize, err := intstr.GetScaledValueFromIntOrPercent(rollout.BatchSize, numberOfTargets, true)
+	if err != nil || batchSize < 1 {
+		// invalid values are prevented by the validation
+		return 1
+	}
+	return batchSize
+}
+
+func maxFailedTargets(rollout *lsv1alpha1.Rollout) int {
+	if rollout.MaxFailedTargets == nil {
+		return 0
+	}
+	return *rollout.MaxFailedTargets
+}
+
+func isBatchFinished(batch []*Target) bool {
+	for _, target := range batch {
+		if target.State != TargetSucceeded && target.State != TargetFailed {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,66 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing
+
+import (
+	"context"
+	crand "crypto/rand"
+	"encoding/binary"
+	"math/rand"
+	"sync"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+type forcedIDsKey struct{}
+
+// forcedIDs are the ids of the next span that is started with the context.
+type forcedIDs struct {
+	traceID trace.TraceID
+	spanID  trace.SpanID
+}
+
+// withForcedIDs returns a context that forces the id generator to use the given ids for the next span.
+func withForcedIDs(ctx context.Context, traceID trace.TraceID, spanID trace.SpanID) context.Context {
+	return context.WithValue(ctx, forcedIDsKey{}, forcedIDs{traceID: traceID, spanID: spanID})
+}
+
+// idGenerator generates random ids unless the context forces specific ids.
+type idGenerator struct {
+	mux  sync.Mutex
+	rand *rand.Rand
+}
+
+func newIDGenerator() *idGenerator {
+	var seed int64
+	_ = binary.Read(crand.Reader, binary.LittleEndian, &seed)
+	return &idGenerator{rand: rand.New(rand.NewSource(seed))}
+}
+
+// NewIDs returns the trace id and span id of a new root span.
+func (g *idGenerator) NewIDs(ctx context.Context) (trace.TraceID, trace.SpanID) {
+	if ids, ok := ctx.Value(forcedIDsKey{}).(forcedIDs); ok {
+		return ids.traceID, ids.spanID
+	}
+	g.mux.Lock()
+	defer g.mux.Unlock()
+	traceID := trace.TraceID{}
+	_, _ = g.rand.Read(traceID[:])
+	spanID := trace.SpanID{}
+	_, _ = g.rand.Read(spanID[:])
+	return traceID, spanID
+}
+
+// NewSpanID returns the span id of a new child span.
+func (g *idGenerator) NewSpanID(ctx context.Context, traceID trace.TraceID) trace.SpanID {
+	if ids, ok := ctx.Value(forcedIDsKey{}).(forcedIDs); ok && ids.traceID == traceID {
+		return ids.spanID
+	}
+	g.mux.Lock()
+	defer g.mux.Unlock()
+	spanID := trace.SpanID{}
+	_, _ = g.rand.Read(spanID[:])
+	return spanID
+}
@@ -0,0 +1,285 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/utils/redact"
+)
+
+const (
+	// TracerName is the name of the tracer that is used for all landscaper spans.
+	TracerName = "github.com/gardener/landscaper"
+
+	// EnvOTLPEndpoint and EnvOTLPTracesEndpoint are the standard OpenTelemetry environment variables
+	// that configure the endpoint of the otlp exporter. Tracing is only enabled if one of them is set.
+	EnvOTLPEndpoint       = "OTEL_EXPORTER_OTLP_ENDPOINT"
+	EnvOTLPTracesEndpoint = "OTEL_EXPORTER_OTLP_TRACES_ENDPOINT"
+
+	// shutdownTimeout is the time that is granted to export the remaining spans on shutdown.
+	shutdownTimeout = 10 * time.Second
+)
+
+// Attribute keys of landscaper spans.
+const (
+	KeyKind      = attribute.Key("landscaper.kind")
+	KeyNamespace = attribute.Key("landscaper.namespace")
+	KeyName      = attribute.Key("landscaper.name")
+	KeyJobID     = attribute.Key("landscaper.jobID")
+	KeyPhase     = attribute.Key("landscaper.phase")
+	KeyOperation = attribute.Key("landscaper.operation")
+
+	KeyComponentName    = attribute.Key("landscaper.component.name")
+	KeyComponentVersion = attribute.Key("landscaper.component.version")
+)
+
+// Setup configures the global tracer provider with an otlp grpc exporter.
+// The exporter is configured with the standard OpenTelemetry environment variables (OTEL_EXPORTER_OTLP_*).
+// If no otlp endpoint is configured, tracing stays disabled and all spans are no-ops.
+// The returned function flushes the remaining spans and stops the exporter.
+func Setup(ctx context.Context, serviceName string) (func(), error) {
+	if len(os.Getenv(EnvOTLPEndpoint)) == 0 && len(os.Getenv(EnvOTLPTracesEndpoint)) == 0 {
+		return func() {}, nil
+	}
+	exporter, err := otlptracegrpc.New(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create otlp trace exporter: %w", err)
+	}
+	tp := NewTracerProvider(exporter, serviceName)
+	otel.SetTracerProvider(tp)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	logger, _ := logging.FromContextOrNew(ctx, nil)
+	logger.Info("Tracing enabled", "serviceName", serviceName)
+	return func() {
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		if err := tp.Shutdown(shutdownCtx); err != nil {
+			logger.Error(err, "unable to shut down tracer provider")
+		}
+	}, nil
+}
+
+// NewTracerProvider creates a tracer provider that exports the landscaper spans with the given exporter.
+// The provider has to be used for all landscaper spans because the job spans have deterministic ids
+// that can only be set by its id generator.
+func NewTracerProvider(exporter sdktrace.SpanExporter, serviceName string, opts ...sdktrace.TracerProviderOption) *sdktrace.TracerProvider {
+	res := resource.NewSchemaless(attribute.String("service.name", serviceName))
+	opts = append([]sdktrace.TracerProviderOption{
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.AlwaysSample())),
+		sdktrace.WithIDGenerator(newIDGenerator()),
+	}, opts...)
+	return sdktrace.NewTracerProvider(opts...)
+}
+
+// Tracer returns the landscaper tracer of the global tracer provider.
+func Tracer() trace.Tracer {
+	return otel.Tracer(TracerName)
+}
+
+// Object identifies an Installation, Execution or DeployItem in a trace.
+type Object struct {
+	Kind      string
+	Namespace string
+	Name      string
+}
+
+func (o Object) attributes() []attribute.KeyValue {
+	return []attribute.KeyValue{
+		KeyKind.String(o.Kind),
+		KeyNamespace.String(o.Namespace),
+		KeyName.String(o.Name),
+	}
+}
+
+// InstallationObject returns the trace object of an installation and of its parent installation.
+// The parent is nil for root installations.
+func InstallationObject(inst *lsv1alpha1.Installation) (Object, *Object) {
+	obj := Object{Kind: "Installation", Namespace: inst.Namespace, Name: inst.Name}
+	parentName, ok := inst.Labels[lsv1alpha1.EncompassedByLabel]
+	if !ok || len(parentName) == 0 {
+		return obj, nil
+	}
+	return obj, &Object{Kind: "Installation", Namespace: inst.Namespace, Name: parentName}
+}
+
+// ExecutionObject returns the trace object of an execution and of the installation that owns it.
+func ExecutionObject(exec *lsv1alpha1.Execution) (Object, *Object) {
+	obj := Object{Kind: "Execution", Namespace: exec.Namespace, Name: exec.Name}
+	owner := metav1.GetControllerOf(exec)
+	if owner == nil || owner.Kind != "Installation" {
+		return obj, nil
+	}
+	return obj, &Object{Kind: "Installation", Namespace: exec.Namespace, Name: owner.Name}
+}
+
+// DeployItemObject returns the trace object of a deploy item and of the execution that manages it.
+func DeployItemObject(di *lsv1alpha1.DeployItem) (Object, *Object) {
+	obj := Object{Kind: "DeployItem", Namespace: di.Namespace, Name: di.Name}
+	execName, ok := di.Labels[lsv1alpha1.ExecutionManagedByLabel]
+	if !ok || len(execName) == 0 {
+		return obj, nil
+	}
+	return obj, &Object{Kind: "Execution", Namespace: di.Namespace, Name: execName}
+}
+
+// TraceIDFromJobID returns the trace id of a job.
+// All spans of a job share the same trace id which is the uuid of the job.
+func TraceIDFromJobID(jobID string) (trace.TraceID, bool) {
+	id, err := uuid.Parse(jobID)
+	if err != nil {
+		return trace.TraceID{}, false
+	}
+	traceID := trace.TraceID(id)
+	return traceID, traceID.IsValid()
+}
+
+// jobSpanID returns the deterministic span id of the job span of an object.
+// The id can be computed by the object and by all its children without any coordination,
+// so that the spans of all controllers end up in one trace tree.
+func jobSpanID(jobID string, obj Object) trace.SpanID {
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%s/%s/%s/%s", jobID, obj.Kind, obj.Namespace, obj.Name)))
+	var spanID trace.SpanID
+	copy(spanID[:], sum[:len(spanID)])
+	return spanID
+}
+
+// jobSpanContext returns the span context of the job span of an object.
+func jobSpanContext(traceID trace.TraceID, jobID string, obj Object) trace.SpanContext {
+	return trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     jobSpanID(jobID, obj),
+		TraceFlags: trace.FlagsSampled,
+		Remote:     true,
+	})
+}
+
+// StartReconcile starts the span of a reconciliation of an object.
+// The span is a child of the job span of the object, which is recorded with RecordJob when the job is finished.
+func StartReconcile(ctx context.Context, obj Object, jobID string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	attrs := append(obj.attributes(), KeyJobID.String(jobID))
+	if traceID, ok := TraceIDFromJobID(jobID); ok {
+		ctx = trace.ContextWithRemoteSpanContext(ctx, jobSpanContext(traceID, jobID, obj))
+	}
+	opts = append(opts, trace.WithAttributes(attrs...))
+	return Tracer().Start(ctx, "Reconcile "+obj.Kind, opts...)
+}
+
+// RecordJob records the job span of an object that covers the time from the trigger of the job until it finished.
+// The job span of a root object is the root of the trace, all other job spans are children of the job span of their owner.
+func RecordJob(ctx context.Context, obj Object, owner *Object, jobID string, transitionTimes *lsv1alpha1.TransitionTimes,
+	phase string, lastError *lsv1alpha1.Error) {
+
+	traceID, ok := TraceIDFromJobID(jobID)
+	if !ok {
+		return
+	}
+
+	start, end := time.Now(), time.Now()
+	if transitionTimes != nil {
+		if transitionTimes.TriggerTime != nil {
+			start = transitionTimes.TriggerTime.Time
+		}
+		if transitionTimes.FinishedTime != nil {
+			end = transitionTimes.FinishedTime.Time
+		}
+	}
+
+	opts := []trace.SpanStartOption{
+		trace.WithTimestamp(start),
+		trace.WithAttributes(append(obj.attributes(), KeyJobID.String(jobID), KeyPhase.String(phase))...),
+	}
+	if owner != nil {
+		ctx = trace.ContextWithRemoteSpanContext(ctx, jobSpanContext(traceID, jobID, *owner))
+	} else {
+		opts = append(opts, trace.WithNewRoot())
+	}
+	ctx = withForcedIDs(ctx, traceID, jobSpanID(jobID, obj))
+
+	_, span := Tracer().Start(ctx, "Job "+obj.Kind, opts...)
+	if lastError != nil && isFailedPhase(phase) {
+		span.SetStatus(codes.Error, redact.FromContext(ctx).Redact(lastError.Message))
+	}
+	span.End(trace.WithTimestamp(end))
+}
+
+// RecordInstallationJob records the job span of a finished installation.
+func RecordInstallationJob(ctx context.Context, inst *lsv1alpha1.Installation) {
+	obj, owner := InstallationObject(inst)
+	RecordJob(ctx, obj, owner, inst.Status.JobIDFinished, inst.Status.TransitionTimes,
+		string(inst.Status.InstallationPhase), inst.Status.LastError)
+}
+
+// RecordExecutionJob records the job span of a finished execution.
+func RecordExecutionJob(ctx context.Context, exec *lsv1alpha1.Execution) {
+	obj, owner := ExecutionObject(exec)
+	RecordJob(ctx, obj, owner, exec.Status.JobIDFinished, exec.Status.TransitionTimes,
+		string(exec.Status.ExecutionPhase), exec.Status.LastError)
+}
+
+// RecordDeployItemJob records the job span of a finished deploy item.
+func RecordDeployItemJob(ctx context.Context, di *lsv1alpha1.DeployItem) {
+	obj, owner := DeployItemObject(di)
+	RecordJob(ctx, obj, owner, di.Status.JobIDFinished, di.Status.TransitionTimes,
+		string(di.Status.Phase), di.Status.LastError)
+}
+
+// StartSpan starts a child span of the span in the context.
+func StartSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return Tracer().Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// RecordSpan records a child span of the span in the context for an operation that has already finished.
+func RecordSpan(ctx context.Context, name string, start, end time.Time, err error, attrs ...attribute.KeyValue) {
+	_, span := Tracer().Start(ctx, name, trace.WithTimestamp(start), trace.WithAttributes(attrs...))
+	setError(ctx, span, err)
+	span.End(trace.WithTimestamp(end))
+}
+
+// EndSpan ends a span and records the error if it is not nil.
+// Sensitive values are removed from the error message with the redactor of the context.
+func EndSpan(ctx context.Context, span trace.Span, err error) {
+	setError(ctx, span, err)
+	span.End()
+}
+
+// setError records the redacted error at the span and sets its status.
+func setError(ctx context.Context, span trace.Span, err error) {
+	if err == nil {
+		return
+	}
+	msg := redact.FromContext(ctx).Redact(err.Error())
+	span.RecordError(errors.New(msg))
+	span.SetStatus(codes.Error, msg)
+}
+
+func isFailedPhase(phase string) bool {
+	switch phase {
+	case lsv1alpha1.PhaseStringFailed, lsv1alpha1.PhaseStringDeleteFailed:
+		return true
+	}
+	return false
+}
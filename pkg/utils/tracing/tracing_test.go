@@ -0,0 +1,181 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/grpc"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/utils/redact"
+	"github.com/gardener/landscaper/pkg/utils/tracing"
+)
+
+// collector is an in-process otlp trace collector that stores all received spans.
+type collector struct {
+	coltracepb.UnimplementedTraceServiceServer
+	mux   sync.Mutex
+	spans []*tracepb.Span
+}
+
+func (c *collector) Export(_ context.Context, req *coltracepb.ExportTraceServiceRequest) (*coltracepb.ExportTraceServiceResponse, error) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	for _, rs := range req.GetResourceSpans() {
+		for _, ss := range rs.GetScopeSpans() {
+			c.spans = append(c.spans, ss.GetSpans()...)
+		}
+	}
+	return &coltracepb.ExportTraceServiceResponse{}, nil
+}
+
+func (c *collector) getSpan(name, objectName string) *tracepb.Span {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	for _, span := range c.spans {
+		if span.GetName() != name {
+			continue
+		}
+		if len(objectName) == 0 {
+			return span
+		}
+		for _, attr := range span.GetAttributes() {
+			if attr.GetKey() == string(tracing.KeyName) && attr.GetValue().GetStringValue() == objectName {
+				return span
+			}
+		}
+	}
+	return nil
+}
+
+var _ = Describe("Tracing", func() {
+
+	var (
+		ctx       context.Context
+		server    *grpc.Server
+		col       *collector
+		tp        *sdktrace.TracerProvider
+		oldTracer trace.TracerProvider
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		lis, err := net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).ToNot(HaveOccurred())
+		col = &collector{}
+		server = grpc.NewServer()
+		coltracepb.RegisterTraceServiceServer(server, col)
+		go func() {
+			_ = server.Serve(lis)
+		}()
+
+		exporter, err := otlptracegrpc.New(ctx, otlptracegrpc.WithEndpoint(lis.Addr().String()), otlptracegrpc.WithInsecure())
+		Expect(err).ToNot(HaveOccurred())
+		tp = tracing.NewTracerProvider(exporter, "test")
+		oldTracer = otel.GetTracerProvider()
+		otel.SetTracerProvider(tp)
+	})
+
+	AfterEach(func() {
+		otel.SetTracerProvider(oldTracer)
+		Expect(tp.Shutdown(ctx)).To(Succeed())
+		server.Stop()
+	})
+
+	It("should link the spans of all objects of a job in one trace", func() {
+		jobID := uuid.New().String()
+		start := metav1.NewTime(time.Now().Add(-time.Minute))
+		end := metav1.Now()
+		transitionTimes := &lsv1alpha1.TransitionTimes{TriggerTime: &start, FinishedTime: &end}
+
+		root := &lsv1alpha1.Installation{ObjectMeta: metav1.ObjectMeta{Name: "root", Namespace: "default"}}
+		sub := &lsv1alpha1.Installation{ObjectMeta: metav1.ObjectMeta{Name: "sub", Namespace: "default",
+			Labels: map[string]string{lsv1alpha1.EncompassedByLabel: "root"}}}
+		exec := &lsv1alpha1.Execution{ObjectMeta: metav1.ObjectMeta{Name: "exec", Namespace: "default"}}
+		exec.OwnerReferences = []metav1.OwnerReference{*metav1.NewControllerRef(sub, lsv1alpha1.SchemeGroupVersion.WithKind("Installation"))}
+		di := &lsv1alpha1.DeployItem{ObjectMeta: metav1.ObjectMeta{Name: "di", Namespace: "default",
+			Labels: map[string]string{lsv1alpha1.ExecutionManagedByLabel: "exec"}}}
+
+		// reconcile the deploy item with an operation that fails with a sensitive value
+		rctx := redact.NewContext(ctx, redact.New("my-secret-token"))
+		obj, _ := tracing.DeployItemObject(di)
+		rctx, span := tracing.StartReconcile(rctx, obj, jobID)
+		_, applySpan := tracing.StartSpan(rctx, "ApplyManifests")
+		tracing.EndSpan(rctx, applySpan, errors.New("unable to apply with token my-secret-token"))
+		tracing.EndSpan(rctx, span, nil)
+
+		for _, inst := range []*lsv1alpha1.Installation{root, sub} {
+			inst.Status.JobIDFinished = jobID
+			inst.Status.TransitionTimes = transitionTimes
+			inst.Status.InstallationPhase = lsv1alpha1.InstallationPhases.Succeeded
+			tracing.RecordInstallationJob(ctx, inst)
+		}
+		exec.Status.JobIDFinished = jobID
+		exec.Status.TransitionTimes = transitionTimes
+		exec.Status.ExecutionPhase = lsv1alpha1.ExecutionPhases.Succeeded
+		tracing.RecordExecutionJob(ctx, exec)
+		di.Status.JobIDFinished = jobID
+		di.Status.TransitionTimes = transitionTimes
+		di.Status.Phase = lsv1alpha1.DeployItemPhases.Failed
+		di.Status.LastError = &lsv1alpha1.Error{Message: "apply failed"}
+		tracing.RecordDeployItemJob(ctx, di)
+
+		Expect(tp.ForceFlush(ctx)).To(Succeed())
+
+		rootJob := col.getSpan("Job Installation", "root")
+		subJob := col.getSpan("Job Installation", "sub")
+		execJob := col.getSpan("Job Execution", "exec")
+		diJob := col.getSpan("Job DeployItem", "di")
+		diReconcile := col.getSpan("Reconcile DeployItem", "di")
+		apply := col.getSpan("ApplyManifests", "")
+		for _, s := range []*tracepb.Span{rootJob, subJob, execJob, diJob, diReconcile, apply} {
+			Expect(s).ToNot(BeNil())
+		}
+
+		traceID, ok := tracing.TraceIDFromJobID(jobID)
+		Expect(ok).To(BeTrue())
+		for _, s := range []*tracepb.Span{rootJob, subJob, execJob, diJob, diReconcile, apply} {
+			Expect(bytes.Equal(s.GetTraceId(), traceID[:])).To(BeTrue(), "span %s is not part of the job trace", s.GetName())
+		}
+
+		Expect(rootJob.GetParentSpanId()).To(BeEmpty())
+		Expect(subJob.GetParentSpanId()).To(Equal(rootJob.GetSpanId()))
+		Expect(execJob.GetParentSpanId()).To(Equal(subJob.GetSpanId()))
+		Expect(diJob.GetParentSpanId()).To(Equal(execJob.GetSpanId()))
+		Expect(diReconcile.GetParentSpanId()).To(Equal(diJob.GetSpanId()))
+		Expect(apply.GetParentSpanId()).To(Equal(diReconcile.GetSpanId()))
+
+		Expect(rootJob.GetStartTimeUnixNano()).To(Equal(uint64(start.UnixNano())))
+		Expect(rootJob.GetEndTimeUnixNano()).To(Equal(uint64(end.UnixNano())))
+
+		Expect(diJob.GetStatus().GetCode()).To(Equal(tracepb.Status_STATUS_CODE_ERROR))
+		Expect(apply.GetStatus().GetCode()).To(Equal(tracepb.Status_STATUS_CODE_ERROR))
+		Expect(apply.GetStatus().GetMessage()).To(ContainSubstring(redact.Mask))
+		Expect(apply.GetStatus().GetMessage()).ToNot(ContainSubstring("my-secret-token"))
+	})
+
+	It("should start an independent trace for invalid job ids", func() {
+		_, span := tracing.StartReconcile(ctx, tracing.Object{Kind: "Installation", Name: "test"}, "")
+		Expect(span.SpanContext().IsValid()).To(BeTrue())
+		Expect(span.SpanContext().TraceID().IsValid()).To(BeTrue())
+		span.End()
+	})
+})
@@ -6,6 +6,7 @@ package webhook
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 
@@ -13,10 +14,13 @@ import (
 
 	admissionv1 "k8s.io/api/admission/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	lscore "github.com/gardener/landscaper/apis/core"
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/apis/core/v1alpha1/targettypes"
 	"github.com/gardener/landscaper/apis/core/validation"
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	webhooklib "github.com/gardener/landscaper/controller-utils/pkg/webhook"
@@ -43,7 +47,19 @@ var InstallationWebhookLogic webhooklib.WebhookLogic = func(ctx context.Context,
 
 // DEPLOYITEM
 
-var DeployItemWebhookLogic webhooklib.WebhookLogic = func(ctx context.Context, req admission.Request, dec runtime.Decoder) admission.Response {
+// DeployItemWebhookLogic validates deploy items. Approvals of deploy item jobs are denied.
+var DeployItemWebhookLogic webhooklib.WebhookLogic = NewDeployItemWebhookLogic(nil)
+
+// NewDeployItemWebhookLogic returns the validation logic for deploy items.
+// Approvals of deploy item jobs are only allowed for members of one of the given approver groups.
+func NewDeployItemWebhookLogic(approverGroups []string) webhooklib.WebhookLogic {
+	groups := sets.New[string](approverGroups...)
+	return func(ctx context.Context, req admission.Request, dec runtime.Decoder) admission.Response {
+		return validateDeployItem(ctx, req, dec, groups)
+	}
+}
+
+func validateDeployItem(ctx context.Context, req admission.Request, dec runtime.Decoder, approverGroups sets.Set[string]) admission.Response {
 	logger, _ := logging.FromContextOrNew(ctx, []interface{}{lc.KeyMethod, "DeployItemWebhookLogic"})
 
 	di := &lscore.DeployItem{}
@@ -59,8 +75,9 @@ var DeployItemWebhookLogic webhooklib.WebhookLogic = func(ctx context.Context, r
 	}
 
 	// check if the type was updated for update events
+	var oldDi *lscore.DeployItem
 	if req.Operation == admissionv1.Update {
-		oldDi := &lscore.DeployItem{}
+		oldDi = &lscore.DeployItem{}
 		if _, _, err := dec.Decode(req.OldObject.Raw, nil, oldDi); err != nil {
 			logger.Debug("Decoding old failed: " + err.Error())
 			return admission.Errored(http.StatusBadRequest, err)
@@ -71,9 +88,56 @@ var DeployItemWebhookLogic webhooklib.WebhookLogic = func(ctx context.Context, r
 		}
 	}
 
+	if err := validateApproval(req, oldDi, di, approverGroups); err != nil {
+		logger.Debug("Approval denied: " + err.Error())
+		return admission.Errored(http.StatusForbidden, err)
+	}
+
 	return admission.Allowed("DeployItem is valid")
 }
 
+// validateApproval checks that a new or changed approval of a deploy item job is given by a member of an approver group,
+// that the approval is signed with the name of the requesting user, and that the approved job is waiting for the approval.
+func validateApproval(req admission.Request, oldDi, di *lscore.DeployItem, approverGroups sets.Set[string]) *field.Error {
+	approvedJob := di.Annotations[lsv1alpha1.ApprovedJobAnnotation]
+	approvedBy := di.Annotations[lsv1alpha1.ApprovedByAnnotation]
+
+	if oldDi != nil &&
+		approvedJob == oldDi.Annotations[lsv1alpha1.ApprovedJobAnnotation] &&
+		approvedBy == oldDi.Annotations[lsv1alpha1.ApprovedByAnnotation] {
+		// approval unchanged
+		return nil
+	}
+
+	if len(approvedJob) == 0 && len(approvedBy) == 0 {
+		// no approval or approval removed
+		return nil
+	}
+
+	annotationsPath := field.NewPath("metadata", "annotations")
+	if len(approvedJob) == 0 {
+		return field.Required(annotationsPath.Key(lsv1alpha1.ApprovedJobAnnotation),
+			fmt.Sprintf("must be set together with annotation %s", lsv1alpha1.ApprovedByAnnotation))
+	}
+
+	if approvedBy != req.UserInfo.Username {
+		return field.Forbidden(annotationsPath.Key(lsv1alpha1.ApprovedByAnnotation),
+			fmt.Sprintf("must be the name of the approving user %q", req.UserInfo.Username))
+	}
+
+	if !approverGroups.HasAny(req.UserInfo.Groups...) {
+		return field.Forbidden(annotationsPath.Key(lsv1alpha1.ApprovedJobAnnotation),
+			fmt.Sprintf("user %q is not a member of an approver group", req.UserInfo.Username))
+	}
+
+	if approvedJob != di.Status.JobID || string(di.Status.Phase) != lsv1alpha1.PhaseStringWaitingForApproval {
+		return field.Invalid(annotationsPath.Key(lsv1alpha1.ApprovedJobAnnotation), approvedJob,
+			"job is not waiting for an approval")
+	}
+
+	return nil
+}
+
 // EXECUTION
 
 var ExecutionWebhookLogic webhooklib.WebhookLogic = func(ctx context.Context, req admission.Request, dec runtime.Decoder) admission.Response {
@@ -96,20 +160,71 @@ var ExecutionWebhookLogic webhooklib.WebhookLogic = func(ctx context.Context, re
 
 // TARGET
 
-var TargetWebhookLogic webhooklib.WebhookLogic = func(ctx context.Context, req admission.Request, dec runtime.Decoder) admission.Response {
-	logger, _ := logging.FromContextOrNew(ctx, []interface{}{lc.KeyMethod, "ExecutionWebhookLogic"})
+// TargetWebhookLogic validates targets. Targets that use the exec target resolver are denied.
+var TargetWebhookLogic = NewTargetWebhookLogic(nil)
+
+// NewTargetWebhookLogic returns the validation logic for targets.
+// Targets that use the exec target resolver are only allowed if their command is one of the given commands.
+func NewTargetWebhookLogic(allowedExecCommands []string) webhooklib.WebhookLogic {
+	allowed := sets.New[string](allowedExecCommands...)
+	return func(ctx context.Context, req admission.Request, dec runtime.Decoder) admission.Response {
+		logger, _ := logging.FromContextOrNew(ctx, []interface{}{lc.KeyMethod, "TargetWebhookLogic"})
+
+		t := &lscore.Target{}
+		if _, _, err := dec.Decode(req.Object.Raw, nil, t); err != nil {
+			logger.Debug("Decoding failed: " + err.Error())
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+
+		errs := validation.ValidateTarget(t)
+		errs = append(errs, validateExecTargetResolver(t, allowed)...)
+		if len(errs) > 0 {
+			aggErr := errs.ToAggregate().Error()
+			logger.Debug("Validation failed: " + aggErr)
+			return admission.Denied(aggErr)
+		}
+
+		return admission.Allowed("Target is valid")
+	}
+}
+
+// validateExecTargetResolver denies targets that use the exec target resolver with a command that is not allowed.
+func validateExecTargetResolver(t *lscore.Target, allowed sets.Set[string]) field.ErrorList {
+	ref := t.Spec.ResolverRef
+	if ref == nil || ref.Name != targettypes.ExecResolverName {
+		return nil
+	}
+
+	fldPath := field.NewPath("spec", "resolverRef", "config")
+	config := &targettypes.ExecResolverConfig{}
+	if ref.Config != nil {
+		if err := json.Unmarshal(ref.Config.RawMessage, config); err != nil {
+			return field.ErrorList{field.Invalid(fldPath, string(ref.Config.RawMessage), err.Error())}
+		}
+	}
+	if !allowed.Has(config.Command) {
+		return field.ErrorList{field.Forbidden(fldPath.Child("command"),
+			fmt.Sprintf("command %q is not allowed for the exec target resolver", config.Command))}
+	}
+	return nil
+}
+
+// CONTEXT
+
+var ContextWebhookLogic webhooklib.WebhookLogic = func(ctx context.Context, req admission.Request, dec runtime.Decoder) admission.Response {
+	logger, _ := logging.FromContextOrNew(ctx, []interface{}{lc.KeyMethod, "ContextWebhookLogic"})
 
-	t := &lscore.Target{}
-	if _, _, err := dec.Decode(req.Object.Raw, nil, t); err != nil {
+	lsCtx := &lscore.Context{}
+	if _, _, err := dec.Decode(req.Object.Raw, nil, lsCtx); err != nil {
 		logger.Debug("Decoding failed: " + err.Error())
 		return admission.Errored(http.StatusBadRequest, err)
 	}
 
-	if errs := validation.ValidateTarget(t); len(errs) > 0 {
+	if errs := validation.ValidateContext(lsCtx); len(errs) > 0 {
 		aggErr := errs.ToAggregate().Error()
 		logger.Debug("Validation failed: " + aggErr)
 		return admission.Denied(aggErr)
 	}
 
-	return admission.Allowed("Target is valid")
+	return admission.Allowed("Context is valid")
 }
@@ -0,0 +1,266 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook_test
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/apis/core/v1alpha1/targettypes"
+	"github.com/gardener/landscaper/pkg/api"
+	"github.com/gardener/landscaper/pkg/utils/webhook"
+)
+
+var _ = Describe("DeployItem Webhook", func() {
+
+	var (
+		ctx     context.Context
+		decoder runtime.Decoder
+		logic   = webhook.NewDeployItemWebhookLogic([]string{"approvers"})
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		decoder = serializer.NewCodecFactory(api.LandscaperScheme).UniversalDecoder()
+	})
+
+	buildDeployItem := func(phase lsv1alpha1.DeployItemPhase, annotations map[string]string) *lsv1alpha1.DeployItem {
+		di := &lsv1alpha1.DeployItem{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: lsv1alpha1.SchemeGroupVersion.String(),
+				Kind:       "DeployItem",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "di",
+				Namespace:   "test",
+				Annotations: annotations,
+			},
+			Spec: lsv1alpha1.DeployItemSpec{
+				Type: "landscaper.gardener.cloud/mock",
+			},
+			Status: lsv1alpha1.DeployItemStatus{
+				Phase: phase,
+			},
+		}
+		di.Status.SetJobID("job-1")
+		return di
+	}
+
+	buildRequest := func(oldDi, di *lsv1alpha1.DeployItem, username string, groups ...string) admission.Request {
+		raw, err := json.Marshal(di)
+		Expect(err).NotTo(HaveOccurred())
+		oldRaw, err := json.Marshal(oldDi)
+		Expect(err).NotTo(HaveOccurred())
+		return admission.Request{
+			AdmissionRequest: admissionv1.AdmissionRequest{
+				Operation: admissionv1.Update,
+				Object:    runtime.RawExtension{Raw: raw},
+				OldObject: runtime.RawExtension{Raw: oldRaw},
+				UserInfo: authenticationv1.UserInfo{
+					Username: username,
+					Groups:   groups,
+				},
+			},
+		}
+	}
+
+	approval := func(job, user string) map[string]string {
+		return map[string]string{
+			lsv1alpha1.ApprovedJobAnnotation: job,
+			lsv1alpha1.ApprovedByAnnotation:  user,
+		}
+	}
+
+	It("should allow an approval by a member of an approver group", func() {
+		oldDi := buildDeployItem(lsv1alpha1.DeployItemPhases.WaitingForApproval, nil)
+		di := buildDeployItem(lsv1alpha1.DeployItemPhases.WaitingForApproval, approval("job-1", "alice"))
+
+		resp := logic(ctx, buildRequest(oldDi, di, "alice", "developers", "approvers"), decoder)
+		Expect(resp.Allowed).To(BeTrue())
+	})
+
+	It("should deny an approval by a user who is not a member of an approver group", func() {
+		oldDi := buildDeployItem(lsv1alpha1.DeployItemPhases.WaitingForApproval, nil)
+		di := buildDeployItem(lsv1alpha1.DeployItemPhases.WaitingForApproval, approval("job-1", "bob"))
+
+		resp := logic(ctx, buildRequest(oldDi, di, "bob", "developers"), decoder)
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).To(ContainSubstring("not a member of an approver group"))
+	})
+
+	It("should deny an approval which is signed with the name of another user", func() {
+		oldDi := buildDeployItem(lsv1alpha1.DeployItemPhases.WaitingForApproval, nil)
+		di := buildDeployItem(lsv1alpha1.DeployItemPhases.WaitingForApproval, approval("job-1", "alice"))
+
+		resp := logic(ctx, buildRequest(oldDi, di, "bob", "approvers"), decoder)
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).To(ContainSubstring(lsv1alpha1.ApprovedByAnnotation))
+	})
+
+	It("should deny an approval of a job which is not waiting for an approval", func() {
+		oldDi := buildDeployItem(lsv1alpha1.DeployItemPhases.WaitingForApproval, nil)
+		di := buildDeployItem(lsv1alpha1.DeployItemPhases.WaitingForApproval, approval("job-0", "alice"))
+
+		resp := logic(ctx, buildRequest(oldDi, di, "alice", "approvers"), decoder)
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).To(ContainSubstring("not waiting for an approval"))
+
+		oldDi = buildDeployItem(lsv1alpha1.DeployItemPhases.Succeeded, nil)
+		di = buildDeployItem(lsv1alpha1.DeployItemPhases.Succeeded, approval("job-1", "alice"))
+
+		resp = logic(ctx, buildRequest(oldDi, di, "alice", "approvers"), decoder)
+		Expect(resp.Allowed).To(BeFalse())
+	})
+
+	It("should allow updates which do not change the approval", func() {
+		oldDi := buildDeployItem(lsv1alpha1.DeployItemPhases.Succeeded, approval("job-0", "alice"))
+		di := buildDeployItem(lsv1alpha1.DeployItemPhases.Succeeded, approval("job-0", "alice"))
+		di.Labels = map[string]string{"a": "b"}
+
+		resp := logic(ctx, buildRequest(oldDi, di, "landscaper"), decoder)
+		Expect(resp.Allowed).To(BeTrue())
+	})
+
+	It("should deny all approvals if no approver groups are configured", func() {
+		oldDi := buildDeployItem(lsv1alpha1.DeployItemPhases.WaitingForApproval, nil)
+		di := buildDeployItem(lsv1alpha1.DeployItemPhases.WaitingForApproval, approval("job-1", "alice"))
+
+		resp := webhook.DeployItemWebhookLogic(ctx, buildRequest(oldDi, di, "alice", "approvers"), decoder)
+		Expect(resp.Allowed).To(BeFalse())
+	})
+})
+
+var _ = Describe("Context Webhook", func() {
+
+	var (
+		ctx     context.Context
+		decoder runtime.Decoder
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		decoder = serializer.NewCodecFactory(api.LandscaperScheme).UniversalDecoder()
+	})
+
+	buildRequest := func(windows ...lsv1alpha1.MaintenanceWindow) admission.Request {
+		lsCtx := &lsv1alpha1.Context{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: lsv1alpha1.SchemeGroupVersion.String(),
+				Kind:       "Context",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "default",
+				Namespace: "test",
+			},
+			ContextConfiguration: lsv1alpha1.ContextConfiguration{
+				MaintenanceWindows: windows,
+			},
+		}
+		raw, err := json.Marshal(lsCtx)
+		Expect(err).NotTo(HaveOccurred())
+		return admission.Request{
+			AdmissionRequest: admissionv1.AdmissionRequest{
+				Operation: admissionv1.Create,
+				Object:    runtime.RawExtension{Raw: raw},
+			},
+		}
+	}
+
+	It("should allow a context with valid maintenance windows", func() {
+		resp := webhook.ContextWebhookLogic(ctx, buildRequest(lsv1alpha1.MaintenanceWindow{
+			CronSpec: "0 22 * * 1-5",
+			Duration: lsv1alpha1.Duration{Duration: 4 * time.Hour},
+		}), decoder)
+		Expect(resp.Allowed).To(BeTrue())
+	})
+
+	It("should deny a context with an invalid cron spec", func() {
+		resp := webhook.ContextWebhookLogic(ctx, buildRequest(lsv1alpha1.MaintenanceWindow{
+			CronSpec: "every night",
+			Duration: lsv1alpha1.Duration{Duration: 4 * time.Hour},
+		}), decoder)
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).To(ContainSubstring("maintenanceWindows[0].cronSpec"))
+	})
+})
+
+var _ = Describe("Target Webhook", func() {
+
+	var (
+		ctx     context.Context
+		decoder runtime.Decoder
+		logic   = webhook.NewTargetWebhookLogic([]string{"my-plugin"})
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		decoder = serializer.NewCodecFactory(api.LandscaperScheme).UniversalDecoder()
+	})
+
+	buildRequest := func(resolverName, command string) admission.Request {
+		config, err := json.Marshal(targettypes.ExecResolverConfig{
+			Server:  "https://api.example.com",
+			Command: command,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		target := &lsv1alpha1.Target{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: lsv1alpha1.SchemeGroupVersion.String(),
+				Kind:       "Target",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "target",
+				Namespace: "test",
+			},
+			Spec: lsv1alpha1.TargetSpec{
+				Type: targettypes.KubernetesClusterTargetType,
+				ResolverRef: &lsv1alpha1.TargetResolverReference{
+					Name:   resolverName,
+					Config: lsv1alpha1.NewAnyJSONPointer(config),
+				},
+			},
+		}
+		raw, err := json.Marshal(target)
+		Expect(err).NotTo(HaveOccurred())
+		return admission.Request{
+			AdmissionRequest: admissionv1.AdmissionRequest{
+				Operation: admissionv1.Create,
+				Object:    runtime.RawExtension{Raw: raw},
+			},
+		}
+	}
+
+	It("should allow an exec target with an allowed command", func() {
+		resp := logic(ctx, buildRequest(targettypes.ExecResolverName, "my-plugin"), decoder)
+		Expect(resp.Allowed).To(BeTrue())
+	})
+
+	It("should deny an exec target with a command that is not allowed", func() {
+		resp := logic(ctx, buildRequest(targettypes.ExecResolverName, "/bin/sh"), decoder)
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).To(ContainSubstring("spec.resolverRef.config.command"))
+	})
+
+	It("should deny all exec targets by default", func() {
+		resp := webhook.TargetWebhookLogic(ctx, buildRequest(targettypes.ExecResolverName, "my-plugin"), decoder)
+		Expect(resp.Allowed).To(BeFalse())
+	})
+
+	It("should allow targets that use other resolvers", func() {
+		resp := webhook.TargetWebhookLogic(ctx, buildRequest("my-resolver", ""), decoder)
+		Expect(resp.Allowed).To(BeTrue())
+	})
+})